@@ -0,0 +1,52 @@
+package defang_schemes_test
+
+import (
+	"testing"
+
+	"github.com/jakewilliami/defang-schemes"
+	"github.com/jakewilliami/defang-schemes/corpus"
+)
+
+// Defanging every indicator in text, then refanging it, changes nothing
+func TestDefangAllRoundTrip(t *testing.T) {
+	texts := []string{
+		"Beacon (to https://evil.test/x), e.g. 10.0.0.1 or bob@evil.test; see \\\\srv.corp\\c$\\a.exe and evil.example.org.\nversion 1.2.3, i.e. done",
+		"Same as urn:uuid:6e8bc430-9c3a-11d9-9669-0800200c9a66 and URN:ISBN:0451450523",
+	}
+	for _, c := range corpus.Cases() {
+		texts = append(texts, c.Refanged)
+	}
+	for _, text := range texts {
+		if roundTrip := defang_schemes.RefangAll(defang_schemes.DefangAll(text)); roundTrip != text {
+			t.Errorf("RefangAll(DefangAll(%q)) = %q", text, roundTrip)
+		}
+	}
+}
+
+func TestDefangAll(t *testing.T) {
+	cases := []struct {
+		input, want string
+	}{
+		// The rest of a URL is written as it was, whatever its characters
+		{"http://例え.jp/パス", "hxxp[://]例え[.]jp/パス"},
+		{"http://例え.jp/パス#フラグ", "hxxp[://]例え[.]jp/パス#フラグ"},
+		{"http://x.jp/a%20b?q=ü#a%20b", "hxxp[://]x[.]jp/a%20b?q=ü#a%20b"},
+
+		// Indicators that are already defanged are left alone
+		{"hxxp://bad(.)org", "hxxp://bad(.)org"},
+		{"see hxxps://bad[.]org/x and 1[.]2[.]3[.]4", "see hxxps://bad[.]org/x and 1[.]2[.]3[.]4"},
+		{"bob[at]evil[.]com", "bob[at]evil[.]com"},
+
+		// The address of a mailto: URL is defanged as a bare email address is
+		{"mailto:bob@evil.com or bob@evil.com", "mxxlto[:]bob[at]evil[.]com or bob[at]evil[.]com"},
+
+		// File names are not domains
+		{"open report.pdf, foo.exe and readme.txt", "open report.pdf, foo.exe and readme.txt"},
+		{"fetched payload.zip from evil.sh", "fetched payload[.]zip from evil[.]sh"},
+	}
+	for _, c := range cases {
+		if defanged := defang_schemes.DefangAll(c.input); defanged != c.want {
+			t.Errorf("DefangAll(%q) = %q, want %q", c.input, defanged, c.want)
+		}
+	}
+}
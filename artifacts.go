@@ -0,0 +1,24 @@
+package defang_schemes
+
+import "embed"
+
+// Paths of the generated artifacts within Artifacts
+const (
+	// The dataset, as written by WriteJSON (shared with the data package)
+	ARTIFACT_DATASET_JSON = "data/schemes.json"
+	// The dataset, as written by WriteCSV (shared with the data package)
+	ARTIFACT_DATASET_CSV = "data/schemes.csv"
+	// The source of DefangedSchemePattern
+	ARTIFACT_DEFANGED_SCHEME_REGEX = "artifacts/defanged_schemes.regex"
+	// The source of URLPattern
+	ARTIFACT_URL_REGEX = "artifacts/url.regex"
+	// The defang rule of each scheme, as written by WriteDefangRules
+	ARTIFACT_DEFANG_RULES = "artifacts/defang_rules.json"
+)
+
+// The dataset in formats for non-Go consumers, so that programs can serve or write them
+// out at runtime (e.g., at /dataset.json) without regenerating them.  The dataset itself is
+// written to data/ by tools/writeconsts, and the rest to artifacts/ by tools/writeartifacts
+//
+//go:embed artifacts data/schemes.json data/schemes.csv
+var Artifacts embed.FS
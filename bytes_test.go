@@ -0,0 +1,38 @@
+package defang_schemes_test
+
+import (
+	"testing"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+func TestByteAPIsAgreeWithDefangScheme(t *testing.T) {
+	inputs := []string{"", " ", "x", "HTTP", " http ", "z39.50s", "a-b+c", "hτtp", "wxxyz"}
+	for name := range defang_schemes.Schemes() {
+		inputs = append(inputs, name)
+	}
+
+	buf := make([]byte, 0, 64)
+	for _, input := range inputs {
+		want := defang_schemes.DefangScheme(input)
+		if appended := string(defang_schemes.AppendDefangedScheme(buf, input)); appended != want {
+			t.Errorf("AppendDefangedScheme(buf, %q) = %q, want %q", input, appended, want)
+		}
+		if defanged := string(defang_schemes.DefangSchemeBytes([]byte(input))); defanged != want {
+			t.Errorf("DefangSchemeBytes(%q) = %q, want %q", input, defanged, want)
+		}
+	}
+}
+
+func TestAppendDefangedSchemeDoesNotAllocate(t *testing.T) {
+	schemes := defang_schemes.PermanentSchemes()
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		for _, scheme := range schemes {
+			defang_schemes.AppendDefangedScheme(buf[:0], scheme.Scheme)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("AppendDefangedScheme allocated %v times per run", allocs)
+	}
+}
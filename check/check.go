@@ -0,0 +1,187 @@
+// Safety checks on defanged schemes
+//
+// The invariants that tools/defangcheck enforces over the generated data, as functions
+// returning structured reports, so that custom registries (and overrides of the generated
+// defanged forms) can be validated programmatically:
+//
+//	report := check.Registry(registry)
+//	for _, violation := range report.Failures() {
+//		log.Println(violation)
+//	}
+package check
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jakewilliami/defang-schemes"
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+	"github.com/jakewilliami/defang-schemes/internal/invariants"
+)
+
+type Scheme = defang_schemes.Scheme
+
+// An invariant of defanged schemes
+type Invariant int
+
+const (
+	// A defanged scheme must not be a registered scheme, so that defanged URIs are not
+	// still valid
+	DefangedIsNotScheme = Invariant(invariants.DefangedIsNotScheme)
+	// No two schemes may share a defanged form, so that refanging is unambiguous
+	OneToOne = Invariant(invariants.OneToOne)
+)
+
+func (i Invariant) String() string {
+	switch i {
+	case DefangedIsNotScheme:
+		return "defanged-is-not-scheme"
+	case OneToOne:
+		return "one-to-one"
+	default:
+		return fmt.Sprintf("Invariant(%d)", int(i))
+	}
+}
+
+// A scheme whose defanged form breaks an invariant
+type Violation struct {
+	Invariant Invariant
+	Scheme    string
+	Defanged  string
+	// The registered scheme that the defanged form is (DefangedIsNotScheme), or the other
+	// schemes sharing it (OneToOne), sorted
+	Conflicts []string
+	// Whether every scheme involved is a known edge case (see defang_schemes.IsEdgeCase, and
+	// WithAllowed), which is reported but accepted
+	EdgeCase bool
+}
+
+// Configuration for the checks
+type config struct {
+	allowed func(scheme string) bool
+}
+
+// Option to configure the checks
+type Option func(*config)
+
+// Accept violations between the given schemes as edge cases, rather than those of the
+// generated data (see defang_schemes.IsEdgeCase), as Registry.Validate does with
+// defang_schemes.WithAllowedCollisions.  Call with no schemes to accept no violations
+func WithAllowed(schemes ...string) Option {
+	allowed := make(map[string]bool, len(schemes))
+	for _, scheme := range schemes {
+		allowed[ascii.ToLower(scheme)] = true
+	}
+	return func(cfg *config) {
+		cfg.allowed = func(scheme string) bool {
+			return allowed[scheme]
+		}
+	}
+}
+
+func newConfig(opts []Option) *config {
+	cfg := &config{allowed: defang_schemes.IsEdgeCase}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+func (v Violation) Error() string {
+	switch v.Invariant {
+	case DefangedIsNotScheme:
+		return fmt.Sprintf("defanged scheme \"%s\" of \"%s\" is still a valid scheme", v.Defanged, v.Scheme)
+	default:
+		return fmt.Sprintf("defanged scheme \"%s\" of \"%s\" is duplicated, meaning that re-fanging would be ambiguous due to the following offenders: %s", v.Defanged, v.Scheme, strings.Join(v.Conflicts, ", "))
+	}
+}
+
+// As a warning, for violations accepted as edge cases
+func (v Violation) Warning() defang_schemes.Warning {
+	kind := defang_schemes.WarningDefangedIsScheme
+	if v.Invariant == OneToOne {
+		kind = defang_schemes.WarningDefangAmbiguous
+	}
+	return defang_schemes.Warning{Kind: kind, Scheme: v.Scheme, Defanged: v.Defanged, Message: v.Error(), Err: v}
+}
+
+// The violations found by a check, sorted by invariant, then by scheme
+type Report struct {
+	Violations []Violation
+}
+
+// Whether no invariant is broken, other than by known edge cases
+func (r Report) OK() bool {
+	return len(r.Failures()) == 0
+}
+
+// Violations that are not known edge cases
+func (r Report) Failures() []Violation {
+	var failures []Violation
+	for _, violation := range r.Violations {
+		if !violation.EdgeCase {
+			failures = append(failures, violation)
+		}
+	}
+	return failures
+}
+
+// Violations accepted as known edge cases, as warnings
+func (r Report) Warnings() []defang_schemes.Warning {
+	var warnings []defang_schemes.Warning
+	for _, violation := range r.Violations {
+		if violation.EdgeCase {
+			warnings = append(warnings, violation.Warning())
+		}
+	}
+	return warnings
+}
+
+// Check that no defanged form of the schemes is itself one of the schemes
+func DefangedSchemesAreNotValid(schemes []Scheme, opts ...Option) Report {
+	return newReport(invariants.DefangedIsNotSchemeViolations(entries(schemes), newConfig(opts).allowed))
+}
+
+// Check that there exists a one-to-one mapping between the schemes and their defanged forms
+func DefangedSchemesAreOneToOne(schemes []Scheme, opts ...Option) Report {
+	return newReport(invariants.OneToOneViolations(entries(schemes), newConfig(opts).allowed))
+}
+
+// Check every invariant over the schemes
+func Schemes(schemes []Scheme, opts ...Option) Report {
+	var report Report
+	report.Violations = append(report.Violations, DefangedSchemesAreNotValid(schemes, opts...).Violations...)
+	report.Violations = append(report.Violations, DefangedSchemesAreOneToOne(schemes, opts...).Violations...)
+	return report
+}
+
+// Check every invariant over the schemes of the registry
+func Registry(registry *defang_schemes.Registry, opts ...Option) Report {
+	var schemes []Scheme
+	for _, scheme := range registry.Schemes() {
+		schemes = append(schemes, scheme)
+	}
+	return Schemes(schemes, opts...)
+}
+
+func entries(schemes []Scheme) []invariants.Entry {
+	entries := make([]invariants.Entry, len(schemes))
+	for i, scheme := range schemes {
+		entries[i] = invariants.Entry{Scheme: scheme.Scheme, Defanged: scheme.DefangedScheme}
+	}
+	return entries
+}
+
+func newReport(violations []invariants.Violation) Report {
+	var report Report
+	for _, violation := range violations {
+		report.Violations = append(report.Violations, Violation{
+			Invariant: Invariant(violation.Invariant),
+			Scheme:    violation.Scheme,
+			Defanged:  violation.Defanged,
+			Conflicts: violation.Conflicts,
+			EdgeCase:  violation.Allowed,
+		})
+	}
+	return report
+}
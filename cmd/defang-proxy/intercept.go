@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// How long the certificates minted for intercepted hosts are valid, and how long before
+// they expire that they are minted again
+const (
+	CERTIFICATE_VALIDITY = 7 * 24 * time.Hour
+	CERTIFICATE_RENEWAL  = time.Hour
+)
+
+// Mints certificates for the hosts of intercepted (CONNECT) requests, signed by a CA that
+// the browsers using the proxy have been configured to trust
+type certificateAuthority struct {
+	certificate *x509.Certificate
+	key         crypto.PrivateKey
+	// Every minted certificate shares a key, as generating one per host is slow
+	leafKey *ecdsa.PrivateKey
+
+	mu     sync.Mutex
+	minted map[string]*tls.Certificate
+}
+
+// Use a CA certificate and its key, as loaded by tls.LoadX509KeyPair, to intercept TLS.
+// The certificate must be a CA allowed to sign certificates
+func newCertificateAuthority(ca tls.Certificate) (*certificateAuthority, error) {
+	if len(ca.Certificate) == 0 {
+		return nil, errors.New("no CA certificate given")
+	}
+	certificate, err := x509.ParseCertificate(ca.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("could not parse CA certificate: %w", err)
+	}
+	if !certificate.IsCA || certificate.KeyUsage&x509.KeyUsageCertSign == 0 {
+		return nil, fmt.Errorf("certificate for %q is not a CA allowed to sign certificates", certificate.Subject.CommonName)
+	}
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate key: %w", err)
+	}
+	return &certificateAuthority{
+		certificate: certificate,
+		key:         ca.PrivateKey,
+		leafKey:     leafKey,
+		minted:      make(map[string]*tls.Certificate),
+	}, nil
+}
+
+// A certificate for the host (a domain name or an IP address), minted on first use and
+// cached until it is close to expiring.  It expires no later than the CA
+func (ca *certificateAuthority) certificateFor(host string) (*tls.Certificate, error) {
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+
+	now := time.Now()
+	// A certificate that expires with the CA could not be renewed for longer
+	if minted, ok := ca.minted[host]; ok && (now.Add(CERTIFICATE_RENEWAL).Before(minted.Leaf.NotAfter) || !minted.Leaf.NotAfter.Before(ca.certificate.NotAfter)) {
+		return minted, nil
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("could not generate serial number: %w", err)
+	}
+	notAfter := now.Add(CERTIFICATE_VALIDITY)
+	if ca.certificate.NotAfter.Before(notAfter) {
+		notAfter = ca.certificate.NotAfter
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: host},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		template.IPAddresses = []net.IP{ip}
+	} else {
+		template.DNSNames = []string{host}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, &ca.leafKey.PublicKey, ca.key)
+	if err != nil {
+		return nil, fmt.Errorf("could not mint certificate for %q: %w", host, err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse certificate for %q: %w", host, err)
+	}
+	minted := &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: ca.leafKey, Leaf: leaf}
+	ca.minted[host] = minted
+	return minted, nil
+}
+
+// Intercept a CONNECT request: take over the connection, terminate TLS with a certificate
+// for the requested host, and serve the requests sent through the tunnel with the handler,
+// as requests for absolute https: URLs on that host
+func (ca *certificateAuthority) intercept(w http.ResponseWriter, r *http.Request, handler http.Handler) {
+	target := r.URL.Host
+	hostname, _, err := net.SplitHostPort(target)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid CONNECT target %q", target), http.StatusBadRequest)
+		return
+	}
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "CONNECT is not supported over this connection", http.StatusInternalServerError)
+		return
+	}
+	conn, _, err := hijacker.Hijack()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if _, err := conn.Write([]byte("HTTP/1.1 200 Connection Established\r\n\r\n")); err != nil {
+		conn.Close()
+		return
+	}
+
+	tlsConn := tls.Server(conn, &tls.Config{
+		GetCertificate: func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+			// The certificate is for the host the tunnel was opened to, whatever name the
+			// client asks for, as that is where its requests are sent
+			return ca.certificateFor(hostname)
+		},
+		// The tunnel is served by net/http's HTTP/1.1 server
+		NextProtos: []string{"http/1.1"},
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		fmt.Printf("[WARN] TLS handshake for %s failed: %s\n", target, err)
+		conn.Close()
+		return
+	}
+
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.URL.Scheme = "https"
+			r.URL.Host = target
+			handler.ServeHTTP(w, r)
+		}),
+		ReadHeaderTimeout: time.Minute,
+		IdleTimeout:       2 * time.Minute,
+	}
+	server.Serve(newConnListener(tlsConn))
+}
+
+// A listener that accepts a single connection, then blocks until that connection is closed
+type connListener struct {
+	conn     net.Conn
+	accepted bool
+	closed   chan struct{}
+}
+
+// A connection that signals its listener when it is closed
+type listenedConn struct {
+	net.Conn
+	once   sync.Once
+	closed chan struct{}
+}
+
+func (c *listenedConn) Close() error {
+	err := c.Conn.Close()
+	c.once.Do(func() { close(c.closed) })
+	return err
+}
+
+func newConnListener(conn net.Conn) *connListener {
+	closed := make(chan struct{})
+	return &connListener{conn: &listenedConn{Conn: conn, closed: closed}, closed: closed}
+}
+
+// Accept is only called from http.Server.Serve's loop, so needs no lock
+func (l *connListener) Accept() (net.Conn, error) {
+	if !l.accepted {
+		l.accepted = true
+		return l.conn, nil
+	}
+	<-l.closed
+	return nil, net.ErrClosed
+}
+
+func (l *connListener) Close() error {
+	return nil
+}
+
+func (l *connListener) Addr() net.Addr {
+	return l.conn.LocalAddr()
+}
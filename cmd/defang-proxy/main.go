@@ -0,0 +1,416 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jakewilliami/defang-schemes"
+	"golang.org/x/net/html"
+)
+
+// Attributes of HTML elements whose values are URLs that may link out of the page
+var LINK_ATTRIBUTES = []string{
+	"href", "src", "action", "formaction", "cite", "poster", "background", "longdesc",
+	"lowsrc", "dynsrc", "data", "codebase", "manifest", "icon", "xlink:href",
+}
+
+// Attributes whose values are lists of URLs: space-separated, or image candidates
+// ("a.png 1x, b.png 2x")
+var (
+	SPACE_SEPARATED_LINK_ATTRIBUTES = []string{"ping", "archive"}
+	SRCSET_ATTRIBUTES               = []string{"srcset", "imagesrcset"}
+)
+
+// Elements whose content the tokeniser takes as raw text, but which browsers parse as
+// markup when scripting (or frames, or embeds) are disabled, as they may be in a sandbox
+var MARKUP_RAW_TEXT_ELEMENTS = []string{"noscript", "noembed", "noframes"}
+
+// Whether the link begins with a scheme, as browsers parse it.  Unlike ExtractScheme, this
+// includes single letters, which browsers do not take for drive letters
+func hasScheme(link string) bool {
+	i := strings.IndexByte(link, ':')
+	return i > 0 && defang_schemes.IsValidScheme(link[:i])
+}
+
+// Whether the link is a network-path reference ("//example.com/"), which leaves the page's
+// host without giving a scheme.  Browsers also take backslashes for slashes
+func isNetworkPath(link string) bool {
+	return len(link) >= 2 && (link[0] == '/' || link[0] == '\\') && (link[1] == '/' || link[1] == '\\')
+}
+
+// Defang a link, returning false if it is left as it is.  Relative links resolve back
+// through the proxy, so only links with a scheme, or to another host, are defanged; links
+// that cannot be parsed are emptied, so that they are not left clickable
+func defangLink(value string, base *url.URL) (string, bool) {
+	// Browsers strip leading and trailing spaces and controls from links, and ignore tabs
+	// and newlines within them ("java\tscript:")
+	link := strings.TrimFunc(value, func(r rune) bool { return r <= ' ' })
+	link = strings.Map(func(r rune) rune {
+		if r == '\t' || r == '\n' || r == '\r' {
+			return -1
+		}
+		return r
+	}, link)
+
+	switch {
+	case isNetworkPath(link):
+		scheme := "https"
+		if base != nil && base.Scheme != "" {
+			scheme = base.Scheme
+		}
+		link = scheme + "://" + link[2:]
+	case !hasScheme(link):
+		return value, false
+	}
+
+	defanged, err := defang_schemes.DefangURL(link, defang_schemes.WithNeutraliseScripts())
+	if err != nil {
+		return "", true
+	}
+	return defanged, true
+}
+
+// Defang the URLs of the image candidates of a srcset, parsed as browsers do: each URL is a
+// run of non-space characters (so may contain commas), followed by an optional descriptor
+// up to the next comma outside parentheses
+func defangSrcset(value string, base *url.URL) (string, bool) {
+	var candidates []string
+	changed := false
+	rest := value
+	for {
+		rest = strings.TrimLeft(rest, " \t\n\f\r,")
+		if rest == "" {
+			break
+		}
+		end := strings.IndexAny(rest, " \t\n\f\r")
+		if end < 0 {
+			end = len(rest)
+		}
+		link := rest[:end]
+		rest = rest[end:]
+
+		descriptor := ""
+		if trimmed := strings.TrimRight(link, ","); trimmed != link {
+			link = trimmed
+		} else {
+			depth, i := 0, 0
+		descriptor:
+			for ; i < len(rest); i++ {
+				switch rest[i] {
+				case '(':
+					depth++
+				case ')':
+					depth = max(depth-1, 0)
+				case ',':
+					if depth == 0 {
+						break descriptor
+					}
+				}
+			}
+			descriptor = strings.TrimSpace(rest[:i])
+			rest = rest[i:]
+		}
+
+		if defanged, ok := defangLink(link, base); ok {
+			changed = true
+			if defanged == "" {
+				continue
+			}
+			link = defanged
+		}
+		if descriptor != "" {
+			link += " " + descriptor
+		}
+		candidates = append(candidates, link)
+	}
+	return strings.Join(candidates, ", "), changed
+}
+
+// Defang the URL of a refresh directive ("5; url=https://example.com/"), as given by a
+// Refresh header, or by a meta element with http-equiv="refresh"
+func defangRefresh(value string, base *url.URL) (string, bool) {
+	i := strings.IndexAny(value, ";,")
+	if i < 0 {
+		return value, false
+	}
+	delay, link := strings.TrimSpace(value[:i]), strings.TrimSpace(value[i+1:])
+	if len(link) > 3 && strings.EqualFold(link[:3], "url") {
+		if after := strings.TrimSpace(link[3:]); strings.HasPrefix(after, "=") {
+			link = strings.TrimSpace(after[1:])
+		}
+	}
+	link = strings.Trim(link, `"'`)
+
+	defanged, ok := defangLink(link, base)
+	if !ok {
+		return value, false
+	}
+	if defanged == "" {
+		return delay, true
+	}
+	return delay + "; url=" + defanged, true
+}
+
+// Defang the links in the attributes of a start tag, returning whether any changed
+func defangTag(token *html.Token, base *url.URL) bool {
+	refresh := token.Data == "meta" && slices.ContainsFunc(token.Attr, func(attr html.Attribute) bool {
+		return attr.Key == "http-equiv" && strings.EqualFold(strings.TrimSpace(attr.Val), "refresh")
+	})
+
+	changed := false
+	for i, attr := range token.Attr {
+		var defanged string
+		var ok bool
+		switch {
+		case slices.Contains(LINK_ATTRIBUTES, attr.Key):
+			defanged, ok = defangLink(attr.Val, base)
+		case slices.Contains(SRCSET_ATTRIBUTES, attr.Key):
+			defanged, ok = defangSrcset(attr.Val, base)
+		case slices.Contains(SPACE_SEPARATED_LINK_ATTRIBUTES, attr.Key):
+			links := strings.Fields(attr.Val)
+			for j, link := range links {
+				if defangedLink, defangedOK := defangLink(link, base); defangedOK {
+					links[j], ok = defangedLink, true
+				}
+			}
+			defanged = strings.Join(links, " ")
+		case refresh && attr.Key == "content":
+			defanged, ok = defangRefresh(attr.Val, base)
+		}
+		if ok {
+			token.Attr[i].Val = defanged
+			changed = true
+		}
+	}
+	return changed
+}
+
+// Defang the outbound links of an HTML document.  The document is tokenised as browsers
+// would, so that unquoted and entity-encoded attribute values are found; tags whose links
+// are defanged are written out again, and everything else is passed through as it was
+func defangLinks(body []byte, base *url.URL) []byte {
+	var out bytes.Buffer
+	tokenizer := html.NewTokenizer(bytes.NewReader(body))
+	markup := false
+	for {
+		tokenType := tokenizer.Next()
+		switch tokenType {
+		case html.ErrorToken:
+			// The whole document has been read, as it is held in memory
+			return out.Bytes()
+		case html.StartTagToken, html.SelfClosingTagToken:
+			// Token unescapes attribute values in place, so the raw tag must be kept first
+			raw := bytes.Clone(tokenizer.Raw())
+			token := tokenizer.Token()
+			markup = tokenType == html.StartTagToken && slices.Contains(MARKUP_RAW_TEXT_ELEMENTS, token.Data)
+			if defangTag(&token, base) {
+				out.WriteString(token.String())
+			} else {
+				out.Write(raw)
+			}
+			continue
+		case html.TextToken:
+			if markup {
+				out.Write(defangLinks(tokenizer.Raw(), base))
+				markup = false
+				continue
+			}
+		default:
+			markup = false
+		}
+		out.Write(tokenizer.Raw())
+	}
+}
+
+// Content-Encodings that readBody decodes
+var DECODABLE_ENCODINGS = []string{"", "identity", "gzip", "x-gzip", "deflate"}
+
+func isDecodable(encoding string) bool {
+	return slices.ContainsFunc(DECODABLE_ENCODINGS, func(decodable string) bool {
+		return strings.EqualFold(strings.TrimSpace(encoding), decodable)
+	})
+}
+
+// Read a response body to rewrite it, decoding its Content-Encoding.  The transport only
+// decodes encodings it asked for, but upstreams may send others anyway; those that cannot
+// be decoded are rejected, as the body could not be rewritten
+func readBody(resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
+
+	var r io.Reader = resp.Body
+	switch encoding := strings.TrimSpace(resp.Header.Get("Content-Encoding")); {
+	case encoding == "" || strings.EqualFold(encoding, "identity"):
+	case strings.EqualFold(encoding, "gzip") || strings.EqualFold(encoding, "x-gzip"):
+		gz, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode response body: %w", err)
+		}
+		r = gz
+	case strings.EqualFold(encoding, "deflate"):
+		deflate, err := zlib.NewReader(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode response body: %w", err)
+		}
+		r = deflate
+	default:
+		return nil, fmt.Errorf("cannot rewrite response body with Content-Encoding %q", encoding)
+	}
+
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("could not read response body: %w", err)
+	}
+	resp.Header.Del("Content-Encoding")
+	return body, nil
+}
+
+// Rewrite HTML responses so that their outbound links are defanged, as are those of Refresh
+// headers.  Other responses are passed through, whatever their encoding.  Responses without
+// a Content-Type are sniffed, as browsers would render them as HTML if they look like it;
+// those that cannot be decoded to sniff are passed through as opaque data, which browsers
+// are told not to sniff
+func modifyResponse(resp *http.Response) error {
+	var base *url.URL
+	if resp.Request != nil {
+		base = resp.Request.URL
+	}
+	if refresh := resp.Header.Get("Refresh"); refresh != "" {
+		if defanged, ok := defangRefresh(refresh, base); ok {
+			resp.Header.Set("Refresh", defanged)
+		}
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if contentType != "" && mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return nil
+	}
+	if contentType == "" && !isDecodable(resp.Header.Get("Content-Encoding")) {
+		resp.Header.Set("Content-Type", "application/octet-stream")
+		resp.Header.Set("X-Content-Type-Options", "nosniff")
+		return nil
+	}
+
+	body, err := readBody(resp)
+	if err != nil {
+		return err
+	}
+	if contentType == "" && strings.HasPrefix(http.DetectContentType(body), "text/html") {
+		contentType = "text/html"
+	}
+	if contentType != "" {
+		body = defangLinks(body, base)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+
+	return nil
+}
+
+// Serve as a forward proxy, or as a reverse proxy in front of the upstream URL if given.
+// As a forward proxy, CONNECT requests are intercepted with the CA if given, and refused
+// otherwise.  Requests are sent with the transport, or http.DefaultTransport if nil
+func newProxy(upstream *url.URL, ca *certificateAuthority, transport http.RoundTripper) http.Handler {
+	proxy := &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			if upstream != nil {
+				r.SetURL(upstream)
+			} else {
+				r.Out.URL = r.In.URL
+				r.Out.Host = r.In.URL.Host
+			}
+			r.SetXForwarded()
+
+			// We need to read the response body to rewrite it, so leave the transport to ask
+			// for (and decode) the encodings it supports
+			r.Out.Header.Del("Accept-Encoding")
+		},
+		ModifyResponse: modifyResponse,
+		Transport:      transport,
+	}
+
+	var handler http.HandlerFunc
+	handler = func(w http.ResponseWriter, r *http.Request) {
+		// Tunnelled (HTTPS) traffic can only be rewritten if it is intercepted; otherwise, it
+		// must not pass through a sanitising proxy
+		if r.Method == http.MethodConnect {
+			if upstream != nil || ca == nil {
+				http.Error(w, "CONNECT is not supported: tunnelled responses cannot be defanged without -ca-cert and -ca-key", http.StatusMethodNotAllowed)
+				return
+			}
+			ca.intercept(w, r, handler)
+			return
+		}
+		if upstream == nil && !r.URL.IsAbs() {
+			http.Error(w, "forward proxy requests must use an absolute URL", http.StatusBadRequest)
+			return
+		}
+		proxy.ServeHTTP(w, r)
+	}
+	return handler
+}
+
+func main() {
+	listen := flag.String("listen", "127.0.0.1:8080", "address to listen on; as a forward proxy, HTTPS (CONNECT) is only proxied if intercepted with -ca-cert and -ca-key")
+	upstreamRaw := flag.String("upstream", "", "act as a reverse proxy for this URL (e.g., https://example.com), which may be HTTPS; if empty, act as a forward proxy")
+	caCert := flag.String("ca-cert", "", "as a forward proxy, intercept HTTPS with certificates signed by this CA certificate (PEM), which browsers using the proxy must trust")
+	caKey := flag.String("ca-key", "", "private key (PEM) of the -ca-cert CA")
+	flag.Parse()
+
+	if (*caCert == "") != (*caKey == "") {
+		fmt.Printf("[ERROR] -ca-cert and -ca-key must be given together\n")
+		os.Exit(1)
+	}
+	if *caCert != "" && *upstreamRaw != "" {
+		fmt.Printf("[ERROR] -ca-cert and -ca-key only apply to forward proxy mode, without -upstream\n")
+		os.Exit(1)
+	}
+
+	var ca *certificateAuthority
+	if *caCert != "" {
+		pair, err := tls.LoadX509KeyPair(*caCert, *caKey)
+		if err == nil {
+			ca, err = newCertificateAuthority(pair)
+		}
+		if err != nil {
+			fmt.Printf("[ERROR] Invalid CA: %s\n", err)
+			os.Exit(1)
+		}
+	}
+
+	var upstream *url.URL
+	switch {
+	case *upstreamRaw != "":
+		var err error
+		upstream, err = url.Parse(*upstreamRaw)
+		if err != nil || upstream.Scheme == "" || upstream.Host == "" {
+			fmt.Printf("[ERROR] Invalid upstream URL \"%s\"\n", *upstreamRaw)
+			os.Exit(1)
+		}
+		fmt.Printf("[INFO] Reverse proxying %s on %s\n", upstream, *listen)
+	case ca != nil:
+		fmt.Printf("[INFO] Forward proxying HTTP, and HTTPS intercepted with the CA \"%s\", on %s\n", ca.certificate.Subject.CommonName, *listen)
+	default:
+		fmt.Printf("[INFO] Forward proxying plain HTTP on %s\n", *listen)
+	}
+
+	err := http.ListenAndServe(*listen, newProxy(upstream, ca, nil))
+	if err != nil {
+		fmt.Printf("[ERROR] %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+var base = &url.URL{Scheme: "http", Host: "page.test", Path: "/dir/"}
+
+func TestDefangLinks(t *testing.T) {
+	cases := []struct {
+		input, want string
+	}{
+		{`<a href="https://evil.test/x">x</a>`, `<a href="hxxps[://]evil[.]test/x">x</a>`},
+		{`<a href=https://evil.test/x>x</a>`, `<a href="hxxps[://]evil[.]test/x">x</a>`},
+		{`<a href="//evil.test/">x</a>`, `<a href="hxxp[://]evil[.]test/">x</a>`},
+		{`<a href="java&#x09;script:alert(1)">x</a>`, `<a href="jxxascript[:][neutralised]alert(1)">x</a>`},
+		{`<img srcset="https://a.test/1.png 1x, https://b.test/2.png 2x">`, `<img srcset="hxxps[://]a[.]test/1.png 1x, hxxps[://]b[.]test/2.png 2x">`},
+		{`<meta http-equiv="refresh" content="0; url=https://evil.test/">`, `<meta http-equiv="refresh" content="0; url=hxxps[://]evil[.]test/">`},
+		{`<noscript><a href="https://evil.test/">x</a></noscript>`, `<noscript><a href="hxxps[://]evil[.]test/">x</a></noscript>`},
+
+		// Relative links resolve back through the proxy, and only attributes are links
+		{`<a HREF='/local' class=x>x</a>`, `<a HREF='/local' class=x>x</a>`},
+		{`<p>https://evil.test/ in text</p>`, `<p>https://evil.test/ in text</p>`},
+		{`<script>var u = "<a href='https://evil.test/'>";</script>`, `<script>var u = "<a href='https://evil.test/'>";</script>`},
+	}
+	for _, c := range cases {
+		if defanged := string(defangLinks([]byte(c.input), base)); defanged != c.want {
+			t.Errorf("defangLinks(%q) = %q, want %q", c.input, defanged, c.want)
+		}
+	}
+}
+
+func TestDefangSrcset(t *testing.T) {
+	cases := []struct {
+		input, want string
+		changed     bool
+	}{
+		{"a.png 1x, https://b.test/2.png 2x", "a.png 1x, hxxps[://]b[.]test/2.png 2x", true},
+		{"https://a.test/x,1.png 1x", "hxxps[://]a[.]test/x,1.png 1x", true},
+		{"https://a.test/i(1).png 100w, /b.png 200w", "hxxps[://]a[.]test/i(1).png 100w, /b.png 200w", true},
+		{"javascript:x 1x", "jxxascript[:][neutralised]x 1x", true},
+		{"a.png 1x, b.png 2x", "a.png 1x, b.png 2x", false},
+		{"", "", false},
+	}
+	for _, c := range cases {
+		if defanged, changed := defangSrcset(c.input, base); defanged != c.want || changed != c.changed {
+			t.Errorf("defangSrcset(%q) = %q, %v, want %q, %v", c.input, defanged, changed, c.want, c.changed)
+		}
+	}
+}
+
+func TestDefangRefresh(t *testing.T) {
+	cases := []struct {
+		input, want string
+		changed     bool
+	}{
+		{"5; url=https://evil.test/", "5; url=hxxps[://]evil[.]test/", true},
+		{"0;URL='https://evil.test/'", "0; url=hxxps[://]evil[.]test/", true},
+		{"0, https://evil.test/", "0; url=hxxps[://]evil[.]test/", true},
+		{"0; url=javascript:alert(1)", "0; url=jxxascript[:][neutralised]alert(1)", true},
+		// Links that cannot be parsed are dropped, leaving the delay
+		{"0; url=http://[bad", "0", true},
+		{"5; url=/local", "5; url=/local", false},
+		{"5", "5", false},
+	}
+	for _, c := range cases {
+		if defanged, changed := defangRefresh(c.input, base); defanged != c.want || changed != c.changed {
+			t.Errorf("defangRefresh(%q) = %q, %v, want %q, %v", c.input, defanged, changed, c.want, c.changed)
+		}
+	}
+}
+
+func TestModifyResponseEncodings(t *testing.T) {
+	var gzipped bytes.Buffer
+	gz := gzip.NewWriter(&gzipped)
+	gz.Write([]byte(`<a href="https://evil.test/">x</a>`))
+	gz.Close()
+
+	cases := []struct {
+		contentType, encoding, body string
+		want                        string
+		wantErr                     bool
+	}{
+		{"text/html", "gzip", gzipped.String(), `<a href="hxxps[://]evil[.]test/">x</a>`, false},
+		// Responses that are not HTML pass through, whatever their encoding
+		{"image/png", "br", "\x1b\x00\x00", "\x1b\x00\x00", false},
+		{"", "br", "\x1b\x00\x00", "\x1b\x00\x00", false},
+		// HTML that cannot be decoded cannot be rewritten
+		{"text/html", "br", "\x1b\x00\x00", "", true},
+	}
+	for _, c := range cases {
+		resp := &http.Response{
+			Header:  http.Header{},
+			Body:    io.NopCloser(strings.NewReader(c.body)),
+			Request: &http.Request{URL: base},
+		}
+		if c.contentType != "" {
+			resp.Header.Set("Content-Type", c.contentType)
+		}
+		resp.Header.Set("Content-Encoding", c.encoding)
+
+		err := modifyResponse(resp)
+		if (err != nil) != c.wantErr {
+			t.Errorf("modifyResponse(%q, %q) error = %v, want error %v", c.contentType, c.encoding, err, c.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if body, _ := io.ReadAll(resp.Body); string(body) != c.want {
+			t.Errorf("modifyResponse(%q, %q) body = %q, want %q", c.contentType, c.encoding, body, c.want)
+		}
+		if c.contentType == "" && resp.Header.Get("X-Content-Type-Options") != "nosniff" {
+			t.Errorf("modifyResponse(%q, %q) lets browsers sniff an undecodable body", c.contentType, c.encoding)
+		}
+	}
+}
+
+// A CA for tests, and a pool trusting it
+func newTestCA(t *testing.T) (*certificateAuthority, *x509.CertPool) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "defang-proxy test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca, err := newCertificateAuthority(tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.certificate)
+	return ca, pool
+}
+
+func TestCertificateFor(t *testing.T) {
+	ca, pool := newTestCA(t)
+	for _, host := range []string{"evil.test", "127.0.0.1", "::1"} {
+		minted, err := ca.certificateFor(host)
+		if err != nil {
+			t.Fatalf("certificateFor(%q): %v", host, err)
+		}
+		if _, err := minted.Leaf.Verify(x509.VerifyOptions{DNSName: host, Roots: pool}); err != nil {
+			t.Errorf("certificate for %q does not verify: %v", host, err)
+		}
+		// The certificate may not outlive the CA
+		if minted.Leaf.NotAfter.After(ca.certificate.NotAfter) {
+			t.Errorf("certificate for %q expires at %s, after the CA", host, minted.Leaf.NotAfter)
+		}
+		if again, _ := ca.certificateFor(host); again != minted {
+			t.Errorf("certificate for %q was minted again", host)
+		}
+	}
+
+	// Only CAs may sign certificates
+	leaf, _ := ca.certificateFor("evil.test")
+	if _, err := newCertificateAuthority(*leaf); err == nil {
+		t.Errorf("newCertificateAuthority accepted a certificate that is not a CA")
+	}
+}
+
+// Through an intercepting forward proxy, the links of HTTPS pages are defanged
+func TestInterceptConnect(t *testing.T) {
+	upstream := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		io.WriteString(w, `<a href="https://evil.test/x">x</a>`)
+	}))
+	defer upstream.Close()
+
+	ca, pool := newTestCA(t)
+	proxy := httptest.NewServer(newProxy(nil, ca, upstream.Client().Transport))
+	defer proxy.Close()
+	proxyURL, _ := url.Parse(proxy.URL)
+	client := &http.Client{Transport: &http.Transport{
+		Proxy:           http.ProxyURL(proxyURL),
+		TLSClientConfig: &tls.Config{RootCAs: pool},
+	}}
+
+	// Twice, over one tunnel
+	for range 2 {
+		resp, err := client.Get(upstream.URL + "/page")
+		if err != nil {
+			t.Fatalf("GET %s through the proxy: %v", upstream.URL, err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if want := `<a href="hxxps[://]evil[.]test/x">x</a>`; string(body) != want {
+			t.Errorf("GET %s through the proxy = %q, want %q", upstream.URL, body, want)
+		}
+	}
+
+	// Without a CA, tunnels are refused
+	refusing := httptest.NewServer(newProxy(nil, nil, upstream.Client().Transport))
+	defer refusing.Close()
+	refusingURL, _ := url.Parse(refusing.URL)
+	client.Transport.(*http.Transport).Proxy = http.ProxyURL(refusingURL)
+	if _, err := client.Get(upstream.URL); err == nil {
+		t.Errorf("GET %s through a proxy without a CA succeeded", upstream.URL)
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+func runCompletionsData(args []string) error {
+	flags := flag.NewFlagSet("completions-data", flag.ExitOnError)
+	format := flags.String("format", "tsv", "output format: tsv (scheme<TAB>description) or json")
+	flags.Parse(args)
+
+	completions := defang_schemes.Completions()
+	switch *format {
+	case "tsv":
+		for _, completion := range completions {
+			fmt.Printf("%s\t%s\n", completion.Scheme, completion.Description)
+		}
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(completions)
+	default:
+		return fmt.Errorf("unknown format \"%s\"", *format)
+	}
+
+	return nil
+}
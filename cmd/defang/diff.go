@@ -0,0 +1,79 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/jakewilliami/defang-schemes"
+	"github.com/jakewilliami/defang-schemes/snapshots"
+)
+
+// Name of the dataset compiled into this binary, for use in place of a file or snapshot
+const CURRENT_DATASET = "current"
+
+// Load a dataset from a JSON file (as written by defang_schemes.WriteJSON), a snapshot
+// name, or CURRENT_DATASET
+func loadDataset(name string) (map[string]defang_schemes.Scheme, error) {
+	if name == CURRENT_DATASET {
+		return defang_schemes.Schemes(), nil
+	}
+
+	file, err := os.Open(name)
+	if err != nil {
+		if schemes, ok := snapshots.Get(name); ok {
+			return schemes, nil
+		}
+		return nil, fmt.Errorf("%s is neither a dataset file nor a snapshot (available snapshots: %v)", name, snapshots.Names())
+	}
+	defer file.Close()
+
+	schemes, err := defang_schemes.LoadFromJSON(file)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load %s: %w", name, err)
+	}
+	return schemes, nil
+}
+
+func runDiff(args []string) error {
+	flags := flag.NewFlagSet("diff", flag.ExitOnError)
+	format := flags.String("format", "text", "output format: text or json")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: defang diff [flags] OLD NEW\n\nOLD and NEW are JSON dataset files, snapshot names, or %q\n\n", CURRENT_DATASET)
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	if flags.NArg() != 2 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	old, err := loadDataset(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	new, err := loadDataset(flags.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	changelog := defang_schemes.DiffSchemes(old, new)
+	switch *format {
+	case "text":
+		if changelog.Empty() {
+			fmt.Println("No changes")
+			return nil
+		}
+		fmt.Print(changelog.String())
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(changelog)
+	default:
+		return fmt.Errorf("unknown format \"%s\"", *format)
+	}
+
+	return nil
+}
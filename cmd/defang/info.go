@@ -0,0 +1,70 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jakewilliami/defang-schemes"
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+)
+
+func runInfo(args []string) error {
+	flags := flag.NewFlagSet("info", flag.ExitOnError)
+	dataset := flags.String("dataset", CURRENT_DATASET, "JSON dataset file, snapshot name, or \""+CURRENT_DATASET+"\"")
+	flags.Parse(args)
+	if flags.NArg() == 0 {
+		return errors.New("usage: defang info [-dataset name] <scheme>...")
+	}
+
+	schemes, err := loadDataset(*dataset)
+	if err != nil {
+		return err
+	}
+
+	for i, name := range flags.Args() {
+		scheme, ok := schemes[ascii.ToLower(name)]
+		if !ok {
+			return fmt.Errorf("%w: \"%s\"", defang_schemes.ErrUnknownScheme, name)
+		}
+		if i > 0 {
+			fmt.Println()
+		}
+		printScheme(scheme)
+	}
+	return nil
+}
+
+// Print each field of the scheme that has a value, with each example alongside its
+// defanged form
+func printScheme(scheme defang_schemes.Scheme) {
+	field := func(name, value string) {
+		if value != "" {
+			fmt.Printf("%-12s %s\n", name+":", value)
+		}
+	}
+	field("scheme", scheme.Scheme)
+	field("defanged", scheme.DefangedScheme)
+	field("status", string(scheme.Status))
+	field("description", scheme.Description)
+	field("template", scheme.Template)
+	field("wellknown", scheme.WellKnownUriSupport)
+	field("reference", scheme.Reference)
+	field("notes", scheme.Notes)
+	if scheme.Obsolete {
+		field("obsolete", strconv.FormatBool(scheme.Obsolete))
+	}
+	field("related", strings.Join(scheme.Related, ", "))
+	for i, example := range scheme.Examples {
+		label := ""
+		if i == 0 {
+			label = "examples:"
+		}
+		if defanged, err := defang_schemes.DefangURL(example); err == nil {
+			example += " → " + defanged
+		}
+		fmt.Printf("%-12s %s\n", label, example)
+	}
+}
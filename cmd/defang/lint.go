@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+const UNDEFANGED_URL_RULE = "undefanged-url"
+
+// A live (fanged) URL found in a report
+type Finding struct {
+	Path     string
+	Line     int
+	Column   int // In Unicode code points, starting at 1
+	URL      string
+	Defanged string
+}
+
+// Find URLs with registered schemes that have not been defanged
+func lintReport(path string, r io.Reader) ([]Finding, error) {
+	var findings []Finding
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := scanner.Text()
+		for _, loc := range defang_schemes.URLPattern().FindAllStringIndex(line, -1) {
+			// Trailing punctuation is more likely prose than part of the URL
+			match := strings.TrimRight(line[loc[0]:loc[1]], ".,;:!?)")
+			// URLs whose scheme separator is already defanged ("[:]//") are not findings
+			if i := strings.IndexByte(match, ':'); i <= 0 || strings.IndexByte("[({", match[i-1]) >= 0 {
+				continue
+			}
+			if _, ok := defang_schemes.SchemeOf(match); !ok {
+				continue
+			}
+			defanged, err := defang_schemes.DefangURL(match)
+			if err != nil {
+				continue
+			}
+			findings = append(findings, Finding{
+				Path:     path,
+				Line:     lineNumber,
+				Column:   utf8.RuneCountInString(line[:loc[0]]) + 1,
+				URL:      match,
+				Defanged: defanged,
+			})
+		}
+	}
+
+	return findings, scanner.Err()
+}
+
+func (f Finding) Message() string {
+	return fmt.Sprintf("un-defanged URL %q; use %q", f.URL, f.Defanged)
+}
+
+func runReportLint(args []string) error {
+	flags := flag.NewFlagSet("report-lint", flag.ExitOnError)
+	format := flags.String("format", "text", "output format: text or sarif")
+	flags.Parse(args)
+
+	paths := flags.Args()
+	if len(paths) == 0 {
+		paths = []string{"-"}
+	}
+
+	var findings []Finding
+	for _, path := range paths {
+		var r io.Reader = os.Stdin
+		if path != "-" {
+			file, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			r = file
+		}
+
+		found, err := lintReport(path, r)
+		if err != nil {
+			return fmt.Errorf("cannot read %s: %w", path, err)
+		}
+		findings = append(findings, found...)
+	}
+
+	switch *format {
+	case "text":
+		for _, finding := range findings {
+			fmt.Printf("%s:%d:%d: %s\n", finding.Path, finding.Line, finding.Column, finding.Message())
+		}
+	case "sarif":
+		err := writeSARIF(os.Stdout, findings)
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown format \"%s\"", *format)
+	}
+
+	if len(findings) > 0 {
+		os.Exit(1)
+	}
+	return nil
+}
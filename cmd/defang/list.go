@@ -0,0 +1,59 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+func runList(args []string) error {
+	flags := flag.NewFlagSet("list", flag.ExitOnError)
+	where := flags.String("where", "", "filter expression, e.g. 'status=permanent && wellknown=true'")
+	dataset := flags.String("dataset", CURRENT_DATASET, "JSON dataset file, snapshot name, or \""+CURRENT_DATASET+"\"")
+	format := flags.String("format", "tsv", "output format: tsv (scheme<TAB>defanged<TAB>status), json, or csv")
+	flags.Parse(args)
+
+	var filter Filter
+	if *where != "" {
+		var err error
+		filter, err = ParseFilter(*where)
+		if err != nil {
+			return err
+		}
+	}
+
+	schemes, err := loadDataset(*dataset)
+	if err != nil {
+		return err
+	}
+	matched := make(map[string]defang_schemes.Scheme)
+	for name, scheme := range schemes {
+		if filter == nil || filter.Match(scheme) {
+			matched[name] = scheme
+		}
+	}
+
+	switch *format {
+	case "tsv":
+		names := make([]string, 0, len(matched))
+		for name := range matched {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			scheme := matched[name]
+			fmt.Printf("%s\t%s\t%s\n", scheme.Scheme, scheme.DefangedScheme, scheme.Status)
+		}
+	case "json":
+		return defang_schemes.WriteJSON(os.Stdout, matched)
+	case "csv":
+		return defang_schemes.WriteCSV(os.Stdout, matched)
+	default:
+		return fmt.Errorf("unknown format \"%s\"", *format)
+	}
+
+	return nil
+}
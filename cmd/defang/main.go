@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+)
+
+// A subcommand of the defang CLI
+type Command struct {
+	Summary string
+	Run     func(args []string) error
+}
+
+var commands = map[string]Command{
+	"completions-data": {
+		Summary: "print scheme names with one-line descriptions, for shell and editor completions",
+		Run:     runCompletionsData,
+	},
+	"diff": {
+		Summary: "print scheme-level differences between two datasets",
+		Run:     runDiff,
+	},
+	"info": {
+		Summary: "print every field of the given schemes, with examples",
+		Run:     runInfo,
+	},
+	"list": {
+		Summary: "list schemes, optionally filtered by an expression over their fields",
+		Run:     runList,
+	},
+	"refang": {
+		Summary: "refang text, choosing (or asking) between schemes that defang alike",
+		Run:     runRefang,
+	},
+	"report-lint": {
+		Summary: "find URLs in reports that have not been defanged",
+		Run:     runReportLint,
+	},
+	"verify": {
+		Summary: "report content that does not survive defanging and refanging byte-for-byte",
+		Run:     runVerify,
+	},
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage: defang <command> [flags] [args]")
+	fmt.Fprintln(os.Stderr, "\nCommands:")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-18s %s\n", name, commands[name].Summary)
+	}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	command, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "[ERROR] Unknown command \"%s\"\n\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+
+	err := command.Run(os.Args[2:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %s\n", err)
+		os.Exit(1)
+	}
+}
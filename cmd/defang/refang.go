@@ -0,0 +1,211 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jakewilliami/defang-schemes"
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+)
+
+// What to do with a defanged scheme that more than one scheme of the dataset defangs into
+var AMBIGUITY_POLICIES = []string{"fail", "skip", "first", "ask"}
+
+// Resolves defanged schemes against a dataset, applying an ambiguity policy
+type Resolver struct {
+	// Defanged form → schemes, sorted
+	candidates map[string][]string
+	policy     string
+	// Choices made interactively for all occurrences of a defanged scheme
+	choices map[string]string
+	prompt  *bufio.Reader
+	// Where the current URL was found, for prompts and errors
+	location string
+}
+
+// Build the reverse lookup of the dataset.  As with RefangScheme, a scheme that defangs
+// to itself is not a candidate where other schemes defang to the same form
+func NewResolver(schemes map[string]defang_schemes.Scheme, policy string) *Resolver {
+	all := make(map[string][]defang_schemes.Scheme)
+	for _, scheme := range schemes {
+		all[scheme.DefangedScheme] = append(all[scheme.DefangedScheme], scheme)
+	}
+
+	candidates := make(map[string][]string, len(all))
+	for defanged, from := range all {
+		var names []string
+		for _, scheme := range from {
+			if len(from) == 1 || scheme.Scheme != scheme.DefangedScheme {
+				names = append(names, scheme.Scheme)
+			}
+		}
+		sort.Strings(names)
+		candidates[defanged] = names
+	}
+	return &Resolver{candidates: candidates, policy: policy, choices: make(map[string]string)}
+}
+
+// Refang a defanged scheme, for defang_schemes.RefangURLWith
+func (r *Resolver) Refang(defanged string) (string, error) {
+	key := ascii.ToLower(strings.TrimSpace(defanged))
+	candidates := r.candidates[key]
+	switch len(candidates) {
+	case 0:
+		return "", fmt.Errorf("%w: %q", defang_schemes.ErrUnknownDefangedScheme, defanged)
+	case 1:
+		return candidates[0], nil
+	}
+
+	ambiguous := &defang_schemes.AmbiguousDefangedSchemeError{Defanged: defanged, Candidates: candidates}
+	switch r.policy {
+	case "first":
+		return candidates[0], nil
+	case "ask":
+		if choice, ok := r.choices[key]; ok {
+			if choice == "" {
+				return "", ambiguous
+			}
+			return choice, nil
+		}
+		return r.ask(key, candidates, ambiguous)
+	default:
+		return "", ambiguous
+	}
+}
+
+// Prompt for the scheme of an ambiguous occurrence on the terminal, as input may be
+// standard input.  An answer suffixed with "!" applies to every later occurrence
+func (r *Resolver) ask(key string, candidates []string, ambiguous error) (string, error) {
+	if r.prompt == nil {
+		tty, err := os.Open("/dev/tty")
+		if err != nil {
+			return "", fmt.Errorf("cannot prompt for %s: %w", ambiguous, err)
+		}
+		r.prompt = bufio.NewReader(tty)
+	}
+
+	for {
+		fmt.Fprintf(os.Stderr, "%s: defanged scheme \"%s\" is ambiguous\n", r.location, key)
+		for i, candidate := range candidates {
+			fmt.Fprintf(os.Stderr, "  %d) %s\n", i+1, candidate)
+		}
+		fmt.Fprintf(os.Stderr, "  s) skip (leave defanged)\n")
+		fmt.Fprintf(os.Stderr, "Choice (add \"!\" to apply to all, e.g. \"1!\"): ")
+
+		answer, err := r.prompt.ReadString('\n')
+		if err != nil && (err != io.EOF || answer == "") {
+			return "", fmt.Errorf("cannot prompt for %s: %w", ambiguous, err)
+		}
+		answer = strings.TrimSpace(answer)
+		all := strings.HasSuffix(answer, "!")
+		answer = strings.TrimSuffix(answer, "!")
+
+		choice := ""
+		if answer != "s" {
+			i, err := strconv.Atoi(answer)
+			if err != nil || i < 1 || i > len(candidates) {
+				fmt.Fprintf(os.Stderr, "[WARN] Invalid choice \"%s\"\n", answer)
+				continue
+			}
+			choice = candidates[i-1]
+		}
+		if all {
+			r.choices[key] = choice
+		}
+		if choice == "" {
+			return "", ambiguous
+		}
+		return choice, nil
+	}
+}
+
+// Refang the URLs of a line, returning an error for an ambiguous scheme under the fail
+// policy.  URLs are found and trimmed as by RefangText
+func (r *Resolver) refangLine(line string) (string, error) {
+	var failure error
+	refanged := defang_schemes.URLPattern().ReplaceAllStringFunc(line, func(match string) string {
+		trimmed := strings.TrimRight(match, ".,;:!?)")
+		refanged, err := defang_schemes.RefangURLWith(trimmed, r.Refang)
+		switch {
+		case err == nil:
+			return refanged + match[len(trimmed):]
+		case errors.Is(err, defang_schemes.ErrAmbiguousDefangedScheme) && r.policy == "fail":
+			if failure == nil {
+				failure = err
+			}
+		case errors.Is(err, defang_schemes.ErrAmbiguousDefangedScheme):
+			fmt.Fprintf(os.Stderr, "[WARN] %s: left %s defanged: %s\n", r.location, trimmed, err)
+		}
+		return match
+	})
+	return refanged, failure
+}
+
+func runRefang(args []string) error {
+	flags := flag.NewFlagSet("refang", flag.ExitOnError)
+	dataset := flags.String("dataset", CURRENT_DATASET, "JSON dataset file, snapshot name, or \""+CURRENT_DATASET+"\"")
+	ambiguous := flags.String("ambiguous", "fail", "for schemes that more than one scheme defangs into: "+strings.Join(AMBIGUITY_POLICIES, ", "))
+	flags.Parse(args)
+
+	known := false
+	for _, policy := range AMBIGUITY_POLICIES {
+		known = known || policy == *ambiguous
+	}
+	if !known {
+		return fmt.Errorf("unknown ambiguity policy \"%s\" (policies: %s)", *ambiguous, strings.Join(AMBIGUITY_POLICIES, ", "))
+	}
+
+	schemes, err := loadDataset(*dataset)
+	if err != nil {
+		return err
+	}
+	resolver := NewResolver(schemes, *ambiguous)
+
+	paths := flags.Args()
+	if len(paths) == 0 {
+		paths = []string{"-"}
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+	for _, path := range paths {
+		var r io.Reader = os.Stdin
+		if path != "-" {
+			file, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			r = file
+		}
+
+		// URLs do not span lines, so refang line by line, to report where ambiguities are
+		reader := bufio.NewReader(r)
+		for line := 1; ; line++ {
+			text, err := reader.ReadString('\n')
+			if text != "" {
+				resolver.location = fmt.Sprintf("%s:%d", path, line)
+				refanged, failure := resolver.refangLine(text)
+				if failure != nil {
+					out.Flush()
+					return fmt.Errorf("%s: %w (use -ambiguous to choose a policy)", resolver.location, failure)
+				}
+				out.WriteString(refanged)
+			}
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("cannot read %s: %w", path, err)
+			}
+		}
+	}
+	return nil
+}
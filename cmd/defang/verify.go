@@ -0,0 +1,97 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+// A line of a document that did not survive a round trip
+type Mismatch struct {
+	Path       string
+	Line       int
+	Original   string
+	RoundTrip  string
+	Transition string
+}
+
+// Transform the document one way and back again, returning the lines which differ
+func verifyRoundTrip(path string, document, mode string) ([]Mismatch, error) {
+	var there, back func(string) string
+	switch mode {
+	case "defang":
+		there, back = defang_schemes.DefangText, defang_schemes.RefangText
+	case "refang":
+		there, back = defang_schemes.RefangText, defang_schemes.DefangText
+	default:
+		return nil, fmt.Errorf("unknown mode \"%s\"", mode)
+	}
+
+	// URLs do not span lines, so the transformed document has the same lines
+	intermediate := strings.Split(there(document), "\n")
+	roundTrip := strings.Split(back(strings.Join(intermediate, "\n")), "\n")
+
+	var mismatches []Mismatch
+	for i, original := range strings.Split(document, "\n") {
+		if roundTrip[i] != original {
+			mismatches = append(mismatches, Mismatch{
+				Path:       path,
+				Line:       i + 1,
+				Original:   original,
+				RoundTrip:  roundTrip[i],
+				Transition: intermediate[i],
+			})
+		}
+	}
+	return mismatches, nil
+}
+
+func runVerify(args []string) error {
+	flags := flag.NewFlagSet("verify", flag.ExitOnError)
+	mode := flags.String("mode", "defang", "defang then refang (defang), or refang then defang (refang), e.g. for a sanitised document")
+	flags.Parse(args)
+
+	paths := flags.Args()
+	if len(paths) == 0 {
+		paths = []string{"-"}
+	}
+
+	var mismatches []Mismatch
+	for _, path := range paths {
+		var r io.Reader = os.Stdin
+		if path != "-" {
+			file, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			r = file
+		}
+
+		document, err := io.ReadAll(r)
+		if err != nil {
+			return fmt.Errorf("cannot read %s: %w", path, err)
+		}
+		found, err := verifyRoundTrip(path, string(document), *mode)
+		if err != nil {
+			return err
+		}
+		mismatches = append(mismatches, found...)
+	}
+
+	for _, mismatch := range mismatches {
+		fmt.Printf("%s:%d: does not round-trip\n", mismatch.Path, mismatch.Line)
+		fmt.Printf("  original:   %s\n", mismatch.Original)
+		fmt.Printf("  %-11s %s\n", *mode+"ed:", mismatch.Transition)
+		fmt.Printf("  round trip: %s\n", mismatch.RoundTrip)
+	}
+
+	if len(mismatches) > 0 {
+		os.Exit(1)
+	}
+	return nil
+}
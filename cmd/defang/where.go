@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+// Fields that filter expressions can refer to, as the values of a scheme.  Fields with
+// several values (related, examples) match if any value does
+var WHERE_FIELDS = map[string]func(defang_schemes.Scheme) []string{
+	"scheme":      func(s defang_schemes.Scheme) []string { return []string{s.Scheme} },
+	"defanged":    func(s defang_schemes.Scheme) []string { return []string{s.DefangedScheme} },
+	"template":    func(s defang_schemes.Scheme) []string { return []string{s.Template} },
+	"description": func(s defang_schemes.Scheme) []string { return []string{s.Description} },
+	"status":      func(s defang_schemes.Scheme) []string { return []string{string(s.Status)} },
+	"wellknown":   func(s defang_schemes.Scheme) []string { return []string{strconv.FormatBool(s.SupportsWellKnownURIs())} },
+	"reference":   func(s defang_schemes.Scheme) []string { return []string{s.Reference} },
+	"notes":       func(s defang_schemes.Scheme) []string { return []string{s.Notes} },
+	"obsolete":    func(s defang_schemes.Scheme) []string { return []string{strconv.FormatBool(s.Obsolete)} },
+	"deprecated":  func(s defang_schemes.Scheme) []string { return []string{strconv.FormatBool(s.Deprecated())} },
+	"related":     func(s defang_schemes.Scheme) []string { return s.Related },
+	"examples":    func(s defang_schemes.Scheme) []string { return s.Examples },
+}
+
+// Comparison operators, in the order they are searched for ("!=" before "=")
+var WHERE_OPERATORS = []string{"!=", "=", "~"}
+
+// One comparison of a filter expression, such as "status=permanent"
+type condition struct {
+	field    string
+	operator string
+	value    string
+}
+
+// A filter expression: comparisons joined by "&&", and alternatives of those joined by
+// "||" ("&&" binds more tightly).  Comparisons are case-insensitive: "=" and "!=" compare
+// whole values, and "~" matches a substring
+type Filter [][]condition
+
+// Parse a filter expression, such as "status=permanent && wellknown=true"
+func ParseFilter(expr string) (Filter, error) {
+	var filter Filter
+	for _, alternative := range strings.Split(expr, "||") {
+		var conditions []condition
+		for _, comparison := range strings.Split(alternative, "&&") {
+			cond, err := parseCondition(strings.TrimSpace(comparison))
+			if err != nil {
+				return nil, err
+			}
+			conditions = append(conditions, cond)
+		}
+		filter = append(filter, conditions)
+	}
+	return filter, nil
+}
+
+func parseCondition(comparison string) (condition, error) {
+	for _, operator := range WHERE_OPERATORS {
+		field, value, ok := strings.Cut(comparison, operator)
+		if !ok {
+			continue
+		}
+		field = strings.ToLower(strings.TrimSpace(field))
+		if _, known := WHERE_FIELDS[field]; !known {
+			return condition{}, fmt.Errorf("unknown field \"%s\" in \"%s\" (fields: %s)", field, comparison, strings.Join(whereFieldNames(), ", "))
+		}
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+		return condition{field: field, operator: operator, value: value}, nil
+	}
+	return condition{}, fmt.Errorf("expected FIELD%sVALUE in \"%s\"", strings.Join(WHERE_OPERATORS, "VALUE, FIELD"), comparison)
+}
+
+func whereFieldNames() []string {
+	names := make([]string, 0, len(WHERE_FIELDS))
+	for name := range WHERE_FIELDS {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Whether the scheme satisfies the filter
+func (f Filter) Match(scheme defang_schemes.Scheme) bool {
+	for _, conditions := range f {
+		matched := true
+		for _, cond := range conditions {
+			if !cond.match(scheme) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (c condition) match(scheme defang_schemes.Scheme) bool {
+	values := WHERE_FIELDS[c.field](scheme)
+	if c.operator == "!=" {
+		for _, value := range values {
+			if strings.EqualFold(value, c.value) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, value := range values {
+		switch c.operator {
+		case "=":
+			if strings.EqualFold(value, c.value) {
+				return true
+			}
+		case "~":
+			if strings.Contains(strings.ToLower(value), strings.ToLower(c.value)) {
+				return true
+			}
+		}
+	}
+	return false
+}
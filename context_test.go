@@ -0,0 +1,45 @@
+package defang_schemes_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+// Text longer than the chunks the context variants check for cancellation between
+func contextText() string {
+	var b strings.Builder
+	for b.Len() < 3*defang_schemes.STREAM_BUFFER_SIZE {
+		b.WriteString("Beacon to https://evil.test/x from 10.0.0.1, then hxxp[://]bad[.]test\n")
+	}
+	return b.String()
+}
+
+// The context variants agree with the plain functions across chunk boundaries
+func TestContextVariantsAgree(t *testing.T) {
+	text, ctx := contextText(), context.Background()
+	if defanged, err := defang_schemes.DefangTextContext(ctx, text); err != nil || defanged != defang_schemes.DefangText(text) {
+		t.Errorf("DefangTextContext differs from DefangText (error: %v)", err)
+	}
+	if refanged, err := defang_schemes.RefangTextContext(ctx, text); err != nil || refanged != defang_schemes.RefangText(text) {
+		t.Errorf("RefangTextContext differs from RefangText (error: %v)", err)
+	}
+	if refanged, err := defang_schemes.RefangAllContext(ctx, text); err != nil || refanged != defang_schemes.RefangAll(text) {
+		t.Errorf("RefangAllContext differs from RefangAll (error: %v)", err)
+	}
+	if matches, err := defang_schemes.FindSchemesContext(ctx, text); err != nil || fmt.Sprint(matches) != fmt.Sprint(defang_schemes.FindSchemes(text)) {
+		t.Errorf("FindSchemesContext differs from FindSchemes (error: %v)", err)
+	}
+}
+
+func TestContextVariantsCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := defang_schemes.DefangTextContext(ctx, contextText()); !errors.Is(err, context.Canceled) {
+		t.Errorf("DefangTextContext error = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,57 @@
+// Regression corpus of defanged text
+//
+// Anonymised snippets modelled on public threat reports, as they appear in the wild
+// (defanged), alongside the text they should refang to.  Hosts are replaced with
+// documentation domains and addresses (RFC 2606, RFC 5737, RFC 3849).  The corpus is
+// exported so that other implementations can test against the same cases; the raw data
+// is also available as JSON:
+//
+//	data := corpus.JSON()
+//
+// Cases are snippets of text, to be refanged according to their Kind: by RefangText if it
+// is empty; by RefangAll if it is "all", as the text also holds indicators outside URLs
+// (IP addresses, email addresses, UNC paths, and domains), which RefangText leaves as they
+// are; or by RefangURL if it is "url", as the case is a single URL (some conventions, such
+// as "example dot com", cannot be found in running text).
+//
+// The cases are checked against these functions by the tests of the root package
+package corpus
+
+import (
+	_ "embed"
+	"encoding/json"
+	"sync"
+)
+
+//go:embed corpus.json
+var corpusJSON []byte
+
+// A snippet of defanged text, and the text it should refang to
+type Case struct {
+	Name   string `json:"name"`
+	Source string `json:"source"`
+	// "url" for a single URL, "all" for text with indicators outside URLs, or empty for
+	// text with only URLs
+	Kind     string `json:"kind,omitempty"`
+	Defanged string `json:"defanged"`
+	Refanged string `json:"refanged"`
+}
+
+var cases = sync.OnceValue(func() []Case {
+	var cases []Case
+	err := json.Unmarshal(corpusJSON, &cases)
+	if err != nil {
+		panic("corpus: invalid corpus.json: " + err.Error())
+	}
+	return cases
+})
+
+// All cases in the corpus.  The returned slice is a copy, and may be modified
+func Cases() []Case {
+	return append([]Case(nil), cases()...)
+}
+
+// The corpus as JSON, in the same shape as Cases
+func JSON() []byte {
+	return append([]byte(nil), corpusJSON...)
+}
@@ -0,0 +1,23 @@
+// The dataset as data files, written by tools/writeconsts alongside the generated Go.
+// This package does not import the library, so embedding the dataset with it does not
+// also compile in the scheme map; decode it with defang_schemes.LoadFromJSON (or any JSON or
+// CSV reader), or vendor schemes.json and schemes.csv directly
+package data
+
+import _ "embed"
+
+// The dataset, as written by defang_schemes.WriteJSON
+//
+//go:embed schemes.json
+var JSON []byte
+
+// The dataset, as written by defang_schemes.WriteCSV
+//
+//go:embed schemes.csv
+var CSV []byte
+
+// The dataset as gzipped JSON, which builds with the defang_schemes_embed build tag
+// decompress in place of the map literal
+//
+//go:embed schemes.json.gz
+var JSONGzip []byte
@@ -0,0 +1,13 @@
+//go:build (!defang_schemes_lazy && !defang_schemes_embed) || defang_schemes_minimal
+
+package defang_schemes
+
+import "github.com/jakewilliami/defang-schemes/schemes"
+
+// As schemes.Map, which eager and minimal builds assign on initialisation
+var Map = schemes.Map
+
+// As schemes.Schemes
+func Schemes() map[string]Scheme {
+	return schemes.Schemes()
+}
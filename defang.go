@@ -0,0 +1,300 @@
+package defang_schemes
+
+import (
+	"io"
+	"net/url"
+
+	"github.com/jakewilliami/defang-schemes/defang"
+)
+
+type (
+	UnknownSchemePolicy          = defang.UnknownSchemePolicy
+	Defanger                     = defang.Defanger
+	DefangerOption               = defang.DefangerOption
+	IndicatorType                = defang.IndicatorType
+	DefangOption                 = defang.DefangOption
+	PolicyConfig                 = defang.PolicyConfig
+	Policy                       = defang.Policy
+	AmbiguousDefangedSchemeError = defang.AmbiguousDefangedSchemeError
+	RefangPart                   = defang.RefangPart
+	RefangRule                   = defang.RefangRule
+	DefangCase                   = defang.DefangCase
+	DefangRule                   = defang.DefangRule
+	UnicodePolicy                = defang.UnicodePolicy
+	NonASCIISchemeError          = defang.NonASCIISchemeError
+)
+
+const (
+	UnknownSchemeDefang         = defang.UnknownSchemeDefang
+	UnknownSchemeReject         = defang.UnknownSchemeReject
+	UnknownSchemePassThrough    = defang.UnknownSchemePassThrough
+	UnknownSchemeGeneric        = defang.UnknownSchemeGeneric
+	IndicatorUnknown            = defang.IndicatorUnknown
+	IndicatorURL                = defang.IndicatorURL
+	IndicatorDomain             = defang.IndicatorDomain
+	IndicatorIP                 = defang.IndicatorIP
+	IndicatorEmail              = defang.IndicatorEmail
+	IndicatorUNC                = defang.IndicatorUNC
+	RefangPartURL               = defang.RefangPartURL
+	RefangPartScheme            = defang.RefangPartScheme
+	RefangPartRest              = defang.RefangPartRest
+	DEFANG_RULES_SCHEMA_VERSION = defang.DEFANG_RULES_SCHEMA_VERSION
+	DefangCaseCustom            = defang.DefangCaseCustom
+	DefangCaseSingleCharacter   = defang.DefangCaseSingleCharacter
+	DefangCaseHTTP              = defang.DefangCaseHTTP
+	DefangCaseAdditionalChars   = defang.DefangCaseAdditionalChars
+	DefangCaseTwoLetter         = defang.DefangCaseTwoLetter
+	DefangCaseThreeLetter       = defang.DefangCaseThreeLetter
+	DefangCaseFourLetter        = defang.DefangCaseFourLetter
+	DefangCaseDefault           = defang.DefangCaseDefault
+	DefangCaseAlternative       = defang.DefangCaseAlternative
+	DEFANGED_UNC_PREFIX         = defang.DEFANGED_UNC_PREFIX
+	UnicodeReject               = defang.UnicodeReject
+	UnicodeNormalize            = defang.UnicodeNormalize
+	UnicodePassThroughGeneric   = defang.UnicodePassThroughGeneric
+	DEFANGED_SCHEME_SEPARATOR   = defang.DEFANGED_SCHEME_SEPARATOR
+	DEFANGED_COLON              = defang.DEFANGED_COLON
+	DEFANGED_DOT                = defang.DEFANGED_DOT
+	DEFANGED_QUERY              = defang.DEFANGED_QUERY
+	DEFANGED_FRAGMENT           = defang.DEFANGED_FRAGMENT
+	DEFANGED_AT                 = defang.DEFANGED_AT
+	DEFANGED_COMMA              = defang.DEFANGED_COMMA
+	NEUTRALISED_SCRIPT_MARKER   = defang.NEUTRALISED_SCRIPT_MARKER
+	REDACTED_PASSWORD           = defang.REDACTED_PASSWORD
+)
+
+var (
+	ErrEmptyInput              = defang.ErrEmptyInput
+	ErrSingleCharacterScheme   = defang.ErrSingleCharacterScheme
+	ErrMissingScheme           = defang.ErrMissingScheme
+	ErrNonASCIIScheme          = defang.ErrNonASCIIScheme
+	ErrUnknownDefangedScheme   = defang.ErrUnknownDefangedScheme
+	ErrAmbiguousDefangedScheme = defang.ErrAmbiguousDefangedScheme
+	ErrUnknownScheme           = defang.ErrUnknownScheme
+	ErrInvalidIP               = defang.ErrInvalidIP
+	ErrUnknownIndicator        = defang.ErrUnknownIndicator
+	ErrInvalidUNCPath          = defang.ErrInvalidUNCPath
+	ErrInvalidPolicy           = defang.ErrInvalidPolicy
+	ErrInvalidDefangRule       = defang.ErrInvalidDefangRule
+	SCRIPT_SCHEMES             = defang.SCRIPT_SCHEMES
+	DEFANG_BRACKETS            = defang.DEFANG_BRACKETS
+	REGISTRY_HIVES             = defang.REGISTRY_HIVES
+)
+
+// As defang.AlternativeDefangs
+func AlternativeDefangs(scheme string) []string {
+	return defang.AlternativeDefangs(scheme)
+}
+
+// As defang.DefangOneToOne
+func DefangOneToOne(schemes map[string]Scheme) (map[string]string, []string) {
+	return defang.DefangOneToOne(schemes)
+}
+
+// As defang.AppendDefangedScheme
+func AppendDefangedScheme(dst []byte, scheme string) []byte {
+	return defang.AppendDefangedScheme(dst, scheme)
+}
+
+// As defang.DefangSchemeBytes
+func DefangSchemeBytes(scheme []byte) []byte {
+	return defang.DefangSchemeBytes(scheme)
+}
+
+// As defang.DefangScheme
+func DefangScheme(scheme string, opts ...DefangOption) string {
+	return defang.DefangScheme(scheme, opts...)
+}
+
+// As defang.DefangSchemeStrict
+func DefangSchemeStrict(scheme string, opts ...DefangOption) (string, error) {
+	return defang.DefangSchemeStrict(scheme, opts...)
+}
+
+// As defang.WithStyle
+func WithStyle(opts ...DefangOption) DefangerOption {
+	return defang.WithStyle(opts...)
+}
+
+// As defang.WithUnknownSchemePolicy
+func WithUnknownSchemePolicy(policy UnknownSchemePolicy) DefangerOption {
+	return defang.WithUnknownSchemePolicy(policy)
+}
+
+// As defang.WithPolicy
+func WithPolicy(policy *Policy) DefangerOption {
+	return defang.WithPolicy(policy)
+}
+
+// As defang.WithRegistry.  A nil registry selects the generated data
+func WithRegistry(registry *Registry) DefangerOption {
+	if registry == nil {
+		return defang.WithRegistry(nil)
+	}
+	return defang.WithRegistry(registry)
+}
+
+// As defang.NewDefanger
+func NewDefanger(opts ...DefangerOption) *Defanger {
+	return defang.NewDefanger(opts...)
+}
+
+// As defang.DefangIndicator
+func DefangIndicator(s string) (string, IndicatorType, error) {
+	return defang.DefangIndicator(s)
+}
+
+// As defang.DefangIP
+func DefangIP(ip string) (string, error) {
+	return defang.DefangIP(ip)
+}
+
+// As defang.RefangIP
+func RefangIP(defanged string) (string, error) {
+	return defang.RefangIP(defanged)
+}
+
+// As defang.WithDefangPort
+func WithDefangPort() DefangOption {
+	return defang.WithDefangPort()
+}
+
+// As defang.WithDefangQuery
+func WithDefangQuery() DefangOption {
+	return defang.WithDefangQuery()
+}
+
+// As defang.WithDefangFragment
+func WithDefangFragment() DefangOption {
+	return defang.WithDefangFragment()
+}
+
+// As defang.WithRedactPassword
+func WithRedactPassword() DefangOption {
+	return defang.WithRedactPassword()
+}
+
+// As defang.WithNeutraliseScripts
+func WithNeutraliseScripts() DefangOption {
+	return defang.WithNeutraliseScripts()
+}
+
+// As defang.WithUnicodePolicy
+func WithUnicodePolicy(policy UnicodePolicy) DefangOption {
+	return defang.WithUnicodePolicy(policy)
+}
+
+// As defang.WithReplacementRune
+func WithReplacementRune(replacement rune) DefangOption {
+	return defang.WithReplacementRune(replacement)
+}
+
+// As defang.WithBracketStyle
+func WithBracketStyle() DefangOption {
+	return defang.WithBracketStyle()
+}
+
+// As defang.WithoutFourLetterCase
+func WithoutFourLetterCase() DefangOption {
+	return defang.WithoutFourLetterCase()
+}
+
+// As defang.WithPreserveCase
+func WithPreserveCase() DefangOption {
+	return defang.WithPreserveCase()
+}
+
+// As defang.NewPolicy
+func NewPolicy(config PolicyConfig) (*Policy, error) {
+	return defang.NewPolicy(config)
+}
+
+// As defang.LoadPolicy
+func LoadPolicy(r io.Reader) (*Policy, error) {
+	return defang.LoadPolicy(r)
+}
+
+// As defang.LoadPolicyFile
+func LoadPolicyFile(path string) (*Policy, error) {
+	return defang.LoadPolicyFile(path)
+}
+
+// As defang.RefangScheme
+func RefangScheme(defanged string) (string, error) {
+	return defang.RefangScheme(defanged)
+}
+
+// As defang.LookupDefanged
+func LookupDefanged(defanged string) (Scheme, bool) {
+	return defang.LookupDefanged(defanged)
+}
+
+// As defang.IsDefangedScheme
+func IsDefangedScheme(s string) bool {
+	return defang.IsDefangedScheme(s)
+}
+
+// As defang.RefangRules
+func RefangRules() []RefangRule {
+	return defang.RefangRules()
+}
+
+// As defang.DescribeDefang
+func DescribeDefang(scheme, defanged string) (DefangRule, error) {
+	return defang.DescribeDefang(scheme, defanged)
+}
+
+// As defang.DefangRules
+func DefangRules(schemes map[string]Scheme) ([]DefangRule, error) {
+	return defang.DefangRules(schemes)
+}
+
+// As defang.WriteDefangRules
+func WriteDefangRules(w io.Writer, schemes map[string]Scheme) error {
+	return defang.WriteDefangRules(w, schemes)
+}
+
+// As defang.DefangUNC
+func DefangUNC(path string) (string, error) {
+	return defang.DefangUNC(path)
+}
+
+// As defang.RefangUNC
+func RefangUNC(defanged string) (string, error) {
+	return defang.RefangUNC(defanged)
+}
+
+// As defang.DefangURL
+func DefangURL(raw string, opts ...DefangOption) (string, error) {
+	return defang.DefangURL(raw, opts...)
+}
+
+// As defang.DefangParsedURL
+func DefangParsedURL(u *url.URL, opts ...DefangOption) (string, error) {
+	return defang.DefangParsedURL(u, opts...)
+}
+
+// As defang.Defang
+func Defang(u *url.URL, opts ...DefangOption) string {
+	return defang.Defang(u, opts...)
+}
+
+// As defang.RefangURL
+func RefangURL(s string) (string, error) {
+	return defang.RefangURL(s)
+}
+
+// As defang.RefangURLWith
+func RefangURLWith(s string, refangScheme func(string) (string, error)) (string, error) {
+	return defang.RefangURLWith(s, refangScheme)
+}
+
+// As defang.IsWindowsPath
+func IsWindowsPath(s string) bool {
+	return defang.IsWindowsPath(s)
+}
+
+// As defang.IsRegistryKey
+func IsRegistryKey(s string) bool {
+	return defang.IsRegistryKey(s)
+}
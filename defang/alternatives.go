@@ -0,0 +1,135 @@
+package defang
+
+import (
+	"sort"
+
+	"github.com/jakewilliami/defang-schemes/internal/algorithm"
+	"github.com/jakewilliami/defang-schemes/schemes"
+)
+
+// Schemes whose defanged forms collide with registered schemes, but are kept regardless:
+// http[s] defang into hxxp[s], which are (provisional) schemes in their own right, because
+// hxxp[s] is by far the most common defang convention
+var defangCollisionExempt = map[string]bool{"http": true, "https": true}
+
+// Alternative defanged forms of a scheme, in a fixed order, for when the form given by the
+// rules of DefangScheme collides.  Alternatives replace one further character of that form,
+// then bracket one character of the scheme (e.g., "a[w]"), then replace two further
+// characters.  The first character is only replaced as a last resort, so that the scheme
+// remains recognisable for as long as possible
+func AlternativeDefangs(scheme string) []string {
+	base := algorithm.Defang(scheme)
+	if base == "" {
+		return nil
+	}
+
+	var positions []int
+	for i := 1; i < len(base); i++ {
+		if isDefangablePosition(base, i) {
+			positions = append(positions, i)
+		}
+	}
+
+	var alternatives []string
+	for _, i := range positions {
+		alternatives = append(alternatives, algorithm.DefangAtPositions(base, []int{i}))
+	}
+	for i := 1; i < len(scheme); i++ {
+		if isDefangablePosition(scheme, i) {
+			bracketed := scheme[:i] + "[" + scheme[i:i+1] + "]" + scheme[i+1:]
+			alternatives = append(alternatives, algorithm.BracketAdditionalChars(bracketed))
+		}
+	}
+	for a, i := range positions {
+		for _, j := range positions[a+1:] {
+			alternatives = append(alternatives, algorithm.DefangAtPositions(base, []int{i, j}))
+		}
+	}
+	if isDefangablePosition(base, 0) {
+		alternatives = append(alternatives, algorithm.DefangAtPositions(base, []int{0}))
+		for _, i := range positions {
+			alternatives = append(alternatives, algorithm.DefangAtPositions(base, []int{0, i}))
+		}
+	}
+	return alternatives
+}
+
+// Letters and digits that are not already defanged
+func isDefangablePosition(s string, i int) bool {
+	c := s[i]
+	return c != 'x' && ('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9')
+}
+
+// Assign each scheme a defanged form such that no form is a registered scheme, and no two
+// schemes share a form.  Schemes are visited permanent first, then provisional, then
+// historical, and by name within each status.  Each keeps the form given by the rules of
+// DefangScheme unless it collides with a scheme or with the form of a scheme visited before
+// it.  The forms kept are reserved before any alternative is chosen, so that only the
+// schemes that collide change: each takes the first of its AlternativeDefangs that is
+// neither a scheme nor reserved.  Returns a map from scheme to defanged form, and the
+// schemes for which no collision-free form exists (which keep the rules' form)
+func DefangOneToOne(dataset map[string]schemes.Scheme) (map[string]string, []string) {
+	names := make([]string, 0, len(dataset))
+	for name := range dataset {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		si, sj := statusRank(dataset[names[i]].Status), statusRank(dataset[names[j]].Status)
+		if si != sj {
+			return si < sj
+		}
+		return names[i] < names[j]
+	})
+
+	defanged := make(map[string]string, len(dataset))
+	taken := make(map[string]bool, len(dataset))
+	collides := func(scheme, form string) bool {
+		_, isScheme := dataset[form]
+		return taken[form] || form == scheme || isScheme && !defangCollisionExempt[scheme]
+	}
+
+	// Reserve the rules' form of every scheme that does not collide
+	var colliding []string
+	for _, name := range names {
+		form := algorithm.Defang(name)
+		if collides(name, form) {
+			colliding = append(colliding, name)
+			continue
+		}
+		defanged[name] = form
+		taken[form] = true
+	}
+
+	var unresolved []string
+	for _, name := range colliding {
+		form := algorithm.Defang(name)
+		resolved := false
+		for _, alternative := range AlternativeDefangs(name) {
+			if !collides(name, alternative) {
+				form, resolved = alternative, true
+				break
+			}
+		}
+		if !resolved {
+			unresolved = append(unresolved, name)
+		}
+		defanged[name] = form
+		taken[form] = true
+	}
+
+	sort.Strings(unresolved)
+	return defanged, unresolved
+}
+
+func statusRank(status schemes.Status) int {
+	switch status {
+	case schemes.Permanent:
+		return 0
+	case schemes.Provisional:
+		return 1
+	case schemes.Historical:
+		return 2
+	default:
+		return 3
+	}
+}
@@ -0,0 +1,173 @@
+package defang
+
+import (
+	"unicode/utf8"
+
+	"github.com/jakewilliami/defang-schemes/internal/algorithm"
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+	"github.com/jakewilliami/defang-schemes/schemes"
+)
+
+// Append the defanged form of the scheme to dst, returning the extended buffer, as
+// DefangScheme (with default options) would produce it.  ASCII input is defanged without
+// allocating, beyond growing dst if it is too small; high-throughput callers should reuse
+// dst between calls
+func AppendDefangedScheme(dst []byte, scheme string) []byte {
+	return appendDefangedScheme(dst, scheme)
+}
+
+// As DefangScheme (with default options), but for byte slices.  The result is newly
+// allocated; use AppendDefangedScheme to reuse a buffer
+func DefangSchemeBytes(scheme []byte) []byte {
+	return appendDefangedScheme(make([]byte, 0, len(scheme)+2), scheme)
+}
+
+// The cases of DefangScheme, written directly into dst so that neither form of input is
+// converted.  Non-ASCII input is rare enough to fall back to DefangScheme
+func appendDefangedScheme[S string | []byte](dst []byte, scheme S) []byte {
+	for i := 0; i < len(scheme); i++ {
+		if scheme[i] >= utf8.RuneSelf {
+			return append(dst, DefangScheme(string(scheme))...)
+		}
+	}
+
+	// Surrounding whitespace is trimmed, as in DefangScheme
+	start, end := 0, len(scheme)
+	for start < end && ascii.IsSpace(scheme[start]) {
+		start++
+	}
+	for end > start && ascii.IsSpace(scheme[end-1]) {
+		end--
+	}
+	if start == end {
+		return dst
+	}
+	scheme = scheme[start:end]
+
+	// Schemes in the dataset take their generated form, as in DefangScheme.  The scheme is
+	// lowercased into dst to look it up, so that no key is allocated
+	if schemes.DataGenerated() == nil {
+		n := len(dst)
+		dst = appendLower(dst, scheme)
+		known, ok := schemes.Schemes()[string(dst[n:])]
+		dst = dst[:n]
+		if ok {
+			return appendMatchedCase(dst, scheme, known.DefangedScheme)
+		}
+	}
+
+	if len(scheme) == 1 {
+		return append(append(append(dst, '['), scheme[0]), ']')
+	}
+
+	// As in DefangScheme, a scheme that the rules would leave unchanged ("hxxp") is
+	// bracketed instead, which is rare enough to fall back to DefangScheme
+	n := len(dst)
+	dst = appendDefangedByRules(dst, scheme)
+	if string(dst[n:]) == string(scheme) {
+		return append(dst[:n], DefangScheme(string(scheme))...)
+	}
+	return dst
+}
+
+// The rules of DefangScheme, for ASCII schemes of more than one character
+func appendDefangedByRules[S string | []byte](dst []byte, scheme S) []byte {
+	if ascii.EqualFold(string(scheme), "http") || ascii.EqualFold(string(scheme), "https") {
+		return appendReplacedAt(dst, scheme, 1, 2)
+	}
+
+	if hasAdditionalAllowedSchemeChar(scheme) {
+		return appendBracketedAdditionalChars(dst, scheme)
+	}
+
+	switch len(scheme) {
+	case 2, 3:
+		return appendReplacedAt(dst, scheme, 1, -1)
+	case 4:
+		return appendReplacedAt(dst, scheme, 2, -1)
+	default:
+		return appendReplacedAt(dst, scheme, 1, 2)
+	}
+}
+
+// Append the scheme with the characters at positions i and j (if non-negative) replaced,
+// preserving case
+func appendReplacedAt[S string | []byte](dst []byte, scheme S, i, j int) []byte {
+	for k := 0; k < len(scheme); k++ {
+		if (k == i || k == j) && 'A' <= scheme[k] && scheme[k] <= 'Z' {
+			dst = append(dst, 'X')
+		} else if k == i || k == j {
+			dst = append(dst, 'x')
+		} else {
+			dst = append(dst, scheme[k])
+		}
+	}
+	return dst
+}
+
+// Append the scheme with its ASCII letters lowercased
+func appendLower[S string | []byte](dst []byte, scheme S) []byte {
+	for k := 0; k < len(scheme); k++ {
+		c := scheme[k]
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		dst = append(dst, c)
+	}
+	return dst
+}
+
+// As matchCase, appending the result to dst
+func appendMatchedCase[S string | []byte](dst []byte, template S, s string) []byte {
+	j := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !algorithm.IsDefangBracket(c) {
+			for j < len(template) && algorithm.IsDefangBracket(template[j]) {
+				j++
+			}
+			if j < len(template) {
+				if 'A' <= template[j] && template[j] <= 'Z' && 'a' <= c && c <= 'z' {
+					c -= 'a' - 'A'
+				}
+				j++
+			}
+		}
+		dst = append(dst, c)
+	}
+	return dst
+}
+
+// As bracketAdditionalChars
+func appendBracketedAdditionalChars[S string | []byte](dst []byte, scheme S) []byte {
+	for k := 0; k < len(scheme); k++ {
+		c := scheme[k]
+		additional := isAdditionalAllowedSchemeChar(c)
+		if additional && (k == 0 || !isAdditionalAllowedSchemeChar(scheme[k-1])) {
+			dst = append(dst, '[')
+		}
+		dst = append(dst, c)
+		if additional && (k == len(scheme)-1 || !isAdditionalAllowedSchemeChar(scheme[k+1])) {
+			dst = append(dst, ']')
+		}
+	}
+	return dst
+}
+
+func hasAdditionalAllowedSchemeChar[S string | []byte](scheme S) bool {
+	for k := 0; k < len(scheme); k++ {
+		if isAdditionalAllowedSchemeChar(scheme[k]) {
+			return true
+		}
+	}
+	return false
+}
+
+func isAdditionalAllowedSchemeChar(c byte) bool {
+	for _, char := range schemes.ADDITIONAL_ALLOWED_SCHEME_CHARS {
+		if rune(c) == char {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+// Defanging and refanging of URI schemes, URLs, and other indicators (IP addresses, domains,
+// email addresses, and UNC paths), against the dataset of the schemes package or, with a
+// Defanger, a registry.Registry.  See the extract package to defang them within text
+package defang
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jakewilliami/defang-schemes/internal/algorithm"
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+	"github.com/jakewilliami/defang-schemes/schemes"
+)
+
+// The goal of defanging is to malform the URI such that it does not open if clicked.
+//
+// However, as there is a *[re]fang* option in the Tomtils library, we need an algorithm
+// to map invertibly fanged and defanged schemes.  Many libraries do not support schemes
+// beyond http[s] [1, 2], as browsers do not support many different schemes.  However,
+// it may be the case that different schemes are supported on different non-browser
+// applications, so we *should* support defanging.
+//
+// There is also consideration to have enough information in a defanged stream such that
+// it is invertible* to its original scheme.  Actually, not invertible, as there will not
+// always be enough information just from the defanged scheme to reconstruct the scheme
+// without having the list of valid schemes.  So what we need is for the defanged scheme
+// to be one-to-one, so that given a defanged scheme, you know that there is a single
+// valid scheme.
+//
+// Schemes in the dataset defang to their generated form (Scheme.DefangedScheme), so that
+// DefangScheme always agrees with the data.  That is the form given by the rules below,
+// unless it collides with a registered scheme or with the form of another, in which case it
+// is the first of the scheme's AlternativeDefangs that does not (see DefangOneToOne): for
+// example, "at" defangs to "a[t]", as "ar" defangs to "ax", and "hxxp" defangs to "hxxx".
+//
+// Input containing non-ASCII characters cannot be a registered scheme, so it is given the
+// generic positional defang (bracketing single characters, and any characters that
+// replacing would leave unchanged); see DefangSchemeStrict to choose a different
+// UnicodePolicy.
+// Surrounding whitespace is trimmed (" http " → "hxxp").  Empty (or pure whitespace) input
+// defangs to the empty string, and single characters are bracketed ("x" → "[x]");
+// DefangSchemeStrict returns errors for these instead.  The case of the input is preserved
+// ("Https" → "Hxxps"), but RefangScheme always returns the canonical lowercase scheme.
+//
+// The replaced characters, and whether they are bracketed rather than replaced, can be
+// chosen with WithReplacementRune, WithBracketStyle, and WithoutFourLetterCase.  Forms
+// other than the default may not refang with RefangScheme.
+//
+// [1]: https://stackoverflow.com/a/56150152
+// [2]: https://github.com/ioc-fang/ioc_fanger
+func DefangScheme(scheme string, opts ...DefangOption) string {
+	scheme = strings.TrimSpace(scheme)
+
+	// Schemes in the dataset take their generated form, which is the algorithm's unless that
+	// collides (see DefangOneToOne)
+	if !algorithm.NewConfig(opts).CustomStyle() && schemes.DataGenerated() == nil {
+		if known, ok := schemes.Schemes()[ascii.ToLower(scheme)]; ok {
+			return algorithm.MatchCase(scheme, known.DefangedScheme)
+		}
+	}
+
+	return algorithm.DefangChanged(scheme, opts...)
+}
+
+// As DefangScheme, but non-ASCII input is handled according to the UnicodePolicy set
+// with WithUnicodePolicy, returning a *NonASCIISchemeError if it is rejected.  Returns
+// ErrEmptyInput for empty or pure whitespace input, and ErrSingleCharacterScheme for input
+// of one character
+func DefangSchemeStrict(scheme string, opts ...DefangOption) (string, error) {
+	cfg := algorithm.NewConfig(opts)
+
+	scheme = strings.TrimSpace(scheme)
+	if scheme == "" {
+		return "", ErrEmptyInput
+	}
+
+	if i, r := algorithm.FirstNonASCII(scheme); i >= 0 {
+		switch cfg.Unicode {
+		case UnicodeNormalize:
+			normalized, ok := normalizeScheme(scheme)
+			if !ok {
+				return "", &NonASCIISchemeError{Scheme: scheme, Rune: r, Offset: i}
+			}
+			scheme = normalized
+		case UnicodePassThroughGeneric:
+			return algorithm.Generic(scheme), nil
+		default:
+			return "", &NonASCIISchemeError{Scheme: scheme, Rune: r, Offset: i}
+		}
+	}
+
+	if len(scheme) == 1 {
+		return "", fmt.Errorf("%w: %q", ErrSingleCharacterScheme, scheme)
+	}
+
+	return DefangScheme(scheme, opts...), nil
+}
@@ -0,0 +1,178 @@
+package defang
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jakewilliami/defang-schemes/internal/algorithm"
+	"github.com/jakewilliami/defang-schemes/schemes"
+)
+
+// What a Defanger does with schemes that are not in its registry
+type UnknownSchemePolicy int
+
+const (
+	// Defang unknown schemes with DefangSchemeStrict
+	UnknownSchemeDefang UnknownSchemePolicy = iota
+	// Return ErrUnknownScheme
+	UnknownSchemeReject
+	// Return the scheme unchanged, so that nothing is defanged that cannot be refanged
+	UnknownSchemePassThrough
+	// Defang the second and third characters, regardless of the scheme's length or
+	// characters, so that unknown schemes are recognisably defanged by position alone
+	UnknownSchemeGeneric
+)
+
+func (p UnknownSchemePolicy) String() string {
+	switch p {
+	case UnknownSchemeDefang:
+		return "defang"
+	case UnknownSchemeReject:
+		return "reject"
+	case UnknownSchemePassThrough:
+		return "pass-through"
+	case UnknownSchemeGeneric:
+		return "generic"
+	default:
+		return fmt.Sprintf("UnknownSchemePolicy(%d)", int(p))
+	}
+}
+
+// The schemes a Defanger defangs and refangs against: the generated data, or a
+// registry.Registry
+type SchemeSet interface {
+	// The scheme of the given name, case-insensitively
+	Lookup(scheme string) (schemes.Scheme, bool)
+	// The scheme with the given defanged form, case-insensitively
+	LookupDefanged(defanged string) (schemes.Scheme, bool)
+}
+
+// The generated data, as a SchemeSet
+type generatedSchemeSet struct{}
+
+func (generatedSchemeSet) Lookup(scheme string) (schemes.Scheme, bool) {
+	return schemes.Lookup(scheme)
+}
+
+func (generatedSchemeSet) LookupDefanged(defanged string) (schemes.Scheme, bool) {
+	return LookupDefanged(defanged)
+}
+
+// Defangs and refangs schemes against a registry, in a given style.  A Defanger is not
+// modified after construction, and its registry is safe for concurrent use, so a Defanger
+// may be shared between goroutines
+type Defanger struct {
+	registry SchemeSet
+	style    []DefangOption
+	unknown  UnknownSchemePolicy
+	policy   *Policy
+}
+
+// Option to configure a Defanger
+type DefangerOption func(*Defanger)
+
+// Defang schemes in the given style (default: the generated defanged forms, or DefangScheme
+// for unknown schemes).  With a custom style, registered schemes are also defanged with
+// DefangScheme, so may not refang
+func WithStyle(opts ...DefangOption) DefangerOption {
+	return func(d *Defanger) {
+		d.style = opts
+	}
+}
+
+// Choose what happens to schemes that are not in the registry (default: UnknownSchemeDefang)
+func WithUnknownSchemePolicy(policy UnknownSchemePolicy) DefangerOption {
+	return func(d *Defanger) {
+		d.unknown = policy
+	}
+}
+
+// Never defang the allowlisted schemes, domains, and networks of the policy, and always
+// defang those it denylists (default: no policy).  Hosts are checked by an
+// extract.Processor using the Defanger
+func WithPolicy(policy *Policy) DefangerOption {
+	return func(d *Defanger) {
+		d.policy = policy
+	}
+}
+
+// Defang and refang against a custom registry (default: the generated data)
+func WithRegistry(registry SchemeSet) DefangerOption {
+	return func(d *Defanger) {
+		d.registry = registry
+	}
+}
+
+// Create a Defanger.  Its zero configuration defangs over the generated data as
+// DefangSchemeStrict does, preserving the case of the scheme as written, and refangs as
+// RefangScheme does
+func NewDefanger(opts ...DefangerOption) *Defanger {
+	d := &Defanger{}
+	for _, opt := range opts {
+		opt(d)
+	}
+	if d.registry == nil {
+		d.registry = generatedSchemeSet{}
+	}
+	return d
+}
+
+// The Defanger's policy, or nil if it has none (see WithPolicy)
+func (d *Defanger) Policy() *Policy {
+	return d.policy
+}
+
+// Defang a scheme: registered schemes take their registered defanged form, and unknown
+// schemes are handled according to the UnknownSchemePolicy.  Schemes allowlisted by the
+// Policy are returned unchanged, and those it denylists are always defanged
+func (d *Defanger) Defang(scheme string) (string, error) {
+	if d.policy.AllowsScheme(scheme) && strings.TrimSpace(scheme) != "" {
+		return scheme, nil
+	}
+
+	cfg := algorithm.NewConfig(d.style)
+	if known, ok := d.registry.Lookup(scheme); ok {
+		defanged := known.DefangedScheme
+		if cfg.CustomStyle() {
+			defanged = DefangScheme(known.Scheme, d.style...)
+		}
+		return algorithm.MatchCase(strings.TrimSpace(scheme), defanged), nil
+	}
+
+	// Degenerate input is an error whatever the policy
+	defanged, err := DefangSchemeStrict(scheme, d.style...)
+	if err != nil {
+		return "", err
+	}
+	if d.policy.DeniesScheme(scheme) {
+		return defanged, nil
+	}
+	switch d.unknown {
+	case UnknownSchemeReject:
+		return "", fmt.Errorf("%w: %q", ErrUnknownScheme, scheme)
+	case UnknownSchemePassThrough:
+		return scheme, nil
+	case UnknownSchemeGeneric:
+		return algorithm.Generic(scheme), nil
+	default:
+		return defanged, nil
+	}
+}
+
+// Resolve a defanged scheme back to its scheme in the registry, in its canonical lowercase
+// form unless the style includes WithPreserveCase.  Returns
+// ErrUnknownDefangedScheme if no registered scheme defangs as given
+func (d *Defanger) Refang(defanged string) (string, error) {
+	if strings.TrimSpace(defanged) == "" {
+		return "", ErrEmptyInput
+	}
+
+	known, ok := d.registry.LookupDefanged(defanged)
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownDefangedScheme, defanged)
+	}
+	if algorithm.NewConfig(d.style).PreserveCase {
+		return algorithm.MatchCase(strings.TrimSpace(defanged), known.Scheme), nil
+	}
+	return known.Scheme, nil
+}
@@ -0,0 +1,27 @@
+package defang
+
+import "errors"
+
+var ErrEmptyInput = errors.New("input is empty")
+
+var ErrSingleCharacterScheme = errors.New("scheme has a single character")
+
+var ErrMissingScheme = errors.New("URL has no scheme")
+
+var ErrNonASCIIScheme = errors.New("scheme contains non-ASCII characters")
+
+var ErrUnknownDefangedScheme = errors.New("unknown defanged scheme")
+
+var ErrAmbiguousDefangedScheme = errors.New("ambiguous defanged scheme")
+
+var ErrUnknownScheme = errors.New("unknown scheme")
+
+var ErrInvalidIP = errors.New("invalid IP address")
+
+var ErrUnknownIndicator = errors.New("input is not a URL, domain, IP address, email address, or UNC path")
+
+var ErrInvalidUNCPath = errors.New(`invalid UNC path: expected \\host\share`)
+
+var ErrInvalidPolicy = errors.New("invalid defang policy")
+
+var ErrInvalidDefangRule = errors.New("defanged scheme is not derived from the scheme by replacing and bracketing characters")
@@ -0,0 +1,128 @@
+package defang
+
+import (
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strings"
+
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+	"github.com/jakewilliami/defang-schemes/schemes"
+)
+
+// The kind of an indicator, as detected by DefangIndicator
+type IndicatorType int
+
+const (
+	IndicatorUnknown IndicatorType = iota
+	IndicatorURL
+	IndicatorDomain
+	IndicatorIP
+	IndicatorEmail
+	IndicatorUNC
+)
+
+func (t IndicatorType) String() string {
+	switch t {
+	case IndicatorUnknown:
+		return "unknown"
+	case IndicatorURL:
+		return "URL"
+	case IndicatorDomain:
+		return "domain"
+	case IndicatorIP:
+		return "IP"
+	case IndicatorEmail:
+		return "email"
+	case IndicatorUNC:
+		return "UNC path"
+	default:
+		return fmt.Sprintf("IndicatorType(%d)", int(t))
+	}
+}
+
+// Detect whether the input is an IP address, UNC path, URL, email address, or domain (in
+// that order), and defang it accordingly, returning the detected type alongside the defanged
+// string:
+//
+//	DefangIndicator("user@example.com") == "user[at]example[.]com", IndicatorEmail
+//
+// Returns ErrUnknownIndicator (and IndicatorUnknown) if the input is none of these
+func DefangIndicator(s string) (string, IndicatorType, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", IndicatorUnknown, ErrEmptyInput
+	}
+
+	if _, err := netip.ParseAddr(s); err == nil {
+		defanged, err := DefangIP(s)
+		return defanged, IndicatorIP, err
+	}
+
+	if strings.HasPrefix(s, `\\`) {
+		defanged, err := DefangUNC(s)
+		if err == nil {
+			return defanged, IndicatorUNC, nil
+		}
+	}
+
+	if isURLIndicator(s) {
+		defanged, err := DefangURL(s)
+		return defanged, IndicatorURL, err
+	}
+
+	if local, domain, ok := strings.Cut(s, "@"); ok && local != "" && !strings.ContainsAny(local, " \t@") && IsDomain(domain) {
+		return local + DEFANGED_AT + defangDomain(domain), IndicatorEmail, nil
+	}
+
+	if IsDomain(s) {
+		return defangDomain(s), IndicatorDomain, nil
+	}
+
+	return "", IndicatorUnknown, fmt.Errorf("%w: %q", ErrUnknownIndicator, s)
+}
+
+// A URL has an authority ("https://example.com"), or is opaque with a registered scheme
+// ("mailto:user@example.com"); "example.com:8080" parses with the scheme "example.com",
+// so is not taken for a URL
+func isURLIndicator(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme == "" {
+		return false
+	}
+	if u.Host != "" {
+		return true
+	}
+	_, registered := schemes.Lookup(u.Scheme)
+	return registered && u.Opaque != ""
+}
+
+// Whether the input is a domain name: at least two dot-separated labels of letters, digits,
+// and hyphens (not at either end of a label), the last of which is not numeric
+func IsDomain(s string) bool {
+	labels := strings.Split(strings.TrimSuffix(s, "."), ".")
+	if len(labels) < 2 || len(s) > 253 {
+		return false
+	}
+	for _, label := range labels {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			if c := label[i]; c == '_' || (!ascii.IsWordChar(c) && c != '-') {
+				return false
+			}
+		}
+	}
+	tld := labels[len(labels)-1]
+	for i := 0; i < len(tld); i++ {
+		if tld[i] < '0' || tld[i] > '9' {
+			return true
+		}
+	}
+	return false
+}
+
+func defangDomain(domain string) string {
+	return strings.ReplaceAll(domain, ".", DEFANGED_DOT)
+}
@@ -0,0 +1,39 @@
+package defang
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// Defang an IP address: dots are bracketed ("1.1.1.1" → "1[.]1[.]1[.]1"), as are the
+// colons of IPv6 addresses ("2001:db8::1" → "2001[:]db8[:][:]1").  The address is written
+// as given, rather than in canonical form.  Returns ErrInvalidIP if it does not parse
+func DefangIP(ip string) (string, error) {
+	ip = strings.TrimSpace(ip)
+	if ip == "" {
+		return "", ErrEmptyInput
+	}
+	if _, err := netip.ParseAddr(ip); err != nil {
+		return "", fmt.Errorf("%w: %q", ErrInvalidIP, ip)
+	}
+
+	defanged := strings.ReplaceAll(ip, ".", DEFANGED_DOT)
+	return strings.ReplaceAll(defanged, ":", DEFANGED_COLON), nil
+}
+
+// Refang an IP address defanged with DefangIP, or with any of the bracket conventions
+// RefangURL recognises ("1(.)1(.)1(.)1", "1[dot]1[dot]1[dot]1").  Returns ErrInvalidIP if
+// the result does not parse
+func RefangIP(defanged string) (string, error) {
+	defanged = strings.TrimSpace(defanged)
+	if defanged == "" {
+		return "", ErrEmptyInput
+	}
+
+	ip := DefangedDelimiterPattern().ReplaceAllStringFunc(defanged, RefangDelimiter)
+	if _, err := netip.ParseAddr(ip); err != nil {
+		return "", fmt.Errorf("%w: %q", ErrInvalidIP, defanged)
+	}
+	return ip, nil
+}
@@ -0,0 +1,85 @@
+package defang
+
+import "github.com/jakewilliami/defang-schemes/internal/algorithm"
+
+// Option to configure how a scheme or URL is defanged
+type DefangOption = algorithm.Option
+
+// Defang the separator of an explicit port (":8080" → "[:]8080"), for sharing policies that
+// require the whole authority component to be non-parseable
+func WithDefangPort() DefangOption {
+	return func(cfg *algorithm.Config) {
+		cfg.Port = true
+	}
+}
+
+// Defang the query delimiter ("?q=1" → "[?]q=1"), for tooling that would otherwise
+// auto-link or pre-fetch the path and query
+func WithDefangQuery() DefangOption {
+	return func(cfg *algorithm.Config) {
+		cfg.Query = true
+	}
+}
+
+// Defang the fragment delimiter ("#top" → "[#]top")
+func WithDefangFragment() DefangOption {
+	return func(cfg *algorithm.Config) {
+		cfg.Fragment = true
+	}
+}
+
+// Redact the password of any user information in the URL ("user:pass@" → "user:xxxxx[at]").
+// Note that redaction is not reversible by refanging
+func WithRedactPassword() DefangOption {
+	return func(cfg *algorithm.Config) {
+		cfg.Redact = true
+	}
+}
+
+// Neutralise the payload of script-capable URLs (see SCRIPT_SCHEMES), by inserting
+// NEUTRALISED_SCRIPT_MARKER after the scheme separator, for sanitising HTML attributes.  Like
+// redaction, this is not reversed by refanging
+func WithNeutraliseScripts() DefangOption {
+	return func(cfg *algorithm.Config) {
+		cfg.Scripts = true
+	}
+}
+
+// Bracket characters of the scheme, rather than replacing them ("https" → "h[tt]ps"), as is
+// already done for schemes containing additional allowed characters ("coap[+]tcp")
+func WithBracketStyle() DefangOption {
+	return algorithm.WithBracketStyle()
+}
+
+// Choose how non-ASCII scheme input is handled (default: UnicodeReject)
+func WithUnicodePolicy(policy UnicodePolicy) DefangOption {
+	return func(cfg *algorithm.Config) {
+		cfg.Unicode = policy
+	}
+}
+
+// Replace characters of the scheme with the given rune, rather than 'x' ("https" → "h__ps").
+// Where that would leave the scheme unchanged ("https" with 't'), the characters are
+// bracketed instead ("h[tt]ps")
+func WithReplacementRune(replacement rune) DefangOption {
+	return func(cfg *algorithm.Config) {
+		cfg.Replacement = replacement
+	}
+}
+
+// Defang 4-letter schemes as longer schemes are, at the second and third characters ("imap"
+// → "ixxp"), rather than at the third only ("imxp")
+func WithoutFourLetterCase() DefangOption {
+	return func(cfg *algorithm.Config) {
+		cfg.NoFourLetterCase = true
+	}
+}
+
+// Preserve the case of a defanged scheme when a Defanger refangs it ("HXXPS" → "HTTPS"),
+// rather than refanging to its canonical lowercase form.  Defanging always preserves the
+// case of the scheme as written ("HTTPS://example.com" → "HXXPS[://]example[.]com")
+func WithPreserveCase() DefangOption {
+	return func(cfg *algorithm.Config) {
+		cfg.PreserveCase = true
+	}
+}
@@ -0,0 +1,148 @@
+package defang
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"strings"
+
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+)
+
+// The lists of a Policy, as written in code or in a JSON policy file:
+//
+//	{
+//	  "allow_domains": ["example.com", "corp.internal"],
+//	  "allow_cidrs": ["10.0.0.0/8"],
+//	  "deny_domains": ["evil.corp.internal"]
+//	}
+type PolicyConfig struct {
+	// Never defanged: schemes, domains (and their subdomains), and networks
+	AllowSchemes []string `json:"allow_schemes,omitempty"`
+	AllowDomains []string `json:"allow_domains,omitempty"`
+	AllowCIDRs   []string `json:"allow_cidrs,omitempty"`
+	// Always defanged, even where also allowed, or where the UnknownSchemePolicy would
+	// not defang the scheme
+	DenySchemes []string `json:"deny_schemes,omitempty"`
+	DenyDomains []string `json:"deny_domains,omitempty"`
+	DenyCIDRs   []string `json:"deny_cidrs,omitempty"`
+}
+
+// Schemes, domains, and networks that a Defanger never (allowlist) or always (denylist)
+// defangs; see WithPolicy.  A Policy is not modified after construction
+type Policy struct {
+	allow, deny policyList
+}
+
+type policyList struct {
+	schemes  map[string]bool
+	domains  []string
+	networks []netip.Prefix
+}
+
+// Compile a policy from its lists.  Returns ErrInvalidPolicy if a CIDR does not parse
+func NewPolicy(config PolicyConfig) (*Policy, error) {
+	allow, err := newPolicyList(config.AllowSchemes, config.AllowDomains, config.AllowCIDRs)
+	if err != nil {
+		return nil, err
+	}
+	deny, err := newPolicyList(config.DenySchemes, config.DenyDomains, config.DenyCIDRs)
+	if err != nil {
+		return nil, err
+	}
+	return &Policy{allow: allow, deny: deny}, nil
+}
+
+// Read a policy from JSON, as described by PolicyConfig
+func LoadPolicy(r io.Reader) (*Policy, error) {
+	var config PolicyConfig
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPolicy, err)
+	}
+	return NewPolicy(config)
+}
+
+// Read a policy from a JSON file; see LoadPolicy
+func LoadPolicyFile(path string) (*Policy, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return LoadPolicy(file)
+}
+
+func newPolicyList(schemes, domains, cidrs []string) (policyList, error) {
+	list := policyList{schemes: make(map[string]bool, len(schemes))}
+	for _, scheme := range schemes {
+		list.schemes[ascii.ToLower(strings.TrimSpace(scheme))] = true
+	}
+	for _, domain := range domains {
+		list.domains = append(list.domains, ascii.ToLower(strings.TrimSuffix(strings.TrimSpace(domain), ".")))
+	}
+	for _, cidr := range cidrs {
+		network, err := netip.ParsePrefix(strings.TrimSpace(cidr))
+		if err != nil {
+			return policyList{}, fmt.Errorf("%w: CIDR %q: %v", ErrInvalidPolicy, cidr, err)
+		}
+		list.networks = append(list.networks, network.Masked())
+	}
+	return list, nil
+}
+
+func (l policyList) hasScheme(scheme string) bool {
+	return l.schemes[ascii.ToLower(strings.TrimSpace(scheme))]
+}
+
+// Whether the host is one of the domains (or a subdomain of one), or an address in one
+// of the networks
+func (l policyList) hasHost(host string) bool {
+	host = ascii.ToLower(strings.TrimSuffix(host, "."))
+	if host == "" {
+		return false
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		addr = addr.Unmap()
+		for _, network := range l.networks {
+			if network.Contains(addr) {
+				return true
+			}
+		}
+		return false
+	}
+	return MatchesDomain(host, l.domains)
+}
+
+// Whether the host is one of the domains, or a subdomain of one
+func MatchesDomain(host string, domains []string) bool {
+	for _, domain := range domains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// Whether the scheme is allowlisted, and not also denylisted
+func (p *Policy) AllowsScheme(scheme string) bool {
+	return p != nil && p.allow.hasScheme(scheme) && !p.deny.hasScheme(scheme)
+}
+
+// Whether the scheme is denylisted
+func (p *Policy) DeniesScheme(scheme string) bool {
+	return p != nil && p.deny.hasScheme(scheme)
+}
+
+// Whether the host is allowlisted (by domain or network), and not also denylisted
+func (p *Policy) AllowsHost(host string) bool {
+	return p != nil && p.allow.hasHost(host) && !p.deny.hasHost(host)
+}
+
+// Whether the host is denylisted (by domain or network)
+func (p *Policy) DeniesHost(host string) bool {
+	return p != nil && p.deny.hasHost(host)
+}
@@ -0,0 +1,108 @@
+package defang
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+	"github.com/jakewilliami/defang-schemes/schemes"
+)
+
+// Reverse lookup of defanged schemes, built on first use
+var (
+	defangedSchemeMap     map[string][]schemes.Scheme
+	defangedSchemeMapOnce sync.Once
+)
+
+func buildDefangedSchemeMap() {
+	defangedSchemeMap = make(map[string][]schemes.Scheme, len(schemes.Schemes()))
+	for _, scheme := range schemes.Schemes() {
+		defangedSchemeMap[scheme.DefangedScheme] = append(defangedSchemeMap[scheme.DefangedScheme], scheme)
+	}
+}
+
+// Error returned by RefangScheme when a defanged scheme could have come from more than
+// one scheme.  Matches ErrAmbiguousDefangedScheme with errors.Is
+type AmbiguousDefangedSchemeError struct {
+	Defanged   string
+	Candidates []string
+}
+
+func (e *AmbiguousDefangedSchemeError) Error() string {
+	return fmt.Sprintf("defanged scheme %q is ambiguous between: %s", e.Defanged, strings.Join(e.Candidates, ", "))
+}
+
+func (e *AmbiguousDefangedSchemeError) Is(target error) bool {
+	return target == ErrAmbiguousDefangedScheme
+}
+
+// Resolve a defanged scheme back to its canonical scheme:
+//
+//	RefangScheme("hxxps") == "https", nil
+//	RefangScheme("coap[+]tcp") == "coap+tcp", nil
+//
+// The generated data assigns defanged forms one-to-one (see DefangOneToOne).  Should more
+// than one scheme defang as given regardless, a scheme that defangs to itself is not taken
+// to be a candidate; otherwise, returns an *AmbiguousDefangedSchemeError.  Returns
+// ErrUnknownDefangedScheme if no scheme defangs as given.  Surrounding whitespace is
+// trimmed, as by DefangScheme.
+//
+// Unlike DefangScheme, which preserves case ("Https" → "Hxxps"), the result is always
+// lowercase ("HXXPS" → "https"): defang conventions capitalise the replaced characters
+// whatever the case of the scheme ("hXXp"), so the case of a defanged scheme does not say
+// how the scheme was written.  To keep it regardless, refang with a Defanger styled
+// WithPreserveCase
+func RefangScheme(defanged string) (string, error) {
+	defanged = strings.TrimSpace(defanged)
+	if defanged == "" {
+		return "", ErrEmptyInput
+	}
+
+	defangedSchemeMapOnce.Do(buildDefangedSchemeMap)
+
+	candidates := defangedSchemeMap[ascii.ToLower(defanged)]
+	switch len(candidates) {
+	case 0:
+		return "", fmt.Errorf("%w: %q", ErrUnknownDefangedScheme, defanged)
+	case 1:
+		return candidates[0].Scheme, nil
+	}
+
+	// A scheme that defangs to itself is not a defanged form of anything
+	var defangedFrom []schemes.Scheme
+	for _, candidate := range candidates {
+		if candidate.Scheme != candidate.DefangedScheme {
+			defangedFrom = append(defangedFrom, candidate)
+		}
+	}
+	if len(defangedFrom) == 1 {
+		return defangedFrom[0].Scheme, nil
+	}
+
+	schemes := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		schemes = append(schemes, candidate.Scheme)
+	}
+	sort.Strings(schemes)
+
+	return "", &AmbiguousDefangedSchemeError{Defanged: defanged, Candidates: schemes}
+}
+
+// Look up the registered scheme with the given defanged form, as per RefangScheme.  The
+// second return value is false if no scheme (or more than one) defangs as given
+func LookupDefanged(defanged string) (schemes.Scheme, bool) {
+	scheme, err := RefangScheme(defanged)
+	if err != nil {
+		return schemes.Scheme{}, false
+	}
+	return schemes.Schemes()[scheme], true
+}
+
+// Whether s is the defanged form of a registered scheme; for example, a token in a report
+// such as "hxxps" or "coap[+]tcp"
+func IsDefangedScheme(s string) bool {
+	_, ok := LookupDefanged(s)
+	return ok
+}
@@ -0,0 +1,134 @@
+package defang
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+)
+
+// The part of a URL to which a RefangRule applies
+type RefangPart int
+
+const (
+	// The whole input, before the scheme is split from the rest of the URL
+	RefangPartURL RefangPart = iota
+	// The scheme, where it is not a registered defanged scheme
+	RefangPartScheme
+	// The rest of the URL, after the scheme separator
+	RefangPartRest
+)
+
+func (p RefangPart) String() string {
+	switch p {
+	case RefangPartURL:
+		return "url"
+	case RefangPartScheme:
+		return "scheme"
+	case RefangPartRest:
+		return "rest"
+	default:
+		return fmt.Sprintf("RefangPart(%d)", int(p))
+	}
+}
+
+// A rewrite undoing one defang convention found in the wild, as applied by RefangURL
+type RefangRule struct {
+	Name string
+	Part RefangPart
+	// An example of the convention, and what the rule rewrites it to
+	Example  string
+	Refanged string
+
+	Pattern *regexp.Regexp
+	Replace func(match string) string
+}
+
+func (r RefangRule) apply(s string) string {
+	return r.Pattern.ReplaceAllStringFunc(s, r.Replace)
+}
+
+func replaceWith(replacement string) func(string) string {
+	return func(string) string {
+		return replacement
+	}
+}
+
+// The rules applied by RefangURL, in order within each part.  Registered defanged schemes
+// are refanged by lookup (see RefangScheme), so the scheme rules only cover conventions
+// that are not the defanged form of any registered scheme
+func refangRules() []RefangRule {
+	return []RefangRule{
+		{
+			Name:     "escaped slashes",
+			Part:     RefangPartURL,
+			Example:  `http:\/\/example.com\/path`,
+			Refanged: "http://example.com/path",
+			Pattern:  regexp.MustCompile(`\\/`),
+			Replace:  replaceWith("/"),
+		},
+		{
+			Name:     "obscured http",
+			Part:     RefangPartScheme,
+			Example:  "h__ps",
+			Refanged: "https",
+			Pattern:  regexp.MustCompile(`(?i)^h[tx_*]{2}p(s?)$`),
+			Replace: func(match string) string {
+				return "http" + ascii.ToLower(match[len("h__p"):])
+			},
+		},
+		{
+			Name:     "meow",
+			Part:     RefangPartScheme,
+			Example:  "meow",
+			Refanged: "http",
+			Pattern:  regexp.MustCompile(`(?i)^meows?$`),
+			Replace: func(match string) string {
+				return "http" + ascii.ToLower(match[len("meow"):])
+			},
+		},
+		{
+			Name:     "bracketed delimiter",
+			Part:     RefangPartRest,
+			Example:  "example(dot)com[/]path",
+			Refanged: "example.com/path",
+			Pattern:  DefangedDelimiterPattern(),
+			Replace:  RefangDelimiter,
+		},
+		{
+			Name:     "spaced dot",
+			Part:     RefangPartRest,
+			Example:  "example dot com",
+			Refanged: "example.com",
+			Pattern:  regexp.MustCompile(`(?i)\s+dot\s+|\s+\.\s*|\.\s+`),
+			Replace:  replaceWith("."),
+		},
+		{
+			Name:     "spaced at",
+			Part:     RefangPartRest,
+			Example:  "bob at example.com",
+			Refanged: "bob@example.com",
+			Pattern:  regexp.MustCompile(`(?i)\s+at\s+`),
+			Replace:  replaceWith("@"),
+		},
+	}
+}
+
+var refangRulesOnce = sync.OnceValue(refangRules)
+
+// The rules RefangURL applies to undo defang conventions beyond DefangURL's own, for
+// documentation and testing
+func RefangRules() []RefangRule {
+	return append([]RefangRule(nil), refangRulesOnce()...)
+}
+
+// Apply the RefangRules for a part of the URL to s, in order
+func ApplyRefangRules(part RefangPart, s string) string {
+	for _, rule := range refangRulesOnce() {
+		if rule.Part == part {
+			s = rule.apply(s)
+		}
+	}
+	return s
+}
@@ -0,0 +1,216 @@
+package defang
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/jakewilliami/defang-schemes/internal/algorithm"
+	"github.com/jakewilliami/defang-schemes/schemes"
+)
+
+// Version of the JSON document written by WriteDefangRules
+const DEFANG_RULES_SCHEMA_VERSION = 1
+
+// The case of DefangScheme (or of the collision handling of the generated data) that
+// produced a scheme's defanged form
+type DefangCase int
+
+const (
+	// The form is not produced by DefangScheme or AlternativeDefangs, as for schemes
+	// overridden in a registry.Registry
+	DefangCaseCustom DefangCase = iota
+	// Single characters are bracketed
+	DefangCaseSingleCharacter
+	// http and https have their second and third characters replaced
+	DefangCaseHTTP
+	// Runs of ADDITIONAL_ALLOWED_SCHEME_CHARS are bracketed
+	DefangCaseAdditionalChars
+	// Two-letter schemes have their second character replaced
+	DefangCaseTwoLetter
+	// Three-letter schemes have their second character replaced
+	DefangCaseThreeLetter
+	// Four-letter schemes have their third character replaced
+	DefangCaseFourLetter
+	// Longer schemes have their second and third characters replaced
+	DefangCaseDefault
+	// The form given by the rules of DefangScheme collided, so one of AlternativeDefangs
+	// was taken
+	DefangCaseAlternative
+)
+
+func (c DefangCase) String() string {
+	switch c {
+	case DefangCaseCustom:
+		return "custom"
+	case DefangCaseSingleCharacter:
+		return "single-character"
+	case DefangCaseHTTP:
+		return "http"
+	case DefangCaseAdditionalChars:
+		return "additional-chars"
+	case DefangCaseTwoLetter:
+		return "two-letter"
+	case DefangCaseThreeLetter:
+		return "three-letter"
+	case DefangCaseFourLetter:
+		return "four-letter"
+	case DefangCaseDefault:
+		return "default"
+	case DefangCaseAlternative:
+		return "alternative"
+	default:
+		return fmt.Sprintf("DefangCase(%d)", int(c))
+	}
+}
+
+func (c DefangCase) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// The transformation from a scheme to its defanged form, as data: characters are first
+// replaced, then runs of characters are bracketed.  Positions are zero-based byte offsets
+// into the scheme (schemes are ASCII), so that other implementations can reproduce each
+// defanged form exactly, without reimplementing DefangScheme
+type DefangRule struct {
+	Scheme         string     `json:"scheme"`
+	DefangedScheme string     `json:"defanged_scheme"`
+	Case           DefangCase `json:"case"`
+	// Positions of the characters replaced with Replacement, a single character
+	Replaced    []int  `json:"replaced,omitempty"`
+	Replacement string `json:"replacement,omitempty"`
+	// Half-open [start, end) ranges of the scheme enclosed in a pair of brackets
+	Bracketed [][2]int `json:"bracketed,omitempty"`
+}
+
+// Apply the rule to its scheme, giving its defanged form
+func (r DefangRule) Apply() string {
+	scheme := []byte(r.Scheme)
+	for _, pos := range r.Replaced {
+		if pos >= 0 && pos < len(scheme) && len(r.Replacement) == 1 {
+			scheme[pos] = r.Replacement[0]
+		}
+	}
+
+	var b strings.Builder
+	for i := 0; i <= len(scheme); i++ {
+		for _, run := range r.Bracketed {
+			if run[1] == i {
+				b.WriteByte(']')
+			}
+		}
+		for _, run := range r.Bracketed {
+			if run[0] == i {
+				b.WriteByte('[')
+			}
+		}
+		if i < len(scheme) {
+			b.WriteByte(scheme[i])
+		}
+	}
+	return b.String()
+}
+
+// The case of DefangScheme that applies to a lowercase ASCII scheme, mirroring its rules
+func defangCaseOf(scheme string) DefangCase {
+	switch {
+	case len(scheme) == 1:
+		return DefangCaseSingleCharacter
+	case scheme == "http" || scheme == "https":
+		return DefangCaseHTTP
+	case schemes.AdditionalAllowedSchemeCharsPattern().MatchString(scheme):
+		return DefangCaseAdditionalChars
+	case len(scheme) == 2:
+		return DefangCaseTwoLetter
+	case len(scheme) == 3:
+		return DefangCaseThreeLetter
+	case len(scheme) == 4:
+		return DefangCaseFourLetter
+	default:
+		return DefangCaseDefault
+	}
+}
+
+// Describe how the defanged form is derived from the scheme, as a DefangRule.  Returns
+// ErrInvalidDefangRule if the form is not the scheme with some characters replaced (all
+// with the same replacement) and some runs bracketed
+func DescribeDefang(scheme, defanged string) (DefangRule, error) {
+	rule := DefangRule{Scheme: scheme, DefangedScheme: defanged}
+	invalid := fmt.Errorf("%w: %q from %q", ErrInvalidDefangRule, defanged, scheme)
+
+	i, start, inBracket := 0, 0, false
+	for j := 0; j < len(defanged); j++ {
+		c := defanged[j]
+		switch {
+		case c == '[' && !inBracket:
+			start, inBracket = i, true
+			continue
+		case c == ']' && inBracket:
+			if i == start {
+				return DefangRule{}, invalid
+			}
+			rule.Bracketed = append(rule.Bracketed, [2]int{start, i})
+			inBracket = false
+			continue
+		case i >= len(scheme):
+			return DefangRule{}, invalid
+		}
+		if c != scheme[i] {
+			if rule.Replacement != "" && rule.Replacement != string(c) {
+				return DefangRule{}, invalid
+			}
+			rule.Replacement = string(c)
+			rule.Replaced = append(rule.Replaced, i)
+		}
+		i++
+	}
+	if inBracket || i != len(scheme) {
+		return DefangRule{}, invalid
+	}
+
+	switch {
+	case algorithm.Defang(scheme) == defanged:
+		rule.Case = defangCaseOf(scheme)
+	case slices.Contains(AlternativeDefangs(scheme), defanged):
+		rule.Case = DefangCaseAlternative
+	default:
+		rule.Case = DefangCaseCustom
+	}
+	return rule, nil
+}
+
+// The defang rule of each scheme, sorted by scheme
+func DefangRules(dataset map[string]schemes.Scheme) ([]DefangRule, error) {
+	rules := make([]DefangRule, 0, len(dataset))
+	for _, scheme := range dataset {
+		rule, err := DescribeDefang(scheme.Scheme, scheme.DefangedScheme)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Scheme < rules[j].Scheme
+	})
+	return rules, nil
+}
+
+// Write the defang rule of each scheme as a JSON document carrying a schema_version,
+// sorted by scheme
+func WriteDefangRules(w io.Writer, dataset map[string]schemes.Scheme) error {
+	rules, err := DefangRules(dataset)
+	if err != nil {
+		return err
+	}
+	document := struct {
+		SchemaVersion int          `json:"schema_version"`
+		Rules         []DefangRule `json:"rules"`
+	}{DEFANG_RULES_SCHEMA_VERSION, rules}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(document)
+}
@@ -0,0 +1,49 @@
+package defang
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Defanged form of the leading backslashes of a UNC path
+const DEFANGED_UNC_PREFIX = `[\\]`
+
+// Defang a Windows UNC path, as often found in lateral movement IOCs: the leading
+// backslashes are bracketed, as are the dots of the host:
+//
+//	DefangUNC(`\\fileserver.corp.example\c$\x.exe`) == `[\\]fileserver[.]corp[.]example\c$\x.exe`
+//
+// Returns ErrInvalidUNCPath if the input is not of the form \\host\share, including for
+// local device paths (`\\?\C:\x`, `\\.\pipe\x`), which have no host
+func DefangUNC(path string) (string, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return "", ErrEmptyInput
+	}
+
+	rest, ok := strings.CutPrefix(path, `\\`)
+	host, share, hasShare := strings.Cut(rest, `\`)
+	if !ok || host == "" || host == "?" || host == "." || !hasShare || share == "" {
+		return "", fmt.Errorf("%w: %q", ErrInvalidUNCPath, path)
+	}
+	return DEFANGED_UNC_PREFIX + strings.ReplaceAll(host, ".", DEFANGED_DOT) + `\` + share, nil
+}
+
+// Refang a UNC path defanged with DefangUNC, also accepting the other bracket conventions
+// RefangURL recognises for the host ("fileserver(.)corp(.)example")
+func RefangUNC(defanged string) (string, error) {
+	defanged = strings.TrimSpace(defanged)
+	if defanged == "" {
+		return "", ErrEmptyInput
+	}
+
+	rest, ok := strings.CutPrefix(defanged, DEFANGED_UNC_PREFIX)
+	if !ok {
+		rest, ok = strings.CutPrefix(defanged, `\\`)
+	}
+	host, share, hasShare := strings.Cut(rest, `\`)
+	if !ok || host == "" || !hasShare || share == "" {
+		return "", fmt.Errorf("%w: %q", ErrInvalidUNCPath, defanged)
+	}
+	return `\\` + DefangedDelimiterPattern().ReplaceAllStringFunc(host, RefangDelimiter) + `\` + share, nil
+}
@@ -0,0 +1,65 @@
+package defang
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/jakewilliami/defang-schemes/internal/algorithm"
+)
+
+// URI schemes are ASCII-only (RFC 3986, section 3.1), so input containing other
+// characters is not a scheme we know of.  The policy defines what we do with it
+type UnicodePolicy = algorithm.UnicodePolicy
+
+const (
+	// Return a *NonASCIISchemeError
+	UnicodeReject = algorithm.UnicodeReject
+	// Map fullwidth forms (e.g., "ｈｔｔｐ") to their ASCII equivalents and lower case the
+	// result, rejecting the scheme if non-ASCII characters remain
+	UnicodeNormalize = algorithm.UnicodeNormalize
+	// Skip the scheme-specific rules and apply the generic positional defang
+	UnicodePassThroughGeneric = algorithm.UnicodePassThroughGeneric
+)
+
+// Error returned for non-ASCII scheme input under UnicodeReject (or UnicodeNormalize,
+// where normalisation was not possible).  Matches ErrNonASCIIScheme with errors.Is
+type NonASCIISchemeError struct {
+	Scheme string
+	Rune   rune
+	Offset int
+}
+
+func (e *NonASCIISchemeError) Error() string {
+	return fmt.Sprintf("scheme %q contains non-ASCII character %q at byte offset %d", e.Scheme, e.Rune, e.Offset)
+}
+
+func (e *NonASCIISchemeError) Is(target error) bool {
+	return target == ErrNonASCIIScheme
+}
+
+// Fullwidth ASCII variants occupy U+FF01–U+FF5E, offset from ASCII by a constant
+// https://www.unicode.org/charts/PDF/UFF00.pdf
+const (
+	fullwidthFirst  = '！'
+	fullwidthLast   = '～'
+	fullwidthOffset = fullwidthFirst - '!'
+)
+
+// Normalise fullwidth forms to ASCII and lower case the scheme.  Returns false if
+// non-ASCII characters remain
+func normalizeScheme(scheme string) (string, bool) {
+	runes := []rune(scheme)
+	for i, r := range runes {
+		if r >= fullwidthFirst && r <= fullwidthLast {
+			r -= fullwidthOffset
+		}
+		if r >= utf8.RuneSelf {
+			return "", false
+		}
+		if r >= 'A' && r <= 'Z' {
+			r += 'a' - 'A'
+		}
+		runes[i] = r
+	}
+	return string(runes), true
+}
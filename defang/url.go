@@ -0,0 +1,436 @@
+package defang
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/jakewilliami/defang-schemes/internal/algorithm"
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+	"github.com/jakewilliami/defang-schemes/schemes"
+	"github.com/jakewilliami/defang-schemes/urn"
+)
+
+// Defanged variants of the delimiters in a URL
+const (
+	DEFANGED_SCHEME_SEPARATOR = "[://]"
+	DEFANGED_COLON            = "[:]"
+	DEFANGED_DOT              = "[.]"
+	DEFANGED_QUERY            = "[?]"
+	DEFANGED_FRAGMENT         = "[#]"
+	DEFANGED_AT               = "[at]"
+	DEFANGED_COMMA            = "[,]"
+)
+
+// Schemes whose payload is script, run by browsers when the link is followed
+var SCRIPT_SCHEMES = []string{"javascript", "vbscript", "livescript"}
+
+// Inserted before the payload of script-capable URLs by WithNeutraliseScripts.  Refanging
+// does not remove it, and it breaks the payload as script: in JavaScript, it is an array
+// literal followed by an unexpected token, and in VBScript, an identifier followed by one
+const NEUTRALISED_SCRIPT_MARKER = "[neutralised]"
+
+// Placeholder for redacted passwords, as used by url.URL.Redacted
+const REDACTED_PASSWORD = "xxxxx"
+
+// Brackets used by common defang conventions, other than DefangURL's square brackets:
+// "example(.)com", "example{.}com"
+var DEFANG_BRACKETS = algorithm.DEFANG_BRACKETS
+
+// Defanged form of the scheme, preferring the generated data over the algorithm unless a
+// custom defang style is configured
+func defangedSchemeOf(scheme string, opts []DefangOption) string {
+	if known, ok := schemes.Schemes()[scheme]; ok && !algorithm.NewConfig(opts).CustomStyle() {
+		return known.DefangedScheme
+	}
+	return DefangScheme(scheme, opts...)
+}
+
+// Defang a full URL: the scheme is defanged as per DefangScheme, in the case it is written
+// ("HTTPS" → "HXXPS"), the scheme separator is bracketed, the user information delimiter
+// is replaced, and dots in the host are bracketed:
+//
+//	DefangURL("https://example.com/index.html") == "hxxps[://]example[.]com/index.html"
+//
+// The host of a file: URL is defanged as DefangUNC defangs that of a UNC path: a
+// single-label host is left as it is ("file://fileserver/share" →
+// "fixe[://]fileserver/share"), as the bracketed scheme separator, like DefangUNC's
+// bracketed backslashes, already keeps it from being parsed as a host.
+//
+// Opaque URLs (such as javascript:alert(1)) only have their scheme and separator
+// defanged, except that data: URIs also have their payload separator defanged
+// ("data:text/html,<script>" → "daxa[:]text/html[,]<script>"), mailto: URLs their
+// addresses ("mailto:bob@example.com" → "mxxlto[:]bob[at]example[.]com"), and well-formed
+// URNs are defanged by urn.Defang, which keeps the scheme and brackets the separator after
+// the namespace identifier too ("urn:isbn:0451450523" → "urn[:]isbn[:]0451450523").
+// Windows paths and registry keys ("C:\Users", "HKLM:\Software") are rejected with
+// ErrMissingScheme
+func DefangURL(raw string, opts ...DefangOption) (string, error) {
+	if strings.TrimSpace(raw) == "" {
+		return "", ErrEmptyInput
+	}
+	if IsWindowsLocation(strings.TrimSpace(raw)) {
+		return "", fmt.Errorf("%w: %q is a Windows path or registry key", ErrMissingScheme, raw)
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("cannot parse URL %q: %w", raw, err)
+	}
+
+	if u.Scheme == "" {
+		return DefangParsedURL(u, opts...)
+	}
+	if ascii.EqualFold(u.Scheme, urn.SCHEME) {
+		if defanged, err := urn.Defang(raw); err == nil {
+			return defanged, nil
+		}
+	}
+
+	// The parser lowercases the scheme, so take its case from the input, which it begins
+	defangedScheme := algorithm.MatchCase(raw[:len(u.Scheme)], defangedSchemeOf(u.Scheme, opts))
+	defanged := defangParsedURL(u, defangedScheme, algorithm.NewConfig(opts))
+
+	// The parser does not record an empty authority ("http://"), so restore its separator
+	if hasEmptyAuthority(raw, u) {
+		defanged = defangedScheme + DEFANGED_SCHEME_SEPARATOR + strings.TrimPrefix(defanged, defangedScheme+DEFANGED_COLON)
+	}
+	return defanged, nil
+}
+
+// Whether the URL has an empty authority ("http://", "http://?q"), which url.URL.String
+// would drop
+func hasEmptyAuthority(raw string, u *url.URL) bool {
+	return u.Opaque == "" && u.Host == "" && u.User == nil && u.Path == "" &&
+		strings.HasPrefix(raw[len(u.Scheme)+len(":"):], "//")
+}
+
+// Defang an already-parsed URL, as per DefangURL.  The parser lowercases the scheme, so
+// its defanged form is lowercase too
+func DefangParsedURL(u *url.URL, opts ...DefangOption) (string, error) {
+	if u == nil {
+		return "", ErrEmptyInput
+	}
+	if u.Scheme == "" {
+		return "", fmt.Errorf("%w: %q", ErrMissingScheme, u.String())
+	}
+
+	return Defang(u, opts...), nil
+}
+
+// Defang a *url.URL, for net/url users: the scheme, user information delimiter, and host
+// are defanged, and the port, path, query, and fragment are left intact unless configured
+// otherwise (see WithDefangPort, WithDefangQuery, and WithDefangFragment).  Unlike
+// DefangParsedURL, URLs without a scheme are accepted, and only have their host defanged
+// ("//example.com/x" → "//example[.]com/x"); nil defangs to the empty string
+func Defang(u *url.URL, opts ...DefangOption) string {
+	if u == nil {
+		return ""
+	}
+	var defangedScheme string
+	if u.Scheme != "" {
+		defangedScheme = defangedSchemeOf(u.Scheme, opts)
+	}
+	return defangParsedURL(u, defangedScheme, algorithm.NewConfig(opts))
+}
+
+// Defang a *url.URL as per Defang, but with the given defanged form of its scheme, such as
+// a Defanger gives, in place of its own
+func DefangAs(u *url.URL, defangedScheme string, opts ...DefangOption) string {
+	if u == nil {
+		return ""
+	}
+	return defangParsedURL(u, defangedScheme, algorithm.NewConfig(opts))
+}
+
+// Defang the host of a URL.  IP literals are defanged as by DefangIP, so that a URL to an
+// address defangs it as DefangIndicator does the bare address ("http://[2001:db8::1]/" →
+// "hxxp[://][2001[:]db8[:][:]1]/"); IPv6 hosts keep their brackets and zone ("%25eth0")
+func defangHost(u *url.URL) string {
+	host := u.Hostname()
+	if !strings.Contains(host, ":") {
+		if defanged, err := DefangIP(host); err == nil {
+			return defanged
+		}
+		return strings.ReplaceAll(host, ".", DEFANGED_DOT)
+	}
+
+	// The parser unescapes the zone, which must be escaped again
+	address, zone, hasZone := strings.Cut(host, "%")
+	defanged, err := DefangIP(address)
+	if err != nil {
+		defanged = address
+	}
+	if hasZone {
+		defanged += "%25" + zone
+	}
+	return "[" + defanged + "]"
+}
+
+// The path as it was written, where the parser kept it; url.URL.EscapedPath would
+// percent-encode non-ASCII characters ("/パス" → "/%E3%83%91%E3%82%B9")
+func rawPath(u *url.URL) string {
+	if path, err := url.PathUnescape(u.RawPath); u.RawPath == "" || err != nil || path != u.Path {
+		return u.EscapedPath()
+	}
+	return u.RawPath
+}
+
+// The fragment as it was written, where the parser kept it, as rawPath
+func rawFragment(u *url.URL) string {
+	if fragment, err := url.PathUnescape(u.RawFragment); u.RawFragment == "" || err != nil || fragment != u.Fragment {
+		return u.EscapedFragment()
+	}
+	return u.RawFragment
+}
+
+// A file: URL with an empty authority may carry a UNC host in its path
+// ("file:////fileserver/share"), which is defanged as a host would be
+func defangFileURLPath(path string) string {
+	rest, ok := strings.CutPrefix(path, "//")
+	if !ok {
+		return path
+	}
+	host, share := rest, ""
+	if i := strings.IndexByte(rest, '/'); i >= 0 {
+		host, share = rest[:i], rest[i:]
+	}
+	return "//" + strings.ReplaceAll(host, ".", DEFANGED_DOT) + share
+}
+
+func isScriptScheme(scheme string) bool {
+	for _, script := range SCRIPT_SCHEMES {
+		if ascii.EqualFold(scheme, script) {
+			return true
+		}
+	}
+	return false
+}
+
+// The payload of a data: URI (RFC 2397) is still rendered by many viewers when only the
+// scheme is defanged, so the separator between the media type and the payload is also
+// defanged ("text/html;base64,PHNj..." → "text/html;base64[,]PHNj...")
+func defangDataURI(opaque string) string {
+	mediaType, payload, ok := strings.Cut(opaque, ",")
+	if !ok {
+		return opaque
+	}
+	return mediaType + DEFANGED_COMMA + payload
+}
+
+// A mail client opens a mailto: URL (RFC 6068) with its addresses filled in, and they can be
+// copied from it as they are, so each is defanged as DefangIndicator defangs an email
+// address ("bob@example.com,eve@example.org" → "bob[at]example[.]com,eve[at]example[.]org").
+// Header fields in the query, such as "?cc=", are left as they are
+func defangMailto(opaque string) string {
+	addresses := strings.Split(opaque, ",")
+	for i, address := range addresses {
+		if local, domain, ok := strings.Cut(address, "@"); ok {
+			addresses[i] = local + DEFANGED_AT + defangDomain(domain)
+		}
+	}
+	return strings.Join(addresses, ",")
+}
+
+// Defang a URL, given the defanged form of its scheme (if it has one)
+func defangParsedURL(u *url.URL, defangedScheme string, cfg *algorithm.Config) string {
+	var b strings.Builder
+	b.WriteString(defangedScheme)
+
+	if u.Opaque != "" {
+		b.WriteString(DEFANGED_COLON)
+		if cfg.Scripts && isScriptScheme(u.Scheme) {
+			b.WriteString(NEUTRALISED_SCRIPT_MARKER)
+		}
+		switch {
+		case ascii.EqualFold(u.Scheme, "data"):
+			b.WriteString(defangDataURI(u.Opaque))
+		case ascii.EqualFold(u.Scheme, "mailto"):
+			b.WriteString(defangMailto(u.Opaque))
+		default:
+			b.WriteString(u.Opaque)
+		}
+	} else {
+		// As url.URL.String, a URL without an authority ("magnet:?xt=...", "news:/x") has
+		// no "//" to defang
+		hasAuthority := u.Host != "" || u.User != nil || (u.Path != "" && !u.OmitHost)
+		switch {
+		case defangedScheme != "" && hasAuthority:
+			b.WriteString(DEFANGED_SCHEME_SEPARATOR)
+		case defangedScheme != "":
+			b.WriteString(DEFANGED_COLON)
+		case u.Host != "" || u.User != nil:
+			b.WriteString("//")
+		}
+		if cfg.Scripts && isScriptScheme(u.Scheme) {
+			b.WriteString(NEUTRALISED_SCRIPT_MARKER)
+		}
+
+		// User information (user:pass@) is defanged such that the host can no longer be
+		// parsed from the authority
+		if u.User != nil {
+			if _, hasPassword := u.User.Password(); hasPassword && cfg.Redact {
+				b.WriteString(url.UserPassword(u.User.Username(), REDACTED_PASSWORD).String())
+			} else {
+				b.WriteString(u.User.String())
+			}
+			b.WriteString(DEFANGED_AT)
+		}
+
+		b.WriteString(defangHost(u))
+
+		if port := u.Port(); port != "" {
+			if cfg.Port {
+				b.WriteString(DEFANGED_COLON)
+			} else {
+				b.WriteString(":")
+			}
+			b.WriteString(port)
+		}
+
+		path := rawPath(u)
+		if ascii.EqualFold(u.Scheme, "file") && u.Host == "" {
+			path = defangFileURLPath(path)
+		}
+		b.WriteString(path)
+	}
+
+	if u.ForceQuery || u.RawQuery != "" {
+		if cfg.Query {
+			b.WriteString(DEFANGED_QUERY)
+		} else {
+			b.WriteString("?")
+		}
+		b.WriteString(u.RawQuery)
+	}
+	if u.Fragment != "" {
+		if cfg.Fragment {
+			b.WriteString(DEFANGED_FRAGMENT)
+		} else {
+			b.WriteString("#")
+		}
+		b.WriteString(rawFragment(u))
+	}
+
+	return b.String()
+}
+
+// Split a defanged URL into its (still defanged) scheme, and the remainder of the URL
+// following the scheme separator.  The third return value is false if the URL has no scheme
+func SplitDefangedScheme(s string) (string, string, bool) {
+	i := strings.Index(s, ":")
+	if i <= 0 {
+		return "", "", false
+	}
+
+	if closing, ok := DEFANG_BRACKETS[s[i-1]]; ok {
+		scheme := s[:i-1]
+		switch {
+		case strings.HasPrefix(s[i:], "://"+string(closing)):
+			return scheme, "://" + s[i+len("://")+1:], scheme != ""
+		case strings.HasPrefix(s[i:], ":"+string(closing)):
+			return scheme, ":" + s[i+len(":")+1:], scheme != ""
+		}
+	}
+
+	return s[:i], s[i:], true
+}
+
+// Matches the defanged delimiters recognised by RefangURL, in any of the DEFANG_BRACKETS,
+// with the delimiter spelled out or not: "[.]", "(dot)", "{:}", "[at]", "(@)", and so on
+func DefangedDelimiterPattern() *regexp.Regexp {
+	return defangedDelimiterPatternOnce()
+}
+
+var defangedDelimiterPatternOnce = sync.OnceValue(func() *regexp.Regexp {
+	return regexp.MustCompile(`(?i)\[(dot|at|[.:/?#@,])\]|\((dot|at|[.:/?#@,])\)|\{(dot|at|[.:/?#@,])\}`)
+})
+
+// The delimiter of a defanged delimiter matched by DefangedDelimiterPattern ("(dot)" → ".")
+func RefangDelimiter(match string) string {
+	switch delimiter := ascii.ToLower(match[1 : len(match)-1]); delimiter {
+	case "dot":
+		return "."
+	case "at":
+		return "@"
+	default:
+		return delimiter
+	}
+}
+
+// Characters of a scheme bracketed by DefangScheme, such as the additional allowed
+// characters ("coap[+]tcp"), or those that replacing would leave unchanged ("w[xx]yz")
+var bracketedSchemeCharsPatternOnce = sync.OnceValue(func() *regexp.Regexp {
+	return regexp.MustCompile(`\[([a-zA-Z0-9+.\-]+)\]`)
+})
+
+// Refang a URL defanged by DefangURL, or by other common conventions ("hxxp(://)",
+// "h__p://", "meow://", "example(.)com", "example{.}com", "example[dot]com",
+// "example dot com", "user(at)example.com"; see RefangRules), such that it is once again a
+// valid URL
+//
+//	RefangURL("hxxps[://]example[.]com[:]8080/") == "https://example.com:8080/"
+//
+// The scheme is refanged with RefangScheme, so is lowercase whatever the case of the
+// defanged scheme ("HXXPS[://]" → "https://", although DefangURL keeps the case of the
+// scheme as written), and returns an *AmbiguousDefangedSchemeError if more than one
+// registered scheme defangs to it.  Schemes that are not known defanged
+// schemes are left as they are (e.g., where they were never defanged), but for their
+// bracketed characters ("w[xx]yz" → "wxxyz").  As such, unregistered schemes do not
+// round-trip where DefangURL replaced their characters: the replaced characters are not
+// recorded, so "javascript:alert(1)" defangs to "jxxascript[:]alert(1)", which refangs to
+// "jxxascript:alert(1)"
+func RefangURL(s string) (string, error) {
+	return refangURL(s, RefangScheme)
+}
+
+// Refang a URL as per RefangURL, resolving its scheme with the given function rather than
+// RefangScheme; for example, against another dataset, or to choose between the candidates
+// of an *AmbiguousDefangedSchemeError.  Errors matching ErrAmbiguousDefangedScheme are
+// returned; for any other error, the scheme is refanged by the RefangRules alone
+func RefangURLWith(s string, refangScheme func(string) (string, error)) (string, error) {
+	return refangURL(s, refangScheme)
+}
+
+// Refang a URL as per RefangURL, refanging its scheme with the given function
+func refangURL(s string, refangScheme func(string) (string, error)) (string, error) {
+	if strings.TrimSpace(s) == "" {
+		return "", ErrEmptyInput
+	}
+
+	scheme, rest, ok := SplitDefangedScheme(ApplyRefangRules(RefangPartURL, s))
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrMissingScheme, s)
+	}
+
+	// Additional allowed characters of the scheme may be bracketed in any convention; bring
+	// them back to DefangScheme's square brackets
+	for opening, closing := range DEFANG_BRACKETS {
+		for _, char := range schemes.ADDITIONAL_ALLOWED_SCHEME_CHARS {
+			scheme = strings.ReplaceAll(scheme, string(opening)+string(char)+string(closing), "["+string(char)+"]")
+		}
+	}
+
+	refangedScheme, err := refangScheme(scheme)
+	switch {
+	case err == nil:
+		scheme = refangedScheme
+	case errors.Is(err, ErrAmbiguousDefangedScheme):
+		return "", err
+	default:
+		scheme = ApplyRefangRules(RefangPartScheme, scheme)
+		scheme = bracketedSchemeCharsPatternOnce().ReplaceAllString(scheme, "$1")
+	}
+
+	rest = ApplyRefangRules(RefangPartRest, rest)
+
+	refanged := scheme + rest
+	if _, err := url.Parse(refanged); err != nil {
+		return "", fmt.Errorf("cannot refang URL %q: %w", s, err)
+	}
+
+	return refanged, nil
+}
@@ -0,0 +1,39 @@
+package defang
+
+import "github.com/jakewilliami/defang-schemes/internal/ascii"
+
+// Registry hives as written in incident reports, both abbreviated ("HKLM\Software") and
+// as PowerShell drives ("HKLM:\Software")
+var REGISTRY_HIVES = []string{
+	"HKLM", "HKCU", "HKCR", "HKU", "HKCC",
+	"HKEY_LOCAL_MACHINE", "HKEY_CURRENT_USER", "HKEY_CLASSES_ROOT", "HKEY_USERS", "HKEY_CURRENT_CONFIG",
+}
+
+// Whether the input begins with a Windows drive letter ("C:\Users", "c:/windows", or a
+// bare "D:"), which a URL parser would otherwise take for a single-character scheme
+func IsWindowsPath(s string) bool {
+	if len(s) < 2 || !isASCIILetter(s[0]) || s[1] != ':' {
+		return false
+	}
+	return len(s) == 2 || s[2] == '\\' || s[2] == '/'
+}
+
+// Whether the input begins with a registry hive followed by a colon or backslash
+// ("HKLM:\Software\Run", "HKEY_CURRENT_USER\Environment"), case-insensitively
+func IsRegistryKey(s string) bool {
+	for _, hive := range REGISTRY_HIVES {
+		if len(s) > len(hive) && ascii.EqualFold(s[:len(hive)], hive) && (s[len(hive)] == ':' || s[len(hive)] == '\\') {
+			return true
+		}
+	}
+	return false
+}
+
+// Whether the input is a Windows path or registry key, so must not be treated as a URL
+func IsWindowsLocation(s string) bool {
+	return IsWindowsPath(s) || IsRegistryKey(s)
+}
+
+func isASCIILetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
@@ -0,0 +1,94 @@
+package defang_schemes_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+func TestDefangSchemeDegenerateInput(t *testing.T) {
+	for _, input := range []string{"", " ", "\t", "\n", "  \r\n  "} {
+		if defanged := defang_schemes.DefangScheme(input); defanged != "" {
+			t.Errorf("DefangScheme(%q) = %q, want \"\"", input, defanged)
+		}
+		if defanged := string(defang_schemes.AppendDefangedScheme(nil, input)); defanged != "" {
+			t.Errorf("AppendDefangedScheme(nil, %q) = %q, want \"\"", input, defanged)
+		}
+
+		rejecting := map[string]func(string) (string, error){
+			"DefangSchemeStrict": func(s string) (string, error) { return defang_schemes.DefangSchemeStrict(s) },
+			"DefangURL":          func(s string) (string, error) { return defang_schemes.DefangURL(s) },
+			"RefangURL":          defang_schemes.RefangURL,
+		}
+		for name, f := range rejecting {
+			if _, err := f(input); !errors.Is(err, defang_schemes.ErrEmptyInput) {
+				t.Errorf("%s(%q) error = %v, want ErrEmptyInput", name, input, err)
+			}
+		}
+	}
+}
+
+func TestDefangSchemeSingleCharacter(t *testing.T) {
+	if defanged := defang_schemes.DefangScheme("x"); defanged != "[x]" {
+		t.Errorf("DefangScheme(%q) = %q, want %q", "x", defanged, "[x]")
+	}
+	if _, err := defang_schemes.DefangSchemeStrict(" x "); !errors.Is(err, defang_schemes.ErrSingleCharacterScheme) {
+		t.Errorf("DefangSchemeStrict(%q) error = %v, want ErrSingleCharacterScheme", " x ", err)
+	}
+}
+
+func TestDefangScheme(t *testing.T) {
+	cases := []struct {
+		input, want string
+		opts        []defang_schemes.DefangOption
+	}{
+		{"https", "hxxps", nil},
+		{"Https", "Hxxps", nil},
+		{" http ", "hxxp", nil},
+		{"\tHTTPS\n", "HXXPS", nil},
+		{"coap+tcp", "coap[+]tcp", nil},
+
+		// Input is never defanged to itself, whatever the replacement rune
+		{"wxxyz", "w[xx]yz", nil},
+		{"WXXYZ", "W[XX]YZ", nil},
+		{"https", "h[tt]ps", []defang_schemes.DefangOption{defang_schemes.WithReplacementRune('t')}},
+		{"https", "h__ps", []defang_schemes.DefangOption{defang_schemes.WithReplacementRune('_')}},
+
+		// Non-ASCII input is bracketed as ASCII input is, and never defangs to itself
+		{"ü", "[ü]", nil},
+		{"éxx", "é[xx]", nil},
+		{"éx", "é[x]", nil},
+		{"héllo", "hxxlo", nil},
+		{" héllo ", "hxxlo", nil},
+	}
+	for _, c := range cases {
+		if defanged := defang_schemes.DefangScheme(c.input, c.opts...); defanged != c.want {
+			t.Errorf("DefangScheme(%q) = %q, want %q", c.input, defanged, c.want)
+		}
+		if c.opts != nil {
+			continue
+		}
+		if appended := string(defang_schemes.AppendDefangedScheme(nil, c.input)); appended != c.want {
+			t.Errorf("AppendDefangedScheme(nil, %q) = %q, want %q", c.input, appended, c.want)
+		}
+		if defanged, err := defang_schemes.DefangSchemeStrict(c.input, defang_schemes.WithUnicodePolicy(defang_schemes.UnicodePassThroughGeneric)); err != nil || defanged != c.want {
+			t.Errorf("DefangSchemeStrict(%q) = %q, %v, want %q", c.input, defanged, err, c.want)
+		}
+	}
+}
+
+// Every scheme in the dataset defangs to its generated form, including those that took an
+// alternative
+func TestDefangSchemeAgreesWithData(t *testing.T) {
+	for name, scheme := range defang_schemes.Schemes() {
+		if defanged := defang_schemes.DefangScheme(name); defanged != scheme.DefangedScheme {
+			t.Errorf("DefangScheme(%q) = %q, but the dataset has %q", name, defanged, scheme.DefangedScheme)
+		}
+		upper := strings.ToUpper(name)
+		if defanged := defang_schemes.DefangScheme(upper); !strings.EqualFold(defanged, scheme.DefangedScheme) {
+			t.Errorf("DefangScheme(%q) = %q, but the dataset has %q", upper, defanged, scheme.DefangedScheme)
+		}
+	}
+}
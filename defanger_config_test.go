@@ -0,0 +1,83 @@
+package defang_schemes_test
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+func newConfiguredDefanger(t *testing.T, document string) (*defang_schemes.Defanger, *defang_schemes.DefangerConfig) {
+	t.Helper()
+	config, err := defang_schemes.LoadDefangerConfig(strings.NewReader(document))
+	if err != nil {
+		t.Fatalf("LoadDefangerConfig: %v", err)
+	}
+	opts, err := config.Options()
+	if err != nil {
+		t.Fatalf("DefangerConfig.Options: %v", err)
+	}
+	return defang_schemes.NewDefanger(opts...), config
+}
+
+// A Defanger configured from a policy file applies its overrides (both ways), policy, and
+// unknown scheme policy
+func TestDefangerConfig(t *testing.T) {
+	defanger, _ := newConfiguredDefanger(t, `{"unknown_schemes": "generic", "overrides": {"ftp": "fpt"}, "allow_schemes": ["gopher"]}`)
+	cases := []struct {
+		scheme, want string
+	}{
+		{"ftp", "fpt"},
+		{"gopher", "gopher"},
+		{"notascheme", "nxxascheme"},
+		{"https", "hxxps"},
+	}
+	for _, c := range cases {
+		if defanged, err := defanger.Defang(c.scheme); err != nil || defanged != c.want {
+			t.Errorf("Defang(%q) = %q, %v, want %q", c.scheme, defanged, err, c.want)
+		}
+	}
+	if refanged, err := defanger.Refang("fpt"); err != nil || refanged != "ftp" {
+		t.Errorf("Refang(%q) = %q, %v, want %q", "fpt", refanged, err, "ftp")
+	}
+}
+
+func TestLoadDefangerConfigRejectsUnknownFields(t *testing.T) {
+	if _, err := defang_schemes.LoadDefangerConfig(strings.NewReader(`{"stlye": "hxx"}`)); !errors.Is(err, defang_schemes.ErrInvalidPolicy) {
+		t.Errorf("LoadDefangerConfig of a misspelt field: error = %v, want ErrInvalidPolicy", err)
+	}
+}
+
+// The example configuration in the documentation of DefangerConfig loads, and its overrides
+// are applied
+func TestDefangerConfigDocumentedExample(t *testing.T) {
+	source, err := os.ReadFile("registry/defanger_config.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The example is the indented block of the doc comment
+	var example strings.Builder
+	for _, line := range strings.Split(string(source), "\n") {
+		if strings.HasPrefix(line, "type DefangerConfig ") {
+			break
+		}
+		if strings.HasPrefix(line, "//\t") {
+			example.WriteString(strings.TrimPrefix(line, "//\t") + "\n")
+		}
+	}
+	defanger, config := newConfiguredDefanger(t, example.String())
+	if len(config.Overrides) == 0 {
+		t.Fatalf("no overrides in the example:\n%s", example.String())
+	}
+	for scheme, defanged := range config.Overrides {
+		if got, err := defanger.Defang(scheme); err != nil || got != defanged {
+			t.Errorf("Defang(%q) = %q, %v, want %q", scheme, got, err, defanged)
+		}
+		if got, err := defanger.Refang(defanged); err != nil || got != scheme {
+			t.Errorf("Refang(%q) = %q, %v, want %q", defanged, got, err, scheme)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package defang_schemes
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"regexp"
+
+	"github.com/jakewilliami/defang-schemes/extract"
+)
+
+type (
+	AuditEntry       = extract.AuditEntry
+	CSVOption        = extract.CSVOption
+	MatcherKind      = extract.MatcherKind
+	Match            = extract.Match
+	Matcher          = extract.Matcher
+	Options          = extract.Options
+	DefangLevel      = extract.DefangLevel
+	Processor        = extract.Processor
+	ProcessorOption  = extract.ProcessorOption
+	TokenizerHint    = extract.TokenizerHint
+	TruncationPolicy = extract.TruncationPolicy
+	StreamOption     = extract.StreamOption
+	DefangWriter     = extract.DefangWriter
+	RefangReader     = extract.RefangReader
+)
+
+const (
+	MatcherAuto           = extract.MatcherAuto
+	MatcherRegex          = extract.MatcherRegex
+	MatcherAhoCorasick    = extract.MatcherAhoCorasick
+	POOL_MAX_BUFFER_SIZE  = extract.POOL_MAX_BUFFER_SIZE
+	LevelStandard         = extract.LevelStandard
+	LevelScheme           = extract.LevelScheme
+	LevelFull             = extract.LevelFull
+	PROCESSOR_CACHE_SIZE  = extract.PROCESSOR_CACHE_SIZE
+	TokenizePlain         = extract.TokenizePlain
+	TokenizeCSV           = extract.TokenizeCSV
+	TokenizeJSON          = extract.TokenizeJSON
+	TokenizeHTMLAttribute = extract.TokenizeHTMLAttribute
+	STREAM_BUFFER_SIZE    = extract.STREAM_BUFFER_SIZE
+	TruncationTransform   = extract.TruncationTransform
+	TruncationPassThrough = extract.TruncationPassThrough
+)
+
+var (
+	FILE_EXTENSIONS    = extract.FILE_EXTENSIONS
+	ErrInvalidJSON     = extract.ErrInvalidJSON
+	OPAQUE_URL_SCHEMES = extract.OPAQUE_URL_SCHEMES
+)
+
+// As extract.DefangAll
+func DefangAll(text string) string {
+	return extract.DefangAll(text)
+}
+
+// As extract.RefangAll
+func RefangAll(text string) string {
+	return extract.RefangAll(text)
+}
+
+// As extract.WithAuditHook
+func WithAuditHook(hook func(AuditEntry)) ProcessorOption {
+	return extract.WithAuditHook(hook)
+}
+
+// As extract.DefangTextContext
+func DefangTextContext(ctx context.Context, text string) (string, error) {
+	return extract.DefangTextContext(ctx, text)
+}
+
+// As extract.RefangTextContext
+func RefangTextContext(ctx context.Context, text string) (string, error) {
+	return extract.RefangTextContext(ctx, text)
+}
+
+// As extract.DefangAllContext
+func DefangAllContext(ctx context.Context, text string) (string, error) {
+	return extract.DefangAllContext(ctx, text)
+}
+
+// As extract.RefangAllContext
+func RefangAllContext(ctx context.Context, text string) (string, error) {
+	return extract.RefangAllContext(ctx, text)
+}
+
+// As extract.FindSchemesContext
+func FindSchemesContext(ctx context.Context, text string) ([]Match, error) {
+	return extract.FindSchemesContext(ctx, text)
+}
+
+// As extract.WithCSVColumnIndexes
+func WithCSVColumnIndexes(indexes ...int) CSVOption {
+	return extract.WithCSVColumnIndexes(indexes...)
+}
+
+// As extract.WithCSVColumnNames
+func WithCSVColumnNames(names ...string) CSVOption {
+	return extract.WithCSVColumnNames(names...)
+}
+
+// As extract.WithCSVHeader
+func WithCSVHeader() CSVOption {
+	return extract.WithCSVHeader()
+}
+
+// As extract.WithCSVComma
+func WithCSVComma(comma rune) CSVOption {
+	return extract.WithCSVComma(comma)
+}
+
+// As extract.DefangCSV
+func DefangCSV(r io.Reader, w io.Writer, opts ...CSVOption) error {
+	return extract.DefangCSV(r, w, opts...)
+}
+
+// As extract.DefangJSON
+func DefangJSON(data json.RawMessage) (json.RawMessage, error) {
+	return extract.DefangJSON(data)
+}
+
+// As extract.DefangJSONValue
+func DefangJSONValue(v any) any {
+	return extract.DefangJSONValue(v)
+}
+
+// As extract.ExtractScheme
+func ExtractScheme(raw string) (string, bool) {
+	return extract.ExtractScheme(raw)
+}
+
+// As extract.NewMatcher
+func NewMatcher(kind MatcherKind) *Matcher {
+	return extract.NewMatcher(kind)
+}
+
+// As extract.FindSchemes
+func FindSchemes(text string) []Match {
+	return extract.FindSchemes(text)
+}
+
+// As extract.DefangedSchemePattern
+func DefangedSchemePattern() *regexp.Regexp {
+	return extract.DefangedSchemePattern()
+}
+
+// As extract.URLPattern
+func URLPattern() *regexp.Regexp {
+	return extract.URLPattern()
+}
+
+// As extract.WithOptions
+func WithOptions(o Options) ProcessorOption {
+	return extract.WithOptions(o)
+}
+
+// As extract.WithDefanger
+func WithDefanger(defanger *Defanger) ProcessorOption {
+	return extract.WithDefanger(defanger)
+}
+
+// As extract.WithLevel
+func WithLevel(level DefangLevel) ProcessorOption {
+	return extract.WithLevel(level)
+}
+
+// As extract.WithAllowedHosts
+func WithAllowedHosts(hosts ...string) ProcessorOption {
+	return extract.WithAllowedHosts(hosts...)
+}
+
+// As extract.WithCacheSize
+func WithCacheSize(size int) ProcessorOption {
+	return extract.WithCacheSize(size)
+}
+
+// As extract.NewProcessor
+func NewProcessor(opts ...ProcessorOption) *Processor {
+	return extract.NewProcessor(opts...)
+}
+
+// As extract.DefangText
+func DefangText(text string) string {
+	return extract.DefangText(text)
+}
+
+// As extract.RefangText
+func RefangText(text string) string {
+	return extract.RefangText(text)
+}
+
+// As extract.NormalizeDefanged
+func NormalizeDefanged(text string) string {
+	return extract.NormalizeDefanged(text)
+}
+
+// As extract.RefangMessage
+func RefangMessage(message string) (string, []string) {
+	return extract.RefangMessage(message)
+}
+
+// As extract.ScanIndicators
+func ScanIndicators(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	return extract.ScanIndicators(data, atEOF)
+}
+
+// As extract.ScanIndicatorsIn
+func ScanIndicatorsIn(hint TokenizerHint) bufio.SplitFunc {
+	return extract.ScanIndicatorsIn(hint)
+}
+
+// As extract.NewIndicatorScanner
+func NewIndicatorScanner(r io.Reader) *bufio.Scanner {
+	return extract.NewIndicatorScanner(r)
+}
+
+// As extract.NewIndicatorScannerIn
+func NewIndicatorScannerIn(r io.Reader, hint TokenizerHint) *bufio.Scanner {
+	return extract.NewIndicatorScannerIn(r, hint)
+}
+
+// As extract.WithTruncationPolicy
+func WithTruncationPolicy(policy TruncationPolicy) StreamOption {
+	return extract.WithTruncationPolicy(policy)
+}
+
+// As extract.NewDefangWriter
+func NewDefangWriter(w io.Writer, p *Processor, opts ...StreamOption) *DefangWriter {
+	return extract.NewDefangWriter(w, p, opts...)
+}
+
+// As extract.NewRefangReader
+func NewRefangReader(r io.Reader, p *Processor, opts ...StreamOption) *RefangReader {
+	return extract.NewRefangReader(r, p, opts...)
+}
+
+// As extract.PresetSOCDefault
+func PresetSOCDefault() Options {
+	return extract.PresetSOCDefault()
+}
+
+// As extract.PresetCyberChefCompat
+func PresetCyberChefCompat() Options {
+	return extract.PresetCyberChefCompat()
+}
+
+// As extract.PresetMinimal
+func PresetMinimal() Options {
+	return extract.PresetMinimal()
+}
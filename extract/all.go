@@ -0,0 +1,133 @@
+package extract
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/jakewilliami/defang-schemes/defang"
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+)
+
+// Runs of characters that may form an indicator, delimited by whitespace, quotes, and
+// angle brackets
+var indicatorTokenPatternOnce = sync.OnceValue(func() *regexp.Regexp {
+	return regexp.MustCompile("[^\\s<>\"'`]+")
+})
+
+// Defang every URL (as DefangText) and every other indicator (IP address, email address,
+// UNC path, or domain, as defang.DefangIndicator) in the text, leaving everything else as
+// it is, byte-for-byte.  Domains are only recognised outside URLs if their top-level
+// domain has at least two letters, so abbreviations such as "e.g." are left alone, and is
+// not a common file extension, so file names such as "report.pdf" are too (see
+// FILE_EXTENSIONS).  URLs whose authority is already defanged are left alone, so that
+// RefangAll can undo the result
+func DefangAll(text string) string {
+	return defaultProcessor().defangAll(text)
+}
+
+// As DefangAll, with the Processor's configuration for URLs
+func (p *Processor) defangAll(text string) string {
+	return replaceIndicators(p.DefangText(text), defangIndicatorToken)
+}
+
+// Refang every URL (as RefangText) and every other defanged indicator (IP address, email
+// address, UNC path, or domain, in any of the bracket conventions defang.RefangURL
+// recognises) in the text, leaving everything else as it is, byte-for-byte.  Conventions
+// that span whitespace ("example dot com") are only refanged within URLs
+func RefangAll(text string) string {
+	return replaceIndicators(RefangText(text), refangIndicatorToken)
+}
+
+// Defang a token of text that is an indicator other than a URL
+func defangIndicatorToken(token string) (string, bool) {
+	defanged, kind, err := defang.DefangIndicator(token)
+	if err != nil || kind == defang.IndicatorURL || (kind == defang.IndicatorDomain && (!hasAlphabeticTLD(token) || isFileName(token))) {
+		return "", false
+	}
+	return defanged, true
+}
+
+// Refang a token of text that is a defanged indicator other than a URL
+func refangIndicatorToken(token string) (string, bool) {
+	if strings.HasPrefix(token, defang.DEFANGED_UNC_PREFIX) {
+		refanged, err := defang.RefangUNC(token)
+		return refanged, err == nil
+	}
+	if !defang.DefangedDelimiterPattern().MatchString(token) {
+		return "", false
+	}
+	if refanged, err := defang.RefangIP(token); err == nil {
+		return refanged, true
+	}
+
+	refanged := defang.DefangedDelimiterPattern().ReplaceAllStringFunc(token, defang.RefangDelimiter)
+	domain := refanged
+	if local, rest, ok := strings.Cut(refanged, "@"); ok && local != "" {
+		domain = rest
+	}
+	return refanged, defang.IsDomain(domain)
+}
+
+// Replace each token of the text for which transform succeeds, less any punctuation around
+// it that is more likely prose than part of the indicator
+func replaceIndicators(text string, transform func(token string) (string, bool)) string {
+	return replaceAllPooled(indicatorTokenPatternOnce(), text, func(match string) string {
+		start, end := trimIndicatorToken(match)
+		if start == end {
+			return match
+		}
+
+		transformed, ok := transform(match[start:end])
+		if !ok {
+			return match
+		}
+		return match[:start] + transformed + match[end:]
+	})
+}
+
+// The bounds of the indicator within a token, less any punctuation around it that is
+// more likely prose than part of the indicator
+func trimIndicatorToken(token string) (start, end int) {
+	trimmed := token
+	if !strings.HasPrefix(trimmed, defang.DEFANGED_UNC_PREFIX) {
+		trimmed = strings.TrimLeft(trimmed, "([{")
+	}
+	start = len(token) - len(trimmed)
+	return start, start + len(strings.TrimRight(trimmed, ".,;:!?)]}"))
+}
+
+// Common file extensions that are not also top-level domains, so that a token ending in
+// one ("report.pdf", "readme.txt") is taken as a file name rather than a domain.  File
+// extensions that are also top-level domains (".zip", ".sh", ".py") are still defanged
+var FILE_EXTENSIONS = []string{
+	"bat", "bin", "bmp", "cfg", "cmd", "conf", "csv", "dat", "dll", "doc", "docm", "docx",
+	"exe", "gif", "htm", "hta", "html", "ini", "iso", "jar", "jpeg", "jpg", "js", "json",
+	"lnk", "log", "msi", "pdf", "png", "ppt", "pptx", "rtf", "svg", "sys", "tmp", "txt",
+	"vbs", "wav", "xls", "xlsm", "xlsx", "xml", "yaml", "yml",
+}
+
+// Whether the last label of a domain is one of FILE_EXTENSIONS
+func isFileName(domain string) bool {
+	extension := domain[strings.LastIndexByte(domain, '.')+1:]
+	for _, candidate := range FILE_EXTENSIONS {
+		if ascii.EqualFold(extension, candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+// Whether the last label of a domain (or email address) is at least two letters
+func hasAlphabeticTLD(domain string) bool {
+	tld := domain[strings.LastIndexByte(domain, '.')+1:]
+	if len(tld) < 2 {
+		return false
+	}
+	for i := 0; i < len(tld); i++ {
+		if c := tld[i]; !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
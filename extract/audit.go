@@ -0,0 +1,36 @@
+package extract
+
+// A record of one URL altered by a Processor, for keeping an audit trail of evidence
+// sanitisation
+type AuditEntry struct {
+	// As given to DefangDocument or RefangDocument; empty for DefangText and RefangText
+	DocumentID string
+	Original   string
+	Result     string
+	// The transformation applied: "refang", or "defang/" followed by the DefangLevel
+	// (e.g., "defang/standard")
+	Rule string
+}
+
+// Call the hook for every URL a Processor alters, including those whose results were
+// cached.  The hook is called synchronously, in document order, and must be safe for
+// concurrent use if the Processor is shared between goroutines
+func WithAuditHook(hook func(AuditEntry)) ProcessorOption {
+	return func(p *Processor) {
+		p.auditHook = hook
+	}
+}
+
+// Callback for replaceURLs which passes each alteration to the audit hook (if any) and then
+// to next (if not nil)
+func (p *Processor) audit(id, rule string, next func(original, result string)) func(original, result string) {
+	if p.auditHook == nil {
+		return next
+	}
+	return func(original, result string) {
+		p.auditHook(AuditEntry{DocumentID: id, Original: original, Result: result, Rule: rule})
+		if next != nil {
+			next(original, result)
+		}
+	}
+}
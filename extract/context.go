@@ -0,0 +1,94 @@
+package extract
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+)
+
+// The length of the next chunk of text to process before checking for cancellation:
+// STREAM_BUFFER_SIZE or less, ending at a line or whitespace so as not to split an
+// indicator
+func contextChunk(text string) int {
+	if len(text) <= STREAM_BUFFER_SIZE {
+		return len(text)
+	}
+	window := text[:STREAM_BUFFER_SIZE]
+	if i := strings.LastIndexByte(window, '\n'); i >= 0 {
+		return i + 1
+	}
+	for i := len(window) - 1; i >= 0; i-- {
+		if ascii.IsSpace(window[i]) {
+			return i + 1
+		}
+	}
+	return len(window)
+}
+
+// Transform the text a chunk at a time, returning ctx.Err() if the context is done before
+// it is finished
+func transformContext(ctx context.Context, text string, transform func(string) string) (string, error) {
+	b := getBuffer()
+	defer putBuffer(b)
+	b.Grow(len(text))
+	for len(text) > 0 {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		n := contextChunk(text)
+		b.WriteString(transform(text[:n]))
+		text = text[n:]
+	}
+	return b.String(), nil
+}
+
+// As DefangText, giving up with ctx.Err() if the context is done first
+func DefangTextContext(ctx context.Context, text string) (string, error) {
+	return defaultProcessor().DefangTextContext(ctx, text)
+}
+
+// As RefangText, giving up with ctx.Err() if the context is done first
+func RefangTextContext(ctx context.Context, text string) (string, error) {
+	return defaultProcessor().RefangTextContext(ctx, text)
+}
+
+// As DefangAll, giving up with ctx.Err() if the context is done first
+func DefangAllContext(ctx context.Context, text string) (string, error) {
+	return transformContext(ctx, text, DefangAll)
+}
+
+// As RefangAll, giving up with ctx.Err() if the context is done first
+func RefangAllContext(ctx context.Context, text string) (string, error) {
+	return transformContext(ctx, text, RefangAll)
+}
+
+// As FindSchemes, giving up with ctx.Err() if the context is done first
+func FindSchemesContext(ctx context.Context, text string) ([]Match, error) {
+	var matches []Match
+	for offset := 0; offset < len(text); {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n := contextChunk(text[offset:])
+		for _, match := range FindSchemes(text[offset : offset+n]) {
+			match.Start += offset
+			match.End += offset
+			matches = append(matches, match)
+		}
+		offset += n
+	}
+	return matches, nil
+}
+
+// As Processor.DefangText, giving up with ctx.Err() if the context is done first.  Text
+// is processed in chunks of up to STREAM_BUFFER_SIZE, so servers can enforce per-request
+// budgets on very large inputs
+func (p *Processor) DefangTextContext(ctx context.Context, text string) (string, error) {
+	return transformContext(ctx, text, p.DefangText)
+}
+
+// As Processor.RefangText, giving up with ctx.Err() if the context is done first
+func (p *Processor) RefangTextContext(ctx context.Context, text string) (string, error) {
+	return transformContext(ctx, text, p.RefangText)
+}
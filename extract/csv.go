@@ -0,0 +1,119 @@
+package extract
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/jakewilliami/defang-schemes/schemes"
+)
+
+// Option to configure DefangCSV
+type CSVOption func(*csvConfig)
+
+type csvConfig struct {
+	indexes []int
+	names   []string
+	header  bool
+	comma   rune
+}
+
+// Defang the columns at the given (zero-based) indexes
+func WithCSVColumnIndexes(indexes ...int) CSVOption {
+	return func(c *csvConfig) {
+		c.indexes = append(c.indexes, indexes...)
+	}
+}
+
+// Defang the columns with the given header names.  Implies WithCSVHeader
+func WithCSVColumnNames(names ...string) CSVOption {
+	return func(c *csvConfig) {
+		c.names = append(c.names, names...)
+		c.header = true
+	}
+}
+
+// Treat the first row as a header, writing it unchanged
+func WithCSVHeader() CSVOption {
+	return func(c *csvConfig) {
+		c.header = true
+	}
+}
+
+// Use a field delimiter other than a comma (e.g., '\t')
+func WithCSVComma(comma rune) CSVOption {
+	return func(c *csvConfig) {
+		c.comma = comma
+	}
+}
+
+// Defang the indicators in columns of a CSV file with the default Processor; see
+// Processor.DefangCSV
+func DefangCSV(r io.Reader, w io.Writer, opts ...CSVOption) error {
+	return defaultProcessor().DefangCSV(r, w, opts...)
+}
+
+// Defang every indicator (as DefangAll) in the chosen columns of a CSV file, such as a
+// bulk IOC spreadsheet, writing the result to w.  Rows are streamed one at a time.  If no
+// columns are chosen, every column is defanged.  Returns schemes.ErrUnknownColumn if a
+// named column is not in the header
+func (p *Processor) DefangCSV(r io.Reader, w io.Writer, opts ...CSVOption) error {
+	config := &csvConfig{comma: ','}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	reader := csv.NewReader(r)
+	reader.Comma = config.comma
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+	writer := csv.NewWriter(w)
+	writer.Comma = config.comma
+
+	columns := make(map[int]bool)
+	for _, i := range config.indexes {
+		columns[i] = true
+	}
+
+	for row := 0; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if row == 0 && config.header {
+			for _, name := range config.names {
+				i := indexOf(record, name)
+				if i < 0 {
+					return fmt.Errorf("%w: %q", schemes.ErrUnknownColumn, name)
+				}
+				columns[i] = true
+			}
+		} else {
+			for i, cell := range record {
+				if len(columns) == 0 || columns[i] {
+					record[i] = p.defangAll(cell)
+				}
+			}
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func indexOf(record []string, name string) int {
+	for i, field := range record {
+		if field == name {
+			return i
+		}
+	}
+	return -1
+}
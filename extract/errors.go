@@ -0,0 +1,5 @@
+package extract
+
+import "errors"
+
+var ErrInvalidJSON = errors.New("invalid JSON")
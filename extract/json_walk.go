@@ -0,0 +1,131 @@
+package extract
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Defang URLs in every string value of a JSON document (but not in object keys), as
+// DefangText, with the default Processor; see Processor.DefangJSON
+func DefangJSON(data json.RawMessage) (json.RawMessage, error) {
+	return defaultProcessor().DefangJSON(data)
+}
+
+// Defang URLs in every string within a decoded JSON value, with the default Processor;
+// see Processor.DefangJSONValue
+func DefangJSONValue(v any) any {
+	return defaultProcessor().DefangJSONValue(v)
+}
+
+// Defang URLs in every string value of a JSON document (but not in object keys), as
+// DefangText, for sanitising API payloads and STIX bundles.  Only the strings that change
+// are rewritten, so key order, whitespace, and number formatting are preserved.  Returns
+// ErrInvalidJSON if the document does not parse
+func (p *Processor) DefangJSON(data json.RawMessage) (json.RawMessage, error) {
+	return walkJSON(data, p.DefangText)
+}
+
+// Defang URLs in every string within a value decoded from JSON (map[string]any, []any,
+// and json.RawMessage, as well as strings), returning a defanged copy.  Object keys are
+// left as they are.  Maps have no order to preserve; decode into a json.RawMessage, and
+// use DefangJSON, where key order matters.  Raw messages that do not parse are left as
+// they are
+func (p *Processor) DefangJSONValue(v any) any {
+	switch v := v.(type) {
+	case string:
+		return p.DefangText(v)
+	case map[string]any:
+		defanged := make(map[string]any, len(v))
+		for key, value := range v {
+			defanged[key] = p.DefangJSONValue(value)
+		}
+		return defanged
+	case []any:
+		defanged := make([]any, len(v))
+		for i, value := range v {
+			defanged[i] = p.DefangJSONValue(value)
+		}
+		return defanged
+	case json.RawMessage:
+		defanged, err := p.DefangJSON(v)
+		if err != nil {
+			return v
+		}
+		return defanged
+	default:
+		return v
+	}
+}
+
+// Rewrite each string value of the document with transform, leaving all other bytes as
+// they are
+func walkJSON(data []byte, transform func(string) string) (json.RawMessage, error) {
+	if !json.Valid(data) {
+		return nil, ErrInvalidJSON
+	}
+
+	// Open containers, and for objects, whether the next token is a key
+	type container struct {
+		object bool
+		key    bool
+	}
+	var open []container
+
+	var out []byte
+	written := 0
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	for {
+		start := decoder.InputOffset()
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+		end := decoder.InputOffset()
+
+		if delim, ok := token.(json.Delim); ok && (delim == '}' || delim == ']') {
+			open = open[:len(open)-1]
+			continue
+		}
+
+		if n := len(open); n > 0 && open[n-1].object {
+			isKey := open[n-1].key
+			open[n-1].key = !isKey
+			if isKey {
+				continue
+			}
+		}
+
+		switch token := token.(type) {
+		case json.Delim:
+			open = append(open, container{object: token == '{', key: token == '{'})
+		case string:
+			transformed := transform(token)
+			if transformed == token {
+				continue
+			}
+			literal, err := marshalJSONString(transformed)
+			if err != nil {
+				return nil, err
+			}
+			// The token is preceded by whitespace and separators
+			start = end - int64(len(bytes.TrimLeft(data[start:end], " \t\r\n,:")))
+			out = append(append(out, data[written:start]...), literal...)
+			written = int(end)
+		}
+	}
+
+	return append(out, data[written:]...), nil
+}
+
+// Encode a string as a JSON literal, without escaping HTML characters (such as the
+// ampersands of queries)
+func marshalJSONString(s string) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(s); err != nil {
+		return nil, fmt.Errorf("cannot encode %q: %w", s, err)
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,53 @@
+package extract
+
+import (
+	"strings"
+
+	"github.com/jakewilliami/defang-schemes/defang"
+)
+
+// Whether s is a scheme, possibly with characters bracketed as done by
+// defang.DefangScheme (e.g., "coap[+]tcp") or defang.AlternativeDefangs (e.g., "a[w]")
+func isSchemeToken(s string) bool {
+	if s == "" || !('a' <= s[0] && s[0] <= 'z' || 'A' <= s[0] && s[0] <= 'Z') {
+		return false
+	}
+
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		case c == '+' || c == '-' || c == '.':
+		case c == '[' && i+2 < len(s) && isBracketableSchemeChar(s[i+1]) && s[i+2] == ']':
+			i += 2
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func isBracketableSchemeChar(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '+' || c == '-' || c == '.'
+}
+
+// Pull the scheme token from a URL-ish string, without parsing the rest of the URL as
+// net/url does (which rejects many defanged or malformed inputs).  The token is returned
+// as written, so defanged schemes remain defanged:
+//
+//	ExtractScheme("hxxps[://]example[.]com") == "hxxps", true
+//	ExtractScheme("coap[+]tcp[://]host") == "coap[+]tcp", true
+//
+// Drive letters and registry hives ("C:\Users", "HKLM:\Software") are not schemes
+func ExtractScheme(raw string) (string, bool) {
+	raw = strings.TrimSpace(raw)
+	if defang.IsWindowsLocation(raw) {
+		return "", false
+	}
+	scheme, _, ok := defang.SplitDefangedScheme(raw)
+	if !ok || !isSchemeToken(scheme) {
+		return "", false
+	}
+	return scheme, true
+}
@@ -0,0 +1,238 @@
+package extract
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/jakewilliami/defang-schemes/defang"
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+	"github.com/jakewilliami/defang-schemes/schemes"
+)
+
+// Implementation used by a Matcher
+type MatcherKind int
+
+const (
+	// Choose the implementation that is fastest for the dataset.  This is currently
+	// MatcherAhoCorasick, which tools/matcherbench measures to be faster than MatcherRegex
+	// at every input size, and no slower to build
+	MatcherAuto MatcherKind = iota
+	// Match with DefangedSchemePattern
+	MatcherRegex
+	// Match with an Aho–Corasick automaton over the defanged schemes
+	MatcherAhoCorasick
+)
+
+func (k MatcherKind) String() string {
+	switch k {
+	case MatcherAuto:
+		return "auto"
+	case MatcherRegex:
+		return "regex"
+	case MatcherAhoCorasick:
+		return "aho-corasick"
+	default:
+		return fmt.Sprintf("MatcherKind(%d)", int(k))
+	}
+}
+
+// An occurrence of a scheme in text, at text[Start:End]
+type Match struct {
+	Start  int
+	End    int
+	Scheme schemes.Scheme
+	// Whether the occurrence is the scheme's defanged form, rather than the scheme itself
+	Defanged bool
+}
+
+// Finds defanged schemes in text.  All implementations return the same matches as
+// DefangedSchemePattern: case-insensitive, on word boundaries, non-overlapping, and
+// preferring the longest defanged scheme at the leftmost position
+type Matcher struct {
+	kind MatcherKind
+}
+
+// Create a Matcher of the given kind
+func NewMatcher(kind MatcherKind) *Matcher {
+	return &Matcher{kind: kind}
+}
+
+// Find all defanged schemes in the text
+func (m *Matcher) FindAll(text string) []Match {
+	if m.kind == MatcherRegex {
+		return findAllRegex(text)
+	}
+	return defangedSchemeAutomatonOnce().findAll(text)
+}
+
+func findAllRegex(text string) []Match {
+	var matches []Match
+	for _, loc := range DefangedSchemePattern().FindAllStringIndex(text, -1) {
+		scheme, ok := defang.LookupDefanged(text[loc[0]:loc[1]])
+		if !ok {
+			continue
+		}
+		matches = append(matches, Match{Start: loc[0], End: loc[1], Scheme: scheme, Defanged: true})
+	}
+	return matches
+}
+
+// Find every scheme in the text, whether fanged or defanged, so that tools can highlight
+// indicators in free text.  Defanged forms are found as by a Matcher; as many scheme names
+// are also common words ("about", "info", "file"), fanged schemes are only found when
+// followed by a colon.  Where a defanged form is also a scheme (hxxp[s]), it is taken to be
+// defanged
+func FindSchemes(text string) []Match {
+	return schemeAutomatonOnce().findAll(text)
+}
+
+// Aho–Corasick automaton over (lower case) schemes or their defanged forms, with a dense
+// transition table over the bytes that occur in them
+type automaton struct {
+	alphabet [256]int32 // Byte → symbol, or -1
+	symbols  int
+	next     []int32 // State × symbol → state
+	outputs  [][]int // State → patterns ending here, including by suffix
+	patterns []string
+	schemes  []schemes.Scheme
+	defanged []bool
+}
+
+var (
+	defangedSchemeAutomatonOnce = sync.OnceValue(func() *automaton { return newAutomaton(false) })
+	schemeAutomatonOnce         = sync.OnceValue(func() *automaton { return newAutomaton(true) })
+)
+
+// Build an automaton over the defanged schemes, and optionally the schemes themselves
+func newAutomaton(fanged bool) *automaton {
+	a := &automaton{}
+	forms := make(map[string]bool)
+	for _, scheme := range schemes.Schemes() {
+		forms[ascii.ToLower(scheme.DefangedScheme)] = true
+	}
+	if fanged {
+		for name := range schemes.Schemes() {
+			forms[name] = true
+		}
+	}
+	for form := range forms {
+		a.patterns = append(a.patterns, form)
+	}
+	sort.Strings(a.patterns)
+	for _, pattern := range a.patterns {
+		scheme, ok := defang.LookupDefanged(pattern)
+		if !ok {
+			scheme, _ = schemes.Lookup(pattern)
+		}
+		a.schemes = append(a.schemes, scheme)
+		a.defanged = append(a.defanged, ok)
+	}
+
+	for i := range a.alphabet {
+		a.alphabet[i] = -1
+	}
+	for _, pattern := range a.patterns {
+		for i := 0; i < len(pattern); i++ {
+			c := pattern[i]
+			if a.alphabet[c] < 0 {
+				a.alphabet[c] = int32(a.symbols)
+				a.symbols++
+			}
+		}
+	}
+
+	// Trie
+	a.addState()
+	for p, pattern := range a.patterns {
+		state := int32(0)
+		for i := 0; i < len(pattern); i++ {
+			symbol := a.alphabet[pattern[i]]
+			if a.next[int(state)*a.symbols+int(symbol)] <= 0 {
+				a.next[int(state)*a.symbols+int(symbol)] = a.addState()
+			}
+			state = a.next[int(state)*a.symbols+int(symbol)]
+		}
+		a.outputs[state] = append(a.outputs[state], p)
+	}
+
+	// Failure links, breadth first, folded into the transition table
+	fail := make([]int32, len(a.outputs))
+	var queue []int32
+	for symbol := 0; symbol < a.symbols; symbol++ {
+		if child := a.next[symbol]; child > 0 {
+			queue = append(queue, child)
+		}
+	}
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+		a.outputs[state] = append(a.outputs[state], a.outputs[fail[state]]...)
+		for symbol := 0; symbol < a.symbols; symbol++ {
+			i := int(state)*a.symbols + symbol
+			fallback := a.next[int(fail[state])*a.symbols+symbol]
+			if child := a.next[i]; child > 0 {
+				fail[child] = fallback
+				queue = append(queue, child)
+			} else {
+				a.next[i] = fallback
+			}
+		}
+	}
+
+	return a
+}
+
+func (a *automaton) addState() int32 {
+	a.next = append(a.next, make([]int32, a.symbols)...)
+	a.outputs = append(a.outputs, nil)
+	return int32(len(a.outputs) - 1)
+}
+
+func (a *automaton) findAll(text string) []Match {
+	// Every occurrence of every pattern on word boundaries, as the regular expression
+	var candidates []Match
+	state := int32(0)
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		symbol := a.alphabet[c]
+		if symbol < 0 {
+			state = 0
+			continue
+		}
+		state = a.next[int(state)*a.symbols+int(symbol)]
+		for _, p := range a.outputs[state] {
+			start, end := i+1-len(a.patterns[p]), i+1
+			if start > 0 && ascii.IsWordChar(text[start-1]) {
+				continue
+			}
+			if ascii.IsWordChar(text[end-1]) && end < len(text) && ascii.IsWordChar(text[end]) {
+				continue
+			}
+			if !a.defanged[p] && (end == len(text) || text[end] != ':') {
+				continue
+			}
+			candidates = append(candidates, Match{Start: start, End: end, Scheme: a.schemes[p], Defanged: a.defanged[p]})
+		}
+	}
+
+	// Leftmost, then longest, without overlaps
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Start != candidates[j].Start {
+			return candidates[i].Start < candidates[j].Start
+		}
+		return candidates[i].End > candidates[j].End
+	})
+	var matches []Match
+	end := 0
+	for _, candidate := range candidates {
+		if candidate.Start >= end {
+			matches = append(matches, candidate)
+			end = candidate.End
+		}
+	}
+	return matches
+}
@@ -0,0 +1,111 @@
+package extract
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/jakewilliami/defang-schemes/defang"
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+	"github.com/jakewilliami/defang-schemes/schemes"
+	"github.com/jakewilliami/defang-schemes/urn"
+)
+
+var (
+	defangedSchemePatternOnce = sync.OnceValue(defangedSchemePattern)
+	urlPatternOnce            = sync.OnceValue(urlPattern)
+)
+
+// Matches any of the defanged schemes in schemes.Map, case-insensitively, preferring the
+// longest
+func DefangedSchemePattern() *regexp.Regexp {
+	return defangedSchemePatternOnce()
+}
+
+// Matches a URL, fanged or defanged, with a hierarchical scheme separator
+// ("://" or "[://]"), or a fanged opaque URL of one of OPAQUE_URL_SCHEMES
+// ("javascript:alert(1)"), up to the next whitespace, quote, or angle bracket
+func URLPattern() *regexp.Regexp {
+	return urlPatternOnce()
+}
+
+func defangedSchemePattern() *regexp.Regexp {
+	defangedSchemes := make([]string, 0, len(schemes.Schemes()))
+	seen := make(map[string]struct{}, len(schemes.Schemes()))
+	for _, scheme := range schemes.Schemes() {
+		if _, exists := seen[scheme.DefangedScheme]; exists {
+			continue
+		}
+		seen[scheme.DefangedScheme] = struct{}{}
+
+		// Word boundaries only hold next to word characters, and alternative defanged forms
+		// may end in a bracket (e.g., "a[w]")
+		pattern := regexp.QuoteMeta(scheme.DefangedScheme)
+		if ascii.IsWordChar(scheme.DefangedScheme[len(scheme.DefangedScheme)-1]) {
+			pattern += `\b`
+		}
+		defangedSchemes = append(defangedSchemes, pattern)
+	}
+
+	// Longest first, so that (e.g.) hxxps is preferred over hxxp
+	sort.Slice(defangedSchemes, func(i, j int) bool {
+		if len(defangedSchemes[i]) != len(defangedSchemes[j]) {
+			return len(defangedSchemes[i]) > len(defangedSchemes[j])
+		}
+		return defangedSchemes[i] < defangedSchemes[j]
+	})
+
+	pattern := fmt.Sprintf(`(?i)\b(?:%s)`, strings.Join(defangedSchemes, "|"))
+	return regexp.MustCompile(pattern)
+}
+
+func urlPattern() *regexp.Regexp {
+	var allowedChars string
+	for _, char := range schemes.ADDITIONAL_ALLOWED_SCHEME_CHARS {
+		allowedChars += string(char)
+	}
+	allowedChars = regexp.QuoteMeta(allowedChars)
+
+	// Defanged schemes may bracket the additional allowed characters (e.g., "coap[+]tcp"),
+	// or any other character (e.g., "a[w]").  Underscores are not scheme characters, but
+	// may replace them in defanged schemes (e.g., "h__ps")
+	schemeChar := fmt.Sprintf(`[A-Za-z0-9%s]`, allowedChars)
+	scheme := fmt.Sprintf(`[A-Za-z](?:%s|_+%s|\[%s\])*`, schemeChar, schemeChar, schemeChar)
+	// The scheme separator may be bracketed whole ("[://]", "(://)"), or only its colon
+	// ("[:]//")
+	separator := `://|[\[({]://[\])}]|[\[({]:[\])}]//`
+
+	// Opaque URLs have no "//" to tell them from prose ("note:"), so only those of
+	// OPAQUE_URL_SCHEMES are matched, fanged or defanged in any style
+	var opaque []string
+	for _, name := range OPAQUE_URL_SCHEMES {
+		registered, ok := schemes.Lookup(name)
+		if !ok {
+			registered = schemes.Scheme{Scheme: name}
+		}
+		forms := []string{name}
+		for _, style := range schemes.STYLES {
+			forms = append(forms, registered.DefangedAs(style))
+		}
+		for _, form := range forms {
+			// As in defangedSchemePattern, word boundaries only hold next to word characters
+			pattern := regexp.QuoteMeta(form)
+			if ascii.IsWordChar(form[0]) {
+				pattern = `\b` + pattern
+			}
+			opaque = append(opaque, pattern)
+		}
+	}
+	opaqueSeparator := `:|[\[({]:[\])}]`
+
+	pattern := fmt.Sprintf(`(?i)(?:\b%s(?:%s)|(?:%s)(?:%s))[^\s<>"'`+"`"+`]+`, scheme, separator, strings.Join(opaque, "|"), opaqueSeparator)
+	return regexp.MustCompile(pattern)
+}
+
+// Schemes of the opaque URLs (without "//") that URLPattern matches: those that run
+// script, those whose payload is rendered or acted on as soon as the link is followed, and
+// URNs, which are defanged as by urn.Defang
+var OPAQUE_URL_SCHEMES = append(slices.Clone(defang.SCRIPT_SCHEMES), "data", "mailto", urn.SCHEME)
@@ -0,0 +1,51 @@
+package extract
+
+import (
+	"bytes"
+	"regexp"
+	"sync"
+)
+
+// Buffers larger than this are not returned to the pool, so that one very large document
+// does not pin its memory for the life of the process
+const POOL_MAX_BUFFER_SIZE = 1 << 20
+
+var bufferPool = sync.Pool{
+	New: func() any {
+		return new(bytes.Buffer)
+	},
+}
+
+// An empty buffer from the pool, for intermediate results; return it with putBuffer
+func getBuffer() *bytes.Buffer {
+	return bufferPool.Get().(*bytes.Buffer)
+}
+
+func putBuffer(b *bytes.Buffer) {
+	if b.Cap() > POOL_MAX_BUFFER_SIZE {
+		return
+	}
+	b.Reset()
+	bufferPool.Put(b)
+}
+
+// As pattern.ReplaceAllStringFunc, building the result in a pooled buffer.  Text without
+// matches is returned as it is, without allocating
+func replaceAllPooled(pattern *regexp.Regexp, text string, replace func(string) string) string {
+	matches := pattern.FindAllStringIndex(text, -1)
+	if matches == nil {
+		return text
+	}
+
+	b := getBuffer()
+	defer putBuffer(b)
+	b.Grow(len(text))
+	written := 0
+	for _, match := range matches {
+		b.WriteString(text[written:match[0]])
+		b.WriteString(replace(text[match[0]:match[1]]))
+		written = match[1]
+	}
+	b.WriteString(text[written:])
+	return b.String()
+}
@@ -0,0 +1,58 @@
+package extract
+
+import "github.com/jakewilliami/defang-schemes/defang"
+
+// Processor settings bundled as one value.  The zero Options are the defaults of
+// NewProcessor; the Preset functions return starting points for common environments
+type Options struct {
+	// Style in which schemes are defanged (see defang.WithStyle)
+	Style []defang.DefangOption
+	// What happens to schemes that are not registered (see defang.WithUnknownSchemePolicy)
+	UnknownSchemes defang.UnknownSchemePolicy
+	// How much of each URL is defanged (see WithLevel)
+	Level DefangLevel
+	// Hosts whose URLs are never defanged (see WithAllowedHosts)
+	AllowedHosts []string
+}
+
+// For SOC tooling and ticketing: every delimiter that could make a URL clickable is
+// defanged, and the domains reserved by RFC 2606 and RFC 6761, which cannot be registered,
+// and so cannot be malicious, are left alone: the documentation domains (example.com,
+// example.net, and example.org), the .test, .example, and .invalid TLDs, and .localhost.
+// Each call returns a fresh copy, which may be modified
+func PresetSOCDefault() Options {
+	return Options{
+		Level:        LevelFull,
+		AllowedHosts: []string{"example.com", "example.net", "example.org", "test", "example", "invalid", "localhost"},
+	}
+}
+
+// Output closest to CyberChef's "Defang URL" operation ("hxxps[://]example[.]com/"), for
+// teams comparing results with CyberChef recipes
+func PresetCyberChefCompat() Options {
+	return Options{
+		Level: LevelStandard,
+	}
+}
+
+// Only the scheme and scheme separator are defanged ("hxxps[://]example.com/"), so hosts
+// and paths stay searchable; unregistered schemes are left as written
+func PresetMinimal() Options {
+	return Options{
+		UnknownSchemes: defang.UnknownSchemePassThrough,
+		Level:          LevelScheme,
+	}
+}
+
+// Configure a Processor from Options, such as a preset:
+//
+//	NewProcessor(WithOptions(PresetSOCDefault()))
+//
+// Later options override those set here
+func WithOptions(o Options) ProcessorOption {
+	return func(p *Processor) {
+		p.defanger = defang.NewDefanger(defang.WithStyle(o.Style...), defang.WithUnknownSchemePolicy(o.UnknownSchemes))
+		WithLevel(o.Level)(p)
+		WithAllowedHosts(o.AllowedHosts...)(p)
+	}
+}
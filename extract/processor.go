@@ -0,0 +1,341 @@
+// Finding, defanging, and refanging indicators within text: free text, streams, CSV, and
+// JSON.  Indicators are defanged as by the defang package, and a Processor chooses how
+// much of each is defanged
+package extract
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/jakewilliami/defang-schemes/defang"
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+	"github.com/jakewilliami/defang-schemes/urn"
+)
+
+// How much of each URL a Processor defangs
+type DefangLevel int
+
+const (
+	// As defang.DefangURL: the scheme, scheme separator, user information delimiter, and host
+	LevelStandard DefangLevel = iota
+	// The scheme and scheme separator only ("hxxps[://]example.com/")
+	LevelScheme
+	// As LevelStandard, and also the port, query, and fragment delimiters
+	LevelFull
+)
+
+func (l DefangLevel) String() string {
+	switch l {
+	case LevelStandard:
+		return "standard"
+	case LevelScheme:
+		return "scheme"
+	case LevelFull:
+		return "full"
+	default:
+		return fmt.Sprintf("DefangLevel(%d)", int(l))
+	}
+}
+
+// Default maximum number of URLs whose results a Processor caches
+const PROCESSOR_CACHE_SIZE = 4096
+
+// Defangs and refangs the URLs in documents.  A Processor is configured once, and may be
+// reused across many documents and goroutines; results for URLs seen before are cached
+type Processor struct {
+	defanger     *defang.Defanger
+	level        DefangLevel
+	allowedHosts []string
+
+	cacheSize int
+	mu        sync.Mutex
+	cache     map[string]string
+
+	auditHook func(AuditEntry)
+}
+
+// Option to configure a Processor
+type ProcessorOption func(*Processor)
+
+// Defang and refang schemes with the given Defanger (default: defang.NewDefanger())
+func WithDefanger(defanger *defang.Defanger) ProcessorOption {
+	return func(p *Processor) {
+		p.defanger = defanger
+	}
+}
+
+// Choose how much of each URL is defanged (default: LevelStandard)
+func WithLevel(level DefangLevel) ProcessorOption {
+	return func(p *Processor) {
+		p.level = level
+	}
+}
+
+// Never defang URLs whose host is one of these hosts, or a subdomain of one (e.g.,
+// internal hosts, or example.com)
+func WithAllowedHosts(hosts ...string) ProcessorOption {
+	return func(p *Processor) {
+		for _, host := range hosts {
+			p.allowedHosts = append(p.allowedHosts, ascii.ToLower(strings.TrimSuffix(host, ".")))
+		}
+	}
+}
+
+// Cache results for at most this many URLs (default: PROCESSOR_CACHE_SIZE); 0 disables the
+// cache
+func WithCacheSize(size int) ProcessorOption {
+	return func(p *Processor) {
+		p.cacheSize = size
+	}
+}
+
+// Create a Processor
+func NewProcessor(opts ...ProcessorOption) *Processor {
+	p := &Processor{cacheSize: PROCESSOR_CACHE_SIZE}
+	for _, opt := range opts {
+		opt(p)
+	}
+	if p.defanger == nil {
+		p.defanger = defang.NewDefanger()
+	}
+	p.cache = make(map[string]string)
+	return p
+}
+
+var defaultProcessor = sync.OnceValue(func() *Processor { return NewProcessor() })
+
+// Defang every (fanged) URL in the text with the default Processor
+func DefangText(text string) string {
+	return defaultProcessor().DefangText(text)
+}
+
+// Refang every defanged URL in the text with the default Processor
+func RefangText(text string) string {
+	return defaultProcessor().RefangText(text)
+}
+
+// Rewrite every defanged URL in the text in the canonical defang style with the default
+// Processor; see Processor.NormalizeDefanged
+func NormalizeDefanged(text string) string {
+	return defaultProcessor().NormalizeDefanged(text)
+}
+
+// Refang every defanged indicator in a chat message with the default Processor, also
+// returning the refanged indicators; see Processor.RefangMessage
+func RefangMessage(message string) (string, []string) {
+	return defaultProcessor().RefangMessage(message)
+}
+
+// Defang every (fanged) URL in the text.  URLs that cannot be defanged (such as those with
+// unknown schemes, if the Defanger rejects them), URLs to allowed hosts, and URLs whose
+// authority is already defanged ("hxxp://bad(.)org") are left as they are
+func (p *Processor) DefangText(text string) string {
+	return p.DefangDocument("", text)
+}
+
+// As DefangText, identifying the document to the audit hook (see WithAuditHook)
+func (p *Processor) DefangDocument(id, text string) string {
+	return p.replaceURLs(text, "defang:", func(match string) (string, bool) {
+		if !hasFangedSeparator(match) || hasDefangedAuthority(match) {
+			return "", false
+		}
+		// Underscores are not scheme characters, so a URL whose "scheme" has one
+		// ("x_https://...") starts after the last
+		scheme, _, _ := strings.Cut(match, ":")
+		if i := strings.LastIndexByte(scheme, '_'); i >= 0 {
+			defanged, ok := p.defangURL(match[i+1:])
+			return match[:i+1] + defanged, ok
+		}
+		return p.defangURL(match)
+	}, p.audit(id, p.defangRule(), nil))
+}
+
+// The audit rule of defanging at the Processor's level (e.g., "defang/standard")
+func (p *Processor) defangRule() string {
+	switch p.level {
+	case LevelStandard:
+		return "defang/standard"
+	case LevelScheme:
+		return "defang/scheme"
+	case LevelFull:
+		return "defang/full"
+	default:
+		return "defang/" + p.level.String()
+	}
+}
+
+// Refang every defanged URL in the text.  URLs that cannot be refanged are left as they are
+func (p *Processor) RefangText(text string) string {
+	return p.RefangDocument("", text)
+}
+
+// As RefangText, identifying the document to the audit hook (see WithAuditHook)
+func (p *Processor) RefangDocument(id, text string) string {
+	return p.refangText(text, p.audit(id, "refang", nil))
+}
+
+// Refang every defanged indicator in a chat message, as RefangAll, and also return the
+// refanged indicators (the message's IOCs: URLs, IP addresses, email addresses, UNC paths,
+// and domains) in order of first appearance, without duplicates.  Indicators that were not
+// defanged are not IOCs, and are not returned.  This suits chat-ops bots, which repost the
+// message and enrich its IOCs from a single call
+func (p *Processor) RefangMessage(message string) (string, []string) {
+	var iocs []string
+	seen := make(map[string]bool)
+	collect := func(_, ioc string) {
+		if !seen[ioc] {
+			seen[ioc] = true
+			iocs = append(iocs, ioc)
+		}
+	}
+	refanged := p.refangText(message, p.audit("", "refang", collect))
+	refanged = replaceIndicators(refanged, func(token string) (string, bool) {
+		ioc, ok := refangIndicatorToken(token)
+		if ok && ioc != token {
+			collect(token, ioc)
+		}
+		return ioc, ok
+	})
+	return refanged, iocs
+}
+
+// Rewrite every defanged URL in the text in this Processor's canonical defang style, so
+// that the same indicator defanged by different tools (hxxp[:]//, http[://], meow://,
+// ...) compares equal.  URLs that were not defanged are left as they are, as are URLs
+// that cannot be refanged or defanged again
+func (p *Processor) NormalizeDefanged(text string) string {
+	return p.replaceURLs(text, "normalise:", func(match string) (string, bool) {
+		refanged, err := defang.RefangURLWith(match, p.defanger.Refang)
+		if err != nil || refanged == match {
+			return "", false
+		}
+		return p.defangURL(refanged)
+	}, nil)
+}
+
+func (p *Processor) refangText(text string, found func(original, result string)) string {
+	return p.replaceURLs(text, "refang:", func(match string) (string, bool) {
+		refanged, err := defang.RefangURLWith(match, p.defanger.Refang)
+		return refanged, err == nil
+	}, found)
+}
+
+// Replace each URL in the text with its transformation, if any, caching results by key
+// prefix and URL.  If found is not nil, it is called with each URL that was changed
+func (p *Processor) replaceURLs(text, prefix string, transform func(string) (string, bool), found func(original, result string)) string {
+	return replaceAllPooled(URLPattern(), text, func(match string) string {
+		// Trailing punctuation is more likely prose than part of the URL
+		trimmed := strings.TrimRight(match, ".,;:!?)")
+		suffix := match[len(trimmed):]
+
+		transformed, ok := p.cached(prefix + trimmed)
+		if !ok {
+			transformed, ok = transform(trimmed)
+			if !ok {
+				transformed = trimmed
+			}
+			p.store(prefix+trimmed, transformed)
+		}
+		if found != nil && transformed != trimmed {
+			found(trimmed, transformed)
+		}
+		return transformed + suffix
+	})
+}
+
+// Whether a URL matched by URLPattern has a fanged scheme separator ("://", or the ":" of
+// an opaque URL), rather than a defanged one ("[:]//")
+func hasFangedSeparator(match string) bool {
+	i := strings.IndexByte(match, ':')
+	if i <= 0 {
+		return false
+	}
+	_, bracketed := defang.DEFANG_BRACKETS[match[i-1]]
+	return !bracketed
+}
+
+// Whether the authority of a URL already uses a defanged delimiter, in any of the
+// conventions defang.RefangURL recognises; defanging it again could not be undone
+func hasDefangedAuthority(raw string) bool {
+	_, authority, _ := strings.Cut(raw, "://")
+	if i := strings.IndexAny(authority, "/?#"); i >= 0 {
+		authority = authority[:i]
+	}
+	return defang.DefangedDelimiterPattern().MatchString(authority)
+}
+
+func (p *Processor) defangURL(raw string) (string, bool) {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme == "" || p.allowed(u.Scheme, u.Hostname()) {
+		return "", false
+	}
+
+	if ascii.EqualFold(u.Scheme, urn.SCHEME) {
+		if defanged, err := urn.Defang(raw); err == nil {
+			return defanged, true
+		}
+	}
+
+	// The parser lowercases the scheme, so pass the Defanger the scheme as written
+	defangedScheme, err := p.defanger.Defang(raw[:len(u.Scheme)])
+	if err != nil {
+		return "", false
+	}
+
+	switch p.level {
+	case LevelScheme:
+		if u.Opaque != "" {
+			return defangedScheme + defang.DEFANGED_COLON + raw[len(u.Scheme)+len(":"):], true
+		}
+		return defangedScheme + defang.DEFANGED_SCHEME_SEPARATOR + raw[len(u.Scheme)+len("://"):], true
+	case LevelFull:
+		return defang.DefangAs(u, defangedScheme, defang.WithDefangPort(), defang.WithDefangQuery(), defang.WithDefangFragment()), true
+	default:
+		return defang.DefangAs(u, defangedScheme), true
+	}
+}
+
+// Whether a URL is left as it is: its host is allowed (by WithAllowedHosts, or the
+// Defanger's Policy) or its scheme is allowlisted, and neither is denylisted
+func (p *Processor) allowed(scheme, host string) bool {
+	policy := p.defanger.Policy()
+	if policy.DeniesScheme(scheme) || policy.DeniesHost(host) {
+		return false
+	}
+	return policy.AllowsScheme(scheme) || policy.AllowsHost(host) || defang.MatchesDomain(ascii.ToLower(host), p.allowedHosts)
+}
+
+// Empty the Processor's cache, releasing its memory, so that a long-lived Processor can be
+// reused (e.g., from a sync.Pool, or between batches of unrelated documents) without
+// retaining results for URLs that will not be seen again.  Configuration is kept
+func (p *Processor) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cache = make(map[string]string)
+}
+
+func (p *Processor) cached(key string) (string, bool) {
+	if p.cacheSize <= 0 {
+		return "", false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	value, ok := p.cache[key]
+	return value, ok
+}
+
+// Cache a result.  The cache is emptied when full, which is cheap, and keeps the common
+// case (documents dominated by a few URLs) fast
+func (p *Processor) store(key, value string) {
+	if p.cacheSize <= 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.cache) >= p.cacheSize {
+		clear(p.cache)
+	}
+	p.cache[key] = value
+}
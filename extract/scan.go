@@ -0,0 +1,222 @@
+package extract
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jakewilliami/defang-schemes/defang"
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+)
+
+// The format of the text being scanned for indicators, which determines where
+// indicators can end
+type TokenizerHint int
+
+const (
+	// Indicators end at whitespace, quotes, and angle brackets
+	TokenizePlain TokenizerHint = iota
+	// As TokenizePlain, and at commas outside quoted cells
+	TokenizeCSV
+	// As TokenizePlain, and at escaped quotes, backslashes, and control characters
+	// ("\"", "\\", "\n", """, ...).  Escaped slashes ("\/") are kept, and undone on
+	// refanging
+	TokenizeJSON
+	// As TokenizePlain, and at character references to any of those characters ("&quot;",
+	// "&#39;", "&lt;", ...).  Other references, such as "&amp;" in a query, are kept
+	TokenizeHTMLAttribute
+)
+
+func (h TokenizerHint) String() string {
+	switch h {
+	case TokenizePlain:
+		return "plain"
+	case TokenizeCSV:
+		return "csv"
+	case TokenizeJSON:
+		return "json"
+	case TokenizeHTMLAttribute:
+		return "html-attribute"
+	default:
+		return fmt.Sprintf("TokenizerHint(%d)", int(h))
+	}
+}
+
+// A bufio.SplitFunc yielding candidate indicators in plain text: URLs (fanged or
+// defanged), and IP addresses, email addresses, UNC paths, and domains, as found by
+// DefangAll and RefangAll.  Surrounding prose punctuation is trimmed, and all other
+// content is skipped:
+//
+//	scanner := bufio.NewScanner(r)
+//	scanner.Split(defang_schemes.ScanIndicators)
+//	for scanner.Scan() {
+//		fmt.Println(scanner.Text())
+//	}
+//
+// Indicators longer than the Scanner's buffer are reported as bufio.ErrTooLong
+func ScanIndicators(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	return (&tokenizer{}).split(data, atEOF)
+}
+
+// As ScanIndicators, for text of the given format.  The SplitFunc keeps state between
+// calls (e.g., whether it is within a quoted CSV cell), so must only be used by one Scanner
+func ScanIndicatorsIn(hint TokenizerHint) bufio.SplitFunc {
+	return (&tokenizer{hint: hint}).split
+}
+
+// Create a bufio.Scanner over r that yields candidate indicators; see ScanIndicators
+func NewIndicatorScanner(r io.Reader) *bufio.Scanner {
+	return NewIndicatorScannerIn(r, TokenizePlain)
+}
+
+// Create a bufio.Scanner over r, of the given format, that yields candidate indicators;
+// see ScanIndicatorsIn
+func NewIndicatorScannerIn(r io.Reader, hint TokenizerHint) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(ScanIndicatorsIn(hint))
+	return scanner
+}
+
+type tokenizer struct {
+	hint TokenizerHint
+	// Whether a CSV cell is open
+	quoted bool
+}
+
+func (t *tokenizer) split(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	for advance < len(data) {
+		// Skip delimiters
+		width := t.delimiter(data[advance:], atEOF)
+		if width < 0 {
+			return advance, nil, nil
+		}
+		if width > 0 {
+			if t.hint == TokenizeCSV && data[advance] == '"' {
+				t.quoted = !t.quoted
+			}
+			advance += width
+			continue
+		}
+
+		end := advance + 1
+		for end < len(data) {
+			width := t.delimiter(data[end:], atEOF)
+			if width < 0 {
+				return advance, nil, nil
+			}
+			if width > 0 {
+				break
+			}
+			end++
+		}
+		if end == len(data) && !atEOF {
+			// The token may continue in the next read
+			return advance, nil, nil
+		}
+
+		candidate := string(data[advance:end])
+		start, stop := trimIndicatorToken(candidate)
+		if isCandidateIndicator(candidate[start:stop]) {
+			return end, data[advance+start : advance+stop], nil
+		}
+		advance = end
+	}
+	return advance, nil, nil
+}
+
+// The width of the delimiter at the start of data, zero if there is none, or -1 if more
+// data is needed to tell
+func (t *tokenizer) delimiter(data []byte, atEOF bool) int {
+	if isIndicatorDelimiter(data[0]) {
+		return 1
+	}
+
+	switch t.hint {
+	case TokenizeCSV:
+		if data[0] == ',' && !t.quoted {
+			return 1
+		}
+	case TokenizeJSON:
+		if data[0] == '\\' {
+			return jsonEscapeDelimiter(data, atEOF)
+		}
+	case TokenizeHTMLAttribute:
+		if data[0] == '&' {
+			return characterReferenceDelimiter(data, atEOF)
+		}
+	}
+	return 0
+}
+
+// Escapes of delimiters, or of control characters, end an indicator in a JSON string
+func jsonEscapeDelimiter(data []byte, atEOF bool) int {
+	if len(data) < 2 {
+		return needMore(atEOF)
+	}
+	switch data[1] {
+	case '"', '\\', 'b', 'f', 'n', 'r', 't':
+		return 2
+	case 'u':
+		if len(data) < 6 {
+			return needMore(atEOF)
+		}
+		code, err := strconv.ParseUint(string(data[2:6]), 16, 16)
+		if err == nil && (code < ' ' || code < utf8.RuneSelf && isIndicatorDelimiter(byte(code))) {
+			return 6
+		}
+	}
+	return 0
+}
+
+// The longest character reference we need recognise ("&#x0000A0;")
+const maxCharacterReference = 10
+
+// References to delimiters, or to whitespace, end an indicator in an HTML attribute
+func characterReferenceDelimiter(data []byte, atEOF bool) int {
+	for i := 1; i < len(data) && i < maxCharacterReference; i++ {
+		if data[i] != ';' {
+			continue
+		}
+		decoded := html.UnescapeString(string(data[:i+1]))
+		r, size := utf8.DecodeRuneInString(decoded)
+		if size == len(decoded) && (unicode.IsSpace(r) || r < utf8.RuneSelf && isIndicatorDelimiter(byte(r))) {
+			return i + 1
+		}
+		return 0
+	}
+	if len(data) < maxCharacterReference {
+		return needMore(atEOF)
+	}
+	return 0
+}
+
+func needMore(atEOF bool) int {
+	if atEOF {
+		return 0
+	}
+	return -1
+}
+
+// Characters that cannot appear in an indicator, as matched by DefangAll and RefangAll
+func isIndicatorDelimiter(c byte) bool {
+	return ascii.IsSpace(c) || c == '<' || c == '>' || c == '"' || c == '\'' || c == '`'
+}
+
+func isCandidateIndicator(token string) bool {
+	if token == "" {
+		return false
+	}
+	// Including URLs with escaped slashes, as in JSON
+	if URLPattern().MatchString(defang.ApplyRefangRules(defang.RefangPartURL, token)) {
+		return true
+	}
+	if _, ok := defangIndicatorToken(token); ok {
+		return true
+	}
+	_, ok := refangIndicatorToken(token)
+	return ok
+}
@@ -0,0 +1,270 @@
+package extract
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+)
+
+// Content is buffered up to the end of each line, as indicators never span lines.  Lines
+// longer than this are split at their last whitespace, and lines with none are split
+// arbitrarily
+const STREAM_BUFFER_SIZE = 64 * 1024
+
+// The length of the prefix of buf that can be transformed without splitting an indicator.
+// Only buf[scanned:] is searched for newlines, as the rest has been searched before
+func streamBoundary(buf []byte, scanned int, final bool) int {
+	if final {
+		return len(buf)
+	}
+	if i := bytes.LastIndexByte(buf[scanned:], '\n'); i >= 0 {
+		return scanned + i + 1
+	}
+	if len(buf) < STREAM_BUFFER_SIZE {
+		return 0
+	}
+	if n := tokenBoundary(buf); n > 0 {
+		return n
+	}
+	return len(buf)
+}
+
+// The length of the prefix of buf up to and including its last whitespace, or 0 if it has
+// none.  Indicators never contain whitespace, so the prefix contains only whole indicators
+func tokenBoundary(buf []byte) int {
+	for i := len(buf) - 1; i >= 0; i-- {
+		if ascii.IsSpace(buf[i]) {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// What a stream does with the content after the last whitespace when the stream ends
+// without a newline, which may be an indicator truncated mid-way (as when a connection
+// is dropped)
+type TruncationPolicy int
+
+const (
+	// Transform the trailing content as if it were complete, so that a partial URL is
+	// still defanged ("https://evil.te" → "hxxps[://]evil[.]te")
+	TruncationTransform TruncationPolicy = iota
+	// Emit the trailing content as it was written or read, so that nothing is
+	// transformed that may have been cut short.  Note that a complete URL at the end of
+	// a file without a final newline is then emitted as-is too
+	TruncationPassThrough
+)
+
+func (p TruncationPolicy) String() string {
+	switch p {
+	case TruncationTransform:
+		return "transform"
+	case TruncationPassThrough:
+		return "pass-through"
+	default:
+		return fmt.Sprintf("TruncationPolicy(%d)", int(p))
+	}
+}
+
+// Configure a DefangWriter or RefangReader
+type StreamOption func(*streamConfig)
+
+type streamConfig struct {
+	truncation TruncationPolicy
+}
+
+// Set what is done with content truncated at the end of the stream (by default,
+// TruncationTransform)
+func WithTruncationPolicy(policy TruncationPolicy) StreamOption {
+	return func(c *streamConfig) {
+		c.truncation = policy
+	}
+}
+
+func newStreamConfig(opts []StreamOption) streamConfig {
+	var c streamConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// The length of the prefix of the final content of a stream that is transformed, the
+// rest being emitted as-is
+func finalBoundary(buf []byte, policy TruncationPolicy) int {
+	if policy == TruncationPassThrough {
+		return tokenBoundary(buf)
+	}
+	return len(buf)
+}
+
+// Flush the underlying writer, if it buffers (as bufio.Writer, or http.ResponseWriter)
+func flushWriter(w io.Writer) error {
+	switch f := w.(type) {
+	case interface{ Flush() error }:
+		return f.Flush()
+	case interface{ Flush() }:
+		f.Flush()
+	}
+	return nil
+}
+
+// An io.Writer that defangs every URL written through it (as Processor.DefangText)
+// before writing it to the underlying writer.  Memory use is bounded by
+// STREAM_BUFFER_SIZE (beyond the buffers passed to Write), so multi-gigabyte logs can be
+// defanged as they are copied.  Close must be called to flush the final line, and Flush
+// may be called to write out complete indicators early, as when wrapping a network stream
+type DefangWriter struct {
+	w          io.Writer
+	transform  func(string) string
+	truncation TruncationPolicy
+	buf        []byte
+	err        error
+}
+
+// Defang content written to w with the Processor (or the default Processor, if nil)
+func NewDefangWriter(w io.Writer, p *Processor, opts ...StreamOption) *DefangWriter {
+	if p == nil {
+		p = defaultProcessor()
+	}
+	return &DefangWriter{w: w, transform: p.DefangText, truncation: newStreamConfig(opts).truncation}
+}
+
+// Buffer p, writing out the defanged form of each complete line.  Errors from the
+// underlying writer are returned by this and every later call
+func (d *DefangWriter) Write(p []byte) (int, error) {
+	if d.err != nil {
+		return 0, d.err
+	}
+	d.buf = append(d.buf, p...)
+	d.err = d.flush(len(d.buf)-len(p), false)
+	if d.err != nil {
+		return 0, d.err
+	}
+	return len(p), nil
+}
+
+// Defang and write buffered content up to its last whitespace, then flush the underlying
+// writer if it has a Flush method.  Only a trailing partial indicator stays buffered, so
+// readers at the other end of a connection are not kept waiting for the end of a line
+func (d *DefangWriter) Flush() error {
+	if d.err != nil {
+		return d.err
+	}
+	if n := tokenBoundary(d.buf); n > 0 {
+		d.err = d.emit(n)
+	}
+	if d.err == nil {
+		d.err = flushWriter(d.w)
+	}
+	return d.err
+}
+
+// Write any buffered content, treating content after the last whitespace as per the
+// TruncationPolicy, then flush the underlying writer if it has a Flush method.  The
+// underlying writer is not closed
+func (d *DefangWriter) Close() error {
+	if d.err != nil {
+		return d.err
+	}
+	n := finalBoundary(d.buf, d.truncation)
+	d.err = d.emit(n)
+	if d.err == nil && len(d.buf) > 0 {
+		_, d.err = d.w.Write(d.buf)
+		d.buf = d.buf[:0]
+	}
+	if d.err == nil {
+		d.err = flushWriter(d.w)
+	}
+	return d.err
+}
+
+func (d *DefangWriter) flush(scanned int, final bool) error {
+	for {
+		n := streamBoundary(d.buf, scanned, final)
+		if n == 0 {
+			return nil
+		}
+		if err := d.emit(n); err != nil {
+			return err
+		}
+		if len(d.buf) == 0 {
+			return nil
+		}
+		// What remains has no newline
+		scanned = len(d.buf)
+	}
+}
+
+// Defang and write the first n bytes of the buffer, removing them from it
+func (d *DefangWriter) emit(n int) error {
+	if n == 0 {
+		return nil
+	}
+	if _, err := io.WriteString(d.w, d.transform(string(d.buf[:n]))); err != nil {
+		return err
+	}
+	d.buf = d.buf[:copy(d.buf, d.buf[n:])]
+	return nil
+}
+
+// An io.Reader that refangs every defanged URL read from the underlying reader (as
+// Processor.RefangText).  As DefangWriter, memory use is bounded by STREAM_BUFFER_SIZE.
+// Content up to the last whitespace is returned as soon as it is read, so a RefangReader
+// can wrap a network stream without waiting for the end of each line
+type RefangReader struct {
+	r          io.Reader
+	transform  func(string) string
+	truncation TruncationPolicy
+	// Read but not yet transformed
+	in []byte
+	// Transformed but not yet returned
+	out   []byte
+	chunk [4096]byte
+	err   error
+}
+
+// Refang content read from r with the Processor (or the default Processor, if nil)
+func NewRefangReader(r io.Reader, p *Processor, opts ...StreamOption) *RefangReader {
+	if p == nil {
+		p = defaultProcessor()
+	}
+	return &RefangReader{r: r, transform: p.RefangText, truncation: newStreamConfig(opts).truncation}
+}
+
+func (r *RefangReader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+
+		n, err := r.r.Read(r.chunk[:])
+		r.in = append(r.in, r.chunk[:n]...)
+		if err != nil {
+			r.err = err
+		}
+
+		if r.err != nil {
+			// The stream has ended, so what remains is transformed or passed through
+			boundary := finalBoundary(r.in, r.truncation)
+			r.out = append(r.out, r.transform(string(r.in[:boundary]))...)
+			r.out = append(r.out, r.in[boundary:]...)
+			r.in = r.in[:0]
+			continue
+		}
+		boundary := streamBoundary(r.in, len(r.in)-n, false)
+		if boundary == 0 {
+			boundary = tokenBoundary(r.in)
+		}
+		if boundary > 0 {
+			r.out = append(r.out, r.transform(string(r.in[:boundary]))...)
+			r.in = r.in[:copy(r.in, r.in[boundary:])]
+		}
+	}
+
+	n := copy(p, r.out)
+	r.out = r.out[:copy(r.out, r.out[n:])]
+	return n, nil
+}
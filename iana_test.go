@@ -0,0 +1,83 @@
+package defang_schemes_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+// Serve the generated data as the IANA registry, with ftp made historical and a new scheme
+// added, under an ETag
+func newIANAServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	var served bytes.Buffer
+	w := csv.NewWriter(&served)
+	w.Write([]string{"URI Scheme", "Template", "Description", "Status", "Well-Known URI Support", "Reference", "Notes"})
+	schemes := defang_schemes.Schemes()
+	for _, name := range slices.Sorted(maps.Keys(schemes)) {
+		scheme := schemes[name]
+		if name == "ftp" {
+			scheme.Status = defang_schemes.Historical
+		}
+		if scheme.Obsolete {
+			name += " (OBSOLETE)"
+		}
+		template := strings.TrimPrefix(scheme.Template, defang_schemes.TEMPLATE_BASE_URL)
+		w.Write([]string{name, template, scheme.Description, string(scheme.Status), scheme.WellKnownUriSupport, scheme.Reference, scheme.Notes})
+	}
+	w.Write([]string{"examplenew", "", "Example scheme registered after release", "Provisional", "-", "[RFC0000]", ""})
+	w.Flush()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("If-None-Match") == `"v1"` {
+			rw.WriteHeader(http.StatusNotModified)
+			return
+		}
+		rw.Header().Set("ETag", `"v1"`)
+		rw.Write(served.Bytes())
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestUpdateFromIANA(t *testing.T) {
+	server := newIANAServer(t)
+	registry := defang_schemes.NewRegistry()
+	update, err := registry.UpdateFromIANA(context.Background(), defang_schemes.WithIANAURL(server.URL))
+	if err != nil || !slices.Equal(update.Added, []string{"examplenew"}) || !slices.Equal(update.Changed, []string{"ftp"}) {
+		t.Fatalf("UpdateFromIANA = %+v, %v, want examplenew added and ftp changed", update, err)
+	}
+	if defanged, err := registry.Defang("examplenew"); err != nil || defanged != defang_schemes.DefangScheme("examplenew") {
+		t.Errorf("Defang(examplenew) = %q, %v, want %q", defanged, err, defang_schemes.DefangScheme("examplenew"))
+	}
+
+	// The registry's ETag is sent with the next request
+	if update, err := registry.UpdateFromIANA(context.Background(), defang_schemes.WithIANAURL(server.URL)); err != nil || !update.NotModified {
+		t.Errorf("UpdateFromIANA = %+v, %v, want NotModified", update, err)
+	}
+}
+
+// A registry that cannot be fetched leaves the registry as it was
+func TestUpdateFromIANAUnavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		http.Error(rw, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	registry := defang_schemes.NewRegistry()
+	if _, err := registry.UpdateFromIANA(context.Background(), defang_schemes.WithIANAURL(server.URL)); !errors.Is(err, defang_schemes.ErrIANAUnavailable) {
+		t.Errorf("UpdateFromIANA error = %v, want ErrIANAUnavailable", err)
+	}
+	if len(registry.Schemes()) != len(defang_schemes.Schemes()) {
+		t.Error("UpdateFromIANA changed the registry although IANA was unavailable")
+	}
+}
@@ -0,0 +1,153 @@
+// The defang algorithm of the defang package, without regard to the dataset, shared with
+// the schemes package, from which the defanged forms of each style are derived
+package algorithm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+)
+
+// As well as [a-z], these characters are allowed in URI schemes
+// https://github.com/JuliaWeb/URIs.jl/blob/dce395c3/src/URIs.jl#L91-L108
+var ADDITIONAL_ALLOWED_SCHEME_CHARS = []rune{'-', '+', '.'}
+
+var additionalAllowedSchemeCharsPatternOnce = sync.OnceValue(additionalAllowedSchemeCharsPattern)
+
+// Matches runs of the non-alphanumeric characters allowed in URI schemes
+func AdditionalAllowedSchemeCharsPattern() *regexp.Regexp {
+	return additionalAllowedSchemeCharsPatternOnce()
+}
+
+func additionalAllowedSchemeCharsPattern() *regexp.Regexp {
+	var allowedChars string
+	for _, char := range ADDITIONAL_ALLOWED_SCHEME_CHARS {
+		allowedChars += string(char)
+	}
+	pattern := fmt.Sprintf(`[%s]+`, regexp.QuoteMeta(allowedChars))
+	return regexp.MustCompile(pattern)
+}
+
+// Within s, replace characters at `positions' with the rune defined in `replacement`
+//
+// For example:
+// ```go
+// ReplaceAtPositions("hello", []int{1, 2}, rune('x')) == "hxxlo"
+// ```
+func ReplaceAtPositions(s string, positions []int, replacement rune) string {
+	runes := []rune(s)
+
+	for _, pos := range positions {
+		if pos >= 0 && pos < len(runes) {
+			runes[pos] = replacement
+		}
+	}
+
+	return string(runes)
+}
+
+// Replace the characters at the given positions with 'x'
+func DefangAtPositions(s string, positions []int) string {
+	return ReplaceAtPositions(s, positions, rune('x'))
+}
+
+// Bracket the characters at the given positions, with one pair of brackets around each run
+// of adjacent positions; for example, "http" with positions 1 and 2 → "h[tt]p"
+func BracketAtPositions(s string, positions []int) string {
+	bracketed := make(map[int]bool, len(positions))
+	for _, pos := range positions {
+		bracketed[pos] = true
+	}
+
+	var b strings.Builder
+	for i, r := range []rune(s) {
+		if bracketed[i] && !bracketed[i-1] {
+			b.WriteRune('[')
+		}
+		b.WriteRune(r)
+		if bracketed[i] && !bracketed[i+1] {
+			b.WriteRune(']')
+		}
+	}
+	return b.String()
+}
+
+// Bracket runs of the additional allowed characters; for example, "coap+tcp" → "coap[+]tcp"
+func BracketAdditionalChars(scheme string) string {
+	return AdditionalAllowedSchemeCharsPattern().ReplaceAllStringFunc(scheme, func(match string) string {
+		return fmt.Sprintf("[%s]", match)
+	})
+}
+
+// The defang algorithm, but where replacing characters would leave them as they are
+// ("hxxp", or "https" with defang.WithReplacementRune('t')), so the scheme live, they are
+// bracketed instead
+func DefangChanged(scheme string, opts ...Option) string {
+	defanged := Defang(scheme, opts...)
+	if defanged == scheme {
+		return Defang(scheme, append(opts, WithBracketStyle())...)
+	}
+	return defanged
+}
+
+// The defang algorithm of defang.DefangScheme, without regard to the dataset, from which
+// the generated forms (and their alternatives) are derived
+func Defang(scheme string, opts ...Option) string {
+	cfg := NewConfig(opts)
+
+	// Empty or pure whitespace input has nothing to defang
+	if strings.TrimSpace(scheme) == "" {
+		return ""
+	}
+
+	// Non-ASCII input: the rules below assume one byte per character
+	if i, _ := FirstNonASCII(scheme); i >= 0 {
+		return Generic(scheme)
+	}
+
+	// Case is preserved ("HTTP" → "HXXP"), but the rules below are written for lowercase
+	if lower := ascii.ToLower(scheme); lower != scheme {
+		return MatchCase(scheme, Defang(lower, opts...))
+	}
+
+	// Case 0: no scheme of length 1 is registered, and there is no character to replace
+	// whilst keeping the scheme recognisable, so bracket it
+	if len(scheme) == 1 {
+		return BracketAtPositions(scheme, []int{0})
+	}
+
+	// Case 1: well-defined base case
+	// TODO: another case where we only remove t?
+	if scheme == "http" || scheme == "https" {
+		return cfg.DefangAt(scheme, []int{1, 2})
+	}
+
+	// Case 2: classical defanging of additional characters to produce invalid schemes
+	if AdditionalAllowedSchemeCharsPattern().MatchString(scheme) {
+		return BracketAdditionalChars(scheme)
+	}
+
+	// Case 3: for 3-letter schemes, we can remove the middle one
+	if len(scheme) == 3 {
+		return cfg.DefangAt(scheme, []int{1})
+	}
+
+	// Case 4: for 2-letter schemes, defang the second character
+	if len(scheme) == 2 {
+		return cfg.DefangAt(scheme, []int{1})
+	}
+
+	// Case 5: for 4-letter schemes, there should be enough nuance to them to defang only one letter
+	// whilst removing the possibility that a valid scheme remains.  We choose to remove the third
+	// letter, because removing the second would produce ambiguous results (e.g., with icap and imap)
+	if len(scheme) == 4 && !cfg.NoFourLetterCase {
+		return cfg.DefangAt(scheme, []int{2})
+	}
+
+	// Default case: all remaining schemes should have length > 4, and hence enough information
+	// to naïvely defang as we do HTTP[S]
+	return cfg.DefangAt(scheme, []int{1, 2})
+}
@@ -0,0 +1,43 @@
+package algorithm
+
+// Apply the case of each letter of the template to the corresponding letter of s, skipping
+// the brackets that defanging inserts (or refanging removes) in either; for example,
+// ("Https", "hxxps") → "Hxxps", and ("HTTP", "h[tt]p") → "H[TT]P"
+func MatchCase(template, s string) string {
+	if i, _ := FirstNonASCII(template); i >= 0 {
+		return s
+	}
+
+	b := []byte(s)
+	j := 0
+	for i := range b {
+		if IsDefangBracket(b[i]) {
+			continue
+		}
+		for j < len(template) && IsDefangBracket(template[j]) {
+			j++
+		}
+		if j >= len(template) {
+			break
+		}
+		if 'A' <= template[j] && template[j] <= 'Z' && 'a' <= b[i] && b[i] <= 'z' {
+			b[i] -= 'a' - 'A'
+		}
+		j++
+	}
+	return string(b)
+}
+
+// Whether the character is one of the DEFANG_BRACKETS
+func IsDefangBracket(c byte) bool {
+	for opening, closing := range DEFANG_BRACKETS {
+		if c == opening || c == closing {
+			return true
+		}
+	}
+	return false
+}
+
+// Brackets used by common defang conventions, other than defang.DefangURL's square brackets:
+// "example(.)com", "example{.}com"
+var DEFANG_BRACKETS = map[byte]byte{'[': ']', '(': ')', '{': '}'}
@@ -0,0 +1,51 @@
+package algorithm
+
+// Configuration for the defang algorithms
+type Config struct {
+	Port     bool
+	Query    bool
+	Fragment bool
+	Redact   bool
+	Scripts  bool
+	Unicode  UnicodePolicy
+
+	Replacement      rune
+	Bracket          bool
+	NoFourLetterCase bool
+
+	PreserveCase bool
+}
+
+// Option to configure how a scheme or URL is defanged
+type Option func(*Config)
+
+// Whether the scheme is defanged other than by the default algorithm, so that the generated
+// defanged forms do not apply
+func (cfg *Config) CustomStyle() bool {
+	return cfg.Replacement != 'x' || cfg.Bracket || cfg.NoFourLetterCase
+}
+
+// Defang the characters of the scheme at the given positions in the configured style
+func (cfg *Config) DefangAt(scheme string, positions []int) string {
+	if cfg.Bracket {
+		return BracketAtPositions(scheme, positions)
+	}
+	return ReplaceAtPositions(scheme, positions, cfg.Replacement)
+}
+
+// The configuration given by the options, over the default algorithm
+func NewConfig(opts []Option) *Config {
+	cfg := &Config{Replacement: 'x'}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// Bracket characters of the scheme, rather than replacing them ("https" → "h[tt]ps"), as is
+// already done for schemes containing additional allowed characters ("coap[+]tcp")
+func WithBracketStyle() Option {
+	return func(cfg *Config) {
+		cfg.Bracket = true
+	}
+}
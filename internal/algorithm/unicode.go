@@ -0,0 +1,60 @@
+package algorithm
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// URI schemes are ASCII-only (RFC 3986, section 3.1), so input containing other
+// characters is not a scheme we know of.  The policy defines what we do with it
+type UnicodePolicy int
+
+const (
+	// Return a *defang.NonASCIISchemeError
+	UnicodeReject UnicodePolicy = iota
+	// Map fullwidth forms (e.g., "ｈｔｔｐ") to their ASCII equivalents and lower case the
+	// result, rejecting the scheme if non-ASCII characters remain
+	UnicodeNormalize
+	// Skip the scheme-specific rules and apply the generic positional defang
+	UnicodePassThroughGeneric
+)
+
+func (p UnicodePolicy) String() string {
+	switch p {
+	case UnicodeReject:
+		return "Reject"
+	case UnicodeNormalize:
+		return "Normalize"
+	case UnicodePassThroughGeneric:
+		return "PassThroughGeneric"
+	default:
+		return fmt.Sprintf("UnicodePolicy(%d)", int(p))
+	}
+}
+
+// Byte offset and value of the first non-ASCII rune in s, or -1 if s is ASCII
+func FirstNonASCII(s string) (int, rune) {
+	for i, r := range s {
+		if r >= utf8.RuneSelf {
+			return i, r
+		}
+	}
+	return -1, 0
+}
+
+// The generic positional defang used where scheme-specific rules do not apply.  As with
+// those rules, a single character is bracketed ("ü" → "[ü]"), as are the characters that
+// replacing would leave unchanged ("éxx" → "é[xx]"), so that the input never defangs to
+// itself
+func Generic(scheme string) string {
+	n := utf8.RuneCountInString(scheme)
+	if n == 1 {
+		return BracketAtPositions(scheme, []int{0})
+	}
+
+	positions := []int{1, 2}[:min(n-1, 2)]
+	if defanged := DefangAtPositions(scheme, positions); defanged != scheme {
+		return defanged
+	}
+	return BracketAtPositions(scheme, positions)
+}
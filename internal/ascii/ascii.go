@@ -0,0 +1,52 @@
+// Case folding over ASCII only, shared by the root package, its subpackages, and its
+// commands.  Schemes are ASCII, so we fold case explicitly over ASCII only: Unicode case
+// mapping (as used by strings.ToLower and strings.EqualFold) maps some non-ASCII
+// characters onto ASCII letters (e.g., the Kelvin sign "K" to "k"), which would let
+// non-ASCII input pass as a registered scheme
+package ascii
+
+// Lowercase the ASCII letters of s, leaving other characters as they are
+func ToLower(s string) string {
+	for i := 0; i < len(s); i++ {
+		if 'A' <= s[i] && s[i] <= 'Z' {
+			b := []byte(s)
+			for j := i; j < len(b); j++ {
+				if 'A' <= b[j] && b[j] <= 'Z' {
+					b[j] += 'a' - 'A'
+				}
+			}
+			return string(b)
+		}
+	}
+	return s
+}
+
+// Whether a and b are equal, ignoring the case of ASCII letters
+func EqualFold(a, b string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		ca, cb := a[i], b[i]
+		if 'A' <= ca && ca <= 'Z' {
+			ca += 'a' - 'A'
+		}
+		if 'A' <= cb && cb <= 'Z' {
+			cb += 'a' - 'A'
+		}
+		if ca != cb {
+			return false
+		}
+	}
+	return true
+}
+
+// The ASCII characters removed by strings.TrimSpace
+func IsSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\v' || c == '\f' || c == '\r'
+}
+
+// The characters that \w matches in regular expressions
+func IsWordChar(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '_'
+}
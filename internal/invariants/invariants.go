@@ -0,0 +1,94 @@
+// The invariants of defanged schemes, shared by Registry.Validate and the check package
+// (which cannot be imported by the root package, as it imports it)
+package invariants
+
+import "sort"
+
+// An invariant of defanged schemes
+type Invariant int
+
+const (
+	// A defanged scheme must not be a registered scheme
+	DefangedIsNotScheme Invariant = iota
+	// No two schemes may share a defanged form
+	OneToOne
+)
+
+// A scheme and its defanged form
+type Entry struct {
+	Scheme   string
+	Defanged string
+}
+
+// A scheme whose defanged form breaks an invariant
+type Violation struct {
+	Invariant Invariant
+	Scheme    string
+	Defanged  string
+	// The registered scheme that the defanged form is (DefangedIsNotScheme), or the other
+	// schemes sharing it (OneToOne), sorted
+	Conflicts []string
+	// Whether every scheme involved is allowed
+	Allowed bool
+}
+
+// Check that no defanged form is itself one of the schemes
+func DefangedIsNotSchemeViolations(entries []Entry, allowed func(scheme string) bool) []Violation {
+	names := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		names[entry.Scheme] = true
+	}
+
+	var violations []Violation
+	for _, entry := range sorted(entries) {
+		if names[entry.Defanged] {
+			violations = append(violations, Violation{
+				Invariant: DefangedIsNotScheme,
+				Scheme:    entry.Scheme,
+				Defanged:  entry.Defanged,
+				Conflicts: []string{entry.Defanged},
+				Allowed:   allowed(entry.Scheme) && allowed(entry.Defanged),
+			})
+		}
+	}
+	return violations
+}
+
+// Check that no two schemes share a defanged form
+func OneToOneViolations(entries []Entry, allowed func(scheme string) bool) []Violation {
+	entries = sorted(entries)
+	byDefanged := make(map[string][]string, len(entries))
+	for _, entry := range entries {
+		byDefanged[entry.Defanged] = append(byDefanged[entry.Defanged], entry.Scheme)
+	}
+
+	var violations []Violation
+	for _, entry := range entries {
+		sharing := byDefanged[entry.Defanged]
+		if len(sharing) < 2 {
+			continue
+		}
+		violation := Violation{Invariant: OneToOne, Scheme: entry.Scheme, Defanged: entry.Defanged, Allowed: true}
+		for _, other := range sharing {
+			if other != entry.Scheme {
+				violation.Conflicts = append(violation.Conflicts, other)
+			}
+			violation.Allowed = violation.Allowed && allowed(other)
+		}
+		violations = append(violations, violation)
+	}
+	return violations
+}
+
+// Check every invariant, returning the violations sorted by invariant, then by scheme
+func Violations(entries []Entry, allowed func(scheme string) bool) []Violation {
+	return append(DefangedIsNotSchemeViolations(entries, allowed), OneToOneViolations(entries, allowed)...)
+}
+
+func sorted(entries []Entry) []Entry {
+	sorted := append([]Entry(nil), entries...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Scheme < sorted[j].Scheme
+	})
+	return sorted
+}
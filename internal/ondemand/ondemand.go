@@ -0,0 +1,9 @@
+// The dataset fetched by GenerateData, for builds from a tree without generated data.  It
+// is fetched by the registry package, which parses the IANA registry, and read by the
+// schemes package, which cannot import it
+package ondemand
+
+import "sync/atomic"
+
+// The fetched map[string]schemes.Scheme, stored at most once
+var Schemes atomic.Value
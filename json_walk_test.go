@@ -0,0 +1,27 @@
+package defang_schemes_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+// Only the string values of JSON documents are rewritten
+func TestDefangJSON(t *testing.T) {
+	cases := []struct {
+		document, want string
+	}{
+		{
+			`{"z": "https://evil.test/a?b=1&c=2", "https://key.test/": [1.50e3, null, "ftp://z.test"]}`,
+			`{"z": "hxxps[://]evil[.]test/a?b=1&c=2", "https://key.test/": [1.50e3, null, "fxp[://]z[.]test"]}`,
+		},
+		{`"https://evil.test/"`, `"hxxps[://]evil[.]test/"`},
+		{`[true, false, 0, "no indicators"]`, `[true, false, 0, "no indicators"]`},
+	}
+	for _, c := range cases {
+		if defanged, err := defang_schemes.DefangJSON(json.RawMessage(c.document)); err != nil || string(defanged) != c.want {
+			t.Errorf("DefangJSON(%s) = %s, %v, want %s", c.document, defanged, err, c.want)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+//go:build (defang_schemes_lazy || defang_schemes_embed) && !defang_schemes_minimal
+
+package defang_schemes
+
+import (
+	"sync"
+
+	"github.com/jakewilliami/defang-schemes/schemes"
+)
+
+// As schemes.Map, which lazy and embed builds assign on first access: nil until the
+// dataset is first accessed via Schemes
+var Map map[string]Scheme
+
+var schemesOnce = sync.OnceValue(func() map[string]Scheme {
+	// Loads the generated data, if any, into schemes.Map
+	schemes.DataGenerated()
+	Map = schemes.Map
+	return Map
+})
+
+// As schemes.Schemes
+func Schemes() map[string]Scheme {
+	if generated := schemesOnce(); generated != nil {
+		return generated
+	}
+	return schemes.Schemes()
+}
@@ -0,0 +1,1064 @@
+package permanent
+
+import "github.com/jakewilliami/defang-schemes"
+
+/*
+THIS FILE WAS AUTOMATICALLY GENERATED AT 2026-10-16 07:57:26
+
+Do not edit this file.  Run "go generate" to re-generate this file with an
+updated version of permanent URI schemes from:
+    iana.org/assignments/uri-schemes/uri-schemes.xhtml.
+*/
+
+func init() {
+	Map = map[string]defang_schemes.Scheme{
+		"aaa": defang_schemes.Scheme{
+			Scheme:              "aaa",
+			DefangedScheme:      "axa",
+			Template:            "",
+			Description:         "Diameter Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6733]",
+			Notes:               "",
+			Related:             []string{"aaas"},
+		},
+		"aaas": defang_schemes.Scheme{
+			Scheme:              "aaas",
+			DefangedScheme:      "aaxs",
+			Template:            "",
+			Description:         "Diameter Protocol with Secure Transport",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6733]",
+			Notes:               "",
+			Related:             []string{"aaa"},
+		},
+		"about": defang_schemes.Scheme{
+			Scheme:              "about",
+			DefangedScheme:      "axxut",
+			Template:            "",
+			Description:         "about",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6694]",
+			Notes:               "",
+		},
+		"acap": defang_schemes.Scheme{
+			Scheme:              "acap",
+			DefangedScheme:      "acxp",
+			Template:            "",
+			Description:         "application configuration access protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2244]",
+			Notes:               "",
+		},
+		"acct": defang_schemes.Scheme{
+			Scheme:              "acct",
+			DefangedScheme:      "acxt",
+			Template:            "",
+			Description:         "acct",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7565]",
+			Notes:               "",
+		},
+		"cap": defang_schemes.Scheme{
+			Scheme:              "cap",
+			DefangedScheme:      "cxp",
+			Template:            "",
+			Description:         "Calendar Access Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4324]",
+			Notes:               "",
+		},
+		"cid": defang_schemes.Scheme{
+			Scheme:              "cid",
+			DefangedScheme:      "cxd",
+			Template:            "",
+			Description:         "content identifier",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2392]",
+			Notes:               "",
+		},
+		"coap": defang_schemes.Scheme{
+			Scheme:              "coap",
+			DefangedScheme:      "coxp",
+			Template:            "",
+			Description:         "coap",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC7252]",
+			Reference:           "[RFC7252]",
+			Notes:               "",
+			Related:             []string{"coaps"},
+			Examples:            []string{"coap://sensor.example.com/temperature"},
+		},
+		"coap+tcp": defang_schemes.Scheme{
+			Scheme:              "coap+tcp",
+			DefangedScheme:      "coap[+]tcp",
+			Template:            "",
+			Description:         "coap+tcp \n      (see [reviewer notes])",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC8323]",
+			Reference:           "[RFC8323]",
+			Notes:               "",
+		},
+		"coap+ws": defang_schemes.Scheme{
+			Scheme:              "coap+ws",
+			DefangedScheme:      "coap[+]ws",
+			Template:            "",
+			Description:         "coap+ws \n      (see [reviewer notes])",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC8323]",
+			Reference:           "[RFC8323]",
+			Notes:               "",
+		},
+		"coaps": defang_schemes.Scheme{
+			Scheme:              "coaps",
+			DefangedScheme:      "cxxps",
+			Template:            "",
+			Description:         "coaps",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC7252]",
+			Reference:           "[RFC7252]",
+			Notes:               "",
+			Related:             []string{"coap"},
+		},
+		"coaps+tcp": defang_schemes.Scheme{
+			Scheme:              "coaps+tcp",
+			DefangedScheme:      "coaps[+]tcp",
+			Template:            "",
+			Description:         "coaps+tcp \n      (see [reviewer notes])",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC8323]",
+			Reference:           "[RFC8323]",
+			Notes:               "",
+		},
+		"coaps+ws": defang_schemes.Scheme{
+			Scheme:              "coaps+ws",
+			DefangedScheme:      "coaps[+]ws",
+			Template:            "",
+			Description:         "coaps+ws \n      (see [reviewer notes])",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC8323]",
+			Reference:           "[RFC8323]",
+			Notes:               "",
+		},
+		"crid": defang_schemes.Scheme{
+			Scheme:              "crid",
+			DefangedScheme:      "crxd",
+			Template:            "",
+			Description:         "TV-Anytime Content Reference Identifier",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4078]",
+			Notes:               "",
+		},
+		"data": defang_schemes.Scheme{
+			Scheme:              "data",
+			DefangedScheme:      "daxa",
+			Template:            "",
+			Description:         "data",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2397]",
+			Notes:               "",
+			Examples:            []string{"data:text/plain;base64,SGVsbG8=", "data:text/html,<b>hello</b>"},
+		},
+		"dav": defang_schemes.Scheme{
+			Scheme:              "dav",
+			DefangedScheme:      "dxv",
+			Template:            "",
+			Description:         "dav",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4918]",
+			Notes:               "",
+		},
+		"dict": defang_schemes.Scheme{
+			Scheme:              "dict",
+			DefangedScheme:      "dixt",
+			Template:            "",
+			Description:         "dictionary service protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2229]",
+			Notes:               "",
+		},
+		"dns": defang_schemes.Scheme{
+			Scheme:              "dns",
+			DefangedScheme:      "dxs",
+			Template:            "",
+			Description:         "Domain Name System",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4501]",
+			Notes:               "",
+			Examples:            []string{"dns:example.com?type=A"},
+		},
+		"doi": defang_schemes.Scheme{
+			Scheme:              "doi",
+			DefangedScheme:      "dxi",
+			Template:            "",
+			Description:         "doi",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[DOI URI Scheme][Pierre-Anthony_Lemieux][DOI_Foundation]",
+			Notes:               "",
+		},
+		"dtn": defang_schemes.Scheme{
+			Scheme:              "dtn",
+			DefangedScheme:      "dxn",
+			Template:            "",
+			Description:         "DTNRG research and development",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC9171]",
+			Notes:               "",
+		},
+		"example": defang_schemes.Scheme{
+			Scheme:              "example",
+			DefangedScheme:      "exxmple",
+			Template:            "",
+			Description:         "example",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7595]",
+			Notes:               "",
+		},
+		"file": defang_schemes.Scheme{
+			Scheme:              "file",
+			DefangedScheme:      "fixe",
+			Template:            "",
+			Description:         "Host-specific file names",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC8089]",
+			Notes:               "",
+			Examples:            []string{"file:///etc/hosts", "file://fileserver.example.com/share/report.docx"},
+		},
+		"ftp": defang_schemes.Scheme{
+			Scheme:              "ftp",
+			DefangedScheme:      "fxp",
+			Template:            "",
+			Description:         "File Transfer Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC1738]",
+			Notes:               "",
+			Examples:            []string{"ftp://ftp.example.com/pub/file.txt", "ftp://user@ftp.example.com:2121/"},
+		},
+		"geo": defang_schemes.Scheme{
+			Scheme:              "geo",
+			DefangedScheme:      "gxo",
+			Template:            "",
+			Description:         "Geographic Locations",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5870]",
+			Notes:               "",
+			Examples:            []string{"geo:51.5007,-0.1246"},
+		},
+		"go": defang_schemes.Scheme{
+			Scheme:              "go",
+			DefangedScheme:      "gx",
+			Template:            "",
+			Description:         "go",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3368]",
+			Notes:               "",
+		},
+		"gopher": defang_schemes.Scheme{
+			Scheme:              "gopher",
+			DefangedScheme:      "gxxher",
+			Template:            "",
+			Description:         "The Gopher Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4266]",
+			Notes:               "",
+			Examples:            []string{"gopher://gopher.example.com/1/"},
+		},
+		"h323": defang_schemes.Scheme{
+			Scheme:              "h323",
+			DefangedScheme:      "h3x3",
+			Template:            "",
+			Description:         "H.323",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3508]",
+			Notes:               "",
+		},
+		"http": defang_schemes.Scheme{
+			Scheme:              "http",
+			DefangedScheme:      "hxxp",
+			Template:            "",
+			Description:         "Hypertext Transfer Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC8615]",
+			Reference:           "[RFC9110, Section 4.2.1]",
+			Notes:               "",
+			Related:             []string{"https"},
+			Examples:            []string{"http://example.com/", "http://192.0.2.1:8080/index.html"},
+		},
+		"https": defang_schemes.Scheme{
+			Scheme:              "https",
+			DefangedScheme:      "hxxps",
+			Template:            "",
+			Description:         "Hypertext Transfer Protocol Secure",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC8615]",
+			Reference:           "[RFC9110, Section 4.2.2]",
+			Notes:               "",
+			Related:             []string{"http", "shttp"},
+			Examples:            []string{"https://example.com/", "https://user@www.example.com/path?query=1#fragment"},
+		},
+		"iax": defang_schemes.Scheme{
+			Scheme:              "iax",
+			DefangedScheme:      "ixx",
+			Template:            "",
+			Description:         "Inter-Asterisk eXchange Version 2",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5456]",
+			Notes:               "",
+		},
+		"icap": defang_schemes.Scheme{
+			Scheme:              "icap",
+			DefangedScheme:      "icxp",
+			Template:            "",
+			Description:         "Internet Content Adaptation Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3507]",
+			Notes:               "",
+		},
+		"im": defang_schemes.Scheme{
+			Scheme:              "im",
+			DefangedScheme:      "ix",
+			Template:            "",
+			Description:         "Instant Messaging",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3860]",
+			Notes:               "",
+		},
+		"imap": defang_schemes.Scheme{
+			Scheme:              "imap",
+			DefangedScheme:      "imxp",
+			Template:            "",
+			Description:         "internet message access protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5092]",
+			Notes:               "",
+			Examples:            []string{"imap://user@mail.example.com/INBOX"},
+		},
+		"info": defang_schemes.Scheme{
+			Scheme:              "info",
+			DefangedScheme:      "inxo",
+			Template:            "",
+			Description:         "Information Assets with Identifiers in Public Namespaces. \n      [RFC4452] (section 3) defines an \"info\" registry \n        of public namespaces, which is maintained by NISO and can be accessed \n        from [http://info-uri.info/].",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4452]",
+			Notes:               "",
+		},
+		"ipn": defang_schemes.Scheme{
+			Scheme:              "ipn",
+			DefangedScheme:      "ixn",
+			Template:            "",
+			Description:         "ipn",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC9758]",
+			Notes:               "",
+		},
+		"ipp": defang_schemes.Scheme{
+			Scheme:              "ipp",
+			DefangedScheme:      "ixp",
+			Template:            "",
+			Description:         "Internet Printing Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3510]",
+			Notes:               "",
+		},
+		"ipps": defang_schemes.Scheme{
+			Scheme:              "ipps",
+			DefangedScheme:      "ipxs",
+			Template:            "",
+			Description:         "Internet Printing Protocol over HTTPS",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7472]",
+			Notes:               "",
+		},
+		"iris": defang_schemes.Scheme{
+			Scheme:              "iris",
+			DefangedScheme:      "irxs",
+			Template:            "",
+			Description:         "Internet Registry Information Service",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3981]",
+			Notes:               "",
+		},
+		"iris.beep": defang_schemes.Scheme{
+			Scheme:              "iris.beep",
+			DefangedScheme:      "iris[.]beep",
+			Template:            "",
+			Description:         "iris.beep",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3983]",
+			Notes:               "",
+		},
+		"iris.lwz": defang_schemes.Scheme{
+			Scheme:              "iris.lwz",
+			DefangedScheme:      "iris[.]lwz",
+			Template:            "",
+			Description:         "iris.lwz",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4993]",
+			Notes:               "",
+		},
+		"iris.xpc": defang_schemes.Scheme{
+			Scheme:              "iris.xpc",
+			DefangedScheme:      "iris[.]xpc",
+			Template:            "",
+			Description:         "iris.xpc",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4992]",
+			Notes:               "",
+			Related:             []string{"iris.xpcs"},
+		},
+		"iris.xpcs": defang_schemes.Scheme{
+			Scheme:              "iris.xpcs",
+			DefangedScheme:      "iris[.]xpcs",
+			Template:            "",
+			Description:         "iris.xpcs",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4992]",
+			Notes:               "",
+			Related:             []string{"iris.xpc"},
+		},
+		"jabber": defang_schemes.Scheme{
+			Scheme:              "jabber",
+			DefangedScheme:      "jxxber",
+			Template:            "https://www.iana.org/assignments/uri-schemes/perm/jabber",
+			Description:         "jabber",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[Peter_Saint-Andre]",
+			Notes:               "",
+		},
+		"ldap": defang_schemes.Scheme{
+			Scheme:              "ldap",
+			DefangedScheme:      "ldxp",
+			Template:            "",
+			Description:         "Lightweight Directory Access Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4516]",
+			Notes:               "",
+			Examples:            []string{"ldap://ldap.example.com/dc=example,dc=com?cn?sub"},
+		},
+		"leaptofrogans": defang_schemes.Scheme{
+			Scheme:              "leaptofrogans",
+			DefangedScheme:      "lxxptofrogans",
+			Template:            "",
+			Description:         "leaptofrogans",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC8589]",
+			Notes:               "",
+		},
+		"mailto": defang_schemes.Scheme{
+			Scheme:              "mailto",
+			DefangedScheme:      "mxxlto",
+			Template:            "",
+			Description:         "Electronic mail address",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6068]",
+			Notes:               "",
+			Examples:            []string{"mailto:user@example.com", "mailto:user@example.com?subject=Hello"},
+		},
+		"mid": defang_schemes.Scheme{
+			Scheme:              "mid",
+			DefangedScheme:      "mxd",
+			Template:            "",
+			Description:         "message identifier",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2392]",
+			Notes:               "",
+		},
+		"msrp": defang_schemes.Scheme{
+			Scheme:              "msrp",
+			DefangedScheme:      "msxp",
+			Template:            "",
+			Description:         "Message Session Relay Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4975]",
+			Notes:               "",
+			Related:             []string{"msrps"},
+		},
+		"msrps": defang_schemes.Scheme{
+			Scheme:              "msrps",
+			DefangedScheme:      "mxxps",
+			Template:            "",
+			Description:         "Message Session Relay Protocol Secure",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4975][RFC8873]",
+			Notes:               "",
+			Related:             []string{"msrp"},
+		},
+		"mt": defang_schemes.Scheme{
+			Scheme:              "mt",
+			DefangedScheme:      "mx",
+			Template:            "https://www.iana.org/assignments/uri-schemes/perm/mt",
+			Description:         "Matter protocol on-boarding payloads that are encoded for use in QR Codes and/or NFC Tags",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[Connectivity_Standards_Alliance]",
+			Notes:               "",
+		},
+		"mtqp": defang_schemes.Scheme{
+			Scheme:              "mtqp",
+			DefangedScheme:      "mtxp",
+			Template:            "",
+			Description:         "Message Tracking Query Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3887]",
+			Notes:               "",
+		},
+		"mupdate": defang_schemes.Scheme{
+			Scheme:              "mupdate",
+			DefangedScheme:      "mxxdate",
+			Template:            "",
+			Description:         "Mailbox Update (MUPDATE) Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3656]",
+			Notes:               "",
+		},
+		"news": defang_schemes.Scheme{
+			Scheme:              "news",
+			DefangedScheme:      "nexs",
+			Template:            "",
+			Description:         "USENET news",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5538]",
+			Notes:               "",
+			Examples:            []string{"news:comp.lang.go"},
+		},
+		"nfs": defang_schemes.Scheme{
+			Scheme:              "nfs",
+			DefangedScheme:      "nxs",
+			Template:            "",
+			Description:         "network file system protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2224]",
+			Notes:               "",
+			Examples:            []string{"nfs://fileserver.example.com/export/home"},
+		},
+		"ni": defang_schemes.Scheme{
+			Scheme:              "ni",
+			DefangedScheme:      "nx",
+			Template:            "",
+			Description:         "ni",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6920]",
+			Notes:               "",
+		},
+		"nih": defang_schemes.Scheme{
+			Scheme:              "nih",
+			DefangedScheme:      "nxh",
+			Template:            "",
+			Description:         "nih",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6920]",
+			Notes:               "",
+		},
+		"nntp": defang_schemes.Scheme{
+			Scheme:              "nntp",
+			DefangedScheme:      "nnxp",
+			Template:            "",
+			Description:         "USENET news using NNTP access",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5538]",
+			Notes:               "",
+			Examples:            []string{"nntp://news.example.com/comp.lang.go"},
+		},
+		"opaquelocktoken": defang_schemes.Scheme{
+			Scheme:              "opaquelocktoken",
+			DefangedScheme:      "oxxquelocktoken",
+			Template:            "",
+			Description:         "opaquelocktokent",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4918]",
+			Notes:               "",
+		},
+		"pkcs11": defang_schemes.Scheme{
+			Scheme:              "pkcs11",
+			DefangedScheme:      "pxxs11",
+			Template:            "",
+			Description:         "PKCS#11",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7512]",
+			Notes:               "",
+		},
+		"pop": defang_schemes.Scheme{
+			Scheme:              "pop",
+			DefangedScheme:      "pxp",
+			Template:            "",
+			Description:         "Post Office Protocol v3",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2384]",
+			Notes:               "",
+			Examples:            []string{"pop://user@mail.example.com"},
+		},
+		"pres": defang_schemes.Scheme{
+			Scheme:              "pres",
+			DefangedScheme:      "prxs",
+			Template:            "",
+			Description:         "Presence",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3859]",
+			Notes:               "",
+		},
+		"reload": defang_schemes.Scheme{
+			Scheme:              "reload",
+			DefangedScheme:      "rxxoad",
+			Template:            "",
+			Description:         "reload",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6940]",
+			Notes:               "",
+		},
+		"rtsp": defang_schemes.Scheme{
+			Scheme:              "rtsp",
+			DefangedScheme:      "rtxp",
+			Template:            "",
+			Description:         "Real-Time Streaming Protocol (RTSP)",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2326][RFC7826]",
+			Notes:               "",
+			Related:             []string{"rtsps"},
+			Examples:            []string{"rtsp://camera.example.com:554/stream"},
+		},
+		"rtsps": defang_schemes.Scheme{
+			Scheme:              "rtsps",
+			DefangedScheme:      "rxxps",
+			Template:            "",
+			Description:         "Real-Time Streaming Protocol (RTSP) over TLS",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2326][RFC7826]",
+			Notes:               "",
+			Related:             []string{"rtsp"},
+		},
+		"rtspu": defang_schemes.Scheme{
+			Scheme:              "rtspu",
+			DefangedScheme:      "rxxpu",
+			Template:            "",
+			Description:         "Real-Time Streaming Protocol (RTSP) over unreliable datagram transport",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2326]",
+			Notes:               "",
+		},
+		"service": defang_schemes.Scheme{
+			Scheme:              "service",
+			DefangedScheme:      "sxxvice",
+			Template:            "",
+			Description:         "service location",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2609]",
+			Notes:               "",
+		},
+		"session": defang_schemes.Scheme{
+			Scheme:              "session",
+			DefangedScheme:      "sxxsion",
+			Template:            "",
+			Description:         "session",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6787]",
+			Notes:               "",
+		},
+		"shttp": defang_schemes.Scheme{
+			Scheme:              "shttp",
+			DefangedScheme:      "sxxtp",
+			Template:            "",
+			Description:         "Secure Hypertext Transfer Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2660][Status change of HTTP experiments to Historic]",
+			Notes:               "",
+			Obsolete:            true,
+			Related:             []string{"https"},
+		},
+		"sieve": defang_schemes.Scheme{
+			Scheme:              "sieve",
+			DefangedScheme:      "sxxve",
+			Template:            "",
+			Description:         "ManageSieve Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5804]",
+			Notes:               "",
+		},
+		"sip": defang_schemes.Scheme{
+			Scheme:              "sip",
+			DefangedScheme:      "sxp",
+			Template:            "",
+			Description:         "session initiation protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3261]",
+			Notes:               "",
+			Related:             []string{"sips"},
+			Examples:            []string{"sip:alice@example.com"},
+		},
+		"sips": defang_schemes.Scheme{
+			Scheme:              "sips",
+			DefangedScheme:      "sixs",
+			Template:            "",
+			Description:         "secure session initiation protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3261]",
+			Notes:               "",
+			Related:             []string{"sip"},
+			Examples:            []string{"sips:alice@example.com:5061"},
+		},
+		"sms": defang_schemes.Scheme{
+			Scheme:              "sms",
+			DefangedScheme:      "sxs",
+			Template:            "",
+			Description:         "Short Message Service",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5724]",
+			Notes:               "",
+			Examples:            []string{"sms:+447700900123?body=hello"},
+		},
+		"snmp": defang_schemes.Scheme{
+			Scheme:              "snmp",
+			DefangedScheme:      "snxp",
+			Template:            "",
+			Description:         "Simple Network Management Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4088]",
+			Notes:               "",
+		},
+		"soap.beep": defang_schemes.Scheme{
+			Scheme:              "soap.beep",
+			DefangedScheme:      "soap[.]beep",
+			Template:            "",
+			Description:         "soap.beep",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4227]",
+			Notes:               "",
+			Related:             []string{"soap.beeps"},
+		},
+		"soap.beeps": defang_schemes.Scheme{
+			Scheme:              "soap.beeps",
+			DefangedScheme:      "soap[.]beeps",
+			Template:            "",
+			Description:         "soap.beeps",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4227]",
+			Notes:               "",
+			Related:             []string{"soap.beep"},
+		},
+		"stun": defang_schemes.Scheme{
+			Scheme:              "stun",
+			DefangedScheme:      "stxn",
+			Template:            "",
+			Description:         "stun",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7064]",
+			Notes:               "",
+			Related:             []string{"stuns"},
+			Examples:            []string{"stun:stun.example.com:3478"},
+		},
+		"stuns": defang_schemes.Scheme{
+			Scheme:              "stuns",
+			DefangedScheme:      "sxxns",
+			Template:            "",
+			Description:         "stuns",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7064]",
+			Notes:               "",
+			Related:             []string{"stun"},
+		},
+		"tag": defang_schemes.Scheme{
+			Scheme:              "tag",
+			DefangedScheme:      "txg",
+			Template:            "",
+			Description:         "tag",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4151]",
+			Notes:               "",
+		},
+		"tel": defang_schemes.Scheme{
+			Scheme:              "tel",
+			DefangedScheme:      "txl",
+			Template:            "",
+			Description:         "telephone",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3966][RFC5341]",
+			Notes:               "",
+			Related:             []string{"fax", "modem"},
+			Examples:            []string{"tel:+447700900123"},
+		},
+		"telnet": defang_schemes.Scheme{
+			Scheme:              "telnet",
+			DefangedScheme:      "txxnet",
+			Template:            "",
+			Description:         "Reference to interactive sessions",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4248]",
+			Notes:               "",
+			Examples:            []string{"telnet://192.0.2.1:23/"},
+		},
+		"tftp": defang_schemes.Scheme{
+			Scheme:              "tftp",
+			DefangedScheme:      "tfxp",
+			Template:            "",
+			Description:         "Trivial File Transfer Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3617]",
+			Notes:               "",
+		},
+		"thismessage": defang_schemes.Scheme{
+			Scheme:              "thismessage",
+			DefangedScheme:      "txxsmessage",
+			Template:            "https://www.iana.org/assignments/uri-schemes/perm/thismessage",
+			Description:         "multipart/related relative reference resolution",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2557]",
+			Notes:               "",
+		},
+		"tip": defang_schemes.Scheme{
+			Scheme:              "tip",
+			DefangedScheme:      "txp",
+			Template:            "",
+			Description:         "Transaction Internet Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2371]",
+			Notes:               "",
+		},
+		"tn3270": defang_schemes.Scheme{
+			Scheme:              "tn3270",
+			DefangedScheme:      "txx270",
+			Template:            "",
+			Description:         "Interactive 3270 emulation sessions",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6270]",
+			Notes:               "",
+		},
+		"turn": defang_schemes.Scheme{
+			Scheme:              "turn",
+			DefangedScheme:      "tuxn",
+			Template:            "",
+			Description:         "turn",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7065]",
+			Notes:               "",
+			Related:             []string{"turns"},
+			Examples:            []string{"turn:turn.example.com?transport=udp"},
+		},
+		"turns": defang_schemes.Scheme{
+			Scheme:              "turns",
+			DefangedScheme:      "txxns",
+			Template:            "",
+			Description:         "turns",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7065]",
+			Notes:               "",
+			Related:             []string{"turn"},
+		},
+		"tv": defang_schemes.Scheme{
+			Scheme:              "tv",
+			DefangedScheme:      "tx",
+			Template:            "",
+			Description:         "TV Broadcasts",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2838]",
+			Notes:               "",
+		},
+		"urn": defang_schemes.Scheme{
+			Scheme:              "urn",
+			DefangedScheme:      "uxn",
+			Template:            "",
+			Description:         "Uniform Resource Names",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC8141][IANA registryurn-namespaces]",
+			Notes:               "",
+			Examples:            []string{"urn:isbn:0451450523", "urn:ietf:rfc:3986"},
+		},
+		"vemmi": defang_schemes.Scheme{
+			Scheme:              "vemmi",
+			DefangedScheme:      "vxxmi",
+			Template:            "",
+			Description:         "versatile multimedia interface",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2122]",
+			Notes:               "",
+		},
+		"vnc": defang_schemes.Scheme{
+			Scheme:              "vnc",
+			DefangedScheme:      "vxc",
+			Template:            "",
+			Description:         "Remote Framebuffer Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7869]",
+			Notes:               "",
+			Examples:            []string{"vnc://host.example.com:5900"},
+		},
+		"ws": defang_schemes.Scheme{
+			Scheme:              "ws",
+			DefangedScheme:      "wx",
+			Template:            "",
+			Description:         "WebSocket connections",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC8307]",
+			Reference:           "[RFC6455]",
+			Notes:               "",
+			Related:             []string{"wss"},
+			Examples:            []string{"ws://example.com/socket"},
+		},
+		"wss": defang_schemes.Scheme{
+			Scheme:              "wss",
+			DefangedScheme:      "wxs",
+			Template:            "",
+			Description:         "Encrypted WebSocket connections",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC8307]",
+			Reference:           "[RFC6455]",
+			Notes:               "",
+			Related:             []string{"ws"},
+			Examples:            []string{"wss://example.com/socket"},
+		},
+		"xcon": defang_schemes.Scheme{
+			Scheme:              "xcon",
+			DefangedScheme:      "xcxn",
+			Template:            "",
+			Description:         "xcon",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6501]",
+			Notes:               "",
+		},
+		"xcon-userid": defang_schemes.Scheme{
+			Scheme:              "xcon-userid",
+			DefangedScheme:      "xcon[-]userid",
+			Template:            "",
+			Description:         "xcon-userid",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6501]",
+			Notes:               "",
+		},
+		"xmlrpc.beep": defang_schemes.Scheme{
+			Scheme:              "xmlrpc.beep",
+			DefangedScheme:      "xmlrpc[.]beep",
+			Template:            "",
+			Description:         "xmlrpc.beep",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3529]",
+			Notes:               "",
+			Related:             []string{"xmlrpc.beeps"},
+		},
+		"xmlrpc.beeps": defang_schemes.Scheme{
+			Scheme:              "xmlrpc.beeps",
+			DefangedScheme:      "xmlrpc[.]beeps",
+			Template:            "",
+			Description:         "xmlrpc.beeps",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3529]",
+			Notes:               "",
+			Related:             []string{"xmlrpc.beep"},
+		},
+		"xmpp": defang_schemes.Scheme{
+			Scheme:              "xmpp",
+			DefangedScheme:      "xmxp",
+			Template:            "",
+			Description:         "Extensible Messaging and Presence Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5122]",
+			Notes:               "",
+			Examples:            []string{"xmpp:alice@example.com"},
+		},
+		"z39.50r": defang_schemes.Scheme{
+			Scheme:              "z39.50r",
+			DefangedScheme:      "z39[.]50r",
+			Template:            "",
+			Description:         "Z39.50 Retrieval",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2056]",
+			Notes:               "",
+			Related:             []string{"z39.50"},
+		},
+		"z39.50s": defang_schemes.Scheme{
+			Scheme:              "z39.50s",
+			DefangedScheme:      "z39[.]50s",
+			Template:            "",
+			Description:         "Z39.50 Session",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2056]",
+			Notes:               "",
+			Related:             []string{"z39.50"},
+		},
+	}
+}
@@ -0,0 +1,83 @@
+// The URI scheme dataset restricted to Permanent schemes
+//
+// Refanging against the full dataset resolves any registered defanged form, including
+// those of provisional and historical schemes.  Consumers who do not want those entries
+// influencing refang resolution can defang and refang against this subset instead:
+//
+//	permanent.RefangText("hxxps[://]example[.]com/")  // "https://example.com/"
+//
+// The subset is written by tools/writeconsts (with the "permanent" format), and keeps the
+// defanged forms of the full dataset, so that text defanged with either package refangs
+// with the other
+package permanent
+
+import (
+	"sync"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+// Permanent schemes, keyed by scheme, assigned by the generated consts.go.  Nil if the data
+// has not been generated
+var Map map[string]defang_schemes.Scheme
+
+// The Permanent schemes, keyed by scheme.  Panics with defang_schemes.ErrDataNotGenerated
+// if the data has not been generated
+func Schemes() map[string]defang_schemes.Scheme {
+	if Map == nil {
+		panic(defang_schemes.ErrDataNotGenerated)
+	}
+	return Map
+}
+
+// Look up a Permanent scheme, as per defang_schemes.Lookup
+func Lookup(scheme string) (defang_schemes.Scheme, bool) {
+	return defaultRegistry().Lookup(scheme)
+}
+
+// Create a registry containing only the Permanent schemes.  Schemes registered later are
+// added as in any other registry
+func NewRegistry(opts ...defang_schemes.RegistryOption) *defang_schemes.Registry {
+	return defang_schemes.NewRegistry(append([]defang_schemes.RegistryOption{defang_schemes.WithSchemes(Schemes())}, opts...)...)
+}
+
+// Create a Defanger over a registry of the Permanent schemes (unless WithRegistry is given)
+func NewDefanger(opts ...defang_schemes.DefangerOption) *defang_schemes.Defanger {
+	return defang_schemes.NewDefanger(append([]defang_schemes.DefangerOption{defang_schemes.WithRegistry(NewRegistry())}, opts...)...)
+}
+
+// Create a Processor whose Defanger is over the Permanent schemes (unless WithDefanger is
+// given)
+func NewProcessor(opts ...defang_schemes.ProcessorOption) *defang_schemes.Processor {
+	return defang_schemes.NewProcessor(append([]defang_schemes.ProcessorOption{defang_schemes.WithDefanger(defaultDefanger())}, opts...)...)
+}
+
+var (
+	defaultRegistry  = sync.OnceValue(func() *defang_schemes.Registry { return NewRegistry() })
+	defaultDefanger  = sync.OnceValue(func() *defang_schemes.Defanger { return NewDefanger(defang_schemes.WithRegistry(defaultRegistry())) })
+	defaultProcessor = sync.OnceValue(func() *defang_schemes.Processor { return NewProcessor() })
+)
+
+// Defang a scheme against the Permanent schemes, as defang_schemes.DefangSchemeStrict does
+// against the full dataset
+func Defang(scheme string) (string, error) {
+	return defaultDefanger().Defang(scheme)
+}
+
+// Resolve a defanged scheme to a Permanent scheme.  Returns
+// defang_schemes.ErrUnknownDefangedScheme for the defanged forms of other schemes
+func Refang(defanged string) (string, error) {
+	return defaultDefanger().Refang(defanged)
+}
+
+// Defang every (fanged) URL in the text, as defang_schemes.DefangText
+func DefangText(text string) string {
+	return defaultProcessor().DefangText(text)
+}
+
+// Refang every defanged URL in the text, as defang_schemes.RefangText.  Schemes that do not
+// refang to a Permanent scheme are left defanged, as unknown schemes are by RefangText
+// ("axd[://]example[.]com" refangs as "axd://example.com", not "acd://example.com")
+func RefangText(text string) string {
+	return defaultProcessor().RefangText(text)
+}
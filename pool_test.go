@@ -0,0 +1,21 @@
+package defang_schemes_test
+
+import (
+	"testing"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+// Lines without URLs, the bulk of most logs, are passed through without allocating
+func TestProcessorCleanLinesDoNotAllocate(t *testing.T) {
+	line := "2024-05-01T12:00:00Z INFO request completed in 12ms status=200"
+	processor := defang_schemes.NewProcessor()
+	processor.DefangText(line) // Build the pattern outside the measured runs
+	allocs := testing.AllocsPerRun(100, func() {
+		processor.DefangText(line)
+		processor.RefangText(line)
+	})
+	if allocs != 0 {
+		t.Errorf("processing a line without URLs allocated %v times per run", allocs)
+	}
+}
@@ -0,0 +1,114 @@
+package defang_schemes_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jakewilliami/defang-schemes"
+	"github.com/jakewilliami/defang-schemes/corpus"
+)
+
+// The regression corpus of real-world defanged text refangs as expected
+func TestRefangCorpus(t *testing.T) {
+	for _, c := range corpus.Cases() {
+		t.Run(c.Name, func(t *testing.T) {
+			switch c.Kind {
+			case "url":
+				if refanged, err := defang_schemes.RefangURL(c.Defanged); err != nil || refanged != c.Refanged {
+					t.Errorf("RefangURL(%q) = %q, %v, want %q", c.Defanged, refanged, err, c.Refanged)
+				}
+			case "all":
+				if refanged := defang_schemes.RefangAll(c.Defanged); refanged != c.Refanged {
+					t.Errorf("RefangAll(%q) = %q, want %q", c.Defanged, refanged, c.Refanged)
+				}
+			case "":
+				if refanged := defang_schemes.RefangText(c.Defanged); refanged != c.Refanged {
+					t.Errorf("RefangText(%q) = %q, want %q", c.Defanged, refanged, c.Refanged)
+				}
+			default:
+				t.Errorf("unknown kind %q", c.Kind)
+			}
+		})
+	}
+}
+
+// Normalising the corpus does not depend on how its URLs were defanged
+func TestNormalizeDefangedCorpus(t *testing.T) {
+	for _, c := range corpus.Cases() {
+		if c.Kind != "" {
+			continue
+		}
+		t.Run(c.Name, func(t *testing.T) {
+			if normalized, want := defang_schemes.NormalizeDefanged(c.Defanged), defang_schemes.DefangText(c.Refanged); normalized != want {
+				t.Errorf("NormalizeDefanged(%q) = %q, want %q", c.Defanged, normalized, want)
+			}
+		})
+	}
+}
+
+func TestRefangMessage(t *testing.T) {
+	cases := []struct {
+		message, want string
+		iocs          []string
+	}{
+		{"Seen hxxps://evil[.]test/x twice", "Seen https://evil.test/x twice", []string{"https://evil.test/x"}},
+		{"from 1[.]2[.]3[.]4 and bob[at]evil[.]com", "from 1.2.3.4 and bob@evil.com", []string{"1.2.3.4", "bob@evil.com"}},
+		{"hxxp://evil[.]test, evil[.]test, then hxxp://evil[.]test again", "http://evil.test, evil.test, then http://evil.test again", []string{"http://evil.test", "evil.test"}},
+		{"https://example.com and 10.0.0.1 are not IOCs", "https://example.com and 10.0.0.1 are not IOCs", nil},
+	}
+	for _, c := range cases {
+		refanged, iocs := defang_schemes.RefangMessage(c.message)
+		if refanged != c.want || !slices.Equal(iocs, c.iocs) {
+			t.Errorf("RefangMessage(%q) = %q, %q, want %q, %q", c.message, refanged, iocs, c.want, c.iocs)
+		}
+	}
+}
+
+func TestDefangTextOpaqueURLs(t *testing.T) {
+	cases := []struct {
+		input, want string
+	}{
+		{`<a href="javascript:alert(1)">`, `<a href="jxxascript[:]alert(1)">`},
+		{"JavaScript:alert(1)", "JxxaScript[:]alert(1)"},
+		{"vbscript:msgbox(1)", "vxxcript[:]msgbox(1)"},
+		{"open data:text/html;base64,PHNj now", "open daxa[:]text/html;base64[,]PHNj now"},
+		{"mail mailto:bob@evil.com.", "mail mxxlto[:]bob[at]evil[.]com."},
+		{"id urn:isbn:0451450523.", "id urn[:]isbn[:]0451450523."},
+		{"id URN:x", "id UXN[:]x"},
+
+		// Only the opaque URLs of OPAQUE_URL_SCHEMES are told from prose
+		{"Note: metadata:foo", "Note: metadata:foo"},
+		{"jxxascript[:]alert(1)", "jxxascript[:]alert(1)"},
+
+		// Underscores are not scheme characters
+		{"x_https://evil.com", "x_hxxps[://]evil[.]com"},
+	}
+	for _, c := range cases {
+		if defanged := defang_schemes.DefangText(c.input); defanged != c.want {
+			t.Errorf("DefangText(%q) = %q, want %q", c.input, defanged, c.want)
+		}
+	}
+	if refanged := defang_schemes.RefangText("open daxa[:]text/html;base64[,]PHNj now"); refanged != "open data:text/html;base64,PHNj now" {
+		t.Errorf("RefangText(%q) = %q, want %q", "open daxa[:]text/html;base64[,]PHNj now", refanged, "open data:text/html;base64,PHNj now")
+	}
+}
+
+// The SOC preset leaves the reserved domains alone, and each call returns a fresh copy
+func TestPresetSOCDefault(t *testing.T) {
+	p := defang_schemes.NewProcessor(defang_schemes.WithOptions(defang_schemes.PresetSOCDefault()))
+	for _, host := range []string{"example.com", "www.example.org", "evil.test", "a.example", "x.invalid", "localhost", "app.localhost"} {
+		if text := "https://" + host + "/x"; p.DefangText(text) != text {
+			t.Errorf("DefangText(%q) = %q, want it unchanged", text, p.DefangText(text))
+		}
+	}
+	if text, want := "https://evil.com/x?q#f", "hxxps[://]evil[.]com/x[?]q[#]f"; p.DefangText(text) != want {
+		t.Errorf("DefangText(%q) = %q, want %q", text, p.DefangText(text), want)
+	}
+
+	preset := defang_schemes.PresetSOCDefault()
+	preset.AllowedHosts[0] = "evil.com"
+	preset.AllowedHosts = append(preset.AllowedHosts, "evil.org")
+	if fresh := defang_schemes.PresetSOCDefault(); slices.Contains(fresh.AllowedHosts, "evil.com") || slices.Contains(fresh.AllowedHosts, "evil.org") {
+		t.Errorf("modifying one PresetSOCDefault changed another: %q", fresh.AllowedHosts)
+	}
+}
@@ -0,0 +1,70 @@
+package defang_schemes_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+// Each refang rule refangs its own example as documented
+func TestRefangRuleExamples(t *testing.T) {
+	for _, rule := range defang_schemes.RefangRules() {
+		if refanged := rule.Pattern.ReplaceAllStringFunc(rule.Example, rule.Replace); refanged != rule.Refanged {
+			t.Errorf("rule %q rewrote %q to %q, want %q", rule.Name, rule.Example, refanged, rule.Refanged)
+		}
+	}
+}
+
+func TestRefangURLConventions(t *testing.T) {
+	cases := []struct {
+		defanged, want string
+	}{
+		{"hxxps[://]example[.]com/path", "https://example.com/path"},
+		{"hXXp://example[.]com", "http://example.com"},
+		{"h__ps://example(.)com", "https://example.com"},
+		{"h**p://example{.}com", "http://example.com"},
+		{"meows://example(dot)com[/]path", "https://example.com/path"},
+		{`hxxps:\/\/example[.]com\/path`, "https://example.com/path"},
+		{"hxxp://example dot com", "http://example.com"},
+		{"fxp[://]bob at example[.]com/pub", "ftp://bob@example.com/pub"},
+	}
+	for _, c := range cases {
+		if refanged, err := defang_schemes.RefangURL(c.defanged); err != nil || refanged != c.want {
+			t.Errorf("RefangURL(%q) = %q, %v, want %q", c.defanged, refanged, err, c.want)
+		}
+	}
+}
+
+// Surrounding whitespace is trimmed, as DefangScheme trims it
+func TestRefangSchemeWhitespace(t *testing.T) {
+	for _, defanged := range []string{" hxxps ", "\thxxps", "hxxps\n"} {
+		if refanged, err := defang_schemes.RefangScheme(defanged); err != nil || refanged != "https" {
+			t.Errorf("RefangScheme(%q) = %q, %v, want %q", defanged, refanged, err, "https")
+		}
+		if scheme, ok := defang_schemes.LookupDefanged(defanged); !ok || scheme.Scheme != "https" {
+			t.Errorf("LookupDefanged(%q) = %q, %t, want %q", defanged, scheme.Scheme, ok, "https")
+		}
+	}
+	if _, err := defang_schemes.RefangScheme("  "); !errors.Is(err, defang_schemes.ErrEmptyInput) {
+		t.Errorf("RefangScheme(%q) = %v, want ErrEmptyInput", "  ", err)
+	}
+}
+
+// Refanging returns the canonical lowercase scheme, whatever the case of the defanged one,
+// unless the Defanger preserves case
+func TestRefangSchemeCase(t *testing.T) {
+	for _, defanged := range []string{"hxxps", "HXXPS", "Hxxps", "hXXps"} {
+		if refanged, err := defang_schemes.RefangScheme(defanged); err != nil || refanged != "https" {
+			t.Errorf("RefangScheme(%q) = %q, %v, want %q", defanged, refanged, err, "https")
+		}
+	}
+	if refanged, err := defang_schemes.RefangURL("HXXPS[://]example[.]com"); err != nil || refanged != "https://example.com" {
+		t.Errorf("RefangURL(%q) = %q, %v, want %q", "HXXPS[://]example[.]com", refanged, err, "https://example.com")
+	}
+
+	defanger := defang_schemes.NewDefanger(defang_schemes.WithStyle(defang_schemes.WithPreserveCase()))
+	if refanged, err := defanger.Refang("HXXPS"); err != nil || refanged != "HTTPS" {
+		t.Errorf("Refang(%q) = %q, %v, want %q", "HXXPS", refanged, err, "HTTPS")
+	}
+}
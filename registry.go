@@ -0,0 +1,112 @@
+package defang_schemes
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/jakewilliami/defang-schemes/registry"
+)
+
+type (
+	DefangerConfig       = registry.DefangerConfig
+	IANAUpdate           = registry.IANAUpdate
+	IANAOption           = registry.IANAOption
+	CollisionPolicy      = registry.CollisionPolicy
+	DefangCollisionError = registry.DefangCollisionError
+	Registry             = registry.Registry
+	RegistryOption       = registry.RegistryOption
+	RegistryStore        = registry.RegistryStore
+	RegistryChanges      = registry.RegistryChanges
+	FileStore            = registry.FileStore
+	ValidateOption       = registry.ValidateOption
+	WarningKind          = registry.WarningKind
+	Warning              = registry.Warning
+)
+
+const (
+	IANA_SCHEMES_CSV_URL     = registry.IANA_SCHEMES_CSV_URL
+	IANA_FETCH_TIMEOUT       = registry.IANA_FETCH_TIMEOUT
+	IANA_MIN_SCHEME_FRACTION = registry.IANA_MIN_SCHEME_FRACTION
+	IANA_MAX_RESPONSE_SIZE   = registry.IANA_MAX_RESPONSE_SIZE
+	CollisionReject          = registry.CollisionReject
+	CollisionAutoAdjust      = registry.CollisionAutoAdjust
+	CollisionWarn            = registry.CollisionWarn
+	WarningDefangedIsScheme  = registry.WarningDefangedIsScheme
+	WarningDefangAmbiguous   = registry.WarningDefangAmbiguous
+	WarningDefangCollision   = registry.WarningDefangCollision
+)
+
+var (
+	ErrInvalidScheme      = registry.ErrInvalidScheme
+	ErrSchemeExists       = registry.ErrSchemeExists
+	ErrDefangCollision    = registry.ErrDefangCollision
+	ErrIANAUnavailable    = registry.ErrIANAUnavailable
+	IANA_REQUIRED_COLUMNS = registry.IANA_REQUIRED_COLUMNS
+)
+
+// As registry.LoadDefangerConfig
+func LoadDefangerConfig(r io.Reader) (*DefangerConfig, error) {
+	return registry.LoadDefangerConfig(r)
+}
+
+// As registry.LoadDefangerFile
+func LoadDefangerFile(path string) (*Defanger, error) {
+	return registry.LoadDefangerFile(path)
+}
+
+// As registry.GenerateData
+func GenerateData(ctx context.Context, opts ...IANAOption) error {
+	return registry.GenerateData(ctx, opts...)
+}
+
+// As registry.WithIANAURL
+func WithIANAURL(url string) IANAOption {
+	return registry.WithIANAURL(url)
+}
+
+// As registry.WithHTTPClient
+func WithHTTPClient(client *http.Client) IANAOption {
+	return registry.WithHTTPClient(client)
+}
+
+// As registry.WithIANATimeout
+func WithIANATimeout(timeout time.Duration) IANAOption {
+	return registry.WithIANATimeout(timeout)
+}
+
+// As registry.WithCollisionPolicy
+func WithCollisionPolicy(policy CollisionPolicy) RegistryOption {
+	return registry.WithCollisionPolicy(policy)
+}
+
+// As registry.WithWarningHandler
+func WithWarningHandler(handle func(Warning)) RegistryOption {
+	return registry.WithWarningHandler(handle)
+}
+
+// As registry.WithCollisionWarning
+func WithCollisionWarning(warn func(error)) RegistryOption {
+	return registry.WithCollisionWarning(warn)
+}
+
+// As registry.WithSchemes
+func WithSchemes(schemes map[string]Scheme) RegistryOption {
+	return registry.WithSchemes(schemes)
+}
+
+// As registry.NewRegistry
+func NewRegistry(opts ...RegistryOption) *Registry {
+	return registry.NewRegistry(opts...)
+}
+
+// As registry.NewFileStore
+func NewFileStore(path string) *FileStore {
+	return registry.NewFileStore(path)
+}
+
+// As registry.WithAllowedCollisions
+func WithAllowedCollisions(schemes ...string) ValidateOption {
+	return registry.WithAllowedCollisions(schemes...)
+}
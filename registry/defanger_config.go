@@ -0,0 +1,163 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/jakewilliami/defang-schemes/defang"
+	"github.com/jakewilliami/defang-schemes/schemes"
+)
+
+// A complete defang.Defanger configuration, as written in a JSON policy file, so that defang
+// conventions can be version-controlled outside code:
+//
+//	{
+//	  "style": "hxx",
+//	  "replacement": "x",
+//	  "unknown_schemes": "generic",
+//	  "overrides": {"https": "hxxps", "ftp": "fxp"},
+//	  "allow_domains": ["example.com"],
+//	  "deny_schemes": ["javascript"]
+//	}
+//
+// Omitted fields take the defaults of defang.NewDefanger
+type DefangerConfig struct {
+	// schemes.Style name, as given by Style.String ("hxx" or "brackets")
+	Style string `json:"style,omitempty"`
+	// Single character replacing those of the scheme, as defang.WithReplacementRune
+	Replacement string `json:"replacement,omitempty"`
+	// As defang.WithPreserveCase (for refanging) and defang.WithoutFourLetterCase
+	PreserveCase     bool `json:"preserve_case,omitempty"`
+	NoFourLetterCase bool `json:"no_four_letter_case,omitempty"`
+	// Policy name, as given by defang.UnknownSchemePolicy.String ("defang", "reject",
+	// "pass-through", or "generic")
+	UnknownSchemes string `json:"unknown_schemes,omitempty"`
+	// Scheme → defanged form, replacing the registered forms (and so also refanged).  Custom
+	// styles defang registered schemes by algorithm, so overrides apply to the default style
+	Overrides map[string]string `json:"overrides,omitempty"`
+	// Allowlists and denylists; see defang.WithPolicy
+	defang.PolicyConfig
+}
+
+// Read a Defanger configuration from JSON, as described by DefangerConfig.  Unknown fields
+// are rejected, so that misspelt settings are not silently ignored.  Only JSON is read, as
+// this module has no YAML dependency; convert YAML policies with a tool such as yq
+func LoadDefangerConfig(r io.Reader) (*DefangerConfig, error) {
+	var config DefangerConfig
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf("%w: %v", defang.ErrInvalidPolicy, err)
+	}
+	return &config, nil
+}
+
+// Read a Defanger configuration from a JSON file and create the Defanger it describes
+func LoadDefangerFile(path string) (*defang.Defanger, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	config, err := LoadDefangerConfig(file)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := config.Options()
+	if err != nil {
+		return nil, err
+	}
+	return defang.NewDefanger(opts...), nil
+}
+
+// The options configuring a Defanger as described.  Returns defang.ErrInvalidPolicy for unknown
+// names, a replacement that is not a single character, invalid lists, or overrides that
+// cannot be registered
+func (c *DefangerConfig) Options() ([]defang.DefangerOption, error) {
+	var style []defang.DefangOption
+	switch c.Style {
+	case "", schemes.StyleHxx.String():
+	case schemes.StyleBrackets.String():
+		style = append(style, schemes.StyleBrackets.Options()...)
+	default:
+		return nil, fmt.Errorf("%w: unknown style %q", defang.ErrInvalidPolicy, c.Style)
+	}
+	if c.Replacement != "" {
+		replacement, size := utf8.DecodeRuneInString(c.Replacement)
+		if size != len(c.Replacement) || replacement == utf8.RuneError {
+			return nil, fmt.Errorf("%w: replacement %q is not a single character", defang.ErrInvalidPolicy, c.Replacement)
+		}
+		style = append(style, defang.WithReplacementRune(replacement))
+	}
+	if c.PreserveCase {
+		style = append(style, defang.WithPreserveCase())
+	}
+	if c.NoFourLetterCase {
+		style = append(style, defang.WithoutFourLetterCase())
+	}
+	opts := []defang.DefangerOption{defang.WithStyle(style...)}
+
+	if c.UnknownSchemes != "" {
+		unknown, ok := parseUnknownSchemePolicy(c.UnknownSchemes)
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown scheme policy %q", defang.ErrInvalidPolicy, c.UnknownSchemes)
+		}
+		opts = append(opts, defang.WithUnknownSchemePolicy(unknown))
+	}
+
+	policy, err := defang.NewPolicy(c.PolicyConfig)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, defang.WithPolicy(policy))
+
+	if len(c.Overrides) > 0 {
+		registry, err := overrideRegistry(c.Overrides)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, defang.WithRegistry(registry))
+	}
+	return opts, nil
+}
+
+func parseUnknownSchemePolicy(name string) (defang.UnknownSchemePolicy, bool) {
+	for _, policy := range []defang.UnknownSchemePolicy{defang.UnknownSchemeDefang, defang.UnknownSchemeReject, defang.UnknownSchemePassThrough, defang.UnknownSchemeGeneric} {
+		if policy.String() == name {
+			return policy, true
+		}
+	}
+	return 0, false
+}
+
+// A registry with the defanged forms of the given schemes replaced.  Overrides are applied
+// in name order, so that collisions between them are reported consistently
+func overrideRegistry(overrides map[string]string) (*Registry, error) {
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	registry := NewRegistry()
+	for _, name := range names {
+		scheme, ok := registry.Lookup(name)
+		if !ok {
+			scheme = schemes.Scheme{Scheme: name}
+		} else if scheme.DefangedScheme == overrides[name] {
+			// Overriding a scheme with its registered form changes nothing, and registering
+			// it again would reject the generated data's edge cases (http[s] defangs into the
+			// registered hxxp[s]; see schemes.EdgeCases)
+			continue
+		}
+		scheme.DefangedScheme = overrides[name]
+		if _, err := registry.register(scheme, true); err != nil {
+			return nil, fmt.Errorf("%w: override of %q: %v", defang.ErrInvalidPolicy, name, err)
+		}
+	}
+	return registry, nil
+}
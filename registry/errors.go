@@ -0,0 +1,11 @@
+package registry
+
+import "errors"
+
+var ErrInvalidScheme = errors.New("invalid scheme")
+
+var ErrSchemeExists = errors.New("scheme is already registered")
+
+var ErrDefangCollision = errors.New("defanged scheme collides with a registered scheme")
+
+var ErrIANAUnavailable = errors.New("cannot fetch the IANA URI schemes registry")
@@ -0,0 +1,95 @@
+package registry
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+	"github.com/jakewilliami/defang-schemes/schemes"
+)
+
+// Merge per-scheme frequency counts (e.g., from your own telemetry) into the registry, from
+// CSV records of the form "scheme,count".  A header row is skipped, and counts for a
+// scheme are added to those already imported.  Returns the schemes that were counted but
+// are not registered, which are otherwise ignored
+func (r *Registry) ImportFrequencies(reader io.Reader) ([]string, error) {
+	records := csv.NewReader(reader)
+	records.FieldsPerRecord = 2
+	records.TrimLeadingSpace = true
+
+	counts := make(map[string]int64)
+	for line := 1; ; line++ {
+		record, err := records.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot read frequencies: %w", err)
+		}
+
+		count, err := strconv.ParseInt(strings.TrimSpace(record[1]), 10, 64)
+		if err != nil {
+			if line == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("invalid count %q for scheme %q on line %d", record[1], record[0], line)
+		}
+		if count < 0 {
+			return nil, fmt.Errorf("negative count %d for scheme %q on line %d", count, record[0], line)
+		}
+		counts[ascii.ToLower(strings.TrimSpace(record[0]))] += count
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	registered := r.data.Load().schemes
+	var unregistered []string
+	for scheme, count := range counts {
+		if _, ok := registered[scheme]; !ok {
+			unregistered = append(unregistered, scheme)
+			continue
+		}
+		r.frequencies[scheme] += count
+	}
+
+	sort.Strings(unregistered)
+	return unregistered, nil
+}
+
+// The n most frequent schemes, as imported by ImportFrequencies, in descending order of
+// frequency (then by name).  Schemes with no imported frequency are omitted; if n <= 0,
+// all schemes with a frequency are returned
+func (r *Registry) MostCommonSchemes(n int) []schemes.Scheme {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Schemes may have been removed since their frequencies were imported
+	registered := r.data.Load().schemes
+	names := make([]string, 0, len(r.frequencies))
+	for name, count := range r.frequencies {
+		if _, ok := registered[name]; ok && count > 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if r.frequencies[names[i]] != r.frequencies[names[j]] {
+			return r.frequencies[names[i]] > r.frequencies[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	if n > 0 && n < len(names) {
+		names = names[:n]
+	}
+
+	common := make([]schemes.Scheme, 0, len(names))
+	for _, name := range names {
+		common = append(common, registered[name])
+	}
+	return common
+}
@@ -0,0 +1,35 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jakewilliami/defang-schemes/internal/ondemand"
+	"github.com/jakewilliami/defang-schemes/schemes"
+)
+
+// Fetch the dataset from the IANA registry, for builds without generated data.  This is a
+// no-op if the data has been generated, or already fetched.  It must be called before the
+// dataset is first used, as the patterns, indexes, and defanged forms derived from it are
+// computed once.  If IANA cannot be reached in time, or its response cannot be read,
+// ErrIANAUnavailable is returned
+func GenerateData(ctx context.Context, opts ...IANAOption) error {
+	if schemes.DataGenerated() == nil {
+		return nil
+	}
+
+	cfg := ianaConfig{url: IANA_SCHEMES_CSV_URL, client: http.DefaultClient, timeout: IANA_FETCH_TIMEOUT}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	ctx, cancel := context.WithTimeout(ctx, cfg.timeout)
+	defer cancel()
+
+	fetched, _, err := fetchIANASchemes(ctx, cfg, "")
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrIANAUnavailable, err)
+	}
+	ondemand.Schemes.CompareAndSwap(nil, fetched)
+	return nil
+}
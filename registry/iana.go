@@ -0,0 +1,283 @@
+package registry
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jakewilliami/defang-schemes/defang"
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+	"github.com/jakewilliami/defang-schemes/schemes"
+)
+
+// IANA's CSV export of the URI Schemes registry, as fetched by Registry.UpdateFromIANA
+const IANA_SCHEMES_CSV_URL = "https://www.iana.org/assignments/uri-schemes/uri-schemes-1.csv"
+
+// How long UpdateFromIANA waits for IANA, unless given WithIANATimeout
+const IANA_FETCH_TIMEOUT = 30 * time.Second
+
+// Columns that IANA's CSV export must have
+var IANA_REQUIRED_COLUMNS = []string{"URI Scheme", "Description", "Status"}
+
+// Refuse a registry with fewer schemes than this fraction of the generated data; IANA
+// rarely removes schemes, so a large drop means the registry was misread
+const IANA_MIN_SCHEME_FRACTION = 0.9
+
+// Responses larger than this are not IANA's registry (which is tens of kilobytes)
+const IANA_MAX_RESPONSE_SIZE = 16 << 20
+
+// The changes made by Registry.UpdateFromIANA
+type IANAUpdate struct {
+	// Schemes not previously in the registry
+	Added []string
+	// Registered schemes whose entries changed.  Their defanged forms are kept, so that
+	// text defanged before the update still refangs
+	Changed []string
+	// IANA reported (by ETag) that its registry has not changed since the last update
+	NotModified bool
+}
+
+// Option to configure Registry.UpdateFromIANA
+type IANAOption func(*ianaConfig)
+
+type ianaConfig struct {
+	url     string
+	client  *http.Client
+	timeout time.Duration
+}
+
+// Fetch the registry from a mirror of IANA's CSV export, rather than IANA_SCHEMES_CSV_URL
+func WithIANAURL(url string) IANAOption {
+	return func(c *ianaConfig) {
+		c.url = url
+	}
+}
+
+// Fetch the registry with the given client, rather than http.DefaultClient
+func WithHTTPClient(client *http.Client) IANAOption {
+	return func(c *ianaConfig) {
+		c.client = client
+	}
+}
+
+// Give up on IANA after the given duration, rather than IANA_FETCH_TIMEOUT
+func WithIANATimeout(timeout time.Duration) IANAOption {
+	return func(c *ianaConfig) {
+		c.timeout = timeout
+	}
+}
+
+// Fetch the current IANA registry and merge it into this registry, so that long-running
+// services pick up schemes registered since the library was released.  New schemes are
+// registered as by Register (so the registry's CollisionPolicy applies), and changed
+// entries replace the registered ones, keeping their defanged forms.  Schemes that IANA
+// no longer lists, including custom schemes, are kept.
+//
+// The registry's ETag is remembered, so later updates are cheap when nothing has changed.
+// If IANA cannot be reached in time, or its response cannot be read, ErrIANAUnavailable is
+// returned and the registry is left as it was (by default, with the embedded data)
+func (r *Registry) UpdateFromIANA(ctx context.Context, opts ...IANAOption) (IANAUpdate, error) {
+	cfg := ianaConfig{url: IANA_SCHEMES_CSV_URL, client: http.DefaultClient, timeout: IANA_FETCH_TIMEOUT}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	ctx, cancel := context.WithTimeout(ctx, cfg.timeout)
+	defer cancel()
+
+	r.mu.Lock()
+	etag := r.ianaETag
+	r.mu.Unlock()
+
+	fetched, etag, err := fetchIANASchemes(ctx, cfg, etag)
+	if err != nil {
+		return IANAUpdate{}, fmt.Errorf("%w: %v", ErrIANAUnavailable, err)
+	}
+	if fetched == nil {
+		return IANAUpdate{NotModified: true}, nil
+	}
+
+	update, err := r.merge(fetched)
+	if err == nil {
+		// Only skip the next fetch once every scheme has been merged
+		r.mu.Lock()
+		r.ianaETag = etag
+		r.mu.Unlock()
+	}
+	return update, err
+}
+
+// Merge fetched schemes into the registry, publishing the changes at once.  Schemes that
+// cannot be registered are skipped, and their errors returned together
+func (r *Registry) merge(fetched map[string]schemes.Scheme) (IANAUpdate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var update IANAUpdate
+	var errs []error
+	data := r.data.Load().clone()
+	for _, name := range slices.Sorted(maps.Keys(fetched)) {
+		scheme := generatedFields(fetched[name])
+		existing, exists := data.schemes[name]
+		if exists {
+			scheme.DefangedScheme = existing.DefangedScheme
+			if scheme.Equal(existing) {
+				continue
+			}
+		}
+
+		scheme, err := normaliseScheme(scheme)
+		if err == nil {
+			if exists {
+				// The defanged form is kept, so any collision it has (such as http's with
+				// hxxp in the generated data) was accepted when it was registered
+				data.remove(existing)
+				data.insert(scheme)
+			} else {
+				_, err = r.place(data, scheme)
+			}
+		}
+		switch {
+		case err != nil:
+			errs = append(errs, err)
+		case exists:
+			update.Changed = append(update.Changed, name)
+		default:
+			update.Added = append(update.Added, name)
+		}
+	}
+	r.data.Store(data)
+	return update, errors.Join(errs...)
+}
+
+// Fetch and parse IANA's CSV export.  Returns nil schemes (and the same ETag) if the
+// registry has not changed since the given ETag
+func fetchIANASchemes(ctx context.Context, cfg ianaConfig, etag string) (map[string]schemes.Scheme, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cfg.url, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	resp, err := cfg.client.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNotModified:
+		return nil, etag, nil
+	case http.StatusOK:
+	default:
+		return nil, "", fmt.Errorf("unexpected response from %s: %s", cfg.url, resp.Status)
+	}
+
+	parsed, err := parseIANASchemes(io.LimitReader(resp.Body, IANA_MAX_RESPONSE_SIZE))
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot read registry from %s: %w", cfg.url, err)
+	}
+	return parsed, resp.Header.Get("ETag"), nil
+}
+
+// IANA annotates some scheme names ("shttp (OBSOLETE)")
+var ianaSchemePatternOnce = sync.OnceValue(func() *regexp.Regexp {
+	return regexp.MustCompile(fmt.Sprintf(`^(%s)(?:\s+\((.*)\))?$`, schemes.SchemeNamePattern()))
+})
+
+// Parse IANA's CSV export into schemes, defanged one-to-one and related, as the
+// generated data is
+func parseIANASchemes(r io.Reader) (map[string]schemes.Scheme, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("registry is empty")
+	}
+
+	columns := make(map[string]int)
+	for i, header := range records[0] {
+		columns[strings.TrimSpace(header)] = i
+	}
+	for _, column := range IANA_REQUIRED_COLUMNS {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("%w: %q", schemes.ErrUnknownColumn, column)
+		}
+	}
+	field := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) || strings.TrimSpace(record[i]) == "-" {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	parsed := make(map[string]schemes.Scheme, len(records)-1)
+	for _, record := range records[1:] {
+		matches := ianaSchemePatternOnce().FindStringSubmatch(field(record, "URI Scheme"))
+		if matches == nil {
+			return nil, fmt.Errorf("invalid scheme %q", field(record, "URI Scheme"))
+		}
+		scheme := schemes.Scheme{
+			Scheme:              ascii.ToLower(matches[1]),
+			Template:            schemes.ResolveTemplate(field(record, "Template")),
+			Description:         field(record, "Description"),
+			Status:              schemes.Status(field(record, "Status")),
+			WellKnownUriSupport: field(record, "Well-Known URI Support"),
+			Reference:           field(record, "Reference"),
+			Notes:               field(record, "Notes"),
+		}
+		if annotation := strings.TrimSpace(matches[2]); ascii.EqualFold(annotation, "OBSOLETE") {
+			scheme.Obsolete = true
+		} else if annotation != "" {
+			scheme.Notes = annotation
+		}
+		scheme.DefangedScheme = defang.DefangScheme(scheme.Scheme)
+		if err := scheme.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid scheme %q: %w", scheme.Scheme, err)
+		}
+		parsed[scheme.Scheme] = scheme
+	}
+
+	var generated int
+	if schemes.DataGenerated() == nil {
+		generated = len(schemes.Schemes())
+	}
+	if float64(len(parsed)) < IANA_MIN_SCHEME_FRACTION*float64(generated) {
+		return nil, fmt.Errorf("registry has only %d schemes, but the generated data has %d", len(parsed), generated)
+	}
+
+	defanged, unresolved := defang.DefangOneToOne(parsed)
+	if len(unresolved) > 0 {
+		return nil, fmt.Errorf("no collision-free defanged form for schemes: %s", strings.Join(unresolved, ", "))
+	}
+	related := schemes.RelateSchemes(parsed)
+	for name, scheme := range parsed {
+		scheme.DefangedScheme = defanged[name]
+		scheme.Related = related[name]
+		scheme.Examples = schemes.SchemeExamples(name)
+		parsed[name] = scheme
+	}
+	return parsed, nil
+}
+
+// Schemes from elsewhere keep only the fields of the generated data, so that they compare
+// equal to it in minimal builds (see schemes.MINIMAL_DATA)
+func generatedFields(scheme schemes.Scheme) schemes.Scheme {
+	if !schemes.MINIMAL_DATA {
+		return scheme
+	}
+	return schemes.Scheme{Scheme: scheme.Scheme, DefangedScheme: scheme.DefangedScheme, Status: scheme.Status}
+}
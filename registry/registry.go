@@ -0,0 +1,391 @@
+// Mutable sets of schemes, for custom schemes, schemes registered since the library was
+// released (see Registry.UpdateFromIANA), and overrides of their defanged forms.  A Registry
+// starts from the dataset of the schemes package; defang against it with
+// defang.WithRegistry
+package registry
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/jakewilliami/defang-schemes/defang"
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+	"github.com/jakewilliami/defang-schemes/schemes"
+)
+
+// What Registry.Register does when a scheme's defanged form collides with a registered
+// scheme, or with the defanged form of one.  These are the invariants checked over the
+// generated data by tools/defangcheck
+type CollisionPolicy int
+
+const (
+	// Refuse to register the scheme, returning a *DefangCollisionError
+	CollisionReject CollisionPolicy = iota
+	// Take the first of the scheme's defang.AlternativeDefangs that does not collide (or,
+	// if the scheme's name is the defanged form of a registered scheme, give that scheme
+	// the first of its alternatives instead)
+	CollisionAutoAdjust
+	// Register the scheme anyway, reporting the collision to the warning handler
+	CollisionWarn
+)
+
+func (p CollisionPolicy) String() string {
+	switch p {
+	case CollisionReject:
+		return "reject"
+	case CollisionAutoAdjust:
+		return "auto-adjust"
+	case CollisionWarn:
+		return "warn"
+	default:
+		return fmt.Sprintf("CollisionPolicy(%d)", int(p))
+	}
+}
+
+// The defanged form of a scheme is either itself a registered scheme (so defanging would
+// not malform the URI), or is the defanged form of another scheme (so refanging would be
+// ambiguous)
+type DefangCollisionError struct {
+	Scheme   string
+	Defanged string
+	// The registered scheme collided with
+	Conflict string
+	// Whether Defanged is the Conflict scheme itself, rather than its defanged form
+	IsScheme bool
+	// Whether Scheme itself, rather than Defanged, is the defanged form of Conflict (so
+	// refanging it would give Conflict)
+	IsDefangedForm bool
+}
+
+func (e *DefangCollisionError) Error() string {
+	if e.IsDefangedForm {
+		return fmt.Sprintf("scheme %q is the defanged form of %q", e.Scheme, e.Conflict)
+	}
+	if e.IsScheme {
+		return fmt.Sprintf("defanged scheme %q of %q is itself a registered scheme", e.Defanged, e.Scheme)
+	}
+	return fmt.Sprintf("defanged scheme %q of %q is also the defanged form of %q", e.Defanged, e.Scheme, e.Conflict)
+}
+
+func (e *DefangCollisionError) Is(target error) bool {
+	return target == ErrDefangCollision
+}
+
+// A set of schemes, seeded from the generated data, that custom schemes can be added to at
+// runtime.  Safe for concurrent use: the schemes are copied on write, so readers (Lookup,
+// Defang, and Refang) never wait for a lock, however many goroutines share the registry.
+// Each change copies the registry, so changes are relatively expensive (see
+// tools/registrybench), which suits registries that are set up once and then read
+type Registry struct {
+	// Serialises writers; readers load the current data without it
+	mu   sync.Mutex
+	data atomic.Pointer[registryData]
+	// Scheme → frequency, as imported by ImportFrequencies
+	frequencies map[string]int64
+
+	policy   CollisionPolicy
+	warnings func(Warning)
+	// The schemes the registry was created with, against which SaveTo finds its changes
+	seed map[string]schemes.Scheme
+	// ETag of the IANA registry as of the last UpdateFromIANA, guarded by mu
+	ianaETag string
+}
+
+// Option to configure a Registry
+type RegistryOption func(*Registry)
+
+// Choose what happens when a registered scheme's defanged form collides (default:
+// CollisionReject)
+func WithCollisionPolicy(policy CollisionPolicy) RegistryOption {
+	return func(r *Registry) {
+		r.policy = policy
+	}
+}
+
+// Handle warnings, such as collisions accepted under CollisionWarn (default: discard them)
+func WithWarningHandler(handle func(Warning)) RegistryOption {
+	return func(r *Registry) {
+		r.warnings = handle
+	}
+}
+
+// Handle collisions accepted under CollisionWarn; see WithWarningHandler
+func WithCollisionWarning(warn func(error)) RegistryOption {
+	return WithWarningHandler(func(w Warning) {
+		if w.Kind == WarningDefangCollision {
+			warn(w.Err)
+		}
+	})
+}
+
+// Seed the registry with the given schemes, such as a snapshot, rather than the generated
+// data.  The schemes are taken as given, without checking for collisions
+func WithSchemes(seed map[string]schemes.Scheme) RegistryOption {
+	return func(r *Registry) {
+		r.seed = maps.Clone(seed)
+	}
+}
+
+// Create a registry containing the generated schemes (or those given by WithSchemes)
+func NewRegistry(opts ...RegistryOption) *Registry {
+	r := &Registry{frequencies: make(map[string]int64)}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if r.seed == nil {
+		r.seed = schemes.Schemes()
+	}
+	data := &registryData{
+		schemes:  make(map[string]schemes.Scheme, len(r.seed)),
+		defanged: make(map[string]string, len(r.seed)),
+	}
+
+	// Insert in name order, so that the generated data's known collisions (hxxp[s]) always
+	// resolve to the same scheme
+	for _, name := range slices.Sorted(maps.Keys(r.seed)) {
+		data.insert(r.seed[name])
+	}
+	r.data.Store(data)
+
+	return r
+}
+
+// The schemes of a registry at one point in time, which are not modified once published
+type registryData struct {
+	schemes map[string]schemes.Scheme
+	// Defanged form → scheme
+	defanged map[string]string
+}
+
+// A copy of the data, to modify and then publish
+func (d *registryData) clone() *registryData {
+	return &registryData{schemes: maps.Clone(d.schemes), defanged: maps.Clone(d.defanged)}
+}
+
+func (d *registryData) insert(scheme schemes.Scheme) {
+	d.schemes[scheme.Scheme] = scheme
+	if _, exists := d.defanged[scheme.DefangedScheme]; !exists {
+		d.defanged[scheme.DefangedScheme] = scheme.Scheme
+	}
+}
+
+// Look up a scheme in the registry, as per Lookup
+func (r *Registry) Lookup(scheme string) (schemes.Scheme, bool) {
+	known, ok := r.data.Load().schemes[ascii.ToLower(strings.TrimSpace(scheme))]
+	return known, ok
+}
+
+// Defang a scheme against this registry, as a defang.Defanger with its zero configuration
+// would
+func (r *Registry) Defang(scheme string) (string, error) {
+	return defang.NewDefanger(defang.WithRegistry(r)).Defang(scheme)
+}
+
+// Refang a defanged scheme against this registry, as a defang.Defanger with its zero
+// configuration would
+func (r *Registry) Refang(defanged string) (string, error) {
+	return defang.NewDefanger(defang.WithRegistry(r)).Refang(defanged)
+}
+
+// A copy of the registered schemes, by name
+func (r *Registry) Schemes() map[string]schemes.Scheme {
+	return maps.Clone(r.data.Load().schemes)
+}
+
+// The registered scheme with the given defanged form, case-insensitively.  The second
+// return value is false if no scheme defangs as given
+func (r *Registry) LookupDefanged(defanged string) (schemes.Scheme, bool) {
+	data := r.data.Load()
+	scheme, ok := data.defanged[ascii.ToLower(strings.TrimSpace(defanged))]
+	if !ok {
+		return schemes.Scheme{}, false
+	}
+	return data.schemes[scheme], true
+}
+
+// Add a custom scheme to the registry, returning the scheme as registered.  If the
+// DefangedScheme field is empty, it is computed with defang.DefangScheme; if the Status
+// field is empty, the scheme is registered as schemes.Provisional.  A defanged form that
+// collides with the registry, or a name that is the defanged form of a registered scheme,
+// is handled according to the registry's CollisionPolicy
+func (r *Registry) Register(scheme schemes.Scheme) (schemes.Scheme, error) {
+	return r.register(scheme, false)
+}
+
+// Register a scheme, replacing any registered scheme of the same name (such as a generated
+// scheme whose defanged form an organisation writes differently).  Otherwise as Register
+func (r *Registry) Override(scheme schemes.Scheme) (schemes.Scheme, error) {
+	return r.register(scheme, true)
+}
+
+// Remove a scheme from the registry, so that it is defanged and refanged as an unknown
+// scheme.  Returns false if the scheme was not registered
+func (r *Registry) Remove(scheme string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.data.Load().schemes[ascii.ToLower(strings.TrimSpace(scheme))]
+	if ok {
+		data := r.data.Load().clone()
+		data.remove(existing)
+		r.data.Store(data)
+	}
+	return ok
+}
+
+// As Register, but if replace is set, a registered scheme of the same name is replaced
+// (with its defanged form no longer counting as a collision) rather than rejected
+func (r *Registry) register(scheme schemes.Scheme, replace bool) (schemes.Scheme, error) {
+	scheme, err := normaliseScheme(scheme)
+	if err != nil {
+		return schemes.Scheme{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Changes are made to a copy, which is only published if the scheme is registered
+	data := r.data.Load()
+	if _, exists := data.schemes[scheme.Scheme]; exists && !replace {
+		return schemes.Scheme{}, fmt.Errorf("%w: %q", ErrSchemeExists, scheme.Scheme)
+	}
+	data = data.clone()
+	scheme, err = r.place(data, scheme)
+	if err != nil {
+		return schemes.Scheme{}, err
+	}
+	r.data.Store(data)
+	return scheme, nil
+}
+
+// Fill in the defaults of a scheme to be registered, and validate it
+func normaliseScheme(scheme schemes.Scheme) (schemes.Scheme, error) {
+	scheme.Scheme = ascii.ToLower(strings.TrimSpace(scheme.Scheme))
+	if !schemes.IsValidScheme(scheme.Scheme) {
+		return schemes.Scheme{}, fmt.Errorf("%w: %q", ErrInvalidScheme, scheme.Scheme)
+	}
+	if scheme.DefangedScheme == "" {
+		scheme.DefangedScheme = defang.DefangScheme(scheme.Scheme)
+	}
+	if scheme.Status == "" {
+		scheme.Status = schemes.Provisional
+	}
+	if err := scheme.Validate(); err != nil {
+		return schemes.Scheme{}, fmt.Errorf("%w: %v", ErrInvalidScheme, err)
+	}
+	return scheme, nil
+}
+
+// Insert a normalised scheme into unpublished data, replacing any scheme of the same name
+// and handling collisions according to the registry's CollisionPolicy.  The caller holds
+// r.mu
+func (r *Registry) place(data *registryData, scheme schemes.Scheme) (schemes.Scheme, error) {
+	existing, replacing := data.schemes[scheme.Scheme]
+	if replacing {
+		data.remove(existing)
+	}
+
+	// A new scheme must not be the defanged form of a registered scheme, or refanging that
+	// form would give the wrong scheme.  The name of a replaced scheme was accepted when it
+	// was registered (as was hxxp, the defanged form of http in the generated data)
+	var displaced string
+	if owner, exists := data.defanged[scheme.Scheme]; exists && !replacing {
+		err := &DefangCollisionError{Scheme: scheme.Scheme, Defanged: scheme.DefangedScheme, Conflict: owner, IsDefangedForm: true}
+		switch r.policy {
+		case CollisionAutoAdjust:
+			// The scheme's name cannot be adjusted, so the registered scheme takes an
+			// alternative defanged form instead, once the scheme is inserted
+			displaced = owner
+		case CollisionWarn:
+			r.warn(err)
+		default:
+			return schemes.Scheme{}, err
+		}
+	}
+
+	if err := data.collision(scheme.Scheme, scheme.DefangedScheme); err != nil {
+		switch r.policy {
+		case CollisionAutoAdjust:
+			adjusted, ok := data.adjust(scheme.Scheme)
+			if !ok {
+				return schemes.Scheme{}, err
+			}
+			scheme.DefangedScheme = adjusted
+		case CollisionWarn:
+			r.warn(err)
+		default:
+			return schemes.Scheme{}, err
+		}
+	}
+
+	data.insert(scheme)
+
+	if displaced != "" {
+		owner := data.schemes[displaced]
+		data.remove(owner)
+		adjusted, ok := data.adjust(owner.Scheme)
+		if !ok {
+			return schemes.Scheme{}, &DefangCollisionError{Scheme: scheme.Scheme, Defanged: scheme.DefangedScheme, Conflict: owner.Scheme, IsDefangedForm: true}
+		}
+		owner.DefangedScheme = adjusted
+		data.insert(owner)
+	}
+	return scheme, nil
+}
+
+// Report a collision accepted under CollisionWarn to the warning handler
+func (r *Registry) warn(err *DefangCollisionError) {
+	if r.warnings == nil {
+		return
+	}
+	r.warnings(Warning{
+		Kind:     WarningDefangCollision,
+		Scheme:   err.Scheme,
+		Defanged: err.Defanged,
+		Message:  err.Error(),
+		Err:      err,
+	})
+}
+
+func (d *registryData) remove(scheme schemes.Scheme) {
+	delete(d.schemes, scheme.Scheme)
+	if d.defanged[scheme.DefangedScheme] != scheme.Scheme {
+		return
+	}
+	delete(d.defanged, scheme.DefangedScheme)
+
+	// Hand the defanged form to the next scheme (in name order) sharing it, if any, as if
+	// the removed scheme had never been inserted
+	for name, other := range d.schemes {
+		if other.DefangedScheme != scheme.DefangedScheme {
+			continue
+		}
+		if current, exists := d.defanged[other.DefangedScheme]; !exists || name < current {
+			d.defanged[other.DefangedScheme] = name
+		}
+	}
+}
+
+// Check a defanged form against the registry, mirroring tools/defangcheck
+func (d *registryData) collision(scheme, defanged string) *DefangCollisionError {
+	if _, exists := d.schemes[defanged]; exists || defanged == scheme {
+		return &DefangCollisionError{Scheme: scheme, Defanged: defanged, Conflict: defanged, IsScheme: true}
+	}
+	if conflict, exists := d.defanged[defanged]; exists {
+		return &DefangCollisionError{Scheme: scheme, Defanged: defanged, Conflict: conflict}
+	}
+	return nil
+}
+
+// The first alternative defanged form of the scheme that does not collide
+func (d *registryData) adjust(scheme string) (string, bool) {
+	for _, alternative := range defang.AlternativeDefangs(scheme) {
+		if d.collision(scheme, alternative) == nil {
+			return alternative, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,128 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/jakewilliami/defang-schemes/schemes"
+)
+
+// A backend to which a Registry's curated changes can be persisted, so that they can be
+// shared between services (see Registry.SaveTo and Registry.LoadFrom).  FileStore stores
+// them as a JSON dataset; databases (SQLite, Redis, and so on) can be supported by
+// implementing this interface
+type RegistryStore interface {
+	// The stored changes.  A store that has never been saved to holds none
+	Load() (RegistryChanges, error)
+	// Replace the stored changes
+	Save(changes RegistryChanges) error
+}
+
+// The changes a registry has made to the schemes it was created with
+type RegistryChanges struct {
+	// Custom schemes, and schemes whose entries differ from those the registry was created
+	// with, by name
+	Schemes map[string]schemes.Scheme
+	// Schemes the registry was created with that have since been removed (tombstones)
+	Removed []string
+}
+
+// A RegistryStore backed by a JSON dataset file, as written by schemes.WriteJSON, listing
+// removed schemes under "removed"
+type FileStore struct {
+	path string
+}
+
+// Create a FileStore at the given path.  The file need not exist until saved to
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+func (s *FileStore) Load() (RegistryChanges, error) {
+	file, err := os.Open(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return RegistryChanges{Schemes: map[string]schemes.Scheme{}}, nil
+	}
+	if err != nil {
+		return RegistryChanges{}, err
+	}
+	defer file.Close()
+	loaded, removed, err := schemes.LoadDataset(file)
+	return RegistryChanges{Schemes: loaded, Removed: removed}, err
+}
+
+// Write the schemes to a temporary file alongside the store, and rename it into place, so
+// that readers never see a partially written store
+func (s *FileStore) Save(changes RegistryChanges) error {
+	file, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if err := schemes.WriteDataset(file, changes.Schemes, changes.Removed); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), s.path)
+}
+
+// Persist the registry's curated changes to the store: custom schemes, schemes whose
+// entries differ from those the registry was created with (the generated data, or those
+// given by WithSchemes), and the names of any such schemes since removed
+func (r *Registry) SaveTo(store RegistryStore) error {
+	registered := r.data.Load().schemes
+
+	changes := RegistryChanges{Schemes: make(map[string]schemes.Scheme)}
+	for name, scheme := range registered {
+		if original, ok := r.seed[name]; !ok || !scheme.Equal(original) {
+			changes.Schemes[name] = scheme
+		}
+	}
+	for name := range r.seed {
+		if _, ok := registered[name]; !ok {
+			changes.Removed = append(changes.Removed, name)
+		}
+	}
+	sort.Strings(changes.Removed)
+
+	return store.Save(changes)
+}
+
+// Apply the changes persisted in the store to the registry.  Removed schemes are removed
+// first, as by Remove; then each stored scheme is registered as by Register, except that a
+// registered scheme of the same name is replaced by the stored entry.  Schemes that cannot
+// be registered (for example, because of a defang collision) are skipped, and their errors
+// returned together
+func (r *Registry) LoadFrom(store RegistryStore) error {
+	changes, err := store.Load()
+	if err != nil {
+		return fmt.Errorf("cannot load registry: %w", err)
+	}
+
+	for _, name := range changes.Removed {
+		r.Remove(name)
+	}
+
+	// Register in name order, so that collisions resolve the same way on every load
+	names := make([]string, 0, len(changes.Schemes))
+	for name := range changes.Schemes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		if _, err := r.register(changes.Schemes[name], true); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,68 @@
+package registry
+
+import (
+	"errors"
+
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+	"github.com/jakewilliami/defang-schemes/internal/invariants"
+	"github.com/jakewilliami/defang-schemes/schemes"
+)
+
+// Configuration for Registry.Validate
+type validateConfig struct {
+	allowed func(scheme string) bool
+}
+
+// Option to configure Registry.Validate
+type ValidateOption func(*validateConfig)
+
+// Accept collisions between the given schemes, rather than the edge cases of the
+// generated data (see schemes.IsEdgeCase).  A collision is accepted only if every scheme
+// involved is allowed, so that, for example, allowing http and hxxp accepts http
+// defanging into hxxp.  Call with no schemes to accept no collisions
+func WithAllowedCollisions(schemes ...string) ValidateOption {
+	allowed := make(map[string]bool, len(schemes))
+	for _, scheme := range schemes {
+		allowed[ascii.ToLower(scheme)] = true
+	}
+	return func(cfg *validateConfig) {
+		cfg.allowed = func(scheme string) bool {
+			return allowed[scheme]
+		}
+	}
+}
+
+// Check the registry's defanged forms against the invariants that tools/defangcheck (and
+// the check package) enforce over the generated data: no defanged form is itself a registered scheme, and no
+// two schemes share a defanged form.  Returns every collision that is not allowed (see
+// WithAllowedCollisions) as a *DefangCollisionError, joined; allowed collisions are passed
+// to the registry's warning handler
+func (r *Registry) Validate(opts ...ValidateOption) error {
+	cfg := &validateConfig{allowed: schemes.IsEdgeCase}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	data := r.data.Load()
+	entries := make([]invariants.Entry, 0, len(data.schemes))
+	for name, scheme := range data.schemes {
+		entries = append(entries, invariants.Entry{Scheme: name, Defanged: scheme.DefangedScheme})
+	}
+
+	var errs []error
+	for _, violation := range invariants.Violations(entries, cfg.allowed) {
+		err := &DefangCollisionError{Scheme: violation.Scheme, Defanged: violation.Defanged, Conflict: violation.Conflicts[0]}
+		kind := WarningDefangAmbiguous
+		if violation.Invariant == invariants.DefangedIsNotScheme {
+			err.IsScheme = true
+			kind = WarningDefangedIsScheme
+		}
+		switch {
+		case !violation.Allowed:
+			errs = append(errs, err)
+		case r.warnings != nil:
+			r.warnings(Warning{Kind: kind, Scheme: err.Scheme, Defanged: err.Defanged, Message: err.Error(), Err: err})
+		}
+	}
+	return errors.Join(errs...)
+}
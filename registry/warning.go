@@ -0,0 +1,48 @@
+package registry
+
+import "fmt"
+
+// The kind of a Warning
+type WarningKind int
+
+const (
+	// A scheme defangs into a registered scheme, so the defanged URI is still well-formed
+	// (such as http into hxxp)
+	WarningDefangedIsScheme WarningKind = iota
+	// More than one scheme defangs into the same form, so refanging it is ambiguous
+	WarningDefangAmbiguous
+	// A scheme was registered despite its defanged form colliding, under CollisionWarn
+	WarningDefangCollision
+)
+
+func (k WarningKind) String() string {
+	switch k {
+	case WarningDefangedIsScheme:
+		return "defanged-is-scheme"
+	case WarningDefangAmbiguous:
+		return "defang-ambiguous"
+	case WarningDefangCollision:
+		return "defang-collision"
+	default:
+		return fmt.Sprintf("WarningKind(%d)", int(k))
+	}
+}
+
+// A condition worth reporting that does not stop an operation.  The library never prints
+// warnings itself; they are returned, or passed to a handler supplied by the caller
+type Warning struct {
+	Kind     WarningKind
+	Scheme   string
+	Defanged string
+	Message  string
+	// The underlying error, if any (e.g. a *DefangCollisionError)
+	Err error
+}
+
+func (w Warning) String() string {
+	return fmt.Sprintf("%s: %s", w.Kind, w.Message)
+}
+
+func (w Warning) Unwrap() error {
+	return w.Err
+}
@@ -0,0 +1,47 @@
+package defang_schemes_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+// A scheme whose name is the defanged form of a registered scheme is handled by the
+// registry's CollisionPolicy, rather than registered with that form still refanging to the
+// other scheme
+func TestRegisterDefangedName(t *testing.T) {
+	registry := defang_schemes.NewRegistry()
+	if _, err := registry.Register(defang_schemes.Scheme{Scheme: "foo", DefangedScheme: "barz"}); err != nil {
+		t.Fatalf("Register(foo): %v", err)
+	}
+	if _, err := registry.Register(defang_schemes.Scheme{Scheme: "barz"}); !errors.Is(err, defang_schemes.ErrDefangCollision) {
+		t.Errorf("Register(barz), the defanged form of foo: error = %v, want ErrDefangCollision", err)
+	}
+}
+
+func TestRegisterDefangedNameAutoAdjust(t *testing.T) {
+	registry := defang_schemes.NewRegistry(defang_schemes.WithCollisionPolicy(defang_schemes.CollisionAutoAdjust))
+	if _, err := registry.Register(defang_schemes.Scheme{Scheme: "foo", DefangedScheme: "barz"}); err != nil {
+		t.Fatalf("Register(foo): %v", err)
+	}
+	if _, err := registry.Register(defang_schemes.Scheme{Scheme: "barz"}); err != nil {
+		t.Fatalf("Register(barz) with CollisionAutoAdjust: %v", err)
+	}
+	foo, _ := registry.Lookup("foo")
+	if foo.DefangedScheme == "barz" {
+		t.Errorf("foo kept the defanged form %q after barz was registered", foo.DefangedScheme)
+	}
+	if refanged, err := registry.Refang(foo.DefangedScheme); err != nil || refanged != "foo" {
+		t.Errorf("Refang(%q) = %q, %v, want %q", foo.DefangedScheme, refanged, err, "foo")
+	}
+}
+
+// The generated data's edge cases were accepted when generated, so may be overridden
+func TestOverrideEdgeCase(t *testing.T) {
+	hxxp, _ := defang_schemes.Lookup("hxxp")
+	hxxp.Notes = "Overridden"
+	if _, err := defang_schemes.NewRegistry().Override(hxxp); err != nil {
+		t.Errorf("Override(hxxp), the defanged form of http: %v", err)
+	}
+}
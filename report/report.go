@@ -0,0 +1,118 @@
+// Render extracted IOCs as a defanged appendix for a report
+//
+// Given IOCs found in a document, this package defangs them, groups them by type, and
+// renders them through a Markdown or HTML template.  The default templates produce one
+// table per type; custom templates receive the same Appendix value.
+package report
+
+import (
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+// The kind of an IOC
+type Type string
+
+const (
+	URL    Type = "URL"
+	Domain Type = "Domain"
+	IP     Type = "IP"
+)
+
+// Order in which groups appear in an appendix
+var TYPE_ORDER = []Type{URL, Domain, IP}
+
+// An indicator of compromise, as found by an extractor.  Value is the fanged (live) form
+type IOC struct {
+	Type  Type
+	Value string
+}
+
+// Defanged form of the IOC.  URLs are defanged with DefangURL, and IPs with DefangIP,
+// falling back to bracketing the dots if they do not parse
+func (i IOC) Defanged() string {
+	switch i.Type {
+	case URL:
+		defanged, err := defang_schemes.DefangURL(i.Value)
+		if err == nil {
+			return defanged
+		}
+	case IP:
+		defanged, err := defang_schemes.DefangIP(i.Value)
+		if err == nil {
+			return defanged
+		}
+	}
+	return strings.ReplaceAll(i.Value, ".", defang_schemes.DEFANGED_DOT)
+}
+
+// IOCs of one type, sorted by value
+type Group struct {
+	Type Type
+	IOCs []IOC
+}
+
+// The data passed to a template
+type Appendix struct {
+	Title  string
+	Groups []Group
+}
+
+// Group IOCs by type, in TYPE_ORDER, followed by any other types in name order.  Duplicate
+// IOCs are dropped and empty groups are omitted
+func NewAppendix(title string, iocs []IOC) Appendix {
+	byType := make(map[Type][]IOC)
+	seen := make(map[IOC]bool)
+	for _, ioc := range iocs {
+		if seen[ioc] {
+			continue
+		}
+		seen[ioc] = true
+		byType[ioc.Type] = append(byType[ioc.Type], ioc)
+	}
+
+	types := append([]Type{}, TYPE_ORDER...)
+	var others []Type
+	for t := range byType {
+		if !isOrderedType(t) {
+			others = append(others, t)
+		}
+	}
+	sort.Slice(others, func(i, j int) bool { return others[i] < others[j] })
+	types = append(types, others...)
+
+	appendix := Appendix{Title: title}
+	for _, t := range types {
+		group := byType[t]
+		if len(group) == 0 {
+			continue
+		}
+		sort.Slice(group, func(i, j int) bool { return group[i].Value < group[j].Value })
+		appendix.Groups = append(appendix.Groups, Group{Type: t, IOCs: group})
+	}
+
+	return appendix
+}
+
+func isOrderedType(t Type) bool {
+	for _, ordered := range TYPE_ORDER {
+		if t == ordered {
+			return true
+		}
+	}
+	return false
+}
+
+// Satisfied by both text/template and html/template templates
+type Template interface {
+	Execute(w io.Writer, data any) error
+}
+
+// Render the appendix through a custom template.  Templates that use the helper
+// functions of the default templates should be created with Funcs(TEMPLATE_FUNCS)
+func Render(w io.Writer, tmpl Template, appendix Appendix) error {
+	return tmpl.Execute(w, appendix)
+}
@@ -0,0 +1,53 @@
+package report
+
+import (
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	texttemplate "text/template"
+)
+
+// Helper functions available to the default templates
+var TEMPLATE_FUNCS = map[string]any{
+	"cell": markdownCell,
+}
+
+// Pipes delimit Markdown table cells and backticks delimit the code span that each IOC
+// is written in
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(s, "`", "'")
+}
+
+const MARKDOWN_TEMPLATE = `{{with .Title}}# {{.}}
+
+{{end}}{{range .Groups}}## {{.Type}}
+
+| {{.Type}} |
+| --- |
+{{range .IOCs}}| ` + "`{{cell .Defanged}}`" + ` |
+{{end}}
+{{end}}`
+
+const HTML_TEMPLATE = `{{with .Title}}<h1>{{.}}</h1>
+{{end}}{{range .Groups}}<h2>{{.Type}}</h2>
+<table>
+<thead><tr><th>{{.Type}}</th></tr></thead>
+<tbody>
+{{range .IOCs}}<tr><td><code>{{.Defanged}}</code></td></tr>
+{{end}}</tbody>
+</table>
+{{end}}`
+
+var markdownTemplate = texttemplate.Must(texttemplate.New("markdown").Funcs(TEMPLATE_FUNCS).Parse(MARKDOWN_TEMPLATE))
+var htmlTemplate = htmltemplate.Must(htmltemplate.New("html").Funcs(TEMPLATE_FUNCS).Parse(HTML_TEMPLATE))
+
+// Render the appendix as Markdown tables
+func RenderMarkdown(w io.Writer, appendix Appendix) error {
+	return Render(w, markdownTemplate, appendix)
+}
+
+// Render the appendix as HTML tables.  Values are escaped by html/template
+func RenderHTML(w io.Writer, appendix Appendix) error {
+	return Render(w, htmlTemplate, appendix)
+}
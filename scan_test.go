@@ -0,0 +1,34 @@
+package defang_schemes_test
+
+import (
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+// Each tokenizer ends indicators where its format does
+func TestIndicatorScannerIn(t *testing.T) {
+	cases := []struct {
+		hint defang_schemes.TokenizerHint
+		text string
+		want []string
+	}{
+		{defang_schemes.TokenizePlain, "Beacon (to https://evil.test/x), e.g. 10.0.0.1", []string{"https://evil.test/x", "10.0.0.1"}},
+		{defang_schemes.TokenizeCSV, "1,https://x.test/a,10.0.0.1\n2,\"https://y.test/a,b\",evil.test\n", []string{"https://x.test/a", "10.0.0.1", "https://y.test/a,b", "evil.test"}},
+		{defang_schemes.TokenizeJSON, `{"u":"https:\/\/x.test\/a","v":"see\nhxxps[://]y[.]test","w":"\"evil.test\""}`, []string{`https:\/\/x.test\/a`, "hxxps[://]y[.]test", "evil.test"}},
+		{defang_schemes.TokenizeHTMLAttribute, `title="&quot;evil.test&quot;" href="https://x.test/?a=1&amp;b=2&#10;ftp://z.test"`, []string{"evil.test", "https://x.test/?a=1&amp;b=2", "ftp://z.test"}},
+	}
+	for _, c := range cases {
+		// One byte at a time, so that every delimiter is split across reads
+		scanner := defang_schemes.NewIndicatorScannerIn(iotest.OneByteReader(strings.NewReader(c.text)), c.hint)
+		var tokens []string
+		for scanner.Scan() {
+			tokens = append(tokens, scanner.Text())
+		}
+		if err := scanner.Err(); err != nil || strings.Join(tokens, "\n") != strings.Join(c.want, "\n") {
+			t.Errorf("tokenising %q as %s gave %q, %v, want %q", c.text, c.hint, tokens, err, c.want)
+		}
+	}
+}
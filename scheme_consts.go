@@ -0,0 +1,822 @@
+package defang_schemes
+
+import "github.com/jakewilliami/defang-schemes/schemes"
+
+/*
+THIS FILE WAS AUTOMATICALLY GENERATED AT 2026-10-16 08:52:18
+
+Do not edit this file.  Run "go generate" to re-generate this file with an
+updated version of URI scheme names from:
+    iana.org/assignments/uri-schemes/uri-schemes.xhtml.
+*/
+
+// Number of registered URI schemes, in total and by status
+const (
+	NumSchemes     = schemes.NumSchemes
+	NumPermanent   = schemes.NumPermanent
+	NumProvisional = schemes.NumProvisional
+	NumHistorical  = schemes.NumHistorical
+)
+
+// Registered URI schemes
+const (
+	SCHEME_AAA                                  = schemes.SCHEME_AAA
+	SCHEME_AAAS                                 = schemes.SCHEME_AAAS
+	SCHEME_ABOUT                                = schemes.SCHEME_ABOUT
+	SCHEME_ACAP                                 = schemes.SCHEME_ACAP
+	SCHEME_ACCT                                 = schemes.SCHEME_ACCT
+	SCHEME_ACD                                  = schemes.SCHEME_ACD
+	SCHEME_ACR                                  = schemes.SCHEME_ACR
+	SCHEME_ADIUMXTRA                            = schemes.SCHEME_ADIUMXTRA
+	SCHEME_ADT                                  = schemes.SCHEME_ADT
+	SCHEME_AFP                                  = schemes.SCHEME_AFP
+	SCHEME_AFS                                  = schemes.SCHEME_AFS
+	SCHEME_AIM                                  = schemes.SCHEME_AIM
+	SCHEME_AMSS                                 = schemes.SCHEME_AMSS
+	SCHEME_ANDROID                              = schemes.SCHEME_ANDROID
+	SCHEME_APPDATA                              = schemes.SCHEME_APPDATA
+	SCHEME_APT                                  = schemes.SCHEME_APT
+	SCHEME_AR                                   = schemes.SCHEME_AR
+	SCHEME_ARI                                  = schemes.SCHEME_ARI
+	SCHEME_ARK                                  = schemes.SCHEME_ARK
+	SCHEME_AT                                   = schemes.SCHEME_AT
+	SCHEME_ATTACHMENT                           = schemes.SCHEME_ATTACHMENT
+	SCHEME_AW                                   = schemes.SCHEME_AW
+	SCHEME_BARION                               = schemes.SCHEME_BARION
+	SCHEME_BB                                   = schemes.SCHEME_BB
+	SCHEME_BESHARE                              = schemes.SCHEME_BESHARE
+	SCHEME_BITCOIN                              = schemes.SCHEME_BITCOIN
+	SCHEME_BITCOINCASH                          = schemes.SCHEME_BITCOINCASH
+	SCHEME_BL                                   = schemes.SCHEME_BL
+	SCHEME_BLOB                                 = schemes.SCHEME_BLOB
+	SCHEME_BLUETOOTH                            = schemes.SCHEME_BLUETOOTH
+	SCHEME_BOLO                                 = schemes.SCHEME_BOLO
+	SCHEME_BRID                                 = schemes.SCHEME_BRID
+	SCHEME_BROWSEREXT                           = schemes.SCHEME_BROWSEREXT
+	SCHEME_CABAL                                = schemes.SCHEME_CABAL
+	SCHEME_CALCULATOR                           = schemes.SCHEME_CALCULATOR
+	SCHEME_CALLTO                               = schemes.SCHEME_CALLTO
+	SCHEME_CAP                                  = schemes.SCHEME_CAP
+	SCHEME_CAST                                 = schemes.SCHEME_CAST
+	SCHEME_CASTS                                = schemes.SCHEME_CASTS
+	SCHEME_CHROME                               = schemes.SCHEME_CHROME
+	SCHEME_CHROME_EXTENSION                     = schemes.SCHEME_CHROME_EXTENSION
+	SCHEME_CID                                  = schemes.SCHEME_CID
+	SCHEME_COAP                                 = schemes.SCHEME_COAP
+	SCHEME_COAP_TCP                             = schemes.SCHEME_COAP_TCP
+	SCHEME_COAP_WS                              = schemes.SCHEME_COAP_WS
+	SCHEME_COAPS                                = schemes.SCHEME_COAPS
+	SCHEME_COAPS_TCP                            = schemes.SCHEME_COAPS_TCP
+	SCHEME_COAPS_WS                             = schemes.SCHEME_COAPS_WS
+	SCHEME_COM_EVENTBRITE_ATTENDEE              = schemes.SCHEME_COM_EVENTBRITE_ATTENDEE
+	SCHEME_CONTENT                              = schemes.SCHEME_CONTENT
+	SCHEME_CONTENT_TYPE                         = schemes.SCHEME_CONTENT_TYPE
+	SCHEME_CRID                                 = schemes.SCHEME_CRID
+	SCHEME_CSTR                                 = schemes.SCHEME_CSTR
+	SCHEME_CVS                                  = schemes.SCHEME_CVS
+	SCHEME_DAB                                  = schemes.SCHEME_DAB
+	SCHEME_DAT                                  = schemes.SCHEME_DAT
+	SCHEME_DATA                                 = schemes.SCHEME_DATA
+	SCHEME_DAV                                  = schemes.SCHEME_DAV
+	SCHEME_DHTTP                                = schemes.SCHEME_DHTTP
+	SCHEME_DIASPORA                             = schemes.SCHEME_DIASPORA
+	SCHEME_DICT                                 = schemes.SCHEME_DICT
+	SCHEME_DID                                  = schemes.SCHEME_DID
+	SCHEME_DIS                                  = schemes.SCHEME_DIS
+	SCHEME_DLNA_PLAYCONTAINER                   = schemes.SCHEME_DLNA_PLAYCONTAINER
+	SCHEME_DLNA_PLAYSINGLE                      = schemes.SCHEME_DLNA_PLAYSINGLE
+	SCHEME_DNS                                  = schemes.SCHEME_DNS
+	SCHEME_DNTP                                 = schemes.SCHEME_DNTP
+	SCHEME_DOI                                  = schemes.SCHEME_DOI
+	SCHEME_DPP                                  = schemes.SCHEME_DPP
+	SCHEME_DRM                                  = schemes.SCHEME_DRM
+	SCHEME_DROP                                 = schemes.SCHEME_DROP
+	SCHEME_DTMI                                 = schemes.SCHEME_DTMI
+	SCHEME_DTN                                  = schemes.SCHEME_DTN
+	SCHEME_DVB                                  = schemes.SCHEME_DVB
+	SCHEME_DVX                                  = schemes.SCHEME_DVX
+	SCHEME_DWEB                                 = schemes.SCHEME_DWEB
+	SCHEME_ED2K                                 = schemes.SCHEME_ED2K
+	SCHEME_EID                                  = schemes.SCHEME_EID
+	SCHEME_ELSI                                 = schemes.SCHEME_ELSI
+	SCHEME_EMBEDDED                             = schemes.SCHEME_EMBEDDED
+	SCHEME_ENS                                  = schemes.SCHEME_ENS
+	SCHEME_ETHEREUM                             = schemes.SCHEME_ETHEREUM
+	SCHEME_EXAMPLE                              = schemes.SCHEME_EXAMPLE
+	SCHEME_FACETIME                             = schemes.SCHEME_FACETIME
+	SCHEME_FAX                                  = schemes.SCHEME_FAX
+	SCHEME_FEED                                 = schemes.SCHEME_FEED
+	SCHEME_FEEDREADY                            = schemes.SCHEME_FEEDREADY
+	SCHEME_FIDO                                 = schemes.SCHEME_FIDO
+	SCHEME_FILE                                 = schemes.SCHEME_FILE
+	SCHEME_FILESYSTEM                           = schemes.SCHEME_FILESYSTEM
+	SCHEME_FINGER                               = schemes.SCHEME_FINGER
+	SCHEME_FIRST_RUN_PEN_EXPERIENCE             = schemes.SCHEME_FIRST_RUN_PEN_EXPERIENCE
+	SCHEME_FISH                                 = schemes.SCHEME_FISH
+	SCHEME_FM                                   = schemes.SCHEME_FM
+	SCHEME_FTP                                  = schemes.SCHEME_FTP
+	SCHEME_FUCHSIA_PKG                          = schemes.SCHEME_FUCHSIA_PKG
+	SCHEME_GEO                                  = schemes.SCHEME_GEO
+	SCHEME_GG                                   = schemes.SCHEME_GG
+	SCHEME_GIT                                  = schemes.SCHEME_GIT
+	SCHEME_GITOID                               = schemes.SCHEME_GITOID
+	SCHEME_GIZMOPROJECT                         = schemes.SCHEME_GIZMOPROJECT
+	SCHEME_GO                                   = schemes.SCHEME_GO
+	SCHEME_GOPHER                               = schemes.SCHEME_GOPHER
+	SCHEME_GRAPH                                = schemes.SCHEME_GRAPH
+	SCHEME_GRD                                  = schemes.SCHEME_GRD
+	SCHEME_GTALK                                = schemes.SCHEME_GTALK
+	SCHEME_H323                                 = schemes.SCHEME_H323
+	SCHEME_HAM                                  = schemes.SCHEME_HAM
+	SCHEME_HCAP                                 = schemes.SCHEME_HCAP
+	SCHEME_HCP                                  = schemes.SCHEME_HCP
+	SCHEME_HS20                                 = schemes.SCHEME_HS20
+	SCHEME_HTTP                                 = schemes.SCHEME_HTTP
+	SCHEME_HTTPS                                = schemes.SCHEME_HTTPS
+	SCHEME_HXXP                                 = schemes.SCHEME_HXXP
+	SCHEME_HXXPS                                = schemes.SCHEME_HXXPS
+	SCHEME_HYDRAZONE                            = schemes.SCHEME_HYDRAZONE
+	SCHEME_HYPER                                = schemes.SCHEME_HYPER
+	SCHEME_IAX                                  = schemes.SCHEME_IAX
+	SCHEME_ICAP                                 = schemes.SCHEME_ICAP
+	SCHEME_ICON                                 = schemes.SCHEME_ICON
+	SCHEME_ILSTRING                             = schemes.SCHEME_ILSTRING
+	SCHEME_IM                                   = schemes.SCHEME_IM
+	SCHEME_IMAP                                 = schemes.SCHEME_IMAP
+	SCHEME_INFO                                 = schemes.SCHEME_INFO
+	SCHEME_IOTDISCO                             = schemes.SCHEME_IOTDISCO
+	SCHEME_IPFS                                 = schemes.SCHEME_IPFS
+	SCHEME_IPN                                  = schemes.SCHEME_IPN
+	SCHEME_IPNS                                 = schemes.SCHEME_IPNS
+	SCHEME_IPP                                  = schemes.SCHEME_IPP
+	SCHEME_IPPS                                 = schemes.SCHEME_IPPS
+	SCHEME_IRC                                  = schemes.SCHEME_IRC
+	SCHEME_IRC6                                 = schemes.SCHEME_IRC6
+	SCHEME_IRCS                                 = schemes.SCHEME_IRCS
+	SCHEME_IRIS                                 = schemes.SCHEME_IRIS
+	SCHEME_IRIS_BEEP                            = schemes.SCHEME_IRIS_BEEP
+	SCHEME_IRIS_LWZ                             = schemes.SCHEME_IRIS_LWZ
+	SCHEME_IRIS_XPC                             = schemes.SCHEME_IRIS_XPC
+	SCHEME_IRIS_XPCS                            = schemes.SCHEME_IRIS_XPCS
+	SCHEME_ISOSTORE                             = schemes.SCHEME_ISOSTORE
+	SCHEME_ITMS                                 = schemes.SCHEME_ITMS
+	SCHEME_JABBER                               = schemes.SCHEME_JABBER
+	SCHEME_JAR                                  = schemes.SCHEME_JAR
+	SCHEME_JMS                                  = schemes.SCHEME_JMS
+	SCHEME_KEYPARC                              = schemes.SCHEME_KEYPARC
+	SCHEME_LASTFM                               = schemes.SCHEME_LASTFM
+	SCHEME_LBRY                                 = schemes.SCHEME_LBRY
+	SCHEME_LDAP                                 = schemes.SCHEME_LDAP
+	SCHEME_LDAPS                                = schemes.SCHEME_LDAPS
+	SCHEME_LEAPTOFROGANS                        = schemes.SCHEME_LEAPTOFROGANS
+	SCHEME_LID                                  = schemes.SCHEME_LID
+	SCHEME_LORAWAN                              = schemes.SCHEME_LORAWAN
+	SCHEME_LPA                                  = schemes.SCHEME_LPA
+	SCHEME_LVLT                                 = schemes.SCHEME_LVLT
+	SCHEME_MACHINEPROVISIONINGPROGRESSREPORTER  = schemes.SCHEME_MACHINEPROVISIONINGPROGRESSREPORTER
+	SCHEME_MAGNET                               = schemes.SCHEME_MAGNET
+	SCHEME_MAILSERVER                           = schemes.SCHEME_MAILSERVER
+	SCHEME_MAILTO                               = schemes.SCHEME_MAILTO
+	SCHEME_MAPS                                 = schemes.SCHEME_MAPS
+	SCHEME_MARKET                               = schemes.SCHEME_MARKET
+	SCHEME_MATRIX                               = schemes.SCHEME_MATRIX
+	SCHEME_MESSAGE                              = schemes.SCHEME_MESSAGE
+	SCHEME_MICROSOFT_WINDOWS_CAMERA             = schemes.SCHEME_MICROSOFT_WINDOWS_CAMERA
+	SCHEME_MICROSOFT_WINDOWS_CAMERA_MULTIPICKER = schemes.SCHEME_MICROSOFT_WINDOWS_CAMERA_MULTIPICKER
+	SCHEME_MICROSOFT_WINDOWS_CAMERA_PICKER      = schemes.SCHEME_MICROSOFT_WINDOWS_CAMERA_PICKER
+	SCHEME_MID                                  = schemes.SCHEME_MID
+	SCHEME_MMS                                  = schemes.SCHEME_MMS
+	SCHEME_MODEM                                = schemes.SCHEME_MODEM
+	SCHEME_MONGODB                              = schemes.SCHEME_MONGODB
+	SCHEME_MOZ                                  = schemes.SCHEME_MOZ
+	SCHEME_MS_ACCESS                            = schemes.SCHEME_MS_ACCESS
+	SCHEME_MS_APPINSTALLER                      = schemes.SCHEME_MS_APPINSTALLER
+	SCHEME_MS_BROWSER_EXTENSION                 = schemes.SCHEME_MS_BROWSER_EXTENSION
+	SCHEME_MS_CALCULATOR                        = schemes.SCHEME_MS_CALCULATOR
+	SCHEME_MS_DRIVE_TO                          = schemes.SCHEME_MS_DRIVE_TO
+	SCHEME_MS_ENROLLMENT                        = schemes.SCHEME_MS_ENROLLMENT
+	SCHEME_MS_EXCEL                             = schemes.SCHEME_MS_EXCEL
+	SCHEME_MS_EYECONTROLSPEECH                  = schemes.SCHEME_MS_EYECONTROLSPEECH
+	SCHEME_MS_GAMEBARSERVICES                   = schemes.SCHEME_MS_GAMEBARSERVICES
+	SCHEME_MS_GAMINGOVERLAY                     = schemes.SCHEME_MS_GAMINGOVERLAY
+	SCHEME_MS_GETOFFICE                         = schemes.SCHEME_MS_GETOFFICE
+	SCHEME_MS_HELP                              = schemes.SCHEME_MS_HELP
+	SCHEME_MS_INFOPATH                          = schemes.SCHEME_MS_INFOPATH
+	SCHEME_MS_INPUTAPP                          = schemes.SCHEME_MS_INPUTAPP
+	SCHEME_MS_LAUNCHREMOTEDESKTOP               = schemes.SCHEME_MS_LAUNCHREMOTEDESKTOP
+	SCHEME_MS_LOCKSCREENCOMPONENT_CONFIG        = schemes.SCHEME_MS_LOCKSCREENCOMPONENT_CONFIG
+	SCHEME_MS_MEDIA_STREAM_ID                   = schemes.SCHEME_MS_MEDIA_STREAM_ID
+	SCHEME_MS_MEETNOW                           = schemes.SCHEME_MS_MEETNOW
+	SCHEME_MS_MIXEDREALITYCAPTURE               = schemes.SCHEME_MS_MIXEDREALITYCAPTURE
+	SCHEME_MS_MOBILEPLANS                       = schemes.SCHEME_MS_MOBILEPLANS
+	SCHEME_MS_NEWSANDINTERESTS                  = schemes.SCHEME_MS_NEWSANDINTERESTS
+	SCHEME_MS_OFFICEAPP                         = schemes.SCHEME_MS_OFFICEAPP
+	SCHEME_MS_PEOPLE                            = schemes.SCHEME_MS_PEOPLE
+	SCHEME_MS_PERSONACARD                       = schemes.SCHEME_MS_PERSONACARD
+	SCHEME_MS_POWERPOINT                        = schemes.SCHEME_MS_POWERPOINT
+	SCHEME_MS_PROJECT                           = schemes.SCHEME_MS_PROJECT
+	SCHEME_MS_PUBLISHER                         = schemes.SCHEME_MS_PUBLISHER
+	SCHEME_MS_RECALL                            = schemes.SCHEME_MS_RECALL
+	SCHEME_MS_REMOTEDESKTOP                     = schemes.SCHEME_MS_REMOTEDESKTOP
+	SCHEME_MS_REMOTEDESKTOP_LAUNCH              = schemes.SCHEME_MS_REMOTEDESKTOP_LAUNCH
+	SCHEME_MS_RESTORETABCOMPANION               = schemes.SCHEME_MS_RESTORETABCOMPANION
+	SCHEME_MS_SCREENCLIP                        = schemes.SCHEME_MS_SCREENCLIP
+	SCHEME_MS_SCREENSKETCH                      = schemes.SCHEME_MS_SCREENSKETCH
+	SCHEME_MS_SEARCH                            = schemes.SCHEME_MS_SEARCH
+	SCHEME_MS_SEARCH_REPAIR                     = schemes.SCHEME_MS_SEARCH_REPAIR
+	SCHEME_MS_SECONDARY_SCREEN_CONTROLLER       = schemes.SCHEME_MS_SECONDARY_SCREEN_CONTROLLER
+	SCHEME_MS_SECONDARY_SCREEN_SETUP            = schemes.SCHEME_MS_SECONDARY_SCREEN_SETUP
+	SCHEME_MS_SETTINGS                          = schemes.SCHEME_MS_SETTINGS
+	SCHEME_MS_SETTINGS_AIRPLANEMODE             = schemes.SCHEME_MS_SETTINGS_AIRPLANEMODE
+	SCHEME_MS_SETTINGS_BLUETOOTH                = schemes.SCHEME_MS_SETTINGS_BLUETOOTH
+	SCHEME_MS_SETTINGS_CAMERA                   = schemes.SCHEME_MS_SETTINGS_CAMERA
+	SCHEME_MS_SETTINGS_CELLULAR                 = schemes.SCHEME_MS_SETTINGS_CELLULAR
+	SCHEME_MS_SETTINGS_CLOUDSTORAGE             = schemes.SCHEME_MS_SETTINGS_CLOUDSTORAGE
+	SCHEME_MS_SETTINGS_CONNECTABLEDEVICES       = schemes.SCHEME_MS_SETTINGS_CONNECTABLEDEVICES
+	SCHEME_MS_SETTINGS_DISPLAYS_TOPOLOGY        = schemes.SCHEME_MS_SETTINGS_DISPLAYS_TOPOLOGY
+	SCHEME_MS_SETTINGS_EMAILANDACCOUNTS         = schemes.SCHEME_MS_SETTINGS_EMAILANDACCOUNTS
+	SCHEME_MS_SETTINGS_LANGUAGE                 = schemes.SCHEME_MS_SETTINGS_LANGUAGE
+	SCHEME_MS_SETTINGS_LOCATION                 = schemes.SCHEME_MS_SETTINGS_LOCATION
+	SCHEME_MS_SETTINGS_LOCK                     = schemes.SCHEME_MS_SETTINGS_LOCK
+	SCHEME_MS_SETTINGS_NFCTRANSACTIONS          = schemes.SCHEME_MS_SETTINGS_NFCTRANSACTIONS
+	SCHEME_MS_SETTINGS_NOTIFICATIONS            = schemes.SCHEME_MS_SETTINGS_NOTIFICATIONS
+	SCHEME_MS_SETTINGS_POWER                    = schemes.SCHEME_MS_SETTINGS_POWER
+	SCHEME_MS_SETTINGS_PRIVACY                  = schemes.SCHEME_MS_SETTINGS_PRIVACY
+	SCHEME_MS_SETTINGS_PROXIMITY                = schemes.SCHEME_MS_SETTINGS_PROXIMITY
+	SCHEME_MS_SETTINGS_SCREENROTATION           = schemes.SCHEME_MS_SETTINGS_SCREENROTATION
+	SCHEME_MS_SETTINGS_WIFI                     = schemes.SCHEME_MS_SETTINGS_WIFI
+	SCHEME_MS_SETTINGS_WORKPLACE                = schemes.SCHEME_MS_SETTINGS_WORKPLACE
+	SCHEME_MS_SPD                               = schemes.SCHEME_MS_SPD
+	SCHEME_MS_STICKERS                          = schemes.SCHEME_MS_STICKERS
+	SCHEME_MS_STTOVERLAY                        = schemes.SCHEME_MS_STTOVERLAY
+	SCHEME_MS_TRANSIT_TO                        = schemes.SCHEME_MS_TRANSIT_TO
+	SCHEME_MS_USERACTIVITYSET                   = schemes.SCHEME_MS_USERACTIVITYSET
+	SCHEME_MS_UUP                               = schemes.SCHEME_MS_UUP
+	SCHEME_MS_VIRTUALTOUCHPAD                   = schemes.SCHEME_MS_VIRTUALTOUCHPAD
+	SCHEME_MS_VISIO                             = schemes.SCHEME_MS_VISIO
+	SCHEME_MS_WALK_TO                           = schemes.SCHEME_MS_WALK_TO
+	SCHEME_MS_WHITEBOARD                        = schemes.SCHEME_MS_WHITEBOARD
+	SCHEME_MS_WHITEBOARD_CMD                    = schemes.SCHEME_MS_WHITEBOARD_CMD
+	SCHEME_MS_WIDGETBOARD                       = schemes.SCHEME_MS_WIDGETBOARD
+	SCHEME_MS_WIDGETS                           = schemes.SCHEME_MS_WIDGETS
+	SCHEME_MS_WORD                              = schemes.SCHEME_MS_WORD
+	SCHEME_MSNIM                                = schemes.SCHEME_MSNIM
+	SCHEME_MSRP                                 = schemes.SCHEME_MSRP
+	SCHEME_MSRPS                                = schemes.SCHEME_MSRPS
+	SCHEME_MSS                                  = schemes.SCHEME_MSS
+	SCHEME_MT                                   = schemes.SCHEME_MT
+	SCHEME_MTQP                                 = schemes.SCHEME_MTQP
+	SCHEME_MTRUST                               = schemes.SCHEME_MTRUST
+	SCHEME_MUMBLE                               = schemes.SCHEME_MUMBLE
+	SCHEME_MUPDATE                              = schemes.SCHEME_MUPDATE
+	SCHEME_MVN                                  = schemes.SCHEME_MVN
+	SCHEME_MVRP                                 = schemes.SCHEME_MVRP
+	SCHEME_MVRPS                                = schemes.SCHEME_MVRPS
+	SCHEME_NEWS                                 = schemes.SCHEME_NEWS
+	SCHEME_NFS                                  = schemes.SCHEME_NFS
+	SCHEME_NI                                   = schemes.SCHEME_NI
+	SCHEME_NIH                                  = schemes.SCHEME_NIH
+	SCHEME_NNTP                                 = schemes.SCHEME_NNTP
+	SCHEME_NOTES                                = schemes.SCHEME_NOTES
+	SCHEME_NUM                                  = schemes.SCHEME_NUM
+	SCHEME_OCF                                  = schemes.SCHEME_OCF
+	SCHEME_OID                                  = schemes.SCHEME_OID
+	SCHEME_ONENOTE                              = schemes.SCHEME_ONENOTE
+	SCHEME_ONENOTE_CMD                          = schemes.SCHEME_ONENOTE_CMD
+	SCHEME_OPAQUELOCKTOKEN                      = schemes.SCHEME_OPAQUELOCKTOKEN
+	SCHEME_OPENID                               = schemes.SCHEME_OPENID
+	SCHEME_OPENPGP4FPR                          = schemes.SCHEME_OPENPGP4FPR
+	SCHEME_OTPAUTH                              = schemes.SCHEME_OTPAUTH
+	SCHEME_P1                                   = schemes.SCHEME_P1
+	SCHEME_PACK                                 = schemes.SCHEME_PACK
+	SCHEME_PALM                                 = schemes.SCHEME_PALM
+	SCHEME_PAPARAZZI                            = schemes.SCHEME_PAPARAZZI
+	SCHEME_PAYMENT                              = schemes.SCHEME_PAYMENT
+	SCHEME_PAYTO                                = schemes.SCHEME_PAYTO
+	SCHEME_PKCS11                               = schemes.SCHEME_PKCS11
+	SCHEME_PLATFORM                             = schemes.SCHEME_PLATFORM
+	SCHEME_POP                                  = schemes.SCHEME_POP
+	SCHEME_PRES                                 = schemes.SCHEME_PRES
+	SCHEME_PROSPERO                             = schemes.SCHEME_PROSPERO
+	SCHEME_PROXY                                = schemes.SCHEME_PROXY
+	SCHEME_PSYC                                 = schemes.SCHEME_PSYC
+	SCHEME_PTTP                                 = schemes.SCHEME_PTTP
+	SCHEME_PWID                                 = schemes.SCHEME_PWID
+	SCHEME_QB                                   = schemes.SCHEME_QB
+	SCHEME_QUERY                                = schemes.SCHEME_QUERY
+	SCHEME_QUIC_TRANSPORT                       = schemes.SCHEME_QUIC_TRANSPORT
+	SCHEME_REDIS                                = schemes.SCHEME_REDIS
+	SCHEME_REDISS                               = schemes.SCHEME_REDISS
+	SCHEME_RELOAD                               = schemes.SCHEME_RELOAD
+	SCHEME_RES                                  = schemes.SCHEME_RES
+	SCHEME_RESOURCE                             = schemes.SCHEME_RESOURCE
+	SCHEME_RMI                                  = schemes.SCHEME_RMI
+	SCHEME_RSYNC                                = schemes.SCHEME_RSYNC
+	SCHEME_RTMFP                                = schemes.SCHEME_RTMFP
+	SCHEME_RTMP                                 = schemes.SCHEME_RTMP
+	SCHEME_RTSP                                 = schemes.SCHEME_RTSP
+	SCHEME_RTSPS                                = schemes.SCHEME_RTSPS
+	SCHEME_RTSPU                                = schemes.SCHEME_RTSPU
+	SCHEME_SARIF                                = schemes.SCHEME_SARIF
+	SCHEME_SECONDLIFE                           = schemes.SCHEME_SECONDLIFE
+	SCHEME_SECRET_TOKEN                         = schemes.SCHEME_SECRET_TOKEN
+	SCHEME_SERVICE                              = schemes.SCHEME_SERVICE
+	SCHEME_SESSION                              = schemes.SCHEME_SESSION
+	SCHEME_SFTP                                 = schemes.SCHEME_SFTP
+	SCHEME_SGN                                  = schemes.SCHEME_SGN
+	SCHEME_SHC                                  = schemes.SCHEME_SHC
+	SCHEME_SHELTER                              = schemes.SCHEME_SHELTER
+	SCHEME_SHTTP                                = schemes.SCHEME_SHTTP
+	SCHEME_SIEVE                                = schemes.SCHEME_SIEVE
+	SCHEME_SIMPLELEDGER                         = schemes.SCHEME_SIMPLELEDGER
+	SCHEME_SIMPLEX                              = schemes.SCHEME_SIMPLEX
+	SCHEME_SIP                                  = schemes.SCHEME_SIP
+	SCHEME_SIPS                                 = schemes.SCHEME_SIPS
+	SCHEME_SKYPE                                = schemes.SCHEME_SKYPE
+	SCHEME_SMB                                  = schemes.SCHEME_SMB
+	SCHEME_SMP                                  = schemes.SCHEME_SMP
+	SCHEME_SMS                                  = schemes.SCHEME_SMS
+	SCHEME_SMTP                                 = schemes.SCHEME_SMTP
+	SCHEME_SNEWS                                = schemes.SCHEME_SNEWS
+	SCHEME_SNMP                                 = schemes.SCHEME_SNMP
+	SCHEME_SOAP_BEEP                            = schemes.SCHEME_SOAP_BEEP
+	SCHEME_SOAP_BEEPS                           = schemes.SCHEME_SOAP_BEEPS
+	SCHEME_SOLDAT                               = schemes.SCHEME_SOLDAT
+	SCHEME_SPIFFE                               = schemes.SCHEME_SPIFFE
+	SCHEME_SPOTIFY                              = schemes.SCHEME_SPOTIFY
+	SCHEME_SSB                                  = schemes.SCHEME_SSB
+	SCHEME_SSH                                  = schemes.SCHEME_SSH
+	SCHEME_STARKNET                             = schemes.SCHEME_STARKNET
+	SCHEME_STEAM                                = schemes.SCHEME_STEAM
+	SCHEME_STUN                                 = schemes.SCHEME_STUN
+	SCHEME_STUNS                                = schemes.SCHEME_STUNS
+	SCHEME_SUBMIT                               = schemes.SCHEME_SUBMIT
+	SCHEME_SVN                                  = schemes.SCHEME_SVN
+	SCHEME_SWH                                  = schemes.SCHEME_SWH
+	SCHEME_SWID                                 = schemes.SCHEME_SWID
+	SCHEME_SWIDPATH                             = schemes.SCHEME_SWIDPATH
+	SCHEME_TAG                                  = schemes.SCHEME_TAG
+	SCHEME_TALER                                = schemes.SCHEME_TALER
+	SCHEME_TEAMSPEAK                            = schemes.SCHEME_TEAMSPEAK
+	SCHEME_TEAPOT                               = schemes.SCHEME_TEAPOT
+	SCHEME_TEAPOTS                              = schemes.SCHEME_TEAPOTS
+	SCHEME_TEL                                  = schemes.SCHEME_TEL
+	SCHEME_TELIAEID                             = schemes.SCHEME_TELIAEID
+	SCHEME_TELNET                               = schemes.SCHEME_TELNET
+	SCHEME_TFTP                                 = schemes.SCHEME_TFTP
+	SCHEME_THINGS                               = schemes.SCHEME_THINGS
+	SCHEME_THISMESSAGE                          = schemes.SCHEME_THISMESSAGE
+	SCHEME_THZP                                 = schemes.SCHEME_THZP
+	SCHEME_TIP                                  = schemes.SCHEME_TIP
+	SCHEME_TN3270                               = schemes.SCHEME_TN3270
+	SCHEME_TOOL                                 = schemes.SCHEME_TOOL
+	SCHEME_TURN                                 = schemes.SCHEME_TURN
+	SCHEME_TURNS                                = schemes.SCHEME_TURNS
+	SCHEME_TV                                   = schemes.SCHEME_TV
+	SCHEME_UDP                                  = schemes.SCHEME_UDP
+	SCHEME_UNREAL                               = schemes.SCHEME_UNREAL
+	SCHEME_UPT                                  = schemes.SCHEME_UPT
+	SCHEME_URN                                  = schemes.SCHEME_URN
+	SCHEME_UT2004                               = schemes.SCHEME_UT2004
+	SCHEME_UUID_IN_PACKAGE                      = schemes.SCHEME_UUID_IN_PACKAGE
+	SCHEME_V_EVENT                              = schemes.SCHEME_V_EVENT
+	SCHEME_VEMMI                                = schemes.SCHEME_VEMMI
+	SCHEME_VENTRILO                             = schemes.SCHEME_VENTRILO
+	SCHEME_VES                                  = schemes.SCHEME_VES
+	SCHEME_VIDEOTEX                             = schemes.SCHEME_VIDEOTEX
+	SCHEME_VIEW_SOURCE                          = schemes.SCHEME_VIEW_SOURCE
+	SCHEME_VNC                                  = schemes.SCHEME_VNC
+	SCHEME_VSCODE                               = schemes.SCHEME_VSCODE
+	SCHEME_VSCODE_INSIDERS                      = schemes.SCHEME_VSCODE_INSIDERS
+	SCHEME_VSLS                                 = schemes.SCHEME_VSLS
+	SCHEME_W3                                   = schemes.SCHEME_W3
+	SCHEME_WAIS                                 = schemes.SCHEME_WAIS
+	SCHEME_WASM                                 = schemes.SCHEME_WASM
+	SCHEME_WASM_JS                              = schemes.SCHEME_WASM_JS
+	SCHEME_WCR                                  = schemes.SCHEME_WCR
+	SCHEME_WEB_AP                               = schemes.SCHEME_WEB_AP
+	SCHEME_WEB3                                 = schemes.SCHEME_WEB3
+	SCHEME_WEBCAL                               = schemes.SCHEME_WEBCAL
+	SCHEME_WIFI                                 = schemes.SCHEME_WIFI
+	SCHEME_WPID                                 = schemes.SCHEME_WPID
+	SCHEME_WS                                   = schemes.SCHEME_WS
+	SCHEME_WSS                                  = schemes.SCHEME_WSS
+	SCHEME_WTAI                                 = schemes.SCHEME_WTAI
+	SCHEME_WYCIWYG                              = schemes.SCHEME_WYCIWYG
+	SCHEME_XCON                                 = schemes.SCHEME_XCON
+	SCHEME_XCON_USERID                          = schemes.SCHEME_XCON_USERID
+	SCHEME_XFIRE                                = schemes.SCHEME_XFIRE
+	SCHEME_XFTP                                 = schemes.SCHEME_XFTP
+	SCHEME_XMLRPC_BEEP                          = schemes.SCHEME_XMLRPC_BEEP
+	SCHEME_XMLRPC_BEEPS                         = schemes.SCHEME_XMLRPC_BEEPS
+	SCHEME_XMPP                                 = schemes.SCHEME_XMPP
+	SCHEME_XRCP                                 = schemes.SCHEME_XRCP
+	SCHEME_XRI                                  = schemes.SCHEME_XRI
+	SCHEME_YMSGR                                = schemes.SCHEME_YMSGR
+	SCHEME_Z39_50                               = schemes.SCHEME_Z39_50
+	SCHEME_Z39_50R                              = schemes.SCHEME_Z39_50R
+	SCHEME_Z39_50S                              = schemes.SCHEME_Z39_50S
+)
+
+// Defanged forms of the registered URI schemes
+const (
+	DEFANGED_SCHEME_AAA                                  = schemes.DEFANGED_SCHEME_AAA
+	DEFANGED_SCHEME_AAAS                                 = schemes.DEFANGED_SCHEME_AAAS
+	DEFANGED_SCHEME_ABOUT                                = schemes.DEFANGED_SCHEME_ABOUT
+	DEFANGED_SCHEME_ACAP                                 = schemes.DEFANGED_SCHEME_ACAP
+	DEFANGED_SCHEME_ACCT                                 = schemes.DEFANGED_SCHEME_ACCT
+	DEFANGED_SCHEME_ACD                                  = schemes.DEFANGED_SCHEME_ACD
+	DEFANGED_SCHEME_ACR                                  = schemes.DEFANGED_SCHEME_ACR
+	DEFANGED_SCHEME_ADIUMXTRA                            = schemes.DEFANGED_SCHEME_ADIUMXTRA
+	DEFANGED_SCHEME_ADT                                  = schemes.DEFANGED_SCHEME_ADT
+	DEFANGED_SCHEME_AFP                                  = schemes.DEFANGED_SCHEME_AFP
+	DEFANGED_SCHEME_AFS                                  = schemes.DEFANGED_SCHEME_AFS
+	DEFANGED_SCHEME_AIM                                  = schemes.DEFANGED_SCHEME_AIM
+	DEFANGED_SCHEME_AMSS                                 = schemes.DEFANGED_SCHEME_AMSS
+	DEFANGED_SCHEME_ANDROID                              = schemes.DEFANGED_SCHEME_ANDROID
+	DEFANGED_SCHEME_APPDATA                              = schemes.DEFANGED_SCHEME_APPDATA
+	DEFANGED_SCHEME_APT                                  = schemes.DEFANGED_SCHEME_APT
+	DEFANGED_SCHEME_AR                                   = schemes.DEFANGED_SCHEME_AR
+	DEFANGED_SCHEME_ARI                                  = schemes.DEFANGED_SCHEME_ARI
+	DEFANGED_SCHEME_ARK                                  = schemes.DEFANGED_SCHEME_ARK
+	DEFANGED_SCHEME_AT                                   = schemes.DEFANGED_SCHEME_AT
+	DEFANGED_SCHEME_ATTACHMENT                           = schemes.DEFANGED_SCHEME_ATTACHMENT
+	DEFANGED_SCHEME_AW                                   = schemes.DEFANGED_SCHEME_AW
+	DEFANGED_SCHEME_BARION                               = schemes.DEFANGED_SCHEME_BARION
+	DEFANGED_SCHEME_BB                                   = schemes.DEFANGED_SCHEME_BB
+	DEFANGED_SCHEME_BESHARE                              = schemes.DEFANGED_SCHEME_BESHARE
+	DEFANGED_SCHEME_BITCOIN                              = schemes.DEFANGED_SCHEME_BITCOIN
+	DEFANGED_SCHEME_BITCOINCASH                          = schemes.DEFANGED_SCHEME_BITCOINCASH
+	DEFANGED_SCHEME_BL                                   = schemes.DEFANGED_SCHEME_BL
+	DEFANGED_SCHEME_BLOB                                 = schemes.DEFANGED_SCHEME_BLOB
+	DEFANGED_SCHEME_BLUETOOTH                            = schemes.DEFANGED_SCHEME_BLUETOOTH
+	DEFANGED_SCHEME_BOLO                                 = schemes.DEFANGED_SCHEME_BOLO
+	DEFANGED_SCHEME_BRID                                 = schemes.DEFANGED_SCHEME_BRID
+	DEFANGED_SCHEME_BROWSEREXT                           = schemes.DEFANGED_SCHEME_BROWSEREXT
+	DEFANGED_SCHEME_CABAL                                = schemes.DEFANGED_SCHEME_CABAL
+	DEFANGED_SCHEME_CALCULATOR                           = schemes.DEFANGED_SCHEME_CALCULATOR
+	DEFANGED_SCHEME_CALLTO                               = schemes.DEFANGED_SCHEME_CALLTO
+	DEFANGED_SCHEME_CAP                                  = schemes.DEFANGED_SCHEME_CAP
+	DEFANGED_SCHEME_CAST                                 = schemes.DEFANGED_SCHEME_CAST
+	DEFANGED_SCHEME_CASTS                                = schemes.DEFANGED_SCHEME_CASTS
+	DEFANGED_SCHEME_CHROME                               = schemes.DEFANGED_SCHEME_CHROME
+	DEFANGED_SCHEME_CHROME_EXTENSION                     = schemes.DEFANGED_SCHEME_CHROME_EXTENSION
+	DEFANGED_SCHEME_CID                                  = schemes.DEFANGED_SCHEME_CID
+	DEFANGED_SCHEME_COAP                                 = schemes.DEFANGED_SCHEME_COAP
+	DEFANGED_SCHEME_COAP_TCP                             = schemes.DEFANGED_SCHEME_COAP_TCP
+	DEFANGED_SCHEME_COAP_WS                              = schemes.DEFANGED_SCHEME_COAP_WS
+	DEFANGED_SCHEME_COAPS                                = schemes.DEFANGED_SCHEME_COAPS
+	DEFANGED_SCHEME_COAPS_TCP                            = schemes.DEFANGED_SCHEME_COAPS_TCP
+	DEFANGED_SCHEME_COAPS_WS                             = schemes.DEFANGED_SCHEME_COAPS_WS
+	DEFANGED_SCHEME_COM_EVENTBRITE_ATTENDEE              = schemes.DEFANGED_SCHEME_COM_EVENTBRITE_ATTENDEE
+	DEFANGED_SCHEME_CONTENT                              = schemes.DEFANGED_SCHEME_CONTENT
+	DEFANGED_SCHEME_CONTENT_TYPE                         = schemes.DEFANGED_SCHEME_CONTENT_TYPE
+	DEFANGED_SCHEME_CRID                                 = schemes.DEFANGED_SCHEME_CRID
+	DEFANGED_SCHEME_CSTR                                 = schemes.DEFANGED_SCHEME_CSTR
+	DEFANGED_SCHEME_CVS                                  = schemes.DEFANGED_SCHEME_CVS
+	DEFANGED_SCHEME_DAB                                  = schemes.DEFANGED_SCHEME_DAB
+	DEFANGED_SCHEME_DAT                                  = schemes.DEFANGED_SCHEME_DAT
+	DEFANGED_SCHEME_DATA                                 = schemes.DEFANGED_SCHEME_DATA
+	DEFANGED_SCHEME_DAV                                  = schemes.DEFANGED_SCHEME_DAV
+	DEFANGED_SCHEME_DHTTP                                = schemes.DEFANGED_SCHEME_DHTTP
+	DEFANGED_SCHEME_DIASPORA                             = schemes.DEFANGED_SCHEME_DIASPORA
+	DEFANGED_SCHEME_DICT                                 = schemes.DEFANGED_SCHEME_DICT
+	DEFANGED_SCHEME_DID                                  = schemes.DEFANGED_SCHEME_DID
+	DEFANGED_SCHEME_DIS                                  = schemes.DEFANGED_SCHEME_DIS
+	DEFANGED_SCHEME_DLNA_PLAYCONTAINER                   = schemes.DEFANGED_SCHEME_DLNA_PLAYCONTAINER
+	DEFANGED_SCHEME_DLNA_PLAYSINGLE                      = schemes.DEFANGED_SCHEME_DLNA_PLAYSINGLE
+	DEFANGED_SCHEME_DNS                                  = schemes.DEFANGED_SCHEME_DNS
+	DEFANGED_SCHEME_DNTP                                 = schemes.DEFANGED_SCHEME_DNTP
+	DEFANGED_SCHEME_DOI                                  = schemes.DEFANGED_SCHEME_DOI
+	DEFANGED_SCHEME_DPP                                  = schemes.DEFANGED_SCHEME_DPP
+	DEFANGED_SCHEME_DRM                                  = schemes.DEFANGED_SCHEME_DRM
+	DEFANGED_SCHEME_DROP                                 = schemes.DEFANGED_SCHEME_DROP
+	DEFANGED_SCHEME_DTMI                                 = schemes.DEFANGED_SCHEME_DTMI
+	DEFANGED_SCHEME_DTN                                  = schemes.DEFANGED_SCHEME_DTN
+	DEFANGED_SCHEME_DVB                                  = schemes.DEFANGED_SCHEME_DVB
+	DEFANGED_SCHEME_DVX                                  = schemes.DEFANGED_SCHEME_DVX
+	DEFANGED_SCHEME_DWEB                                 = schemes.DEFANGED_SCHEME_DWEB
+	DEFANGED_SCHEME_ED2K                                 = schemes.DEFANGED_SCHEME_ED2K
+	DEFANGED_SCHEME_EID                                  = schemes.DEFANGED_SCHEME_EID
+	DEFANGED_SCHEME_ELSI                                 = schemes.DEFANGED_SCHEME_ELSI
+	DEFANGED_SCHEME_EMBEDDED                             = schemes.DEFANGED_SCHEME_EMBEDDED
+	DEFANGED_SCHEME_ENS                                  = schemes.DEFANGED_SCHEME_ENS
+	DEFANGED_SCHEME_ETHEREUM                             = schemes.DEFANGED_SCHEME_ETHEREUM
+	DEFANGED_SCHEME_EXAMPLE                              = schemes.DEFANGED_SCHEME_EXAMPLE
+	DEFANGED_SCHEME_FACETIME                             = schemes.DEFANGED_SCHEME_FACETIME
+	DEFANGED_SCHEME_FAX                                  = schemes.DEFANGED_SCHEME_FAX
+	DEFANGED_SCHEME_FEED                                 = schemes.DEFANGED_SCHEME_FEED
+	DEFANGED_SCHEME_FEEDREADY                            = schemes.DEFANGED_SCHEME_FEEDREADY
+	DEFANGED_SCHEME_FIDO                                 = schemes.DEFANGED_SCHEME_FIDO
+	DEFANGED_SCHEME_FILE                                 = schemes.DEFANGED_SCHEME_FILE
+	DEFANGED_SCHEME_FILESYSTEM                           = schemes.DEFANGED_SCHEME_FILESYSTEM
+	DEFANGED_SCHEME_FINGER                               = schemes.DEFANGED_SCHEME_FINGER
+	DEFANGED_SCHEME_FIRST_RUN_PEN_EXPERIENCE             = schemes.DEFANGED_SCHEME_FIRST_RUN_PEN_EXPERIENCE
+	DEFANGED_SCHEME_FISH                                 = schemes.DEFANGED_SCHEME_FISH
+	DEFANGED_SCHEME_FM                                   = schemes.DEFANGED_SCHEME_FM
+	DEFANGED_SCHEME_FTP                                  = schemes.DEFANGED_SCHEME_FTP
+	DEFANGED_SCHEME_FUCHSIA_PKG                          = schemes.DEFANGED_SCHEME_FUCHSIA_PKG
+	DEFANGED_SCHEME_GEO                                  = schemes.DEFANGED_SCHEME_GEO
+	DEFANGED_SCHEME_GG                                   = schemes.DEFANGED_SCHEME_GG
+	DEFANGED_SCHEME_GIT                                  = schemes.DEFANGED_SCHEME_GIT
+	DEFANGED_SCHEME_GITOID                               = schemes.DEFANGED_SCHEME_GITOID
+	DEFANGED_SCHEME_GIZMOPROJECT                         = schemes.DEFANGED_SCHEME_GIZMOPROJECT
+	DEFANGED_SCHEME_GO                                   = schemes.DEFANGED_SCHEME_GO
+	DEFANGED_SCHEME_GOPHER                               = schemes.DEFANGED_SCHEME_GOPHER
+	DEFANGED_SCHEME_GRAPH                                = schemes.DEFANGED_SCHEME_GRAPH
+	DEFANGED_SCHEME_GRD                                  = schemes.DEFANGED_SCHEME_GRD
+	DEFANGED_SCHEME_GTALK                                = schemes.DEFANGED_SCHEME_GTALK
+	DEFANGED_SCHEME_H323                                 = schemes.DEFANGED_SCHEME_H323
+	DEFANGED_SCHEME_HAM                                  = schemes.DEFANGED_SCHEME_HAM
+	DEFANGED_SCHEME_HCAP                                 = schemes.DEFANGED_SCHEME_HCAP
+	DEFANGED_SCHEME_HCP                                  = schemes.DEFANGED_SCHEME_HCP
+	DEFANGED_SCHEME_HS20                                 = schemes.DEFANGED_SCHEME_HS20
+	DEFANGED_SCHEME_HTTP                                 = schemes.DEFANGED_SCHEME_HTTP
+	DEFANGED_SCHEME_HTTPS                                = schemes.DEFANGED_SCHEME_HTTPS
+	DEFANGED_SCHEME_HXXP                                 = schemes.DEFANGED_SCHEME_HXXP
+	DEFANGED_SCHEME_HXXPS                                = schemes.DEFANGED_SCHEME_HXXPS
+	DEFANGED_SCHEME_HYDRAZONE                            = schemes.DEFANGED_SCHEME_HYDRAZONE
+	DEFANGED_SCHEME_HYPER                                = schemes.DEFANGED_SCHEME_HYPER
+	DEFANGED_SCHEME_IAX                                  = schemes.DEFANGED_SCHEME_IAX
+	DEFANGED_SCHEME_ICAP                                 = schemes.DEFANGED_SCHEME_ICAP
+	DEFANGED_SCHEME_ICON                                 = schemes.DEFANGED_SCHEME_ICON
+	DEFANGED_SCHEME_ILSTRING                             = schemes.DEFANGED_SCHEME_ILSTRING
+	DEFANGED_SCHEME_IM                                   = schemes.DEFANGED_SCHEME_IM
+	DEFANGED_SCHEME_IMAP                                 = schemes.DEFANGED_SCHEME_IMAP
+	DEFANGED_SCHEME_INFO                                 = schemes.DEFANGED_SCHEME_INFO
+	DEFANGED_SCHEME_IOTDISCO                             = schemes.DEFANGED_SCHEME_IOTDISCO
+	DEFANGED_SCHEME_IPFS                                 = schemes.DEFANGED_SCHEME_IPFS
+	DEFANGED_SCHEME_IPN                                  = schemes.DEFANGED_SCHEME_IPN
+	DEFANGED_SCHEME_IPNS                                 = schemes.DEFANGED_SCHEME_IPNS
+	DEFANGED_SCHEME_IPP                                  = schemes.DEFANGED_SCHEME_IPP
+	DEFANGED_SCHEME_IPPS                                 = schemes.DEFANGED_SCHEME_IPPS
+	DEFANGED_SCHEME_IRC                                  = schemes.DEFANGED_SCHEME_IRC
+	DEFANGED_SCHEME_IRC6                                 = schemes.DEFANGED_SCHEME_IRC6
+	DEFANGED_SCHEME_IRCS                                 = schemes.DEFANGED_SCHEME_IRCS
+	DEFANGED_SCHEME_IRIS                                 = schemes.DEFANGED_SCHEME_IRIS
+	DEFANGED_SCHEME_IRIS_BEEP                            = schemes.DEFANGED_SCHEME_IRIS_BEEP
+	DEFANGED_SCHEME_IRIS_LWZ                             = schemes.DEFANGED_SCHEME_IRIS_LWZ
+	DEFANGED_SCHEME_IRIS_XPC                             = schemes.DEFANGED_SCHEME_IRIS_XPC
+	DEFANGED_SCHEME_IRIS_XPCS                            = schemes.DEFANGED_SCHEME_IRIS_XPCS
+	DEFANGED_SCHEME_ISOSTORE                             = schemes.DEFANGED_SCHEME_ISOSTORE
+	DEFANGED_SCHEME_ITMS                                 = schemes.DEFANGED_SCHEME_ITMS
+	DEFANGED_SCHEME_JABBER                               = schemes.DEFANGED_SCHEME_JABBER
+	DEFANGED_SCHEME_JAR                                  = schemes.DEFANGED_SCHEME_JAR
+	DEFANGED_SCHEME_JMS                                  = schemes.DEFANGED_SCHEME_JMS
+	DEFANGED_SCHEME_KEYPARC                              = schemes.DEFANGED_SCHEME_KEYPARC
+	DEFANGED_SCHEME_LASTFM                               = schemes.DEFANGED_SCHEME_LASTFM
+	DEFANGED_SCHEME_LBRY                                 = schemes.DEFANGED_SCHEME_LBRY
+	DEFANGED_SCHEME_LDAP                                 = schemes.DEFANGED_SCHEME_LDAP
+	DEFANGED_SCHEME_LDAPS                                = schemes.DEFANGED_SCHEME_LDAPS
+	DEFANGED_SCHEME_LEAPTOFROGANS                        = schemes.DEFANGED_SCHEME_LEAPTOFROGANS
+	DEFANGED_SCHEME_LID                                  = schemes.DEFANGED_SCHEME_LID
+	DEFANGED_SCHEME_LORAWAN                              = schemes.DEFANGED_SCHEME_LORAWAN
+	DEFANGED_SCHEME_LPA                                  = schemes.DEFANGED_SCHEME_LPA
+	DEFANGED_SCHEME_LVLT                                 = schemes.DEFANGED_SCHEME_LVLT
+	DEFANGED_SCHEME_MACHINEPROVISIONINGPROGRESSREPORTER  = schemes.DEFANGED_SCHEME_MACHINEPROVISIONINGPROGRESSREPORTER
+	DEFANGED_SCHEME_MAGNET                               = schemes.DEFANGED_SCHEME_MAGNET
+	DEFANGED_SCHEME_MAILSERVER                           = schemes.DEFANGED_SCHEME_MAILSERVER
+	DEFANGED_SCHEME_MAILTO                               = schemes.DEFANGED_SCHEME_MAILTO
+	DEFANGED_SCHEME_MAPS                                 = schemes.DEFANGED_SCHEME_MAPS
+	DEFANGED_SCHEME_MARKET                               = schemes.DEFANGED_SCHEME_MARKET
+	DEFANGED_SCHEME_MATRIX                               = schemes.DEFANGED_SCHEME_MATRIX
+	DEFANGED_SCHEME_MESSAGE                              = schemes.DEFANGED_SCHEME_MESSAGE
+	DEFANGED_SCHEME_MICROSOFT_WINDOWS_CAMERA             = schemes.DEFANGED_SCHEME_MICROSOFT_WINDOWS_CAMERA
+	DEFANGED_SCHEME_MICROSOFT_WINDOWS_CAMERA_MULTIPICKER = schemes.DEFANGED_SCHEME_MICROSOFT_WINDOWS_CAMERA_MULTIPICKER
+	DEFANGED_SCHEME_MICROSOFT_WINDOWS_CAMERA_PICKER      = schemes.DEFANGED_SCHEME_MICROSOFT_WINDOWS_CAMERA_PICKER
+	DEFANGED_SCHEME_MID                                  = schemes.DEFANGED_SCHEME_MID
+	DEFANGED_SCHEME_MMS                                  = schemes.DEFANGED_SCHEME_MMS
+	DEFANGED_SCHEME_MODEM                                = schemes.DEFANGED_SCHEME_MODEM
+	DEFANGED_SCHEME_MONGODB                              = schemes.DEFANGED_SCHEME_MONGODB
+	DEFANGED_SCHEME_MOZ                                  = schemes.DEFANGED_SCHEME_MOZ
+	DEFANGED_SCHEME_MS_ACCESS                            = schemes.DEFANGED_SCHEME_MS_ACCESS
+	DEFANGED_SCHEME_MS_APPINSTALLER                      = schemes.DEFANGED_SCHEME_MS_APPINSTALLER
+	DEFANGED_SCHEME_MS_BROWSER_EXTENSION                 = schemes.DEFANGED_SCHEME_MS_BROWSER_EXTENSION
+	DEFANGED_SCHEME_MS_CALCULATOR                        = schemes.DEFANGED_SCHEME_MS_CALCULATOR
+	DEFANGED_SCHEME_MS_DRIVE_TO                          = schemes.DEFANGED_SCHEME_MS_DRIVE_TO
+	DEFANGED_SCHEME_MS_ENROLLMENT                        = schemes.DEFANGED_SCHEME_MS_ENROLLMENT
+	DEFANGED_SCHEME_MS_EXCEL                             = schemes.DEFANGED_SCHEME_MS_EXCEL
+	DEFANGED_SCHEME_MS_EYECONTROLSPEECH                  = schemes.DEFANGED_SCHEME_MS_EYECONTROLSPEECH
+	DEFANGED_SCHEME_MS_GAMEBARSERVICES                   = schemes.DEFANGED_SCHEME_MS_GAMEBARSERVICES
+	DEFANGED_SCHEME_MS_GAMINGOVERLAY                     = schemes.DEFANGED_SCHEME_MS_GAMINGOVERLAY
+	DEFANGED_SCHEME_MS_GETOFFICE                         = schemes.DEFANGED_SCHEME_MS_GETOFFICE
+	DEFANGED_SCHEME_MS_HELP                              = schemes.DEFANGED_SCHEME_MS_HELP
+	DEFANGED_SCHEME_MS_INFOPATH                          = schemes.DEFANGED_SCHEME_MS_INFOPATH
+	DEFANGED_SCHEME_MS_INPUTAPP                          = schemes.DEFANGED_SCHEME_MS_INPUTAPP
+	DEFANGED_SCHEME_MS_LAUNCHREMOTEDESKTOP               = schemes.DEFANGED_SCHEME_MS_LAUNCHREMOTEDESKTOP
+	DEFANGED_SCHEME_MS_LOCKSCREENCOMPONENT_CONFIG        = schemes.DEFANGED_SCHEME_MS_LOCKSCREENCOMPONENT_CONFIG
+	DEFANGED_SCHEME_MS_MEDIA_STREAM_ID                   = schemes.DEFANGED_SCHEME_MS_MEDIA_STREAM_ID
+	DEFANGED_SCHEME_MS_MEETNOW                           = schemes.DEFANGED_SCHEME_MS_MEETNOW
+	DEFANGED_SCHEME_MS_MIXEDREALITYCAPTURE               = schemes.DEFANGED_SCHEME_MS_MIXEDREALITYCAPTURE
+	DEFANGED_SCHEME_MS_MOBILEPLANS                       = schemes.DEFANGED_SCHEME_MS_MOBILEPLANS
+	DEFANGED_SCHEME_MS_NEWSANDINTERESTS                  = schemes.DEFANGED_SCHEME_MS_NEWSANDINTERESTS
+	DEFANGED_SCHEME_MS_OFFICEAPP                         = schemes.DEFANGED_SCHEME_MS_OFFICEAPP
+	DEFANGED_SCHEME_MS_PEOPLE                            = schemes.DEFANGED_SCHEME_MS_PEOPLE
+	DEFANGED_SCHEME_MS_PERSONACARD                       = schemes.DEFANGED_SCHEME_MS_PERSONACARD
+	DEFANGED_SCHEME_MS_POWERPOINT                        = schemes.DEFANGED_SCHEME_MS_POWERPOINT
+	DEFANGED_SCHEME_MS_PROJECT                           = schemes.DEFANGED_SCHEME_MS_PROJECT
+	DEFANGED_SCHEME_MS_PUBLISHER                         = schemes.DEFANGED_SCHEME_MS_PUBLISHER
+	DEFANGED_SCHEME_MS_RECALL                            = schemes.DEFANGED_SCHEME_MS_RECALL
+	DEFANGED_SCHEME_MS_REMOTEDESKTOP                     = schemes.DEFANGED_SCHEME_MS_REMOTEDESKTOP
+	DEFANGED_SCHEME_MS_REMOTEDESKTOP_LAUNCH              = schemes.DEFANGED_SCHEME_MS_REMOTEDESKTOP_LAUNCH
+	DEFANGED_SCHEME_MS_RESTORETABCOMPANION               = schemes.DEFANGED_SCHEME_MS_RESTORETABCOMPANION
+	DEFANGED_SCHEME_MS_SCREENCLIP                        = schemes.DEFANGED_SCHEME_MS_SCREENCLIP
+	DEFANGED_SCHEME_MS_SCREENSKETCH                      = schemes.DEFANGED_SCHEME_MS_SCREENSKETCH
+	DEFANGED_SCHEME_MS_SEARCH                            = schemes.DEFANGED_SCHEME_MS_SEARCH
+	DEFANGED_SCHEME_MS_SEARCH_REPAIR                     = schemes.DEFANGED_SCHEME_MS_SEARCH_REPAIR
+	DEFANGED_SCHEME_MS_SECONDARY_SCREEN_CONTROLLER       = schemes.DEFANGED_SCHEME_MS_SECONDARY_SCREEN_CONTROLLER
+	DEFANGED_SCHEME_MS_SECONDARY_SCREEN_SETUP            = schemes.DEFANGED_SCHEME_MS_SECONDARY_SCREEN_SETUP
+	DEFANGED_SCHEME_MS_SETTINGS                          = schemes.DEFANGED_SCHEME_MS_SETTINGS
+	DEFANGED_SCHEME_MS_SETTINGS_AIRPLANEMODE             = schemes.DEFANGED_SCHEME_MS_SETTINGS_AIRPLANEMODE
+	DEFANGED_SCHEME_MS_SETTINGS_BLUETOOTH                = schemes.DEFANGED_SCHEME_MS_SETTINGS_BLUETOOTH
+	DEFANGED_SCHEME_MS_SETTINGS_CAMERA                   = schemes.DEFANGED_SCHEME_MS_SETTINGS_CAMERA
+	DEFANGED_SCHEME_MS_SETTINGS_CELLULAR                 = schemes.DEFANGED_SCHEME_MS_SETTINGS_CELLULAR
+	DEFANGED_SCHEME_MS_SETTINGS_CLOUDSTORAGE             = schemes.DEFANGED_SCHEME_MS_SETTINGS_CLOUDSTORAGE
+	DEFANGED_SCHEME_MS_SETTINGS_CONNECTABLEDEVICES       = schemes.DEFANGED_SCHEME_MS_SETTINGS_CONNECTABLEDEVICES
+	DEFANGED_SCHEME_MS_SETTINGS_DISPLAYS_TOPOLOGY        = schemes.DEFANGED_SCHEME_MS_SETTINGS_DISPLAYS_TOPOLOGY
+	DEFANGED_SCHEME_MS_SETTINGS_EMAILANDACCOUNTS         = schemes.DEFANGED_SCHEME_MS_SETTINGS_EMAILANDACCOUNTS
+	DEFANGED_SCHEME_MS_SETTINGS_LANGUAGE                 = schemes.DEFANGED_SCHEME_MS_SETTINGS_LANGUAGE
+	DEFANGED_SCHEME_MS_SETTINGS_LOCATION                 = schemes.DEFANGED_SCHEME_MS_SETTINGS_LOCATION
+	DEFANGED_SCHEME_MS_SETTINGS_LOCK                     = schemes.DEFANGED_SCHEME_MS_SETTINGS_LOCK
+	DEFANGED_SCHEME_MS_SETTINGS_NFCTRANSACTIONS          = schemes.DEFANGED_SCHEME_MS_SETTINGS_NFCTRANSACTIONS
+	DEFANGED_SCHEME_MS_SETTINGS_NOTIFICATIONS            = schemes.DEFANGED_SCHEME_MS_SETTINGS_NOTIFICATIONS
+	DEFANGED_SCHEME_MS_SETTINGS_POWER                    = schemes.DEFANGED_SCHEME_MS_SETTINGS_POWER
+	DEFANGED_SCHEME_MS_SETTINGS_PRIVACY                  = schemes.DEFANGED_SCHEME_MS_SETTINGS_PRIVACY
+	DEFANGED_SCHEME_MS_SETTINGS_PROXIMITY                = schemes.DEFANGED_SCHEME_MS_SETTINGS_PROXIMITY
+	DEFANGED_SCHEME_MS_SETTINGS_SCREENROTATION           = schemes.DEFANGED_SCHEME_MS_SETTINGS_SCREENROTATION
+	DEFANGED_SCHEME_MS_SETTINGS_WIFI                     = schemes.DEFANGED_SCHEME_MS_SETTINGS_WIFI
+	DEFANGED_SCHEME_MS_SETTINGS_WORKPLACE                = schemes.DEFANGED_SCHEME_MS_SETTINGS_WORKPLACE
+	DEFANGED_SCHEME_MS_SPD                               = schemes.DEFANGED_SCHEME_MS_SPD
+	DEFANGED_SCHEME_MS_STICKERS                          = schemes.DEFANGED_SCHEME_MS_STICKERS
+	DEFANGED_SCHEME_MS_STTOVERLAY                        = schemes.DEFANGED_SCHEME_MS_STTOVERLAY
+	DEFANGED_SCHEME_MS_TRANSIT_TO                        = schemes.DEFANGED_SCHEME_MS_TRANSIT_TO
+	DEFANGED_SCHEME_MS_USERACTIVITYSET                   = schemes.DEFANGED_SCHEME_MS_USERACTIVITYSET
+	DEFANGED_SCHEME_MS_UUP                               = schemes.DEFANGED_SCHEME_MS_UUP
+	DEFANGED_SCHEME_MS_VIRTUALTOUCHPAD                   = schemes.DEFANGED_SCHEME_MS_VIRTUALTOUCHPAD
+	DEFANGED_SCHEME_MS_VISIO                             = schemes.DEFANGED_SCHEME_MS_VISIO
+	DEFANGED_SCHEME_MS_WALK_TO                           = schemes.DEFANGED_SCHEME_MS_WALK_TO
+	DEFANGED_SCHEME_MS_WHITEBOARD                        = schemes.DEFANGED_SCHEME_MS_WHITEBOARD
+	DEFANGED_SCHEME_MS_WHITEBOARD_CMD                    = schemes.DEFANGED_SCHEME_MS_WHITEBOARD_CMD
+	DEFANGED_SCHEME_MS_WIDGETBOARD                       = schemes.DEFANGED_SCHEME_MS_WIDGETBOARD
+	DEFANGED_SCHEME_MS_WIDGETS                           = schemes.DEFANGED_SCHEME_MS_WIDGETS
+	DEFANGED_SCHEME_MS_WORD                              = schemes.DEFANGED_SCHEME_MS_WORD
+	DEFANGED_SCHEME_MSNIM                                = schemes.DEFANGED_SCHEME_MSNIM
+	DEFANGED_SCHEME_MSRP                                 = schemes.DEFANGED_SCHEME_MSRP
+	DEFANGED_SCHEME_MSRPS                                = schemes.DEFANGED_SCHEME_MSRPS
+	DEFANGED_SCHEME_MSS                                  = schemes.DEFANGED_SCHEME_MSS
+	DEFANGED_SCHEME_MT                                   = schemes.DEFANGED_SCHEME_MT
+	DEFANGED_SCHEME_MTQP                                 = schemes.DEFANGED_SCHEME_MTQP
+	DEFANGED_SCHEME_MTRUST                               = schemes.DEFANGED_SCHEME_MTRUST
+	DEFANGED_SCHEME_MUMBLE                               = schemes.DEFANGED_SCHEME_MUMBLE
+	DEFANGED_SCHEME_MUPDATE                              = schemes.DEFANGED_SCHEME_MUPDATE
+	DEFANGED_SCHEME_MVN                                  = schemes.DEFANGED_SCHEME_MVN
+	DEFANGED_SCHEME_MVRP                                 = schemes.DEFANGED_SCHEME_MVRP
+	DEFANGED_SCHEME_MVRPS                                = schemes.DEFANGED_SCHEME_MVRPS
+	DEFANGED_SCHEME_NEWS                                 = schemes.DEFANGED_SCHEME_NEWS
+	DEFANGED_SCHEME_NFS                                  = schemes.DEFANGED_SCHEME_NFS
+	DEFANGED_SCHEME_NI                                   = schemes.DEFANGED_SCHEME_NI
+	DEFANGED_SCHEME_NIH                                  = schemes.DEFANGED_SCHEME_NIH
+	DEFANGED_SCHEME_NNTP                                 = schemes.DEFANGED_SCHEME_NNTP
+	DEFANGED_SCHEME_NOTES                                = schemes.DEFANGED_SCHEME_NOTES
+	DEFANGED_SCHEME_NUM                                  = schemes.DEFANGED_SCHEME_NUM
+	DEFANGED_SCHEME_OCF                                  = schemes.DEFANGED_SCHEME_OCF
+	DEFANGED_SCHEME_OID                                  = schemes.DEFANGED_SCHEME_OID
+	DEFANGED_SCHEME_ONENOTE                              = schemes.DEFANGED_SCHEME_ONENOTE
+	DEFANGED_SCHEME_ONENOTE_CMD                          = schemes.DEFANGED_SCHEME_ONENOTE_CMD
+	DEFANGED_SCHEME_OPAQUELOCKTOKEN                      = schemes.DEFANGED_SCHEME_OPAQUELOCKTOKEN
+	DEFANGED_SCHEME_OPENID                               = schemes.DEFANGED_SCHEME_OPENID
+	DEFANGED_SCHEME_OPENPGP4FPR                          = schemes.DEFANGED_SCHEME_OPENPGP4FPR
+	DEFANGED_SCHEME_OTPAUTH                              = schemes.DEFANGED_SCHEME_OTPAUTH
+	DEFANGED_SCHEME_P1                                   = schemes.DEFANGED_SCHEME_P1
+	DEFANGED_SCHEME_PACK                                 = schemes.DEFANGED_SCHEME_PACK
+	DEFANGED_SCHEME_PALM                                 = schemes.DEFANGED_SCHEME_PALM
+	DEFANGED_SCHEME_PAPARAZZI                            = schemes.DEFANGED_SCHEME_PAPARAZZI
+	DEFANGED_SCHEME_PAYMENT                              = schemes.DEFANGED_SCHEME_PAYMENT
+	DEFANGED_SCHEME_PAYTO                                = schemes.DEFANGED_SCHEME_PAYTO
+	DEFANGED_SCHEME_PKCS11                               = schemes.DEFANGED_SCHEME_PKCS11
+	DEFANGED_SCHEME_PLATFORM                             = schemes.DEFANGED_SCHEME_PLATFORM
+	DEFANGED_SCHEME_POP                                  = schemes.DEFANGED_SCHEME_POP
+	DEFANGED_SCHEME_PRES                                 = schemes.DEFANGED_SCHEME_PRES
+	DEFANGED_SCHEME_PROSPERO                             = schemes.DEFANGED_SCHEME_PROSPERO
+	DEFANGED_SCHEME_PROXY                                = schemes.DEFANGED_SCHEME_PROXY
+	DEFANGED_SCHEME_PSYC                                 = schemes.DEFANGED_SCHEME_PSYC
+	DEFANGED_SCHEME_PTTP                                 = schemes.DEFANGED_SCHEME_PTTP
+	DEFANGED_SCHEME_PWID                                 = schemes.DEFANGED_SCHEME_PWID
+	DEFANGED_SCHEME_QB                                   = schemes.DEFANGED_SCHEME_QB
+	DEFANGED_SCHEME_QUERY                                = schemes.DEFANGED_SCHEME_QUERY
+	DEFANGED_SCHEME_QUIC_TRANSPORT                       = schemes.DEFANGED_SCHEME_QUIC_TRANSPORT
+	DEFANGED_SCHEME_REDIS                                = schemes.DEFANGED_SCHEME_REDIS
+	DEFANGED_SCHEME_REDISS                               = schemes.DEFANGED_SCHEME_REDISS
+	DEFANGED_SCHEME_RELOAD                               = schemes.DEFANGED_SCHEME_RELOAD
+	DEFANGED_SCHEME_RES                                  = schemes.DEFANGED_SCHEME_RES
+	DEFANGED_SCHEME_RESOURCE                             = schemes.DEFANGED_SCHEME_RESOURCE
+	DEFANGED_SCHEME_RMI                                  = schemes.DEFANGED_SCHEME_RMI
+	DEFANGED_SCHEME_RSYNC                                = schemes.DEFANGED_SCHEME_RSYNC
+	DEFANGED_SCHEME_RTMFP                                = schemes.DEFANGED_SCHEME_RTMFP
+	DEFANGED_SCHEME_RTMP                                 = schemes.DEFANGED_SCHEME_RTMP
+	DEFANGED_SCHEME_RTSP                                 = schemes.DEFANGED_SCHEME_RTSP
+	DEFANGED_SCHEME_RTSPS                                = schemes.DEFANGED_SCHEME_RTSPS
+	DEFANGED_SCHEME_RTSPU                                = schemes.DEFANGED_SCHEME_RTSPU
+	DEFANGED_SCHEME_SARIF                                = schemes.DEFANGED_SCHEME_SARIF
+	DEFANGED_SCHEME_SECONDLIFE                           = schemes.DEFANGED_SCHEME_SECONDLIFE
+	DEFANGED_SCHEME_SECRET_TOKEN                         = schemes.DEFANGED_SCHEME_SECRET_TOKEN
+	DEFANGED_SCHEME_SERVICE                              = schemes.DEFANGED_SCHEME_SERVICE
+	DEFANGED_SCHEME_SESSION                              = schemes.DEFANGED_SCHEME_SESSION
+	DEFANGED_SCHEME_SFTP                                 = schemes.DEFANGED_SCHEME_SFTP
+	DEFANGED_SCHEME_SGN                                  = schemes.DEFANGED_SCHEME_SGN
+	DEFANGED_SCHEME_SHC                                  = schemes.DEFANGED_SCHEME_SHC
+	DEFANGED_SCHEME_SHELTER                              = schemes.DEFANGED_SCHEME_SHELTER
+	DEFANGED_SCHEME_SHTTP                                = schemes.DEFANGED_SCHEME_SHTTP
+	DEFANGED_SCHEME_SIEVE                                = schemes.DEFANGED_SCHEME_SIEVE
+	DEFANGED_SCHEME_SIMPLELEDGER                         = schemes.DEFANGED_SCHEME_SIMPLELEDGER
+	DEFANGED_SCHEME_SIMPLEX                              = schemes.DEFANGED_SCHEME_SIMPLEX
+	DEFANGED_SCHEME_SIP                                  = schemes.DEFANGED_SCHEME_SIP
+	DEFANGED_SCHEME_SIPS                                 = schemes.DEFANGED_SCHEME_SIPS
+	DEFANGED_SCHEME_SKYPE                                = schemes.DEFANGED_SCHEME_SKYPE
+	DEFANGED_SCHEME_SMB                                  = schemes.DEFANGED_SCHEME_SMB
+	DEFANGED_SCHEME_SMP                                  = schemes.DEFANGED_SCHEME_SMP
+	DEFANGED_SCHEME_SMS                                  = schemes.DEFANGED_SCHEME_SMS
+	DEFANGED_SCHEME_SMTP                                 = schemes.DEFANGED_SCHEME_SMTP
+	DEFANGED_SCHEME_SNEWS                                = schemes.DEFANGED_SCHEME_SNEWS
+	DEFANGED_SCHEME_SNMP                                 = schemes.DEFANGED_SCHEME_SNMP
+	DEFANGED_SCHEME_SOAP_BEEP                            = schemes.DEFANGED_SCHEME_SOAP_BEEP
+	DEFANGED_SCHEME_SOAP_BEEPS                           = schemes.DEFANGED_SCHEME_SOAP_BEEPS
+	DEFANGED_SCHEME_SOLDAT                               = schemes.DEFANGED_SCHEME_SOLDAT
+	DEFANGED_SCHEME_SPIFFE                               = schemes.DEFANGED_SCHEME_SPIFFE
+	DEFANGED_SCHEME_SPOTIFY                              = schemes.DEFANGED_SCHEME_SPOTIFY
+	DEFANGED_SCHEME_SSB                                  = schemes.DEFANGED_SCHEME_SSB
+	DEFANGED_SCHEME_SSH                                  = schemes.DEFANGED_SCHEME_SSH
+	DEFANGED_SCHEME_STARKNET                             = schemes.DEFANGED_SCHEME_STARKNET
+	DEFANGED_SCHEME_STEAM                                = schemes.DEFANGED_SCHEME_STEAM
+	DEFANGED_SCHEME_STUN                                 = schemes.DEFANGED_SCHEME_STUN
+	DEFANGED_SCHEME_STUNS                                = schemes.DEFANGED_SCHEME_STUNS
+	DEFANGED_SCHEME_SUBMIT                               = schemes.DEFANGED_SCHEME_SUBMIT
+	DEFANGED_SCHEME_SVN                                  = schemes.DEFANGED_SCHEME_SVN
+	DEFANGED_SCHEME_SWH                                  = schemes.DEFANGED_SCHEME_SWH
+	DEFANGED_SCHEME_SWID                                 = schemes.DEFANGED_SCHEME_SWID
+	DEFANGED_SCHEME_SWIDPATH                             = schemes.DEFANGED_SCHEME_SWIDPATH
+	DEFANGED_SCHEME_TAG                                  = schemes.DEFANGED_SCHEME_TAG
+	DEFANGED_SCHEME_TALER                                = schemes.DEFANGED_SCHEME_TALER
+	DEFANGED_SCHEME_TEAMSPEAK                            = schemes.DEFANGED_SCHEME_TEAMSPEAK
+	DEFANGED_SCHEME_TEAPOT                               = schemes.DEFANGED_SCHEME_TEAPOT
+	DEFANGED_SCHEME_TEAPOTS                              = schemes.DEFANGED_SCHEME_TEAPOTS
+	DEFANGED_SCHEME_TEL                                  = schemes.DEFANGED_SCHEME_TEL
+	DEFANGED_SCHEME_TELIAEID                             = schemes.DEFANGED_SCHEME_TELIAEID
+	DEFANGED_SCHEME_TELNET                               = schemes.DEFANGED_SCHEME_TELNET
+	DEFANGED_SCHEME_TFTP                                 = schemes.DEFANGED_SCHEME_TFTP
+	DEFANGED_SCHEME_THINGS                               = schemes.DEFANGED_SCHEME_THINGS
+	DEFANGED_SCHEME_THISMESSAGE                          = schemes.DEFANGED_SCHEME_THISMESSAGE
+	DEFANGED_SCHEME_THZP                                 = schemes.DEFANGED_SCHEME_THZP
+	DEFANGED_SCHEME_TIP                                  = schemes.DEFANGED_SCHEME_TIP
+	DEFANGED_SCHEME_TN3270                               = schemes.DEFANGED_SCHEME_TN3270
+	DEFANGED_SCHEME_TOOL                                 = schemes.DEFANGED_SCHEME_TOOL
+	DEFANGED_SCHEME_TURN                                 = schemes.DEFANGED_SCHEME_TURN
+	DEFANGED_SCHEME_TURNS                                = schemes.DEFANGED_SCHEME_TURNS
+	DEFANGED_SCHEME_TV                                   = schemes.DEFANGED_SCHEME_TV
+	DEFANGED_SCHEME_UDP                                  = schemes.DEFANGED_SCHEME_UDP
+	DEFANGED_SCHEME_UNREAL                               = schemes.DEFANGED_SCHEME_UNREAL
+	DEFANGED_SCHEME_UPT                                  = schemes.DEFANGED_SCHEME_UPT
+	DEFANGED_SCHEME_URN                                  = schemes.DEFANGED_SCHEME_URN
+	DEFANGED_SCHEME_UT2004                               = schemes.DEFANGED_SCHEME_UT2004
+	DEFANGED_SCHEME_UUID_IN_PACKAGE                      = schemes.DEFANGED_SCHEME_UUID_IN_PACKAGE
+	DEFANGED_SCHEME_V_EVENT                              = schemes.DEFANGED_SCHEME_V_EVENT
+	DEFANGED_SCHEME_VEMMI                                = schemes.DEFANGED_SCHEME_VEMMI
+	DEFANGED_SCHEME_VENTRILO                             = schemes.DEFANGED_SCHEME_VENTRILO
+	DEFANGED_SCHEME_VES                                  = schemes.DEFANGED_SCHEME_VES
+	DEFANGED_SCHEME_VIDEOTEX                             = schemes.DEFANGED_SCHEME_VIDEOTEX
+	DEFANGED_SCHEME_VIEW_SOURCE                          = schemes.DEFANGED_SCHEME_VIEW_SOURCE
+	DEFANGED_SCHEME_VNC                                  = schemes.DEFANGED_SCHEME_VNC
+	DEFANGED_SCHEME_VSCODE                               = schemes.DEFANGED_SCHEME_VSCODE
+	DEFANGED_SCHEME_VSCODE_INSIDERS                      = schemes.DEFANGED_SCHEME_VSCODE_INSIDERS
+	DEFANGED_SCHEME_VSLS                                 = schemes.DEFANGED_SCHEME_VSLS
+	DEFANGED_SCHEME_W3                                   = schemes.DEFANGED_SCHEME_W3
+	DEFANGED_SCHEME_WAIS                                 = schemes.DEFANGED_SCHEME_WAIS
+	DEFANGED_SCHEME_WASM                                 = schemes.DEFANGED_SCHEME_WASM
+	DEFANGED_SCHEME_WASM_JS                              = schemes.DEFANGED_SCHEME_WASM_JS
+	DEFANGED_SCHEME_WCR                                  = schemes.DEFANGED_SCHEME_WCR
+	DEFANGED_SCHEME_WEB_AP                               = schemes.DEFANGED_SCHEME_WEB_AP
+	DEFANGED_SCHEME_WEB3                                 = schemes.DEFANGED_SCHEME_WEB3
+	DEFANGED_SCHEME_WEBCAL                               = schemes.DEFANGED_SCHEME_WEBCAL
+	DEFANGED_SCHEME_WIFI                                 = schemes.DEFANGED_SCHEME_WIFI
+	DEFANGED_SCHEME_WPID                                 = schemes.DEFANGED_SCHEME_WPID
+	DEFANGED_SCHEME_WS                                   = schemes.DEFANGED_SCHEME_WS
+	DEFANGED_SCHEME_WSS                                  = schemes.DEFANGED_SCHEME_WSS
+	DEFANGED_SCHEME_WTAI                                 = schemes.DEFANGED_SCHEME_WTAI
+	DEFANGED_SCHEME_WYCIWYG                              = schemes.DEFANGED_SCHEME_WYCIWYG
+	DEFANGED_SCHEME_XCON                                 = schemes.DEFANGED_SCHEME_XCON
+	DEFANGED_SCHEME_XCON_USERID                          = schemes.DEFANGED_SCHEME_XCON_USERID
+	DEFANGED_SCHEME_XFIRE                                = schemes.DEFANGED_SCHEME_XFIRE
+	DEFANGED_SCHEME_XFTP                                 = schemes.DEFANGED_SCHEME_XFTP
+	DEFANGED_SCHEME_XMLRPC_BEEP                          = schemes.DEFANGED_SCHEME_XMLRPC_BEEP
+	DEFANGED_SCHEME_XMLRPC_BEEPS                         = schemes.DEFANGED_SCHEME_XMLRPC_BEEPS
+	DEFANGED_SCHEME_XMPP                                 = schemes.DEFANGED_SCHEME_XMPP
+	DEFANGED_SCHEME_XRCP                                 = schemes.DEFANGED_SCHEME_XRCP
+	DEFANGED_SCHEME_XRI                                  = schemes.DEFANGED_SCHEME_XRI
+	DEFANGED_SCHEME_YMSGR                                = schemes.DEFANGED_SCHEME_YMSGR
+	DEFANGED_SCHEME_Z39_50                               = schemes.DEFANGED_SCHEME_Z39_50
+	DEFANGED_SCHEME_Z39_50R                              = schemes.DEFANGED_SCHEME_Z39_50R
+	DEFANGED_SCHEME_Z39_50S                              = schemes.DEFANGED_SCHEME_Z39_50S
+)
+
+// Defanged forms of all registered URI schemes, sorted and deduplicated
+var DefangedSchemeNames = schemes.DefangedSchemeNames
@@ -0,0 +1,189 @@
+package defang_schemes
+
+import (
+	"io"
+	"iter"
+	"regexp"
+
+	"github.com/jakewilliami/defang-schemes/schemes"
+)
+
+type (
+	FieldChange     = schemes.FieldChange
+	SchemeChange    = schemes.SchemeChange
+	Changelog       = schemes.Changelog
+	Completion      = schemes.Completion
+	Status          = schemes.Status
+	Scheme          = schemes.Scheme
+	Deprecation     = schemes.Deprecation
+	EdgeCase        = schemes.EdgeCase
+	SchemeCandidate = schemes.SchemeCandidate
+	SchemeIndex     = schemes.SchemeIndex
+	Style           = schemes.Style
+	WellKnownURI    = schemes.WellKnownURI
+)
+
+const (
+	Permanent              = schemes.Permanent
+	Provisional            = schemes.Provisional
+	Historical             = schemes.Historical
+	MINIMAL_DATA           = schemes.MINIMAL_DATA
+	GUESS_MAX_DISTANCE     = schemes.GUESS_MAX_DISTANCE
+	INDEX_MIN_PREFIX       = schemes.INDEX_MIN_PREFIX
+	DATASET_SCHEMA_VERSION = schemes.DATASET_SCHEMA_VERSION
+	StyleHxx               = schemes.StyleHxx
+	StyleBrackets          = schemes.StyleBrackets
+	TEMPLATE_BASE_URL      = schemes.TEMPLATE_BASE_URL
+	WELL_KNOWN_PATH_PREFIX = schemes.WELL_KNOWN_PATH_PREFIX
+)
+
+var (
+	ADDITIONAL_ALLOWED_SCHEME_CHARS = schemes.ADDITIONAL_ALLOWED_SCHEME_CHARS
+	// Deprecated: use AdditionalAllowedSchemeCharsPattern, which is compiled once on first use
+	ADDITIONAL_ALLOWED_SCHEME_CHARS_PATTERN = schemes.ADDITIONAL_ALLOWED_SCHEME_CHARS_PATTERN
+	// Deprecated: use SchemeNamePattern, which is compiled once on first use
+	SCHEME_PATTERN      = schemes.SCHEME_PATTERN
+	SCHEME_REPLACEMENTS = schemes.SCHEME_REPLACEMENTS
+	ErrUnknownColumn    = schemes.ErrUnknownColumn
+	ErrDataNotGenerated = schemes.ErrDataNotGenerated
+	SCHEME_EXAMPLES     = schemes.SCHEME_EXAMPLES
+	CSV_COLUMNS         = schemes.CSV_COLUMNS
+	DATASET_MIGRATIONS  = schemes.DATASET_MIGRATIONS
+	STYLES              = schemes.STYLES
+	WellKnownMap        = schemes.WellKnownMap
+)
+
+// As schemes.DiffSchemes
+func DiffSchemes(old, new map[string]Scheme) Changelog {
+	return schemes.DiffSchemes(old, new)
+}
+
+// As schemes.Completions
+func Completions() []Completion {
+	return schemes.Completions()
+}
+
+// As schemes.DeprecationOf
+func DeprecationOf(scheme string) (Deprecation, bool) {
+	return schemes.DeprecationOf(scheme)
+}
+
+// As schemes.EdgeCases
+func EdgeCases() []EdgeCase {
+	return schemes.EdgeCases()
+}
+
+// As schemes.IsEdgeCase
+func IsEdgeCase(scheme string) bool {
+	return schemes.IsEdgeCase(scheme)
+}
+
+// As schemes.SchemeExamples
+func SchemeExamples(scheme string) []string {
+	return schemes.SchemeExamples(scheme)
+}
+
+// As schemes.WriteCSV
+func WriteCSV(w io.Writer, dataset map[string]Scheme) error {
+	return schemes.WriteCSV(w, dataset)
+}
+
+// As schemes.DataGenerated
+func DataGenerated() error {
+	return schemes.DataGenerated()
+}
+
+// As schemes.GuessScheme
+func GuessScheme(damaged string) []SchemeCandidate {
+	return schemes.GuessScheme(damaged)
+}
+
+// As schemes.NewSchemeIndex
+func NewSchemeIndex(names []string) *SchemeIndex {
+	return schemes.NewSchemeIndex(names)
+}
+
+// As schemes.Index
+func Index() *SchemeIndex {
+	return schemes.Index()
+}
+
+// As schemes.WriteJSON
+func WriteJSON(w io.Writer, dataset map[string]Scheme) error {
+	return schemes.WriteJSON(w, dataset)
+}
+
+// As schemes.LoadFromJSON
+func LoadFromJSON(r io.Reader) (map[string]Scheme, error) {
+	return schemes.LoadFromJSON(r)
+}
+
+// As schemes.IsValidScheme
+func IsValidScheme(s string) bool {
+	return schemes.IsValidScheme(s)
+}
+
+// As schemes.Lookup
+func Lookup(scheme string) (Scheme, bool) {
+	return schemes.Lookup(scheme)
+}
+
+// As schemes.SchemeOf
+func SchemeOf(raw string) (Scheme, bool) {
+	return schemes.SchemeOf(raw)
+}
+
+// As schemes.SchemeNames
+func SchemeNames() []string {
+	return schemes.SchemeNames()
+}
+
+// As schemes.All
+func All() iter.Seq2[string, Scheme] {
+	return schemes.All()
+}
+
+// As schemes.AdditionalAllowedSchemeCharsPattern
+func AdditionalAllowedSchemeCharsPattern() *regexp.Regexp {
+	return schemes.AdditionalAllowedSchemeCharsPattern()
+}
+
+// As schemes.SchemeNamePattern
+func SchemeNamePattern() *regexp.Regexp {
+	return schemes.SchemeNamePattern()
+}
+
+// As schemes.RelateSchemes
+func RelateSchemes(dataset map[string]Scheme) map[string][]string {
+	return schemes.RelateSchemes(dataset)
+}
+
+// As schemes.RelatedSchemes
+func RelatedSchemes(scheme string) []Scheme {
+	return schemes.RelatedSchemes(scheme)
+}
+
+// As schemes.SchemesByStatus
+func SchemesByStatus(status Status) []Scheme {
+	return schemes.SchemesByStatus(status)
+}
+
+// As schemes.PermanentSchemes
+func PermanentSchemes() []Scheme {
+	return schemes.PermanentSchemes()
+}
+
+// As schemes.ProvisionalSchemes
+func ProvisionalSchemes() []Scheme {
+	return schemes.ProvisionalSchemes()
+}
+
+// As schemes.HistoricalSchemes
+func HistoricalSchemes() []Scheme {
+	return schemes.HistoricalSchemes()
+}
+
+// As schemes.ResolveTemplate
+func ResolveTemplate(template string) string {
+	return schemes.ResolveTemplate(template)
+}
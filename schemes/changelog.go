@@ -0,0 +1,91 @@
+package schemes
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// A change to one field of a scheme between two versions of the dataset
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// The changed fields of a scheme present in both versions of the dataset
+type SchemeChange struct {
+	Scheme string        `json:"scheme"`
+	Fields []FieldChange `json:"fields"`
+}
+
+// Scheme-level differences between two versions of the dataset, each sorted by scheme
+type Changelog struct {
+	Added   []Scheme       `json:"added"`
+	Removed []Scheme       `json:"removed"`
+	Changed []SchemeChange `json:"changed"`
+}
+
+// Compute the changes from the old to the new version of the dataset
+func DiffSchemes(old, new map[string]Scheme) Changelog {
+	var changelog Changelog
+
+	for key, newScheme := range new {
+		oldScheme, exists := old[key]
+		if !exists {
+			changelog.Added = append(changelog.Added, newScheme)
+			continue
+		}
+		if fields := diffSchemeFields(oldScheme, newScheme); len(fields) > 0 {
+			changelog.Changed = append(changelog.Changed, SchemeChange{Scheme: key, Fields: fields})
+		}
+	}
+	for key, oldScheme := range old {
+		if _, exists := new[key]; !exists {
+			changelog.Removed = append(changelog.Removed, oldScheme)
+		}
+	}
+
+	sort.Slice(changelog.Added, func(i, j int) bool { return changelog.Added[i].Scheme < changelog.Added[j].Scheme })
+	sort.Slice(changelog.Removed, func(i, j int) bool { return changelog.Removed[i].Scheme < changelog.Removed[j].Scheme })
+	sort.Slice(changelog.Changed, func(i, j int) bool { return changelog.Changed[i].Scheme < changelog.Changed[j].Scheme })
+
+	return changelog
+}
+
+// Compare each field of the Scheme struct, in order of declaration
+func diffSchemeFields(old, new Scheme) []FieldChange {
+	var fields []FieldChange
+	oldVal, newVal := reflect.ValueOf(old), reflect.ValueOf(new)
+	for i := 0; i < oldVal.NumField(); i++ {
+		oldField := fmt.Sprint(oldVal.Field(i).Interface())
+		newField := fmt.Sprint(newVal.Field(i).Interface())
+		if oldField != newField {
+			fields = append(fields, FieldChange{Field: oldVal.Type().Field(i).Name, Old: oldField, New: newField})
+		}
+	}
+	return fields
+}
+
+// Whether there are no differences between the datasets
+func (c Changelog) Empty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0 && len(c.Changed) == 0
+}
+
+// Human-readable summary of the changes, one line per change
+func (c Changelog) String() string {
+	var b strings.Builder
+	for _, scheme := range c.Added {
+		fmt.Fprintf(&b, "+ %s (%s, defanged as %q)\n", scheme.Scheme, scheme.Status, scheme.DefangedScheme)
+	}
+	for _, scheme := range c.Removed {
+		fmt.Fprintf(&b, "- %s (%s)\n", scheme.Scheme, scheme.Status)
+	}
+	for _, change := range c.Changed {
+		for _, field := range change.Fields {
+			fmt.Fprintf(&b, "~ %s: %s %q → %q\n", change.Scheme, field.Field, field.Old, field.New)
+		}
+	}
+	return b.String()
+}
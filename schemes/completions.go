@@ -0,0 +1,28 @@
+package schemes
+
+import (
+	"sort"
+	"strings"
+)
+
+// A completion suggestion for a scheme, for shell completions and editor plugins
+type Completion struct {
+	Scheme      string `json:"scheme"`
+	Description string `json:"description"`
+}
+
+// Completion suggestions for every registered scheme, sorted by scheme.  Descriptions are
+// collapsed onto one line
+func Completions() []Completion {
+	completions := make([]Completion, 0, len(Schemes()))
+	for _, scheme := range Schemes() {
+		completions = append(completions, Completion{
+			Scheme:      scheme.Scheme,
+			Description: strings.Join(strings.Fields(scheme.Description), " "),
+		})
+	}
+	sort.Slice(completions, func(i, j int) bool {
+		return completions[i].Scheme < completions[j].Scheme
+	})
+	return completions
+}
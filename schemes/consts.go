@@ -0,0 +1,4057 @@
+//go:build !defang_schemes_lazy && !defang_schemes_embed && !defang_schemes_minimal
+
+package schemes
+
+/*
+THIS FILE WAS AUTOMATICALLY GENERATED AT 2026-10-16 07:57:26
+
+Do not edit this file.  Run "go generate" to re-generate this file with an
+updated version of URI schemes from:
+    iana.org/assignments/uri-schemes/uri-schemes.xhtml.
+*/
+
+func init() {
+	Map = map[string]Scheme{
+		"aaa": Scheme{
+			Scheme:              "aaa",
+			DefangedScheme:      "axa",
+			Template:            "",
+			Description:         "Diameter Protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6733]",
+			Notes:               "",
+			Related:             []string{"aaas"},
+		},
+		"aaas": Scheme{
+			Scheme:              "aaas",
+			DefangedScheme:      "aaxs",
+			Template:            "",
+			Description:         "Diameter Protocol with Secure Transport",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6733]",
+			Notes:               "",
+			Related:             []string{"aaa"},
+		},
+		"about": Scheme{
+			Scheme:              "about",
+			DefangedScheme:      "axxut",
+			Template:            "",
+			Description:         "about",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6694]",
+			Notes:               "",
+		},
+		"acap": Scheme{
+			Scheme:              "acap",
+			DefangedScheme:      "acxp",
+			Template:            "",
+			Description:         "application configuration access protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2244]",
+			Notes:               "",
+		},
+		"acct": Scheme{
+			Scheme:              "acct",
+			DefangedScheme:      "acxt",
+			Template:            "",
+			Description:         "acct",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7565]",
+			Notes:               "",
+		},
+		"acd": Scheme{
+			Scheme:              "acd",
+			DefangedScheme:      "axd",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/acd",
+			Description:         "acd",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Michael_Hedenus]",
+			Notes:               "",
+		},
+		"acr": Scheme{
+			Scheme:              "acr",
+			DefangedScheme:      "axr",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/acr",
+			Description:         "acr",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[OMA-OMNA]",
+			Notes:               "",
+		},
+		"adiumxtra": Scheme{
+			Scheme:              "adiumxtra",
+			DefangedScheme:      "axxumxtra",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/adiumxtra",
+			Description:         "adiumxtra",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"adt": Scheme{
+			Scheme:              "adt",
+			DefangedScheme:      "axt",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/adt",
+			Description:         "adt",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[SAP_SE]",
+			Notes:               "",
+		},
+		"afp": Scheme{
+			Scheme:              "afp",
+			DefangedScheme:      "axp",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/afp",
+			Description:         "afp",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"afs": Scheme{
+			Scheme:              "afs",
+			DefangedScheme:      "axs",
+			Template:            "",
+			Description:         "Andrew File System global file names",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC1738]",
+			Notes:               "",
+		},
+		"aim": Scheme{
+			Scheme:              "aim",
+			DefangedScheme:      "axm",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/aim",
+			Description:         "aim",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"amss": Scheme{
+			Scheme:              "amss",
+			DefangedScheme:      "amxs",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/amss",
+			Description:         "amss",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RadioDNS_Project]",
+			Notes:               "",
+		},
+		"android": Scheme{
+			Scheme:              "android",
+			DefangedScheme:      "axxroid",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/android",
+			Description:         "android",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Adam_Barth][https://developer.android.com/guide/topics/manifest/manifest-intro]",
+			Notes:               "",
+		},
+		"appdata": Scheme{
+			Scheme:              "appdata",
+			DefangedScheme:      "axxdata",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/appdata",
+			Description:         "appdata",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"apt": Scheme{
+			Scheme:              "apt",
+			DefangedScheme:      "axx",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/apt",
+			Description:         "apt",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"ar": Scheme{
+			Scheme:              "ar",
+			DefangedScheme:      "ax",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ar",
+			Description:         "ar",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Arweave_Team]",
+			Notes:               "",
+		},
+		"ari": Scheme{
+			Scheme:              "ari",
+			DefangedScheme:      "axi",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ari",
+			Description:         "ari",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-ietf-dtn-ari-04]",
+			Notes:               "",
+		},
+		"ark": Scheme{
+			Scheme:              "ark",
+			DefangedScheme:      "axk",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ark",
+			Description:         "ark",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[ARK_agency][https://n2t.net/ark:/21206/10015]",
+			Notes:               "",
+		},
+		"at": Scheme{
+			Scheme:              "at",
+			DefangedScheme:      "a[t]",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/at",
+			Description:         "at \n      (see [reviewer notes])",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Bluesky_PBLLC][Paul_Frazee]",
+			Notes:               "",
+		},
+		"attachment": Scheme{
+			Scheme:              "attachment",
+			DefangedScheme:      "axxachment",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/attachment",
+			Description:         "attachment",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"aw": Scheme{
+			Scheme:              "aw",
+			DefangedScheme:      "a[w]",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/aw",
+			Description:         "aw",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"barion": Scheme{
+			Scheme:              "barion",
+			DefangedScheme:      "bxxion",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/barion",
+			Description:         "barion",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Bíró_Tamás]",
+			Notes:               "",
+		},
+		"bb": Scheme{
+			Scheme:              "bb",
+			DefangedScheme:      "b[b]",
+			Template:            "https://www.iana.org/assignments/uri-schemes/historic/bb",
+			Description:         "bb",
+			Status:              Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[IESG]",
+			Notes:               "",
+		},
+		"beshare": Scheme{
+			Scheme:              "beshare",
+			DefangedScheme:      "bxxhare",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/beshare",
+			Description:         "beshare",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"bitcoin": Scheme{
+			Scheme:              "bitcoin",
+			DefangedScheme:      "bxxcoin",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/bitcoin",
+			Description:         "bitcoin",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+			Examples:            []string{"bitcoin:1BoatSLRHtKNngkdXEeobR76b53LETtpyT?amount=0.01"},
+		},
+		"bitcoincash": Scheme{
+			Scheme:              "bitcoincash",
+			DefangedScheme:      "bxxcoincash",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/bitcoincash",
+			Description:         "bitcoincash",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Corentin_Mercier]",
+			Notes:               "",
+		},
+		"bl": Scheme{
+			Scheme:              "bl",
+			DefangedScheme:      "bx",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/bl",
+			Description:         "bluetooth (shortened)",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Daniel_Cowling]",
+			Notes:               "",
+		},
+		"blob": Scheme{
+			Scheme:              "blob",
+			DefangedScheme:      "blxb",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/blob",
+			Description:         "blob",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[W3C_WebApps_Working_Group][Chris_Rebert]",
+			Notes:               "",
+		},
+		"bluetooth": Scheme{
+			Scheme:              "bluetooth",
+			DefangedScheme:      "bxxetooth",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/bluetooth",
+			Description:         "bluetooth",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Daniel_Cowling]",
+			Notes:               "",
+		},
+		"bolo": Scheme{
+			Scheme:              "bolo",
+			DefangedScheme:      "boxo",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/bolo",
+			Description:         "bolo",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"brid": Scheme{
+			Scheme:              "brid",
+			DefangedScheme:      "brxd",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/brid",
+			Description:         "brid",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Jürgen_Grupp][Michael_Ranft][Sophie_Schenkel]",
+			Notes:               "",
+		},
+		"browserext": Scheme{
+			Scheme:              "browserext",
+			DefangedScheme:      "bxxwserext",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/browserext",
+			Description:         "browserext",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Mike_Pietraszak]",
+			Notes:               "",
+		},
+		"cabal": Scheme{
+			Scheme:              "cabal",
+			DefangedScheme:      "cxxal",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/cabal",
+			Description:         "cabal",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Frédéric_Wang][Cabal_Club]",
+			Notes:               "",
+		},
+		"calculator": Scheme{
+			Scheme:              "calculator",
+			DefangedScheme:      "cxxculator",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/calculator",
+			Description:         "calculator",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"callto": Scheme{
+			Scheme:              "callto",
+			DefangedScheme:      "cxxlto",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/callto",
+			Description:         "callto",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alexey_Melnikov]",
+			Notes:               "",
+		},
+		"cap": Scheme{
+			Scheme:              "cap",
+			DefangedScheme:      "cxp",
+			Template:            "",
+			Description:         "Calendar Access Protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4324]",
+			Notes:               "",
+		},
+		"cast": Scheme{
+			Scheme:              "cast",
+			DefangedScheme:      "caxt",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/cast",
+			Description:         "cast",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Adam_Barth][https://developers.google.com/cast/docs/registration]",
+			Notes:               "",
+			Related:             []string{"casts"},
+		},
+		"casts": Scheme{
+			Scheme:              "casts",
+			DefangedScheme:      "cxxts",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/casts",
+			Description:         "casts",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Adam_Barth][https://developers.google.com/cast/docs/registration]",
+			Notes:               "",
+			Related:             []string{"cast"},
+		},
+		"chrome": Scheme{
+			Scheme:              "chrome",
+			DefangedScheme:      "cxxome",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/chrome",
+			Description:         "chrome",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"chrome-extension": Scheme{
+			Scheme:              "chrome-extension",
+			DefangedScheme:      "chrome[-]extension",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/chrome-extension",
+			Description:         "chrome-extension",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"cid": Scheme{
+			Scheme:              "cid",
+			DefangedScheme:      "cxd",
+			Template:            "",
+			Description:         "content identifier",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2392]",
+			Notes:               "",
+		},
+		"coap": Scheme{
+			Scheme:              "coap",
+			DefangedScheme:      "coxp",
+			Template:            "",
+			Description:         "coap",
+			Status:              Permanent,
+			WellKnownUriSupport: "[RFC7252]",
+			Reference:           "[RFC7252]",
+			Notes:               "",
+			Related:             []string{"coaps"},
+			Examples:            []string{"coap://sensor.example.com/temperature"},
+		},
+		"coap+tcp": Scheme{
+			Scheme:              "coap+tcp",
+			DefangedScheme:      "coap[+]tcp",
+			Template:            "",
+			Description:         "coap+tcp \n      (see [reviewer notes])",
+			Status:              Permanent,
+			WellKnownUriSupport: "[RFC8323]",
+			Reference:           "[RFC8323]",
+			Notes:               "",
+		},
+		"coap+ws": Scheme{
+			Scheme:              "coap+ws",
+			DefangedScheme:      "coap[+]ws",
+			Template:            "",
+			Description:         "coap+ws \n      (see [reviewer notes])",
+			Status:              Permanent,
+			WellKnownUriSupport: "[RFC8323]",
+			Reference:           "[RFC8323]",
+			Notes:               "",
+		},
+		"coaps": Scheme{
+			Scheme:              "coaps",
+			DefangedScheme:      "cxxps",
+			Template:            "",
+			Description:         "coaps",
+			Status:              Permanent,
+			WellKnownUriSupport: "[RFC7252]",
+			Reference:           "[RFC7252]",
+			Notes:               "",
+			Related:             []string{"coap"},
+		},
+		"coaps+tcp": Scheme{
+			Scheme:              "coaps+tcp",
+			DefangedScheme:      "coaps[+]tcp",
+			Template:            "",
+			Description:         "coaps+tcp \n      (see [reviewer notes])",
+			Status:              Permanent,
+			WellKnownUriSupport: "[RFC8323]",
+			Reference:           "[RFC8323]",
+			Notes:               "",
+		},
+		"coaps+ws": Scheme{
+			Scheme:              "coaps+ws",
+			DefangedScheme:      "coaps[+]ws",
+			Template:            "",
+			Description:         "coaps+ws \n      (see [reviewer notes])",
+			Status:              Permanent,
+			WellKnownUriSupport: "[RFC8323]",
+			Reference:           "[RFC8323]",
+			Notes:               "",
+		},
+		"com-eventbrite-attendee": Scheme{
+			Scheme:              "com-eventbrite-attendee",
+			DefangedScheme:      "com[-]eventbrite[-]attendee",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/com-eventbrite-attendee",
+			Description:         "com-eventbrite-attendee",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Bob_Van_Zant]",
+			Notes:               "",
+		},
+		"content": Scheme{
+			Scheme:              "content",
+			DefangedScheme:      "cxxtent",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/content",
+			Description:         "content",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"content-type": Scheme{
+			Scheme:              "content-type",
+			DefangedScheme:      "content[-]type",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/content-type",
+			Description:         "content-type",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Donald_Eastlake]",
+			Notes:               "",
+		},
+		"crid": Scheme{
+			Scheme:              "crid",
+			DefangedScheme:      "crxd",
+			Template:            "",
+			Description:         "TV-Anytime Content Reference Identifier",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4078]",
+			Notes:               "",
+		},
+		"cstr": Scheme{
+			Scheme:              "cstr",
+			DefangedScheme:      "csxr",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/cstr",
+			Description:         "cstr",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Wang_Shu]",
+			Notes:               "",
+		},
+		"cvs": Scheme{
+			Scheme:              "cvs",
+			DefangedScheme:      "cxs",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/cvs",
+			Description:         "cvs",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"dab": Scheme{
+			Scheme:              "dab",
+			DefangedScheme:      "dxb",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/dab",
+			Description:         "dab",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RadioDNS_Project]",
+			Notes:               "",
+		},
+		"dat": Scheme{
+			Scheme:              "dat",
+			DefangedScheme:      "dxt",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/dat",
+			Description:         "dat",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Frédéric_Wang][Paul_Frazee]",
+			Notes:               "",
+		},
+		"data": Scheme{
+			Scheme:              "data",
+			DefangedScheme:      "daxa",
+			Template:            "",
+			Description:         "data",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2397]",
+			Notes:               "",
+			Examples:            []string{"data:text/plain;base64,SGVsbG8=", "data:text/html,<b>hello</b>"},
+		},
+		"dav": Scheme{
+			Scheme:              "dav",
+			DefangedScheme:      "dxv",
+			Template:            "",
+			Description:         "dav",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4918]",
+			Notes:               "",
+		},
+		"dhttp": Scheme{
+			Scheme:              "dhttp",
+			DefangedScheme:      "dxxtp",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/dhttp",
+			Description:         "dhttp \n      (see [reviewer notes])",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Qi_Zhou]",
+			Notes:               "",
+		},
+		"diaspora": Scheme{
+			Scheme:              "diaspora",
+			DefangedScheme:      "dxxspora",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/diaspora",
+			Description:         "diaspora",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dennis_Schubert]",
+			Notes:               "",
+		},
+		"dict": Scheme{
+			Scheme:              "dict",
+			DefangedScheme:      "dixt",
+			Template:            "",
+			Description:         "dictionary service protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2229]",
+			Notes:               "",
+		},
+		"did": Scheme{
+			Scheme:              "did",
+			DefangedScheme:      "dxd",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/did",
+			Description:         "did",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[W3C_Decentralized_Identifier_Working_Group][Manu_Sporny][Ivan_Herman]",
+			Notes:               "",
+		},
+		"dis": Scheme{
+			Scheme:              "dis",
+			DefangedScheme:      "d[i]s",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/dis",
+			Description:         "dis",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Christophe_Meessen]",
+			Notes:               "",
+		},
+		"dlna-playcontainer": Scheme{
+			Scheme:              "dlna-playcontainer",
+			DefangedScheme:      "dlna[-]playcontainer",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/dlna-playcontainer",
+			Description:         "dlna-playcontainer",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[DLNA]",
+			Notes:               "",
+		},
+		"dlna-playsingle": Scheme{
+			Scheme:              "dlna-playsingle",
+			DefangedScheme:      "dlna[-]playsingle",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/dlna-playsingle",
+			Description:         "dlna-playsingle",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[DLNA]",
+			Notes:               "",
+		},
+		"dns": Scheme{
+			Scheme:              "dns",
+			DefangedScheme:      "dxs",
+			Template:            "",
+			Description:         "Domain Name System",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4501]",
+			Notes:               "",
+			Examples:            []string{"dns:example.com?type=A"},
+		},
+		"dntp": Scheme{
+			Scheme:              "dntp",
+			DefangedScheme:      "dnxp",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/dntp",
+			Description:         "dntp",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Hans-Dieter_A._Hiep]",
+			Notes:               "",
+		},
+		"doi": Scheme{
+			Scheme:              "doi",
+			DefangedScheme:      "dxi",
+			Template:            "",
+			Description:         "doi",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[DOI URI Scheme][Pierre-Anthony_Lemieux][DOI_Foundation]",
+			Notes:               "",
+		},
+		"dpp": Scheme{
+			Scheme:              "dpp",
+			DefangedScheme:      "dxp",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/dpp",
+			Description:         "dpp",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Gaurav_Jain][Wi-Fi_Alliance]",
+			Notes:               "",
+		},
+		"drm": Scheme{
+			Scheme:              "drm",
+			DefangedScheme:      "dxm",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/drm",
+			Description:         "drm",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RadioDNS_Project]",
+			Notes:               "",
+		},
+		"drop": Scheme{
+			Scheme:              "drop",
+			DefangedScheme:      "drxp",
+			Template:            "https://www.iana.org/assignments/uri-schemes/historic/drop",
+			Description:         "drop",
+			Status:              Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[IESG]",
+			Notes:               "",
+		},
+		"dtmi": Scheme{
+			Scheme:              "dtmi",
+			DefangedScheme:      "dtxi",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/dtmi",
+			Description:         "dtmi",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"dtn": Scheme{
+			Scheme:              "dtn",
+			DefangedScheme:      "dxn",
+			Template:            "",
+			Description:         "DTNRG research and development",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC9171]",
+			Notes:               "",
+		},
+		"dvb": Scheme{
+			Scheme:              "dvb",
+			DefangedScheme:      "d[v]b",
+			Template:            "",
+			Description:         "dvb",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-mcroberts-uri-dvb-09]",
+			Notes:               "",
+		},
+		"dvx": Scheme{
+			Scheme:              "dvx",
+			DefangedScheme:      "dxx",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/dvx",
+			Description:         "dvx",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Clemens_Bastian]",
+			Notes:               "",
+		},
+		"dweb": Scheme{
+			Scheme:              "dweb",
+			DefangedScheme:      "dwxb",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/dweb",
+			Description:         "dweb",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Frédéric_Wang][Protocol_Labs]",
+			Notes:               "",
+		},
+		"ed2k": Scheme{
+			Scheme:              "ed2k",
+			DefangedScheme:      "edxk",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ed2k",
+			Description:         "ed2k",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"eid": Scheme{
+			Scheme:              "eid",
+			DefangedScheme:      "exd",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/eid",
+			Description:         "eid",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[eSIM_Group_GSM_Association]",
+			Notes:               "",
+		},
+		"elsi": Scheme{
+			Scheme:              "elsi",
+			DefangedScheme:      "elxi",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/elsi",
+			Description:         "elsi",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Kimmo_Lindholm]",
+			Notes:               "",
+		},
+		"embedded": Scheme{
+			Scheme:              "embedded",
+			DefangedScheme:      "exxedded",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/embedded",
+			Description:         "embedded",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Peter_Hoddie]",
+			Notes:               "",
+		},
+		"ens": Scheme{
+			Scheme:              "ens",
+			DefangedScheme:      "exs",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ens",
+			Description:         "ens",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Ricky_Bloomfield][Bradley_Nelson]",
+			Notes:               "",
+		},
+		"ethereum": Scheme{
+			Scheme:              "ethereum",
+			DefangedScheme:      "exxereum",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ethereum",
+			Description:         "ethereum",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Frédéric_Wang][ligi]",
+			Notes:               "",
+		},
+		"example": Scheme{
+			Scheme:              "example",
+			DefangedScheme:      "exxmple",
+			Template:            "",
+			Description:         "example",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7595]",
+			Notes:               "",
+		},
+		"facetime": Scheme{
+			Scheme:              "facetime",
+			DefangedScheme:      "fxxetime",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/facetime",
+			Description:         "facetime",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"fax": Scheme{
+			Scheme:              "fax",
+			DefangedScheme:      "fxx",
+			Template:            "",
+			Description:         "fax",
+			Status:              Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2806][RFC3966]",
+			Notes:               "",
+			Related:             []string{"tel"},
+		},
+		"feed": Scheme{
+			Scheme:              "feed",
+			DefangedScheme:      "fexd",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/feed",
+			Description:         "feed",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"feedready": Scheme{
+			Scheme:              "feedready",
+			DefangedScheme:      "fxxdready",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/feedready",
+			Description:         "feedready",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Mirko_Nosenzo]",
+			Notes:               "",
+		},
+		"fido": Scheme{
+			Scheme:              "fido",
+			DefangedScheme:      "fixo",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/fido",
+			Description:         "fido",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Adam_Langley]",
+			Notes:               "",
+		},
+		"file": Scheme{
+			Scheme:              "file",
+			DefangedScheme:      "fixe",
+			Template:            "",
+			Description:         "Host-specific file names",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC8089]",
+			Notes:               "",
+			Examples:            []string{"file:///etc/hosts", "file://fileserver.example.com/share/report.docx"},
+		},
+		"filesystem": Scheme{
+			Scheme:              "filesystem",
+			DefangedScheme:      "fxxesystem",
+			Template:            "https://www.iana.org/assignments/uri-schemes/historic/filesystem",
+			Description:         "filesystem",
+			Status:              Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[W3C_WebApps_Working_Group][Chris_Rebert]",
+			Notes:               "",
+		},
+		"finger": Scheme{
+			Scheme:              "finger",
+			DefangedScheme:      "fxxger",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/finger",
+			Description:         "finger",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"first-run-pen-experience": Scheme{
+			Scheme:              "first-run-pen-experience",
+			DefangedScheme:      "first[-]run[-]pen[-]experience",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/first-run-pen-experience",
+			Description:         "first-run-pen-experience",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"fish": Scheme{
+			Scheme:              "fish",
+			DefangedScheme:      "fixh",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/fish",
+			Description:         "fish",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"fm": Scheme{
+			Scheme:              "fm",
+			DefangedScheme:      "fx",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/fm",
+			Description:         "fm",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RadioDNS_Project]",
+			Notes:               "",
+		},
+		"ftp": Scheme{
+			Scheme:              "ftp",
+			DefangedScheme:      "fxp",
+			Template:            "",
+			Description:         "File Transfer Protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC1738]",
+			Notes:               "",
+			Examples:            []string{"ftp://ftp.example.com/pub/file.txt", "ftp://user@ftp.example.com:2121/"},
+		},
+		"fuchsia-pkg": Scheme{
+			Scheme:              "fuchsia-pkg",
+			DefangedScheme:      "fuchsia[-]pkg",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/fuchsia-pkg",
+			Description:         "fuchsia-pkg",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Adam_Barth][https://fuchsia.googlesource.com/fuchsia/]",
+			Notes:               "",
+		},
+		"geo": Scheme{
+			Scheme:              "geo",
+			DefangedScheme:      "gxo",
+			Template:            "",
+			Description:         "Geographic Locations",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5870]",
+			Notes:               "",
+			Examples:            []string{"geo:51.5007,-0.1246"},
+		},
+		"gg": Scheme{
+			Scheme:              "gg",
+			DefangedScheme:      "g[g]",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/gg",
+			Description:         "gg",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"git": Scheme{
+			Scheme:              "git",
+			DefangedScheme:      "gxt",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/git",
+			Description:         "git",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+			Examples:            []string{"git://git.example.com/project.git"},
+		},
+		"gitoid": Scheme{
+			Scheme:              "gitoid",
+			DefangedScheme:      "gxxoid",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/gitoid",
+			Description:         "gitoid",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Ed_Warnicke]",
+			Notes:               "",
+		},
+		"gizmoproject": Scheme{
+			Scheme:              "gizmoproject",
+			DefangedScheme:      "gxxmoproject",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/gizmoproject",
+			Description:         "gizmoproject",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"go": Scheme{
+			Scheme:              "go",
+			DefangedScheme:      "gx",
+			Template:            "",
+			Description:         "go",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3368]",
+			Notes:               "",
+		},
+		"gopher": Scheme{
+			Scheme:              "gopher",
+			DefangedScheme:      "gxxher",
+			Template:            "",
+			Description:         "The Gopher Protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4266]",
+			Notes:               "",
+			Examples:            []string{"gopher://gopher.example.com/1/"},
+		},
+		"graph": Scheme{
+			Scheme:              "graph",
+			DefangedScheme:      "gxxph",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/graph",
+			Description:         "graph",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alastair_Green]",
+			Notes:               "",
+		},
+		"grd": Scheme{
+			Scheme:              "grd",
+			DefangedScheme:      "gxd",
+			Template:            "https://www.iana.org/assignments/uri-schemes/historic/grd",
+			Description:         "grd",
+			Status:              Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[IESG]",
+			Notes:               "",
+		},
+		"gtalk": Scheme{
+			Scheme:              "gtalk",
+			DefangedScheme:      "gxxlk",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/gtalk",
+			Description:         "gtalk",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"h323": Scheme{
+			Scheme:              "h323",
+			DefangedScheme:      "h3x3",
+			Template:            "",
+			Description:         "H.323",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3508]",
+			Notes:               "",
+		},
+		"ham": Scheme{
+			Scheme:              "ham",
+			DefangedScheme:      "hxm",
+			Template:            "",
+			Description:         "ham",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7046]",
+			Notes:               "",
+		},
+		"hcap": Scheme{
+			Scheme:              "hcap",
+			DefangedScheme:      "hcxp",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/hcap",
+			Description:         "hcap",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"hcp": Scheme{
+			Scheme:              "hcp",
+			DefangedScheme:      "hxp",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/hcp",
+			Description:         "hcp",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alexey_Melnikov]",
+			Notes:               "",
+		},
+		"hs20": Scheme{
+			Scheme:              "hs20",
+			DefangedScheme:      "hsx0",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/hs20",
+			Description:         "hs20",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Bruno_Tomas]",
+			Notes:               "",
+		},
+		"http": Scheme{
+			Scheme:              "http",
+			DefangedScheme:      "hxxp",
+			Template:            "",
+			Description:         "Hypertext Transfer Protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "[RFC8615]",
+			Reference:           "[RFC9110, Section 4.2.1]",
+			Notes:               "",
+			Related:             []string{"https"},
+			Examples:            []string{"http://example.com/", "http://192.0.2.1:8080/index.html"},
+		},
+		"https": Scheme{
+			Scheme:              "https",
+			DefangedScheme:      "hxxps",
+			Template:            "",
+			Description:         "Hypertext Transfer Protocol Secure",
+			Status:              Permanent,
+			WellKnownUriSupport: "[RFC8615]",
+			Reference:           "[RFC9110, Section 4.2.2]",
+			Notes:               "",
+			Related:             []string{"http", "shttp"},
+			Examples:            []string{"https://example.com/", "https://user@www.example.com/path?query=1#fragment"},
+		},
+		"hxxp": Scheme{
+			Scheme:              "hxxp",
+			DefangedScheme:      "hxxx",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/hxxp",
+			Description:         "hxxp",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-salgado-hxxp-01]",
+			Notes:               "",
+			Related:             []string{"hxxps"},
+		},
+		"hxxps": Scheme{
+			Scheme:              "hxxps",
+			DefangedScheme:      "hxxxs",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/hxxps",
+			Description:         "hxxps",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-salgado-hxxp-01]",
+			Notes:               "",
+			Related:             []string{"hxxp"},
+		},
+		"hydrazone": Scheme{
+			Scheme:              "hydrazone",
+			DefangedScheme:      "hxxrazone",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/hydrazone",
+			Description:         "hydrazone",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Matthias_Merkel][https://tech.hydrazone.pro/uri/specification/hydrazone.txt]",
+			Notes:               "",
+		},
+		"hyper": Scheme{
+			Scheme:              "hyper",
+			DefangedScheme:      "hxxer",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/hyper",
+			Description:         "hyper",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Frédéric_Wang][Paul_Frazee]",
+			Notes:               "",
+		},
+		"iax": Scheme{
+			Scheme:              "iax",
+			DefangedScheme:      "ixx",
+			Template:            "",
+			Description:         "Inter-Asterisk eXchange Version 2",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5456]",
+			Notes:               "",
+		},
+		"icap": Scheme{
+			Scheme:              "icap",
+			DefangedScheme:      "icxp",
+			Template:            "",
+			Description:         "Internet Content Adaptation Protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3507]",
+			Notes:               "",
+		},
+		"icon": Scheme{
+			Scheme:              "icon",
+			DefangedScheme:      "icxn",
+			Template:            "",
+			Description:         "icon",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-lafayette-icon-uri-scheme-01]",
+			Notes:               "",
+		},
+		"ilstring": Scheme{
+			Scheme:              "ilstring",
+			DefangedScheme:      "ixxtring",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ilstring",
+			Description:         "ilstring",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[OPC_Foundation][https://webstore.iec.ch/en/publication/77973]",
+			Notes:               "",
+		},
+		"im": Scheme{
+			Scheme:              "im",
+			DefangedScheme:      "ix",
+			Template:            "",
+			Description:         "Instant Messaging",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3860]",
+			Notes:               "",
+		},
+		"imap": Scheme{
+			Scheme:              "imap",
+			DefangedScheme:      "imxp",
+			Template:            "",
+			Description:         "internet message access protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5092]",
+			Notes:               "",
+			Examples:            []string{"imap://user@mail.example.com/INBOX"},
+		},
+		"info": Scheme{
+			Scheme:              "info",
+			DefangedScheme:      "inxo",
+			Template:            "",
+			Description:         "Information Assets with Identifiers in Public Namespaces. \n      [RFC4452] (section 3) defines an \"info\" registry \n        of public namespaces, which is maintained by NISO and can be accessed \n        from [http://info-uri.info/].",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4452]",
+			Notes:               "",
+		},
+		"iotdisco": Scheme{
+			Scheme:              "iotdisco",
+			DefangedScheme:      "ixxdisco",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/iotdisco",
+			Description:         "iotdisco",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Peter_Waher][https://www.iana.org/assignments/uri-schemes/prov/iotdisco.pdf]",
+			Notes:               "",
+		},
+		"ipfs": Scheme{
+			Scheme:              "ipfs",
+			DefangedScheme:      "ixxs",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ipfs",
+			Description:         "ipfs",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Frédéric_Wang][Protocol_Labs]",
+			Notes:               "",
+		},
+		"ipn": Scheme{
+			Scheme:              "ipn",
+			DefangedScheme:      "ixn",
+			Template:            "",
+			Description:         "ipn",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC9758]",
+			Notes:               "",
+		},
+		"ipns": Scheme{
+			Scheme:              "ipns",
+			DefangedScheme:      "ipxx",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ipns",
+			Description:         "ipns",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Frédéric_Wang][Protocol_Labs]",
+			Notes:               "",
+		},
+		"ipp": Scheme{
+			Scheme:              "ipp",
+			DefangedScheme:      "ixp",
+			Template:            "",
+			Description:         "Internet Printing Protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3510]",
+			Notes:               "",
+		},
+		"ipps": Scheme{
+			Scheme:              "ipps",
+			DefangedScheme:      "ipxs",
+			Template:            "",
+			Description:         "Internet Printing Protocol over HTTPS",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7472]",
+			Notes:               "",
+		},
+		"irc": Scheme{
+			Scheme:              "irc",
+			DefangedScheme:      "ixc",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/irc",
+			Description:         "irc",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+			Related:             []string{"ircs"},
+			Examples:            []string{"irc://irc.example.com/channel"},
+		},
+		"irc6": Scheme{
+			Scheme:              "irc6",
+			DefangedScheme:      "irx6",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/irc6",
+			Description:         "irc6",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"ircs": Scheme{
+			Scheme:              "ircs",
+			DefangedScheme:      "irxx",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ircs",
+			Description:         "ircs",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+			Related:             []string{"irc"},
+			Examples:            []string{"ircs://irc.example.com:6697/channel"},
+		},
+		"iris": Scheme{
+			Scheme:              "iris",
+			DefangedScheme:      "irxs",
+			Template:            "",
+			Description:         "Internet Registry Information Service",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3981]",
+			Notes:               "",
+		},
+		"iris.beep": Scheme{
+			Scheme:              "iris.beep",
+			DefangedScheme:      "iris[.]beep",
+			Template:            "",
+			Description:         "iris.beep",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3983]",
+			Notes:               "",
+		},
+		"iris.lwz": Scheme{
+			Scheme:              "iris.lwz",
+			DefangedScheme:      "iris[.]lwz",
+			Template:            "",
+			Description:         "iris.lwz",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4993]",
+			Notes:               "",
+		},
+		"iris.xpc": Scheme{
+			Scheme:              "iris.xpc",
+			DefangedScheme:      "iris[.]xpc",
+			Template:            "",
+			Description:         "iris.xpc",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4992]",
+			Notes:               "",
+			Related:             []string{"iris.xpcs"},
+		},
+		"iris.xpcs": Scheme{
+			Scheme:              "iris.xpcs",
+			DefangedScheme:      "iris[.]xpcs",
+			Template:            "",
+			Description:         "iris.xpcs",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4992]",
+			Notes:               "",
+			Related:             []string{"iris.xpc"},
+		},
+		"isostore": Scheme{
+			Scheme:              "isostore",
+			DefangedScheme:      "ixxstore",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/isostore",
+			Description:         "isostore",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"itms": Scheme{
+			Scheme:              "itms",
+			DefangedScheme:      "itxs",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/itms",
+			Description:         "itms",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"jabber": Scheme{
+			Scheme:              "jabber",
+			DefangedScheme:      "jxxber",
+			Template:            "https://www.iana.org/assignments/uri-schemes/perm/jabber",
+			Description:         "jabber",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[Peter_Saint-Andre]",
+			Notes:               "",
+		},
+		"jar": Scheme{
+			Scheme:              "jar",
+			DefangedScheme:      "jxr",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/jar",
+			Description:         "jar",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"jms": Scheme{
+			Scheme:              "jms",
+			DefangedScheme:      "jxs",
+			Template:            "",
+			Description:         "Java Message Service",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6167]",
+			Notes:               "",
+		},
+		"keyparc": Scheme{
+			Scheme:              "keyparc",
+			DefangedScheme:      "kxxparc",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/keyparc",
+			Description:         "keyparc",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"lastfm": Scheme{
+			Scheme:              "lastfm",
+			DefangedScheme:      "lxxtfm",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/lastfm",
+			Description:         "lastfm",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"lbry": Scheme{
+			Scheme:              "lbry",
+			DefangedScheme:      "lbxy",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/lbry",
+			Description:         "lbry",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alex_Grintsvayg]",
+			Notes:               "",
+		},
+		"ldap": Scheme{
+			Scheme:              "ldap",
+			DefangedScheme:      "ldxp",
+			Template:            "",
+			Description:         "Lightweight Directory Access Protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4516]",
+			Notes:               "",
+			Examples:            []string{"ldap://ldap.example.com/dc=example,dc=com?cn?sub"},
+		},
+		"ldaps": Scheme{
+			Scheme:              "ldaps",
+			DefangedScheme:      "lxxps",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ldaps",
+			Description:         "ldaps",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"leaptofrogans": Scheme{
+			Scheme:              "leaptofrogans",
+			DefangedScheme:      "lxxptofrogans",
+			Template:            "",
+			Description:         "leaptofrogans",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC8589]",
+			Notes:               "",
+		},
+		"lid": Scheme{
+			Scheme:              "lid",
+			DefangedScheme:      "lxd",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/lid",
+			Description:         "lid",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[IS4]",
+			Notes:               "",
+		},
+		"lorawan": Scheme{
+			Scheme:              "lorawan",
+			DefangedScheme:      "lxxawan",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/lorawan",
+			Description:         "lorawan",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[OMA-DMSE]",
+			Notes:               "",
+		},
+		"lpa": Scheme{
+			Scheme:              "lpa",
+			DefangedScheme:      "lxa",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/lpa",
+			Description:         "lpa",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[eSIM_Group_GSM_Association]",
+			Notes:               "",
+		},
+		"lvlt": Scheme{
+			Scheme:              "lvlt",
+			DefangedScheme:      "lvxt",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/lvlt",
+			Description:         "lvlt",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alexander_Shishenko]",
+			Notes:               "",
+		},
+		"machineprovisioningprogressreporter": Scheme{
+			Scheme:              "machineprovisioningprogressreporter",
+			DefangedScheme:      "mxxhineprovisioningprogressreporter",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/machineProvisioningProgressReporter",
+			Description:         "Windows Autopilot Modern Device Management status updates",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"magnet": Scheme{
+			Scheme:              "magnet",
+			DefangedScheme:      "mxxnet",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/magnet",
+			Description:         "magnet",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+			Examples:            []string{"magnet:?xt=urn:btih:c12fe1c06bba254a9dc9f519b335aa7c1367a88a"},
+		},
+		"mailserver": Scheme{
+			Scheme:              "mailserver",
+			DefangedScheme:      "mxxlserver",
+			Template:            "",
+			Description:         "Access to data available from mail servers",
+			Status:              Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6196]",
+			Notes:               "",
+		},
+		"mailto": Scheme{
+			Scheme:              "mailto",
+			DefangedScheme:      "mxxlto",
+			Template:            "",
+			Description:         "Electronic mail address",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6068]",
+			Notes:               "",
+			Examples:            []string{"mailto:user@example.com", "mailto:user@example.com?subject=Hello"},
+		},
+		"maps": Scheme{
+			Scheme:              "maps",
+			DefangedScheme:      "maxs",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/maps",
+			Description:         "maps",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"market": Scheme{
+			Scheme:              "market",
+			DefangedScheme:      "mxxket",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/market",
+			Description:         "market",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"matrix": Scheme{
+			Scheme:              "matrix",
+			DefangedScheme:      "mxxrix",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/matrix",
+			Description:         "matrix",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Hubert_Chathi]",
+			Notes:               "",
+		},
+		"message": Scheme{
+			Scheme:              "message",
+			DefangedScheme:      "mxxsage",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/message",
+			Description:         "message",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"microsoft.windows.camera": Scheme{
+			Scheme:              "microsoft.windows.camera",
+			DefangedScheme:      "microsoft[.]windows[.]camera",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/microsoft.windows.camera",
+			Description:         "microsoft.windows.camera",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"microsoft.windows.camera.multipicker": Scheme{
+			Scheme:              "microsoft.windows.camera.multipicker",
+			DefangedScheme:      "microsoft[.]windows[.]camera[.]multipicker",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/microsoft.windows.camera.multipicker",
+			Description:         "microsoft.windows.camera.multipicker",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"microsoft.windows.camera.picker": Scheme{
+			Scheme:              "microsoft.windows.camera.picker",
+			DefangedScheme:      "microsoft[.]windows[.]camera[.]picker",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/microsoft.windows.camera.picker",
+			Description:         "microsoft.windows.camera.picker",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"mid": Scheme{
+			Scheme:              "mid",
+			DefangedScheme:      "mxd",
+			Template:            "",
+			Description:         "message identifier",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2392]",
+			Notes:               "",
+		},
+		"mms": Scheme{
+			Scheme:              "mms",
+			DefangedScheme:      "mxs",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/mms",
+			Description:         "mms",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alexey_Melnikov]",
+			Notes:               "",
+		},
+		"modem": Scheme{
+			Scheme:              "modem",
+			DefangedScheme:      "mxxem",
+			Template:            "",
+			Description:         "modem",
+			Status:              Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2806][RFC3966]",
+			Notes:               "",
+			Related:             []string{"tel"},
+		},
+		"mongodb": Scheme{
+			Scheme:              "mongodb",
+			DefangedScheme:      "mxxgodb",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/mongodb",
+			Description:         "mongodb",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Ignacio_Losiggio][Mongo_DB_Inc]",
+			Notes:               "",
+		},
+		"moz": Scheme{
+			Scheme:              "moz",
+			DefangedScheme:      "mxz",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/moz",
+			Description:         "moz",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Joe_Hildebrand]",
+			Notes:               "",
+		},
+		"ms-access": Scheme{
+			Scheme:              "ms-access",
+			DefangedScheme:      "ms[-]access",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-access",
+			Description:         "ms-access",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-appinstaller": Scheme{
+			Scheme:              "ms-appinstaller",
+			DefangedScheme:      "ms[-]appinstaller",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-appinstaller",
+			Description:         "ms-appinstaller",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-browser-extension": Scheme{
+			Scheme:              "ms-browser-extension",
+			DefangedScheme:      "ms[-]browser[-]extension",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-browser-extension",
+			Description:         "ms-browser-extension",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-calculator": Scheme{
+			Scheme:              "ms-calculator",
+			DefangedScheme:      "ms[-]calculator",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-calculator",
+			Description:         "ms-calculator",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-drive-to": Scheme{
+			Scheme:              "ms-drive-to",
+			DefangedScheme:      "ms[-]drive[-]to",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-drive-to",
+			Description:         "ms-drive-to",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-enrollment": Scheme{
+			Scheme:              "ms-enrollment",
+			DefangedScheme:      "ms[-]enrollment",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-enrollment",
+			Description:         "ms-enrollment",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-excel": Scheme{
+			Scheme:              "ms-excel",
+			DefangedScheme:      "ms[-]excel",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-excel",
+			Description:         "ms-excel",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-eyecontrolspeech": Scheme{
+			Scheme:              "ms-eyecontrolspeech",
+			DefangedScheme:      "ms[-]eyecontrolspeech",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-eyecontrolspeech",
+			Description:         "ms-eyecontrolspeech",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-gamebarservices": Scheme{
+			Scheme:              "ms-gamebarservices",
+			DefangedScheme:      "ms[-]gamebarservices",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-gamebarservices",
+			Description:         "ms-gamebarservices",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-gamingoverlay": Scheme{
+			Scheme:              "ms-gamingoverlay",
+			DefangedScheme:      "ms[-]gamingoverlay",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-gamingoverlay",
+			Description:         "ms-gamingoverlay",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-getoffice": Scheme{
+			Scheme:              "ms-getoffice",
+			DefangedScheme:      "ms[-]getoffice",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-getoffice",
+			Description:         "ms-getoffice",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-help": Scheme{
+			Scheme:              "ms-help",
+			DefangedScheme:      "ms[-]help",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-help",
+			Description:         "ms-help",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alexey_Melnikov]",
+			Notes:               "",
+		},
+		"ms-infopath": Scheme{
+			Scheme:              "ms-infopath",
+			DefangedScheme:      "ms[-]infopath",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-infopath",
+			Description:         "ms-infopath",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-inputapp": Scheme{
+			Scheme:              "ms-inputapp",
+			DefangedScheme:      "ms[-]inputapp",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-inputapp",
+			Description:         "ms-inputapp",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-launchremotedesktop": Scheme{
+			Scheme:              "ms-launchremotedesktop",
+			DefangedScheme:      "ms[-]launchremotedesktop",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-launchremotedesktop",
+			Description:         "ms-launchremotedesktop",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-lockscreencomponent-config": Scheme{
+			Scheme:              "ms-lockscreencomponent-config",
+			DefangedScheme:      "ms[-]lockscreencomponent[-]config",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-lockscreencomponent-config",
+			Description:         "ms-lockscreencomponent-config",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-media-stream-id": Scheme{
+			Scheme:              "ms-media-stream-id",
+			DefangedScheme:      "ms[-]media[-]stream[-]id",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-media-stream-id",
+			Description:         "ms-media-stream-id",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-meetnow": Scheme{
+			Scheme:              "ms-meetnow",
+			DefangedScheme:      "ms[-]meetnow",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-meetnow",
+			Description:         "ms-meetnow",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-mixedrealitycapture": Scheme{
+			Scheme:              "ms-mixedrealitycapture",
+			DefangedScheme:      "ms[-]mixedrealitycapture",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-mixedrealitycapture",
+			Description:         "ms-mixedrealitycapture",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-mobileplans": Scheme{
+			Scheme:              "ms-mobileplans",
+			DefangedScheme:      "ms[-]mobileplans",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-mobileplans",
+			Description:         "ms-mobileplans",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-newsandinterests": Scheme{
+			Scheme:              "ms-newsandinterests",
+			DefangedScheme:      "ms[-]newsandinterests",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-newsandinterests",
+			Description:         "ms-newsandinterests",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-officeapp": Scheme{
+			Scheme:              "ms-officeapp",
+			DefangedScheme:      "ms[-]officeapp",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-officeapp",
+			Description:         "ms-officeapp",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-people": Scheme{
+			Scheme:              "ms-people",
+			DefangedScheme:      "ms[-]people",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-people",
+			Description:         "ms-people",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-personacard": Scheme{
+			Scheme:              "ms-personacard",
+			DefangedScheme:      "ms[-]personacard",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-personacard",
+			Description:         "ms-personacard",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-powerpoint": Scheme{
+			Scheme:              "ms-powerpoint",
+			DefangedScheme:      "ms[-]powerpoint",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-powerpoint",
+			Description:         "ms-powerpoint",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-project": Scheme{
+			Scheme:              "ms-project",
+			DefangedScheme:      "ms[-]project",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-project",
+			Description:         "ms-project",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-publisher": Scheme{
+			Scheme:              "ms-publisher",
+			DefangedScheme:      "ms[-]publisher",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-publisher",
+			Description:         "ms-publisher",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-recall": Scheme{
+			Scheme:              "ms-recall",
+			DefangedScheme:      "ms[-]recall",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-recall",
+			Description:         "ms-recall",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-remotedesktop": Scheme{
+			Scheme:              "ms-remotedesktop",
+			DefangedScheme:      "ms[-]remotedesktop",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-remotedesktop",
+			Description:         "ms-remotedesktop",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-remotedesktop-launch": Scheme{
+			Scheme:              "ms-remotedesktop-launch",
+			DefangedScheme:      "ms[-]remotedesktop[-]launch",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-remotedesktop-launch",
+			Description:         "ms-remotedesktop-launch",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-restoretabcompanion": Scheme{
+			Scheme:              "ms-restoretabcompanion",
+			DefangedScheme:      "ms[-]restoretabcompanion",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-restoretabcompanion",
+			Description:         "ms-restoretabcompanion",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-screenclip": Scheme{
+			Scheme:              "ms-screenclip",
+			DefangedScheme:      "ms[-]screenclip",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-screenclip",
+			Description:         "ms-screenclip",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-screensketch": Scheme{
+			Scheme:              "ms-screensketch",
+			DefangedScheme:      "ms[-]screensketch",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-screensketch",
+			Description:         "ms-screensketch",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-search": Scheme{
+			Scheme:              "ms-search",
+			DefangedScheme:      "ms[-]search",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-search",
+			Description:         "ms-search",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-search-repair": Scheme{
+			Scheme:              "ms-search-repair",
+			DefangedScheme:      "ms[-]search[-]repair",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-search-repair",
+			Description:         "ms-search-repair",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-secondary-screen-controller": Scheme{
+			Scheme:              "ms-secondary-screen-controller",
+			DefangedScheme:      "ms[-]secondary[-]screen[-]controller",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-secondary-screen-controller",
+			Description:         "ms-secondary-screen-controller",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-secondary-screen-setup": Scheme{
+			Scheme:              "ms-secondary-screen-setup",
+			DefangedScheme:      "ms[-]secondary[-]screen[-]setup",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-secondary-screen-setup",
+			Description:         "ms-secondary-screen-setup",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings": Scheme{
+			Scheme:              "ms-settings",
+			DefangedScheme:      "ms[-]settings",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings",
+			Description:         "ms-settings",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-airplanemode": Scheme{
+			Scheme:              "ms-settings-airplanemode",
+			DefangedScheme:      "ms[-]settings[-]airplanemode",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings-airplanemode",
+			Description:         "ms-settings-airplanemode",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-bluetooth": Scheme{
+			Scheme:              "ms-settings-bluetooth",
+			DefangedScheme:      "ms[-]settings[-]bluetooth",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings-bluetooth",
+			Description:         "ms-settings-bluetooth",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-camera": Scheme{
+			Scheme:              "ms-settings-camera",
+			DefangedScheme:      "ms[-]settings[-]camera",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings-camera",
+			Description:         "ms-settings-camera",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-cellular": Scheme{
+			Scheme:              "ms-settings-cellular",
+			DefangedScheme:      "ms[-]settings[-]cellular",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings-cellular",
+			Description:         "ms-settings-cellular",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-cloudstorage": Scheme{
+			Scheme:              "ms-settings-cloudstorage",
+			DefangedScheme:      "ms[-]settings[-]cloudstorage",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings-cloudstorage",
+			Description:         "ms-settings-cloudstorage",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-connectabledevices": Scheme{
+			Scheme:              "ms-settings-connectabledevices",
+			DefangedScheme:      "ms[-]settings[-]connectabledevices",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings-connectabledevices",
+			Description:         "ms-settings-connectabledevices",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-displays-topology": Scheme{
+			Scheme:              "ms-settings-displays-topology",
+			DefangedScheme:      "ms[-]settings[-]displays[-]topology",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings-displays-topology",
+			Description:         "ms-settings-displays-topology",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-emailandaccounts": Scheme{
+			Scheme:              "ms-settings-emailandaccounts",
+			DefangedScheme:      "ms[-]settings[-]emailandaccounts",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings-emailandaccounts",
+			Description:         "ms-settings-emailandaccounts",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-language": Scheme{
+			Scheme:              "ms-settings-language",
+			DefangedScheme:      "ms[-]settings[-]language",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings-language",
+			Description:         "ms-settings-language",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-location": Scheme{
+			Scheme:              "ms-settings-location",
+			DefangedScheme:      "ms[-]settings[-]location",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings-location",
+			Description:         "ms-settings-location",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-lock": Scheme{
+			Scheme:              "ms-settings-lock",
+			DefangedScheme:      "ms[-]settings[-]lock",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings-lock",
+			Description:         "ms-settings-lock",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-nfctransactions": Scheme{
+			Scheme:              "ms-settings-nfctransactions",
+			DefangedScheme:      "ms[-]settings[-]nfctransactions",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings-nfctransactions",
+			Description:         "ms-settings-nfctransactions",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-notifications": Scheme{
+			Scheme:              "ms-settings-notifications",
+			DefangedScheme:      "ms[-]settings[-]notifications",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings-notifications",
+			Description:         "ms-settings-notifications",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-power": Scheme{
+			Scheme:              "ms-settings-power",
+			DefangedScheme:      "ms[-]settings[-]power",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings-power",
+			Description:         "ms-settings-power",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-privacy": Scheme{
+			Scheme:              "ms-settings-privacy",
+			DefangedScheme:      "ms[-]settings[-]privacy",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings-privacy",
+			Description:         "ms-settings-privacy",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-proximity": Scheme{
+			Scheme:              "ms-settings-proximity",
+			DefangedScheme:      "ms[-]settings[-]proximity",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings-proximity",
+			Description:         "ms-settings-proximity",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-screenrotation": Scheme{
+			Scheme:              "ms-settings-screenrotation",
+			DefangedScheme:      "ms[-]settings[-]screenrotation",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings-screenrotation",
+			Description:         "ms-settings-screenrotation",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-wifi": Scheme{
+			Scheme:              "ms-settings-wifi",
+			DefangedScheme:      "ms[-]settings[-]wifi",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings-wifi",
+			Description:         "ms-settings-wifi",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-workplace": Scheme{
+			Scheme:              "ms-settings-workplace",
+			DefangedScheme:      "ms[-]settings[-]workplace",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-settings-workplace",
+			Description:         "ms-settings-workplace",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-spd": Scheme{
+			Scheme:              "ms-spd",
+			DefangedScheme:      "ms[-]spd",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-spd",
+			Description:         "ms-spd",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-stickers": Scheme{
+			Scheme:              "ms-stickers",
+			DefangedScheme:      "ms[-]stickers",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-stickers",
+			Description:         "ms-stickers",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-sttoverlay": Scheme{
+			Scheme:              "ms-sttoverlay",
+			DefangedScheme:      "ms[-]sttoverlay",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-sttoverlay",
+			Description:         "ms-sttoverlay",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-transit-to": Scheme{
+			Scheme:              "ms-transit-to",
+			DefangedScheme:      "ms[-]transit[-]to",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-transit-to",
+			Description:         "ms-transit-to",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-useractivityset": Scheme{
+			Scheme:              "ms-useractivityset",
+			DefangedScheme:      "ms[-]useractivityset",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-useractivityset",
+			Description:         "ms-useractivityset",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-uup": Scheme{
+			Scheme:              "ms-uup",
+			DefangedScheme:      "ms[-]uup",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-uup",
+			Description:         "ms-uup",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-virtualtouchpad": Scheme{
+			Scheme:              "ms-virtualtouchpad",
+			DefangedScheme:      "ms[-]virtualtouchpad",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-virtualtouchpad",
+			Description:         "ms-virtualtouchpad",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-visio": Scheme{
+			Scheme:              "ms-visio",
+			DefangedScheme:      "ms[-]visio",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-visio",
+			Description:         "ms-visio",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-walk-to": Scheme{
+			Scheme:              "ms-walk-to",
+			DefangedScheme:      "ms[-]walk[-]to",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-walk-to",
+			Description:         "ms-walk-to",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-whiteboard": Scheme{
+			Scheme:              "ms-whiteboard",
+			DefangedScheme:      "ms[-]whiteboard",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-whiteboard",
+			Description:         "ms-whiteboard",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-whiteboard-cmd": Scheme{
+			Scheme:              "ms-whiteboard-cmd",
+			DefangedScheme:      "ms[-]whiteboard[-]cmd",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-whiteboard-cmd",
+			Description:         "ms-whiteboard-cmd",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-widgetboard": Scheme{
+			Scheme:              "ms-widgetboard",
+			DefangedScheme:      "ms[-]widgetboard",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-widgetboard",
+			Description:         "ms-widgetboard",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-widgets": Scheme{
+			Scheme:              "ms-widgets",
+			DefangedScheme:      "ms[-]widgets",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-widgets",
+			Description:         "ms-widgets",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-word": Scheme{
+			Scheme:              "ms-word",
+			DefangedScheme:      "ms[-]word",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ms-word",
+			Description:         "ms-word",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"msnim": Scheme{
+			Scheme:              "msnim",
+			DefangedScheme:      "mxxim",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/msnim",
+			Description:         "msnim",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alexey_Melnikov]",
+			Notes:               "",
+		},
+		"msrp": Scheme{
+			Scheme:              "msrp",
+			DefangedScheme:      "msxp",
+			Template:            "",
+			Description:         "Message Session Relay Protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4975]",
+			Notes:               "",
+			Related:             []string{"msrps"},
+		},
+		"msrps": Scheme{
+			Scheme:              "msrps",
+			DefangedScheme:      "mxxps",
+			Template:            "",
+			Description:         "Message Session Relay Protocol Secure",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4975][RFC8873]",
+			Notes:               "",
+			Related:             []string{"msrp"},
+		},
+		"mss": Scheme{
+			Scheme:              "mss",
+			DefangedScheme:      "mxx",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/mss",
+			Description:         "mss",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Jarmo_Miettinen]",
+			Notes:               "",
+		},
+		"mt": Scheme{
+			Scheme:              "mt",
+			DefangedScheme:      "mx",
+			Template:            "https://www.iana.org/assignments/uri-schemes/perm/mt",
+			Description:         "Matter protocol on-boarding payloads that are encoded for use in QR Codes and/or NFC Tags",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[Connectivity_Standards_Alliance]",
+			Notes:               "",
+		},
+		"mtqp": Scheme{
+			Scheme:              "mtqp",
+			DefangedScheme:      "mtxp",
+			Template:            "",
+			Description:         "Message Tracking Query Protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3887]",
+			Notes:               "",
+		},
+		"mtrust": Scheme{
+			Scheme:              "mtrust",
+			DefangedScheme:      "mxxust",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/mtrust",
+			Description:         "mtrust",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Egbert_von_Frankenberg]",
+			Notes:               "",
+		},
+		"mumble": Scheme{
+			Scheme:              "mumble",
+			DefangedScheme:      "mxxble",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/mumble",
+			Description:         "mumble",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"mupdate": Scheme{
+			Scheme:              "mupdate",
+			DefangedScheme:      "mxxdate",
+			Template:            "",
+			Description:         "Mailbox Update (MUPDATE) Protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3656]",
+			Notes:               "",
+		},
+		"mvn": Scheme{
+			Scheme:              "mvn",
+			DefangedScheme:      "mxn",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/mvn",
+			Description:         "mvn",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"mvrp": Scheme{
+			Scheme:              "mvrp",
+			DefangedScheme:      "mvxp",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/mvrp",
+			Description:         "mvrp\n      (see [reviewer notes])",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Antonio_Walker]",
+			Notes:               "",
+			Related:             []string{"mvrps"},
+		},
+		"mvrps": Scheme{
+			Scheme:              "mvrps",
+			DefangedScheme:      "mxxxs",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/mvrps",
+			Description:         "mvrps\n      (see [reviewer notes])",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Antonio_Walker]",
+			Notes:               "",
+			Related:             []string{"mvrp"},
+		},
+		"news": Scheme{
+			Scheme:              "news",
+			DefangedScheme:      "nexs",
+			Template:            "",
+			Description:         "USENET news",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5538]",
+			Notes:               "",
+			Examples:            []string{"news:comp.lang.go"},
+		},
+		"nfs": Scheme{
+			Scheme:              "nfs",
+			DefangedScheme:      "nxs",
+			Template:            "",
+			Description:         "network file system protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2224]",
+			Notes:               "",
+			Examples:            []string{"nfs://fileserver.example.com/export/home"},
+		},
+		"ni": Scheme{
+			Scheme:              "ni",
+			DefangedScheme:      "nx",
+			Template:            "",
+			Description:         "ni",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6920]",
+			Notes:               "",
+		},
+		"nih": Scheme{
+			Scheme:              "nih",
+			DefangedScheme:      "nxh",
+			Template:            "",
+			Description:         "nih",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6920]",
+			Notes:               "",
+		},
+		"nntp": Scheme{
+			Scheme:              "nntp",
+			DefangedScheme:      "nnxp",
+			Template:            "",
+			Description:         "USENET news using NNTP access",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5538]",
+			Notes:               "",
+			Examples:            []string{"nntp://news.example.com/comp.lang.go"},
+		},
+		"notes": Scheme{
+			Scheme:              "notes",
+			DefangedScheme:      "nxxes",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/notes",
+			Description:         "notes",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-dconmy-notes-uri-scheme-02]",
+			Notes:               "",
+		},
+		"num": Scheme{
+			Scheme:              "num",
+			DefangedScheme:      "nxm",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/num",
+			Description:         "Namespace Utility Modules",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Elliott_Brown][https://www.numprotocol.com/specification]",
+			Notes:               "",
+		},
+		"ocf": Scheme{
+			Scheme:              "ocf",
+			DefangedScheme:      "oxf",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ocf",
+			Description:         "ocf",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"oid": Scheme{
+			Scheme:              "oid",
+			DefangedScheme:      "oxd",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/oid",
+			Description:         "oid",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-larmouth-oid-iri-04]",
+			Notes:               "",
+		},
+		"onenote": Scheme{
+			Scheme:              "onenote",
+			DefangedScheme:      "oxxnote",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/onenote",
+			Description:         "onenote",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"onenote-cmd": Scheme{
+			Scheme:              "onenote-cmd",
+			DefangedScheme:      "onenote[-]cmd",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/onenote-cmd",
+			Description:         "onenote-cmd",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"opaquelocktoken": Scheme{
+			Scheme:              "opaquelocktoken",
+			DefangedScheme:      "oxxquelocktoken",
+			Template:            "",
+			Description:         "opaquelocktokent",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4918]",
+			Notes:               "",
+		},
+		"openid": Scheme{
+			Scheme:              "openid",
+			DefangedScheme:      "oxxnid",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/openid",
+			Description:         "OpenID Connect",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[OpenID_Foundation_Artifact_Binding_Working_Group][OpenID Connect Core 1.0, Section 7.3]",
+			Notes:               "",
+		},
+		"openpgp4fpr": Scheme{
+			Scheme:              "openpgp4fpr",
+			DefangedScheme:      "oxxnpgp4fpr",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/openpgp4fpr",
+			Description:         "openpgp4fpr",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Wiktor_Kwapisiewicz]",
+			Notes:               "",
+		},
+		"otpauth": Scheme{
+			Scheme:              "otpauth",
+			DefangedScheme:      "oxxauth",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/otpauth",
+			Description:         "otpauth",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Frédéric_Wang][Thomas_Habets]",
+			Notes:               "",
+		},
+		"p1": Scheme{
+			Scheme:              "p1",
+			DefangedScheme:      "px",
+			Template:            "https://www.iana.org/assignments/uri-schemes/historic/p1",
+			Description:         "p1",
+			Status:              Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[IESG]",
+			Notes:               "",
+		},
+		"pack": Scheme{
+			Scheme:              "pack",
+			DefangedScheme:      "paxk",
+			Template:            "https://www.iana.org/assignments/uri-schemes/historic/pack",
+			Description:         "pack",
+			Status:              Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-shur-pack-uri-scheme-05]",
+			Notes:               "",
+		},
+		"palm": Scheme{
+			Scheme:              "palm",
+			DefangedScheme:      "paxm",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/palm",
+			Description:         "palm",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"paparazzi": Scheme{
+			Scheme:              "paparazzi",
+			DefangedScheme:      "pxxarazzi",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/paparazzi",
+			Description:         "paparazzi",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"payment": Scheme{
+			Scheme:              "payment",
+			DefangedScheme:      "pxxment",
+			Template:            "https://www.iana.org/assignments/uri-schemes/historic/payment",
+			Description:         "payment",
+			Status:              Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[IESG]",
+			Notes:               "",
+		},
+		"payto": Scheme{
+			Scheme:              "payto",
+			DefangedScheme:      "pxxto",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/payto",
+			Description:         "payto",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC8905]",
+			Notes:               "",
+		},
+		"pkcs11": Scheme{
+			Scheme:              "pkcs11",
+			DefangedScheme:      "pxxs11",
+			Template:            "",
+			Description:         "PKCS#11",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7512]",
+			Notes:               "",
+		},
+		"platform": Scheme{
+			Scheme:              "platform",
+			DefangedScheme:      "pxxtform",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/platform",
+			Description:         "platform",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"pop": Scheme{
+			Scheme:              "pop",
+			DefangedScheme:      "pxp",
+			Template:            "",
+			Description:         "Post Office Protocol v3",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2384]",
+			Notes:               "",
+			Examples:            []string{"pop://user@mail.example.com"},
+		},
+		"pres": Scheme{
+			Scheme:              "pres",
+			DefangedScheme:      "prxs",
+			Template:            "",
+			Description:         "Presence",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3859]",
+			Notes:               "",
+		},
+		"prospero": Scheme{
+			Scheme:              "prospero",
+			DefangedScheme:      "pxxspero",
+			Template:            "",
+			Description:         "Prospero Directory Service",
+			Status:              Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4157]",
+			Notes:               "",
+		},
+		"proxy": Scheme{
+			Scheme:              "proxy",
+			DefangedScheme:      "pxxxy",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/proxy",
+			Description:         "proxy",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"psyc": Scheme{
+			Scheme:              "psyc",
+			DefangedScheme:      "psxc",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/psyc",
+			Description:         "psyc",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"pttp": Scheme{
+			Scheme:              "pttp",
+			DefangedScheme:      "ptxp",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/pttp",
+			Description:         "pttp",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Tony_Deng][Tuan_Hoang][Bob_Hinkle][Mark_Chen]",
+			Notes:               "",
+		},
+		"pwid": Scheme{
+			Scheme:              "pwid",
+			DefangedScheme:      "pwxd",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/pwid",
+			Description:         "pwid",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Eld_Zierau]",
+			Notes:               "",
+		},
+		"qb": Scheme{
+			Scheme:              "qb",
+			DefangedScheme:      "qx",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/qb",
+			Description:         "qb",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Jan_Pokorny]",
+			Notes:               "",
+		},
+		"query": Scheme{
+			Scheme:              "query",
+			DefangedScheme:      "qxxry",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/query",
+			Description:         "query",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"quic-transport": Scheme{
+			Scheme:              "quic-transport",
+			DefangedScheme:      "quic[-]transport",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/quic-transport",
+			Description:         "quic-transport",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-vvv-webtransport-quic-00]",
+			Notes:               "",
+		},
+		"redis": Scheme{
+			Scheme:              "redis",
+			DefangedScheme:      "rxxis",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/redis",
+			Description:         "redis",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Chris_Rebert]",
+			Notes:               "",
+			Related:             []string{"rediss"},
+		},
+		"rediss": Scheme{
+			Scheme:              "rediss",
+			DefangedScheme:      "rxxiss",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/rediss",
+			Description:         "rediss",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Chris_Rebert]",
+			Notes:               "",
+			Related:             []string{"redis"},
+		},
+		"reload": Scheme{
+			Scheme:              "reload",
+			DefangedScheme:      "rxxoad",
+			Template:            "",
+			Description:         "reload",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6940]",
+			Notes:               "",
+		},
+		"res": Scheme{
+			Scheme:              "res",
+			DefangedScheme:      "rxs",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/res",
+			Description:         "res",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alexey_Melnikov]",
+			Notes:               "",
+		},
+		"resource": Scheme{
+			Scheme:              "resource",
+			DefangedScheme:      "rxxource",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/resource",
+			Description:         "resource",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"rmi": Scheme{
+			Scheme:              "rmi",
+			DefangedScheme:      "rxi",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/rmi",
+			Description:         "rmi",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"rsync": Scheme{
+			Scheme:              "rsync",
+			DefangedScheme:      "rxxnc",
+			Template:            "",
+			Description:         "rsync",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5781]",
+			Notes:               "",
+		},
+		"rtmfp": Scheme{
+			Scheme:              "rtmfp",
+			DefangedScheme:      "rxxfp",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/rtmfp",
+			Description:         "rtmfp",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7425]",
+			Notes:               "",
+		},
+		"rtmp": Scheme{
+			Scheme:              "rtmp",
+			DefangedScheme:      "rxxp",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/rtmp",
+			Description:         "rtmp",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"rtsp": Scheme{
+			Scheme:              "rtsp",
+			DefangedScheme:      "rtxp",
+			Template:            "",
+			Description:         "Real-Time Streaming Protocol (RTSP)",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2326][RFC7826]",
+			Notes:               "",
+			Related:             []string{"rtsps"},
+			Examples:            []string{"rtsp://camera.example.com:554/stream"},
+		},
+		"rtsps": Scheme{
+			Scheme:              "rtsps",
+			DefangedScheme:      "rxxps",
+			Template:            "",
+			Description:         "Real-Time Streaming Protocol (RTSP) over TLS",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2326][RFC7826]",
+			Notes:               "",
+			Related:             []string{"rtsp"},
+		},
+		"rtspu": Scheme{
+			Scheme:              "rtspu",
+			DefangedScheme:      "rxxpu",
+			Template:            "",
+			Description:         "Real-Time Streaming Protocol (RTSP) over unreliable datagram transport",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2326]",
+			Notes:               "",
+		},
+		"sarif": Scheme{
+			Scheme:              "sarif",
+			DefangedScheme:      "sxxif",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/sarif",
+			Description:         "sarif",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[OASIS_Open][Michael_C_Fanning][David_Keaton]",
+			Notes:               "",
+		},
+		"secondlife": Scheme{
+			Scheme:              "secondlife",
+			DefangedScheme:      "sxxondlife",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/secondlife",
+			Description:         "query",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"secret-token": Scheme{
+			Scheme:              "secret-token",
+			DefangedScheme:      "secret[-]token",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/secret-token",
+			Description:         "secret-token",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC8959]",
+			Notes:               "",
+		},
+		"service": Scheme{
+			Scheme:              "service",
+			DefangedScheme:      "sxxvice",
+			Template:            "",
+			Description:         "service location",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2609]",
+			Notes:               "",
+		},
+		"session": Scheme{
+			Scheme:              "session",
+			DefangedScheme:      "sxxsion",
+			Template:            "",
+			Description:         "session",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6787]",
+			Notes:               "",
+		},
+		"sftp": Scheme{
+			Scheme:              "sftp",
+			DefangedScheme:      "sfxp",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/sftp",
+			Description:         "query",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"sgn": Scheme{
+			Scheme:              "sgn",
+			DefangedScheme:      "sxn",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/sgn",
+			Description:         "sgn",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"shc": Scheme{
+			Scheme:              "shc",
+			DefangedScheme:      "sxc",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/shc",
+			Description:         "shc",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Josh_Mandel]",
+			Notes:               "",
+		},
+		"shelter": Scheme{
+			Scheme:              "shelter",
+			DefangedScheme:      "sxxlter",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/shelter",
+			Description:         "shelter",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[okTurtles_Foundation]",
+			Notes:               "",
+		},
+		"shttp": Scheme{
+			Scheme:              "shttp",
+			DefangedScheme:      "sxxtp",
+			Template:            "",
+			Description:         "Secure Hypertext Transfer Protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2660][Status change of HTTP experiments to Historic]",
+			Notes:               "",
+			Obsolete:            true,
+			Related:             []string{"https"},
+		},
+		"sieve": Scheme{
+			Scheme:              "sieve",
+			DefangedScheme:      "sxxve",
+			Template:            "",
+			Description:         "ManageSieve Protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5804]",
+			Notes:               "",
+		},
+		"simpleledger": Scheme{
+			Scheme:              "simpleledger",
+			DefangedScheme:      "sxxpleledger",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/simpleledger",
+			Description:         "simpleledger",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[James_Cramer]",
+			Notes:               "",
+		},
+		"simplex": Scheme{
+			Scheme:              "simplex",
+			DefangedScheme:      "sxxplex",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/simplex",
+			Description:         "simplex",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Evgeny_Poberezkin]",
+			Notes:               "",
+		},
+		"sip": Scheme{
+			Scheme:              "sip",
+			DefangedScheme:      "sxp",
+			Template:            "",
+			Description:         "session initiation protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3261]",
+			Notes:               "",
+			Related:             []string{"sips"},
+			Examples:            []string{"sip:alice@example.com"},
+		},
+		"sips": Scheme{
+			Scheme:              "sips",
+			DefangedScheme:      "sixs",
+			Template:            "",
+			Description:         "secure session initiation protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3261]",
+			Notes:               "",
+			Related:             []string{"sip"},
+			Examples:            []string{"sips:alice@example.com:5061"},
+		},
+		"skype": Scheme{
+			Scheme:              "skype",
+			DefangedScheme:      "sxxpe",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/skype",
+			Description:         "skype",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alexey_Melnikov]",
+			Notes:               "",
+		},
+		"smb": Scheme{
+			Scheme:              "smb",
+			DefangedScheme:      "sxb",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/smb",
+			Description:         "smb",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+			Examples:            []string{"smb://fileserver.example.com/share/"},
+		},
+		"smp": Scheme{
+			Scheme:              "smp",
+			DefangedScheme:      "sxx",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/smp",
+			Description:         "smp",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Evgeny_Poberezkin]",
+			Notes:               "",
+		},
+		"sms": Scheme{
+			Scheme:              "sms",
+			DefangedScheme:      "sxs",
+			Template:            "",
+			Description:         "Short Message Service",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5724]",
+			Notes:               "",
+			Examples:            []string{"sms:+447700900123?body=hello"},
+		},
+		"smtp": Scheme{
+			Scheme:              "smtp",
+			DefangedScheme:      "smxp",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/smtp",
+			Description:         "smtp",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-melnikov-smime-msa-to-mda-03]",
+			Notes:               "",
+		},
+		"snews": Scheme{
+			Scheme:              "snews",
+			DefangedScheme:      "sxxws",
+			Template:            "",
+			Description:         "NNTP over SSL/TLS",
+			Status:              Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5538]",
+			Notes:               "",
+		},
+		"snmp": Scheme{
+			Scheme:              "snmp",
+			DefangedScheme:      "snxp",
+			Template:            "",
+			Description:         "Simple Network Management Protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4088]",
+			Notes:               "",
+		},
+		"soap.beep": Scheme{
+			Scheme:              "soap.beep",
+			DefangedScheme:      "soap[.]beep",
+			Template:            "",
+			Description:         "soap.beep",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4227]",
+			Notes:               "",
+			Related:             []string{"soap.beeps"},
+		},
+		"soap.beeps": Scheme{
+			Scheme:              "soap.beeps",
+			DefangedScheme:      "soap[.]beeps",
+			Template:            "",
+			Description:         "soap.beeps",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4227]",
+			Notes:               "",
+			Related:             []string{"soap.beep"},
+		},
+		"soldat": Scheme{
+			Scheme:              "soldat",
+			DefangedScheme:      "sxxdat",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/soldat",
+			Description:         "soldat",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"spiffe": Scheme{
+			Scheme:              "spiffe",
+			DefangedScheme:      "sxxffe",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/spiffe",
+			Description:         "spiffe",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Evan_Gilman]",
+			Notes:               "",
+		},
+		"spotify": Scheme{
+			Scheme:              "spotify",
+			DefangedScheme:      "sxxtify",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/spotify",
+			Description:         "spotify",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"ssb": Scheme{
+			Scheme:              "ssb",
+			DefangedScheme:      "s[s]b",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ssb",
+			Description:         "ssb",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Frédéric_Wang][Secure_Scuttlebutt_Consortium]",
+			Notes:               "",
+		},
+		"ssh": Scheme{
+			Scheme:              "ssh",
+			DefangedScheme:      "sxh",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ssh",
+			Description:         "ssh",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+			Examples:            []string{"ssh://user@host.example.com:2222"},
+		},
+		"starknet": Scheme{
+			Scheme:              "starknet",
+			DefangedScheme:      "sxxrknet",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/starknet",
+			Description:         "starknet",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Abraham_Makovetsky]",
+			Notes:               "",
+		},
+		"steam": Scheme{
+			Scheme:              "steam",
+			DefangedScheme:      "sxxam",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/steam",
+			Description:         "steam",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"stun": Scheme{
+			Scheme:              "stun",
+			DefangedScheme:      "stxn",
+			Template:            "",
+			Description:         "stun",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7064]",
+			Notes:               "",
+			Related:             []string{"stuns"},
+			Examples:            []string{"stun:stun.example.com:3478"},
+		},
+		"stuns": Scheme{
+			Scheme:              "stuns",
+			DefangedScheme:      "sxxns",
+			Template:            "",
+			Description:         "stuns",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7064]",
+			Notes:               "",
+			Related:             []string{"stun"},
+		},
+		"submit": Scheme{
+			Scheme:              "submit",
+			DefangedScheme:      "sxxmit",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/submit",
+			Description:         "submit",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-melnikov-smime-msa-to-mda-03]",
+			Notes:               "",
+		},
+		"svn": Scheme{
+			Scheme:              "svn",
+			DefangedScheme:      "s[v]n",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/svn",
+			Description:         "svn",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"swh": Scheme{
+			Scheme:              "swh",
+			DefangedScheme:      "s[w]h",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/swh",
+			Description:         "swh",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Software_Heritage][Stefano_Zacchiroli]",
+			Notes:               "",
+		},
+		"swid": Scheme{
+			Scheme:              "swid",
+			DefangedScheme:      "swxd",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/swid",
+			Description:         "swid \n\n      (see [reviewer notes])",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC9393, Section 5.1]",
+			Notes:               "",
+		},
+		"swidpath": Scheme{
+			Scheme:              "swidpath",
+			DefangedScheme:      "sxxdpath",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/swidpath",
+			Description:         "swidpath \n\n      (see [reviewer notes])",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC9393, Section 5.2]",
+			Notes:               "",
+		},
+		"tag": Scheme{
+			Scheme:              "tag",
+			DefangedScheme:      "txg",
+			Template:            "",
+			Description:         "tag",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4151]",
+			Notes:               "",
+		},
+		"taler": Scheme{
+			Scheme:              "taler",
+			DefangedScheme:      "txxer",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/taler",
+			Description:         "taler",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-grothoff-taler-01]",
+			Notes:               "",
+		},
+		"teamspeak": Scheme{
+			Scheme:              "teamspeak",
+			DefangedScheme:      "txxmspeak",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/teamspeak",
+			Description:         "teamspeak",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"teapot": Scheme{
+			Scheme:              "teapot",
+			DefangedScheme:      "txxpot",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/teapot",
+			Description:         "teapot",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Karwan_Stark]",
+			Notes:               "",
+			Related:             []string{"teapots"},
+		},
+		"teapots": Scheme{
+			Scheme:              "teapots",
+			DefangedScheme:      "txxpots",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/teapots",
+			Description:         "teapots",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Karwan_Stark]",
+			Notes:               "",
+			Related:             []string{"teapot"},
+		},
+		"tel": Scheme{
+			Scheme:              "tel",
+			DefangedScheme:      "txl",
+			Template:            "",
+			Description:         "telephone",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3966][RFC5341]",
+			Notes:               "",
+			Related:             []string{"fax", "modem"},
+			Examples:            []string{"tel:+447700900123"},
+		},
+		"teliaeid": Scheme{
+			Scheme:              "teliaeid",
+			DefangedScheme:      "txxiaeid",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/teliaeid",
+			Description:         "teliaeid",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Peter_Lewandowski]",
+			Notes:               "",
+		},
+		"telnet": Scheme{
+			Scheme:              "telnet",
+			DefangedScheme:      "txxnet",
+			Template:            "",
+			Description:         "Reference to interactive sessions",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4248]",
+			Notes:               "",
+			Examples:            []string{"telnet://192.0.2.1:23/"},
+		},
+		"tftp": Scheme{
+			Scheme:              "tftp",
+			DefangedScheme:      "tfxp",
+			Template:            "",
+			Description:         "Trivial File Transfer Protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3617]",
+			Notes:               "",
+		},
+		"things": Scheme{
+			Scheme:              "things",
+			DefangedScheme:      "txxngs",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/things",
+			Description:         "things",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"thismessage": Scheme{
+			Scheme:              "thismessage",
+			DefangedScheme:      "txxsmessage",
+			Template:            "https://www.iana.org/assignments/uri-schemes/perm/thismessage",
+			Description:         "multipart/related relative reference resolution",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2557]",
+			Notes:               "",
+		},
+		"thzp": Scheme{
+			Scheme:              "thzp",
+			DefangedScheme:      "thxp",
+			Template:            "https://www.iana.org/assignments/uri-schemes/historic/thzp",
+			Description:         "thzp",
+			Status:              Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[IESG]",
+			Notes:               "",
+		},
+		"tip": Scheme{
+			Scheme:              "tip",
+			DefangedScheme:      "txp",
+			Template:            "",
+			Description:         "Transaction Internet Protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2371]",
+			Notes:               "",
+		},
+		"tn3270": Scheme{
+			Scheme:              "tn3270",
+			DefangedScheme:      "txx270",
+			Template:            "",
+			Description:         "Interactive 3270 emulation sessions",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6270]",
+			Notes:               "",
+		},
+		"tool": Scheme{
+			Scheme:              "tool",
+			DefangedScheme:      "toxl",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/tool",
+			Description:         "tool",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Matthias_Merkel]",
+			Notes:               "",
+		},
+		"turn": Scheme{
+			Scheme:              "turn",
+			DefangedScheme:      "tuxn",
+			Template:            "",
+			Description:         "turn",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7065]",
+			Notes:               "",
+			Related:             []string{"turns"},
+			Examples:            []string{"turn:turn.example.com?transport=udp"},
+		},
+		"turns": Scheme{
+			Scheme:              "turns",
+			DefangedScheme:      "txxns",
+			Template:            "",
+			Description:         "turns",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7065]",
+			Notes:               "",
+			Related:             []string{"turn"},
+		},
+		"tv": Scheme{
+			Scheme:              "tv",
+			DefangedScheme:      "tx",
+			Template:            "",
+			Description:         "TV Broadcasts",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2838]",
+			Notes:               "",
+		},
+		"udp": Scheme{
+			Scheme:              "udp",
+			DefangedScheme:      "uxp",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/udp",
+			Description:         "udp",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"unreal": Scheme{
+			Scheme:              "unreal",
+			DefangedScheme:      "uxxeal",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/unreal",
+			Description:         "unreal",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"upt": Scheme{
+			Scheme:              "upt",
+			DefangedScheme:      "uxt",
+			Template:            "https://www.iana.org/assignments/uri-schemes/historic/upt",
+			Description:         "upt",
+			Status:              Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[IESG]",
+			Notes:               "",
+		},
+		"urn": Scheme{
+			Scheme:              "urn",
+			DefangedScheme:      "uxn",
+			Template:            "",
+			Description:         "Uniform Resource Names",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC8141][IANA registryurn-namespaces]",
+			Notes:               "",
+			Examples:            []string{"urn:isbn:0451450523", "urn:ietf:rfc:3986"},
+		},
+		"ut2004": Scheme{
+			Scheme:              "ut2004",
+			DefangedScheme:      "uxx004",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ut2004",
+			Description:         "ut2004",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"uuid-in-package": Scheme{
+			Scheme:              "uuid-in-package",
+			DefangedScheme:      "uuid[-]in[-]package",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/uuid-in-package",
+			Description:         "uuid-in-package",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Kunihiko_Sakamoto]",
+			Notes:               "",
+		},
+		"v-event": Scheme{
+			Scheme:              "v-event",
+			DefangedScheme:      "v[-]event",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/v-event",
+			Description:         "v-event",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-menderico-v-event-uri-00]",
+			Notes:               "",
+		},
+		"vemmi": Scheme{
+			Scheme:              "vemmi",
+			DefangedScheme:      "vxxmi",
+			Template:            "",
+			Description:         "versatile multimedia interface",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2122]",
+			Notes:               "",
+		},
+		"ventrilo": Scheme{
+			Scheme:              "ventrilo",
+			DefangedScheme:      "vxxtrilo",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ventrilo",
+			Description:         "ventrilo",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"ves": Scheme{
+			Scheme:              "ves",
+			DefangedScheme:      "vxs",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ves",
+			Description:         "ves",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Jim_Zubov]",
+			Notes:               "",
+		},
+		"videotex": Scheme{
+			Scheme:              "videotex",
+			DefangedScheme:      "vxxeotex",
+			Template:            "https://www.iana.org/assignments/uri-schemes/historic/videotex",
+			Description:         "videotex",
+			Status:              Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-mavrakis-videotex-url-spec-01][RFC2122][RFC3986]",
+			Notes:               "",
+		},
+		"view-source": Scheme{
+			Scheme:              "view-source",
+			DefangedScheme:      "view[-]source",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/view-source",
+			Description:         "view-source",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Mykyta_Yevstifeyev]",
+			Notes:               "",
+		},
+		"vnc": Scheme{
+			Scheme:              "vnc",
+			DefangedScheme:      "vxc",
+			Template:            "",
+			Description:         "Remote Framebuffer Protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7869]",
+			Notes:               "",
+			Examples:            []string{"vnc://host.example.com:5900"},
+		},
+		"vscode": Scheme{
+			Scheme:              "vscode",
+			DefangedScheme:      "vxxode",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/vscode",
+			Description:         "vscode",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"vscode-insiders": Scheme{
+			Scheme:              "vscode-insiders",
+			DefangedScheme:      "vscode[-]insiders",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/vscode-insiders",
+			Description:         "vscode-insiders",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"vsls": Scheme{
+			Scheme:              "vsls",
+			DefangedScheme:      "vsxs",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/vsls",
+			Description:         "vsls",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"w3": Scheme{
+			Scheme:              "w3",
+			DefangedScheme:      "w[3]",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/w3",
+			Description:         "w3 \n      (see [reviewer notes])",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Qi_Zhou]",
+			Notes:               "",
+		},
+		"wais": Scheme{
+			Scheme:              "wais",
+			DefangedScheme:      "waxs",
+			Template:            "",
+			Description:         "Wide Area Information Servers",
+			Status:              Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4156]",
+			Notes:               "",
+		},
+		"wasm": Scheme{
+			Scheme:              "wasm",
+			DefangedScheme:      "waxm",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/wasm",
+			Description:         "wasm",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[W3C_WebAssembly_Community_Group]",
+			Notes:               "",
+		},
+		"wasm-js": Scheme{
+			Scheme:              "wasm-js",
+			DefangedScheme:      "wasm[-]js",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/wasm-js",
+			Description:         "wasm-js",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[W3C_WebAssembly_Community_Group]",
+			Notes:               "",
+		},
+		"wcr": Scheme{
+			Scheme:              "wcr",
+			DefangedScheme:      "wxr",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/wcr",
+			Description:         "wcr",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Jason_Dzubak]",
+			Notes:               "",
+		},
+		"web+ap": Scheme{
+			Scheme:              "web+ap",
+			DefangedScheme:      "web[+]ap",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/web+ap",
+			Description:         "web+ap",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Soni_L.]",
+			Notes:               "",
+		},
+		"web3": Scheme{
+			Scheme:              "web3",
+			DefangedScheme:      "wex3",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/web3",
+			Description:         "web3",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Qi_Zhou]",
+			Notes:               "",
+		},
+		"webcal": Scheme{
+			Scheme:              "webcal",
+			DefangedScheme:      "wxxcal",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/webcal",
+			Description:         "webcal",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+			Examples:            []string{"webcal://calendar.example.com/events.ics"},
+		},
+		"wifi": Scheme{
+			Scheme:              "wifi",
+			DefangedScheme:      "wixi",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/wifi",
+			Description:         "wifi",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Wi-Fi_Alliance][Jun_Tian]",
+			Notes:               "",
+		},
+		"wpid": Scheme{
+			Scheme:              "wpid",
+			DefangedScheme:      "wpxd",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/wpid",
+			Description:         "wpid",
+			Status:              Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[Eld_Zierau]",
+			Notes:               "",
+		},
+		"ws": Scheme{
+			Scheme:              "ws",
+			DefangedScheme:      "wx",
+			Template:            "",
+			Description:         "WebSocket connections",
+			Status:              Permanent,
+			WellKnownUriSupport: "[RFC8307]",
+			Reference:           "[RFC6455]",
+			Notes:               "",
+			Related:             []string{"wss"},
+			Examples:            []string{"ws://example.com/socket"},
+		},
+		"wss": Scheme{
+			Scheme:              "wss",
+			DefangedScheme:      "wxs",
+			Template:            "",
+			Description:         "Encrypted WebSocket connections",
+			Status:              Permanent,
+			WellKnownUriSupport: "[RFC8307]",
+			Reference:           "[RFC6455]",
+			Notes:               "",
+			Related:             []string{"ws"},
+			Examples:            []string{"wss://example.com/socket"},
+		},
+		"wtai": Scheme{
+			Scheme:              "wtai",
+			DefangedScheme:      "wtxi",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/wtai",
+			Description:         "wtai",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"wyciwyg": Scheme{
+			Scheme:              "wyciwyg",
+			DefangedScheme:      "wxxiwyg",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/wyciwyg",
+			Description:         "wyciwyg",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"xcon": Scheme{
+			Scheme:              "xcon",
+			DefangedScheme:      "xcxn",
+			Template:            "",
+			Description:         "xcon",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6501]",
+			Notes:               "",
+		},
+		"xcon-userid": Scheme{
+			Scheme:              "xcon-userid",
+			DefangedScheme:      "xcon[-]userid",
+			Template:            "",
+			Description:         "xcon-userid",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6501]",
+			Notes:               "",
+		},
+		"xfire": Scheme{
+			Scheme:              "xfire",
+			DefangedScheme:      "xxxre",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/xfire",
+			Description:         "xfire",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"xftp": Scheme{
+			Scheme:              "xftp",
+			DefangedScheme:      "xfxp",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/xftp",
+			Description:         "xftp",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Evgeny_Poberezkin]",
+			Notes:               "",
+		},
+		"xmlrpc.beep": Scheme{
+			Scheme:              "xmlrpc.beep",
+			DefangedScheme:      "xmlrpc[.]beep",
+			Template:            "",
+			Description:         "xmlrpc.beep",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3529]",
+			Notes:               "",
+			Related:             []string{"xmlrpc.beeps"},
+		},
+		"xmlrpc.beeps": Scheme{
+			Scheme:              "xmlrpc.beeps",
+			DefangedScheme:      "xmlrpc[.]beeps",
+			Template:            "",
+			Description:         "xmlrpc.beeps",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3529]",
+			Notes:               "",
+			Related:             []string{"xmlrpc.beep"},
+		},
+		"xmpp": Scheme{
+			Scheme:              "xmpp",
+			DefangedScheme:      "xmxp",
+			Template:            "",
+			Description:         "Extensible Messaging and Presence Protocol",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5122]",
+			Notes:               "",
+			Examples:            []string{"xmpp:alice@example.com"},
+		},
+		"xrcp": Scheme{
+			Scheme:              "xrcp",
+			DefangedScheme:      "xrxp",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/xrcp",
+			Description:         "xrcp",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Evgeny_Poberezkin]",
+			Notes:               "",
+		},
+		"xri": Scheme{
+			Scheme:              "xri",
+			DefangedScheme:      "xxi",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/xri",
+			Description:         "xri",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"ymsgr": Scheme{
+			Scheme:              "ymsgr",
+			DefangedScheme:      "yxxgr",
+			Template:            "https://www.iana.org/assignments/uri-schemes/prov/ymsgr",
+			Description:         "ymsgr",
+			Status:              Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"z39.50": Scheme{
+			Scheme:              "z39.50",
+			DefangedScheme:      "z39[.]50",
+			Template:            "",
+			Description:         "Z39.50 information access",
+			Status:              Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC1738][RFC2056]",
+			Notes:               "",
+			Related:             []string{"z39.50r", "z39.50s"},
+		},
+		"z39.50r": Scheme{
+			Scheme:              "z39.50r",
+			DefangedScheme:      "z39[.]50r",
+			Template:            "",
+			Description:         "Z39.50 Retrieval",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2056]",
+			Notes:               "",
+			Related:             []string{"z39.50"},
+		},
+		"z39.50s": Scheme{
+			Scheme:              "z39.50s",
+			DefangedScheme:      "z39[.]50s",
+			Template:            "",
+			Description:         "Z39.50 Session",
+			Status:              Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2056]",
+			Notes:               "",
+			Related:             []string{"z39.50"},
+		},
+	}
+}
@@ -0,0 +1,411 @@
+//go:build defang_schemes_lazy && !defang_schemes_embed && !defang_schemes_minimal
+
+package schemes
+
+/*
+THIS FILE WAS AUTOMATICALLY GENERATED AT 2026-10-16 07:57:26
+
+Do not edit this file.  Run "go generate" to re-generate this file with an
+updated version of URI schemes from:
+    iana.org/assignments/uri-schemes/uri-schemes.xhtml.
+*/
+
+func init() {
+	schemeBlob = "" +
+		"aaa\x1faxa\x1f\x1fDiameter Protocol\x1fPermanent\x1f\x1f[RFC6733]\x1f\x1ffalse\x1faaas\x1f\x1e" +
+		"aaas\x1faaxs\x1f\x1fDiameter Protocol with Secure Transport\x1fPermanent\x1f\x1f[RFC6733]\x1f\x1ffalse\x1faaa\x1f\x1e" +
+		"about\x1faxxut\x1f\x1fabout\x1fPermanent\x1f\x1f[RFC6694]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"acap\x1facxp\x1f\x1fapplication configuration access protocol\x1fPermanent\x1f\x1f[RFC2244]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"acct\x1facxt\x1f\x1facct\x1fPermanent\x1f\x1f[RFC7565]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"acd\x1faxd\x1fhttps://www.iana.org/assignments/uri-schemes/prov/acd\x1facd\x1fProvisional\x1f\x1f[Michael_Hedenus]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"acr\x1faxr\x1fhttps://www.iana.org/assignments/uri-schemes/prov/acr\x1facr\x1fProvisional\x1f\x1f[OMA-OMNA]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"adiumxtra\x1faxxumxtra\x1fhttps://www.iana.org/assignments/uri-schemes/prov/adiumxtra\x1fadiumxtra\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"adt\x1faxt\x1fhttps://www.iana.org/assignments/uri-schemes/prov/adt\x1fadt\x1fProvisional\x1f\x1f[SAP_SE]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"afp\x1faxp\x1fhttps://www.iana.org/assignments/uri-schemes/prov/afp\x1fafp\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"afs\x1faxs\x1f\x1fAndrew File System global file names\x1fProvisional\x1f\x1f[RFC1738]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"aim\x1faxm\x1fhttps://www.iana.org/assignments/uri-schemes/prov/aim\x1faim\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"amss\x1famxs\x1fhttps://www.iana.org/assignments/uri-schemes/prov/amss\x1famss\x1fProvisional\x1f\x1f[RadioDNS_Project]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"android\x1faxxroid\x1fhttps://www.iana.org/assignments/uri-schemes/prov/android\x1fandroid\x1fProvisional\x1f\x1f[Adam_Barth][https://developer.android.com/guide/topics/manifest/manifest-intro]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"appdata\x1faxxdata\x1fhttps://www.iana.org/assignments/uri-schemes/prov/appdata\x1fappdata\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"apt\x1faxx\x1fhttps://www.iana.org/assignments/uri-schemes/prov/apt\x1fapt\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ar\x1fax\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ar\x1far\x1fProvisional\x1f\x1f[Arweave_Team]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ari\x1faxi\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ari\x1fari\x1fProvisional\x1f\x1f[draft-ietf-dtn-ari-04]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ark\x1faxk\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ark\x1fark\x1fProvisional\x1f\x1f[ARK_agency][https://n2t.net/ark:/21206/10015]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"at\x1fa[t]\x1fhttps://www.iana.org/assignments/uri-schemes/prov/at\x1fat \n      (see [reviewer notes])\x1fProvisional\x1f\x1f[Bluesky_PBLLC][Paul_Frazee]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"attachment\x1faxxachment\x1fhttps://www.iana.org/assignments/uri-schemes/prov/attachment\x1fattachment\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"aw\x1fa[w]\x1fhttps://www.iana.org/assignments/uri-schemes/prov/aw\x1faw\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"barion\x1fbxxion\x1fhttps://www.iana.org/assignments/uri-schemes/prov/barion\x1fbarion\x1fProvisional\x1f\x1f[Bíró_Tamás]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"bb\x1fb[b]\x1fhttps://www.iana.org/assignments/uri-schemes/historic/bb\x1fbb\x1fHistorical\x1f\x1f[IESG]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"beshare\x1fbxxhare\x1fhttps://www.iana.org/assignments/uri-schemes/prov/beshare\x1fbeshare\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"bitcoin\x1fbxxcoin\x1fhttps://www.iana.org/assignments/uri-schemes/prov/bitcoin\x1fbitcoin\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1fbitcoin:1BoatSLRHtKNngkdXEeobR76b53LETtpyT?amount=0.01\x1e" +
+		"bitcoincash\x1fbxxcoincash\x1fhttps://www.iana.org/assignments/uri-schemes/prov/bitcoincash\x1fbitcoincash\x1fProvisional\x1f\x1f[Corentin_Mercier]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"bl\x1fbx\x1fhttps://www.iana.org/assignments/uri-schemes/prov/bl\x1fbluetooth (shortened)\x1fProvisional\x1f\x1f[Daniel_Cowling]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"blob\x1fblxb\x1fhttps://www.iana.org/assignments/uri-schemes/prov/blob\x1fblob\x1fProvisional\x1f\x1f[W3C_WebApps_Working_Group][Chris_Rebert]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"bluetooth\x1fbxxetooth\x1fhttps://www.iana.org/assignments/uri-schemes/prov/bluetooth\x1fbluetooth\x1fProvisional\x1f\x1f[Daniel_Cowling]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"bolo\x1fboxo\x1fhttps://www.iana.org/assignments/uri-schemes/prov/bolo\x1fbolo\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"brid\x1fbrxd\x1fhttps://www.iana.org/assignments/uri-schemes/prov/brid\x1fbrid\x1fProvisional\x1f\x1f[Jürgen_Grupp][Michael_Ranft][Sophie_Schenkel]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"browserext\x1fbxxwserext\x1fhttps://www.iana.org/assignments/uri-schemes/prov/browserext\x1fbrowserext\x1fProvisional\x1f\x1f[Mike_Pietraszak]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"cabal\x1fcxxal\x1fhttps://www.iana.org/assignments/uri-schemes/prov/cabal\x1fcabal\x1fProvisional\x1f\x1f[Frédéric_Wang][Cabal_Club]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"calculator\x1fcxxculator\x1fhttps://www.iana.org/assignments/uri-schemes/prov/calculator\x1fcalculator\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"callto\x1fcxxlto\x1fhttps://www.iana.org/assignments/uri-schemes/prov/callto\x1fcallto\x1fProvisional\x1f\x1f[Alexey_Melnikov]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"cap\x1fcxp\x1f\x1fCalendar Access Protocol\x1fPermanent\x1f\x1f[RFC4324]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"cast\x1fcaxt\x1fhttps://www.iana.org/assignments/uri-schemes/prov/cast\x1fcast\x1fProvisional\x1f\x1f[Adam_Barth][https://developers.google.com/cast/docs/registration]\x1f\x1ffalse\x1fcasts\x1f\x1e" +
+		"casts\x1fcxxts\x1fhttps://www.iana.org/assignments/uri-schemes/prov/casts\x1fcasts\x1fProvisional\x1f\x1f[Adam_Barth][https://developers.google.com/cast/docs/registration]\x1f\x1ffalse\x1fcast\x1f\x1e" +
+		"chrome\x1fcxxome\x1fhttps://www.iana.org/assignments/uri-schemes/prov/chrome\x1fchrome\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"chrome-extension\x1fchrome[-]extension\x1fhttps://www.iana.org/assignments/uri-schemes/prov/chrome-extension\x1fchrome-extension\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"cid\x1fcxd\x1f\x1fcontent identifier\x1fPermanent\x1f\x1f[RFC2392]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"coap\x1fcoxp\x1f\x1fcoap\x1fPermanent\x1f[RFC7252]\x1f[RFC7252]\x1f\x1ffalse\x1fcoaps\x1fcoap://sensor.example.com/temperature\x1e" +
+		"coap+tcp\x1fcoap[+]tcp\x1f\x1fcoap+tcp \n      (see [reviewer notes])\x1fPermanent\x1f[RFC8323]\x1f[RFC8323]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"coap+ws\x1fcoap[+]ws\x1f\x1fcoap+ws \n      (see [reviewer notes])\x1fPermanent\x1f[RFC8323]\x1f[RFC8323]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"coaps\x1fcxxps\x1f\x1fcoaps\x1fPermanent\x1f[RFC7252]\x1f[RFC7252]\x1f\x1ffalse\x1fcoap\x1f\x1e" +
+		"coaps+tcp\x1fcoaps[+]tcp\x1f\x1fcoaps+tcp \n      (see [reviewer notes])\x1fPermanent\x1f[RFC8323]\x1f[RFC8323]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"coaps+ws\x1fcoaps[+]ws\x1f\x1fcoaps+ws \n      (see [reviewer notes])\x1fPermanent\x1f[RFC8323]\x1f[RFC8323]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"com-eventbrite-attendee\x1fcom[-]eventbrite[-]attendee\x1fhttps://www.iana.org/assignments/uri-schemes/prov/com-eventbrite-attendee\x1fcom-eventbrite-attendee\x1fProvisional\x1f\x1f[Bob_Van_Zant]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"content\x1fcxxtent\x1fhttps://www.iana.org/assignments/uri-schemes/prov/content\x1fcontent\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"content-type\x1fcontent[-]type\x1fhttps://www.iana.org/assignments/uri-schemes/prov/content-type\x1fcontent-type\x1fProvisional\x1f\x1f[Donald_Eastlake]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"crid\x1fcrxd\x1f\x1fTV-Anytime Content Reference Identifier\x1fPermanent\x1f\x1f[RFC4078]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"cstr\x1fcsxr\x1fhttps://www.iana.org/assignments/uri-schemes/prov/cstr\x1fcstr\x1fProvisional\x1f\x1f[Wang_Shu]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"cvs\x1fcxs\x1fhttps://www.iana.org/assignments/uri-schemes/prov/cvs\x1fcvs\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"dab\x1fdxb\x1fhttps://www.iana.org/assignments/uri-schemes/prov/dab\x1fdab\x1fProvisional\x1f\x1f[RadioDNS_Project]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"dat\x1fdxt\x1fhttps://www.iana.org/assignments/uri-schemes/prov/dat\x1fdat\x1fProvisional\x1f\x1f[Frédéric_Wang][Paul_Frazee]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"data\x1fdaxa\x1f\x1fdata\x1fPermanent\x1f\x1f[RFC2397]\x1f\x1ffalse\x1f\x1fdata:text/plain;base64,SGVsbG8= data:text/html,<b>hello</b>\x1e" +
+		"dav\x1fdxv\x1f\x1fdav\x1fPermanent\x1f\x1f[RFC4918]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"dhttp\x1fdxxtp\x1fhttps://www.iana.org/assignments/uri-schemes/prov/dhttp\x1fdhttp \n      (see [reviewer notes])\x1fProvisional\x1f\x1f[Qi_Zhou]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"diaspora\x1fdxxspora\x1fhttps://www.iana.org/assignments/uri-schemes/prov/diaspora\x1fdiaspora\x1fProvisional\x1f\x1f[Dennis_Schubert]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"dict\x1fdixt\x1f\x1fdictionary service protocol\x1fPermanent\x1f\x1f[RFC2229]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"did\x1fdxd\x1fhttps://www.iana.org/assignments/uri-schemes/prov/did\x1fdid\x1fProvisional\x1f\x1f[W3C_Decentralized_Identifier_Working_Group][Manu_Sporny][Ivan_Herman]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"dis\x1fd[i]s\x1fhttps://www.iana.org/assignments/uri-schemes/prov/dis\x1fdis\x1fProvisional\x1f\x1f[Christophe_Meessen]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"dlna-playcontainer\x1fdlna[-]playcontainer\x1fhttps://www.iana.org/assignments/uri-schemes/prov/dlna-playcontainer\x1fdlna-playcontainer\x1fProvisional\x1f\x1f[DLNA]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"dlna-playsingle\x1fdlna[-]playsingle\x1fhttps://www.iana.org/assignments/uri-schemes/prov/dlna-playsingle\x1fdlna-playsingle\x1fProvisional\x1f\x1f[DLNA]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"dns\x1fdxs\x1f\x1fDomain Name System\x1fPermanent\x1f\x1f[RFC4501]\x1f\x1ffalse\x1f\x1fdns:example.com?type=A\x1e" +
+		"dntp\x1fdnxp\x1fhttps://www.iana.org/assignments/uri-schemes/prov/dntp\x1fdntp\x1fProvisional\x1f\x1f[Hans-Dieter_A._Hiep]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"doi\x1fdxi\x1f\x1fdoi\x1fPermanent\x1f\x1f[DOI URI Scheme][Pierre-Anthony_Lemieux][DOI_Foundation]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"dpp\x1fdxp\x1fhttps://www.iana.org/assignments/uri-schemes/prov/dpp\x1fdpp\x1fProvisional\x1f\x1f[Gaurav_Jain][Wi-Fi_Alliance]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"drm\x1fdxm\x1fhttps://www.iana.org/assignments/uri-schemes/prov/drm\x1fdrm\x1fProvisional\x1f\x1f[RadioDNS_Project]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"drop\x1fdrxp\x1fhttps://www.iana.org/assignments/uri-schemes/historic/drop\x1fdrop\x1fHistorical\x1f\x1f[IESG]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"dtmi\x1fdtxi\x1fhttps://www.iana.org/assignments/uri-schemes/prov/dtmi\x1fdtmi\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"dtn\x1fdxn\x1f\x1fDTNRG research and development\x1fPermanent\x1f\x1f[RFC9171]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"dvb\x1fd[v]b\x1f\x1fdvb\x1fProvisional\x1f\x1f[draft-mcroberts-uri-dvb-09]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"dvx\x1fdxx\x1fhttps://www.iana.org/assignments/uri-schemes/prov/dvx\x1fdvx\x1fProvisional\x1f\x1f[Clemens_Bastian]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"dweb\x1fdwxb\x1fhttps://www.iana.org/assignments/uri-schemes/prov/dweb\x1fdweb\x1fProvisional\x1f\x1f[Frédéric_Wang][Protocol_Labs]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ed2k\x1fedxk\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ed2k\x1fed2k\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"eid\x1fexd\x1fhttps://www.iana.org/assignments/uri-schemes/prov/eid\x1feid\x1fProvisional\x1f\x1f[eSIM_Group_GSM_Association]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"elsi\x1felxi\x1fhttps://www.iana.org/assignments/uri-schemes/prov/elsi\x1felsi\x1fProvisional\x1f\x1f[Kimmo_Lindholm]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"embedded\x1fexxedded\x1fhttps://www.iana.org/assignments/uri-schemes/prov/embedded\x1fembedded\x1fProvisional\x1f\x1f[Peter_Hoddie]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ens\x1fexs\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ens\x1fens\x1fProvisional\x1f\x1f[Ricky_Bloomfield][Bradley_Nelson]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ethereum\x1fexxereum\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ethereum\x1fethereum\x1fProvisional\x1f\x1f[Frédéric_Wang][ligi]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"example\x1fexxmple\x1f\x1fexample\x1fPermanent\x1f\x1f[RFC7595]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"facetime\x1ffxxetime\x1fhttps://www.iana.org/assignments/uri-schemes/prov/facetime\x1ffacetime\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"fax\x1ffxx\x1f\x1ffax\x1fHistorical\x1f\x1f[RFC2806][RFC3966]\x1f\x1ffalse\x1ftel\x1f\x1e" +
+		"feed\x1ffexd\x1fhttps://www.iana.org/assignments/uri-schemes/prov/feed\x1ffeed\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"feedready\x1ffxxdready\x1fhttps://www.iana.org/assignments/uri-schemes/prov/feedready\x1ffeedready\x1fProvisional\x1f\x1f[Mirko_Nosenzo]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"fido\x1ffixo\x1fhttps://www.iana.org/assignments/uri-schemes/prov/fido\x1ffido\x1fProvisional\x1f\x1f[Adam_Langley]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"file\x1ffixe\x1f\x1fHost-specific file names\x1fPermanent\x1f\x1f[RFC8089]\x1f\x1ffalse\x1f\x1ffile:///etc/hosts file://fileserver.example.com/share/report.docx\x1e" +
+		"filesystem\x1ffxxesystem\x1fhttps://www.iana.org/assignments/uri-schemes/historic/filesystem\x1ffilesystem\x1fHistorical\x1f\x1f[W3C_WebApps_Working_Group][Chris_Rebert]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"finger\x1ffxxger\x1fhttps://www.iana.org/assignments/uri-schemes/prov/finger\x1ffinger\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"first-run-pen-experience\x1ffirst[-]run[-]pen[-]experience\x1fhttps://www.iana.org/assignments/uri-schemes/prov/first-run-pen-experience\x1ffirst-run-pen-experience\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"fish\x1ffixh\x1fhttps://www.iana.org/assignments/uri-schemes/prov/fish\x1ffish\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"fm\x1ffx\x1fhttps://www.iana.org/assignments/uri-schemes/prov/fm\x1ffm\x1fProvisional\x1f\x1f[RadioDNS_Project]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ftp\x1ffxp\x1f\x1fFile Transfer Protocol\x1fPermanent\x1f\x1f[RFC1738]\x1f\x1ffalse\x1f\x1fftp://ftp.example.com/pub/file.txt ftp://user@ftp.example.com:2121/\x1e" +
+		"fuchsia-pkg\x1ffuchsia[-]pkg\x1fhttps://www.iana.org/assignments/uri-schemes/prov/fuchsia-pkg\x1ffuchsia-pkg\x1fProvisional\x1f\x1f[Adam_Barth][https://fuchsia.googlesource.com/fuchsia/]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"geo\x1fgxo\x1f\x1fGeographic Locations\x1fPermanent\x1f\x1f[RFC5870]\x1f\x1ffalse\x1f\x1fgeo:51.5007,-0.1246\x1e" +
+		"gg\x1fg[g]\x1fhttps://www.iana.org/assignments/uri-schemes/prov/gg\x1fgg\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"git\x1fgxt\x1fhttps://www.iana.org/assignments/uri-schemes/prov/git\x1fgit\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1fgit://git.example.com/project.git\x1e" +
+		"gitoid\x1fgxxoid\x1fhttps://www.iana.org/assignments/uri-schemes/prov/gitoid\x1fgitoid\x1fProvisional\x1f\x1f[Ed_Warnicke]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"gizmoproject\x1fgxxmoproject\x1fhttps://www.iana.org/assignments/uri-schemes/prov/gizmoproject\x1fgizmoproject\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"go\x1fgx\x1f\x1fgo\x1fPermanent\x1f\x1f[RFC3368]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"gopher\x1fgxxher\x1f\x1fThe Gopher Protocol\x1fPermanent\x1f\x1f[RFC4266]\x1f\x1ffalse\x1f\x1fgopher://gopher.example.com/1/\x1e" +
+		"graph\x1fgxxph\x1fhttps://www.iana.org/assignments/uri-schemes/prov/graph\x1fgraph\x1fProvisional\x1f\x1f[Alastair_Green]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"grd\x1fgxd\x1fhttps://www.iana.org/assignments/uri-schemes/historic/grd\x1fgrd\x1fHistorical\x1f\x1f[IESG]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"gtalk\x1fgxxlk\x1fhttps://www.iana.org/assignments/uri-schemes/prov/gtalk\x1fgtalk\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"h323\x1fh3x3\x1f\x1fH.323\x1fPermanent\x1f\x1f[RFC3508]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ham\x1fhxm\x1f\x1fham\x1fProvisional\x1f\x1f[RFC7046]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"hcap\x1fhcxp\x1fhttps://www.iana.org/assignments/uri-schemes/prov/hcap\x1fhcap\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"hcp\x1fhxp\x1fhttps://www.iana.org/assignments/uri-schemes/prov/hcp\x1fhcp\x1fProvisional\x1f\x1f[Alexey_Melnikov]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"hs20\x1fhsx0\x1fhttps://www.iana.org/assignments/uri-schemes/prov/hs20\x1fhs20\x1fProvisional\x1f\x1f[Bruno_Tomas]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"http\x1fhxxp\x1f\x1fHypertext Transfer Protocol\x1fPermanent\x1f[RFC8615]\x1f[RFC9110, Section 4.2.1]\x1f\x1ffalse\x1fhttps\x1fhttp://example.com/ http://192.0.2.1:8080/index.html\x1e" +
+		"https\x1fhxxps\x1f\x1fHypertext Transfer Protocol Secure\x1fPermanent\x1f[RFC8615]\x1f[RFC9110, Section 4.2.2]\x1f\x1ffalse\x1fhttp shttp\x1fhttps://example.com/ https://user@www.example.com/path?query=1#fragment\x1e" +
+		"hxxp\x1fhxxx\x1fhttps://www.iana.org/assignments/uri-schemes/prov/hxxp\x1fhxxp\x1fProvisional\x1f\x1f[draft-salgado-hxxp-01]\x1f\x1ffalse\x1fhxxps\x1f\x1e" +
+		"hxxps\x1fhxxxs\x1fhttps://www.iana.org/assignments/uri-schemes/prov/hxxps\x1fhxxps\x1fProvisional\x1f\x1f[draft-salgado-hxxp-01]\x1f\x1ffalse\x1fhxxp\x1f\x1e" +
+		"hydrazone\x1fhxxrazone\x1fhttps://www.iana.org/assignments/uri-schemes/prov/hydrazone\x1fhydrazone\x1fProvisional\x1f\x1f[Matthias_Merkel][https://tech.hydrazone.pro/uri/specification/hydrazone.txt]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"hyper\x1fhxxer\x1fhttps://www.iana.org/assignments/uri-schemes/prov/hyper\x1fhyper\x1fProvisional\x1f\x1f[Frédéric_Wang][Paul_Frazee]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"iax\x1fixx\x1f\x1fInter-Asterisk eXchange Version 2\x1fPermanent\x1f\x1f[RFC5456]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"icap\x1ficxp\x1f\x1fInternet Content Adaptation Protocol\x1fPermanent\x1f\x1f[RFC3507]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"icon\x1ficxn\x1f\x1ficon\x1fProvisional\x1f\x1f[draft-lafayette-icon-uri-scheme-01]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ilstring\x1fixxtring\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ilstring\x1filstring\x1fProvisional\x1f\x1f[OPC_Foundation][https://webstore.iec.ch/en/publication/77973]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"im\x1fix\x1f\x1fInstant Messaging\x1fPermanent\x1f\x1f[RFC3860]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"imap\x1fimxp\x1f\x1finternet message access protocol\x1fPermanent\x1f\x1f[RFC5092]\x1f\x1ffalse\x1f\x1fimap://user@mail.example.com/INBOX\x1e" +
+		"info\x1finxo\x1f\x1fInformation Assets with Identifiers in Public Namespaces. \n      [RFC4452] (section 3) defines an \"info\" registry \n        of public namespaces, which is maintained by NISO and can be accessed \n        from [http://info-uri.info/].\x1fPermanent\x1f\x1f[RFC4452]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"iotdisco\x1fixxdisco\x1fhttps://www.iana.org/assignments/uri-schemes/prov/iotdisco\x1fiotdisco\x1fProvisional\x1f\x1f[Peter_Waher][https://www.iana.org/assignments/uri-schemes/prov/iotdisco.pdf]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ipfs\x1fixxs\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ipfs\x1fipfs\x1fProvisional\x1f\x1f[Frédéric_Wang][Protocol_Labs]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ipn\x1fixn\x1f\x1fipn\x1fPermanent\x1f\x1f[RFC9758]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ipns\x1fipxx\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ipns\x1fipns\x1fProvisional\x1f\x1f[Frédéric_Wang][Protocol_Labs]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ipp\x1fixp\x1f\x1fInternet Printing Protocol\x1fPermanent\x1f\x1f[RFC3510]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ipps\x1fipxs\x1f\x1fInternet Printing Protocol over HTTPS\x1fPermanent\x1f\x1f[RFC7472]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"irc\x1fixc\x1fhttps://www.iana.org/assignments/uri-schemes/prov/irc\x1firc\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1fircs\x1firc://irc.example.com/channel\x1e" +
+		"irc6\x1firx6\x1fhttps://www.iana.org/assignments/uri-schemes/prov/irc6\x1firc6\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ircs\x1firxx\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ircs\x1fircs\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1firc\x1fircs://irc.example.com:6697/channel\x1e" +
+		"iris\x1firxs\x1f\x1fInternet Registry Information Service\x1fPermanent\x1f\x1f[RFC3981]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"iris.beep\x1firis[.]beep\x1f\x1firis.beep\x1fPermanent\x1f\x1f[RFC3983]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"iris.lwz\x1firis[.]lwz\x1f\x1firis.lwz\x1fPermanent\x1f\x1f[RFC4993]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"iris.xpc\x1firis[.]xpc\x1f\x1firis.xpc\x1fPermanent\x1f\x1f[RFC4992]\x1f\x1ffalse\x1firis.xpcs\x1f\x1e" +
+		"iris.xpcs\x1firis[.]xpcs\x1f\x1firis.xpcs\x1fPermanent\x1f\x1f[RFC4992]\x1f\x1ffalse\x1firis.xpc\x1f\x1e" +
+		"isostore\x1fixxstore\x1fhttps://www.iana.org/assignments/uri-schemes/prov/isostore\x1fisostore\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"itms\x1fitxs\x1fhttps://www.iana.org/assignments/uri-schemes/prov/itms\x1fitms\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"jabber\x1fjxxber\x1fhttps://www.iana.org/assignments/uri-schemes/perm/jabber\x1fjabber\x1fPermanent\x1f\x1f[Peter_Saint-Andre]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"jar\x1fjxr\x1fhttps://www.iana.org/assignments/uri-schemes/prov/jar\x1fjar\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"jms\x1fjxs\x1f\x1fJava Message Service\x1fProvisional\x1f\x1f[RFC6167]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"keyparc\x1fkxxparc\x1fhttps://www.iana.org/assignments/uri-schemes/prov/keyparc\x1fkeyparc\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"lastfm\x1flxxtfm\x1fhttps://www.iana.org/assignments/uri-schemes/prov/lastfm\x1flastfm\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"lbry\x1flbxy\x1fhttps://www.iana.org/assignments/uri-schemes/prov/lbry\x1flbry\x1fProvisional\x1f\x1f[Alex_Grintsvayg]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ldap\x1fldxp\x1f\x1fLightweight Directory Access Protocol\x1fPermanent\x1f\x1f[RFC4516]\x1f\x1ffalse\x1f\x1fldap://ldap.example.com/dc=example,dc=com?cn?sub\x1e" +
+		"ldaps\x1flxxps\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ldaps\x1fldaps\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"leaptofrogans\x1flxxptofrogans\x1f\x1fleaptofrogans\x1fPermanent\x1f\x1f[RFC8589]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"lid\x1flxd\x1fhttps://www.iana.org/assignments/uri-schemes/prov/lid\x1flid\x1fProvisional\x1f\x1f[IS4]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"lorawan\x1flxxawan\x1fhttps://www.iana.org/assignments/uri-schemes/prov/lorawan\x1florawan\x1fProvisional\x1f\x1f[OMA-DMSE]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"lpa\x1flxa\x1fhttps://www.iana.org/assignments/uri-schemes/prov/lpa\x1flpa\x1fProvisional\x1f\x1f[eSIM_Group_GSM_Association]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"lvlt\x1flvxt\x1fhttps://www.iana.org/assignments/uri-schemes/prov/lvlt\x1flvlt\x1fProvisional\x1f\x1f[Alexander_Shishenko]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"machineprovisioningprogressreporter\x1fmxxhineprovisioningprogressreporter\x1fhttps://www.iana.org/assignments/uri-schemes/prov/machineProvisioningProgressReporter\x1fWindows Autopilot Modern Device Management status updates\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"magnet\x1fmxxnet\x1fhttps://www.iana.org/assignments/uri-schemes/prov/magnet\x1fmagnet\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1fmagnet:?xt=urn:btih:c12fe1c06bba254a9dc9f519b335aa7c1367a88a\x1e" +
+		"mailserver\x1fmxxlserver\x1f\x1fAccess to data available from mail servers\x1fHistorical\x1f\x1f[RFC6196]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"mailto\x1fmxxlto\x1f\x1fElectronic mail address\x1fPermanent\x1f\x1f[RFC6068]\x1f\x1ffalse\x1f\x1fmailto:user@example.com mailto:user@example.com?subject=Hello\x1e" +
+		"maps\x1fmaxs\x1fhttps://www.iana.org/assignments/uri-schemes/prov/maps\x1fmaps\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"market\x1fmxxket\x1fhttps://www.iana.org/assignments/uri-schemes/prov/market\x1fmarket\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"matrix\x1fmxxrix\x1fhttps://www.iana.org/assignments/uri-schemes/prov/matrix\x1fmatrix\x1fProvisional\x1f\x1f[Hubert_Chathi]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"message\x1fmxxsage\x1fhttps://www.iana.org/assignments/uri-schemes/prov/message\x1fmessage\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"microsoft.windows.camera\x1fmicrosoft[.]windows[.]camera\x1fhttps://www.iana.org/assignments/uri-schemes/prov/microsoft.windows.camera\x1fmicrosoft.windows.camera\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"microsoft.windows.camera.multipicker\x1fmicrosoft[.]windows[.]camera[.]multipicker\x1fhttps://www.iana.org/assignments/uri-schemes/prov/microsoft.windows.camera.multipicker\x1fmicrosoft.windows.camera.multipicker\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"microsoft.windows.camera.picker\x1fmicrosoft[.]windows[.]camera[.]picker\x1fhttps://www.iana.org/assignments/uri-schemes/prov/microsoft.windows.camera.picker\x1fmicrosoft.windows.camera.picker\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"mid\x1fmxd\x1f\x1fmessage identifier\x1fPermanent\x1f\x1f[RFC2392]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"mms\x1fmxs\x1fhttps://www.iana.org/assignments/uri-schemes/prov/mms\x1fmms\x1fProvisional\x1f\x1f[Alexey_Melnikov]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"modem\x1fmxxem\x1f\x1fmodem\x1fHistorical\x1f\x1f[RFC2806][RFC3966]\x1f\x1ffalse\x1ftel\x1f\x1e" +
+		"mongodb\x1fmxxgodb\x1fhttps://www.iana.org/assignments/uri-schemes/prov/mongodb\x1fmongodb\x1fProvisional\x1f\x1f[Ignacio_Losiggio][Mongo_DB_Inc]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"moz\x1fmxz\x1fhttps://www.iana.org/assignments/uri-schemes/prov/moz\x1fmoz\x1fProvisional\x1f\x1f[Joe_Hildebrand]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-access\x1fms[-]access\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-access\x1fms-access\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-appinstaller\x1fms[-]appinstaller\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-appinstaller\x1fms-appinstaller\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-browser-extension\x1fms[-]browser[-]extension\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-browser-extension\x1fms-browser-extension\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-calculator\x1fms[-]calculator\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-calculator\x1fms-calculator\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-drive-to\x1fms[-]drive[-]to\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-drive-to\x1fms-drive-to\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-enrollment\x1fms[-]enrollment\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-enrollment\x1fms-enrollment\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-excel\x1fms[-]excel\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-excel\x1fms-excel\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-eyecontrolspeech\x1fms[-]eyecontrolspeech\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-eyecontrolspeech\x1fms-eyecontrolspeech\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-gamebarservices\x1fms[-]gamebarservices\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-gamebarservices\x1fms-gamebarservices\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-gamingoverlay\x1fms[-]gamingoverlay\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-gamingoverlay\x1fms-gamingoverlay\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-getoffice\x1fms[-]getoffice\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-getoffice\x1fms-getoffice\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-help\x1fms[-]help\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-help\x1fms-help\x1fProvisional\x1f\x1f[Alexey_Melnikov]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-infopath\x1fms[-]infopath\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-infopath\x1fms-infopath\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-inputapp\x1fms[-]inputapp\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-inputapp\x1fms-inputapp\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-launchremotedesktop\x1fms[-]launchremotedesktop\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-launchremotedesktop\x1fms-launchremotedesktop\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-lockscreencomponent-config\x1fms[-]lockscreencomponent[-]config\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-lockscreencomponent-config\x1fms-lockscreencomponent-config\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-media-stream-id\x1fms[-]media[-]stream[-]id\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-media-stream-id\x1fms-media-stream-id\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-meetnow\x1fms[-]meetnow\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-meetnow\x1fms-meetnow\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-mixedrealitycapture\x1fms[-]mixedrealitycapture\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-mixedrealitycapture\x1fms-mixedrealitycapture\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-mobileplans\x1fms[-]mobileplans\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-mobileplans\x1fms-mobileplans\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-newsandinterests\x1fms[-]newsandinterests\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-newsandinterests\x1fms-newsandinterests\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-officeapp\x1fms[-]officeapp\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-officeapp\x1fms-officeapp\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-people\x1fms[-]people\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-people\x1fms-people\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-personacard\x1fms[-]personacard\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-personacard\x1fms-personacard\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-powerpoint\x1fms[-]powerpoint\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-powerpoint\x1fms-powerpoint\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-project\x1fms[-]project\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-project\x1fms-project\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-publisher\x1fms[-]publisher\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-publisher\x1fms-publisher\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-recall\x1fms[-]recall\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-recall\x1fms-recall\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-remotedesktop\x1fms[-]remotedesktop\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-remotedesktop\x1fms-remotedesktop\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-remotedesktop-launch\x1fms[-]remotedesktop[-]launch\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-remotedesktop-launch\x1fms-remotedesktop-launch\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-restoretabcompanion\x1fms[-]restoretabcompanion\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-restoretabcompanion\x1fms-restoretabcompanion\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-screenclip\x1fms[-]screenclip\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-screenclip\x1fms-screenclip\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-screensketch\x1fms[-]screensketch\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-screensketch\x1fms-screensketch\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-search\x1fms[-]search\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-search\x1fms-search\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-search-repair\x1fms[-]search[-]repair\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-search-repair\x1fms-search-repair\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-secondary-screen-controller\x1fms[-]secondary[-]screen[-]controller\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-secondary-screen-controller\x1fms-secondary-screen-controller\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-secondary-screen-setup\x1fms[-]secondary[-]screen[-]setup\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-secondary-screen-setup\x1fms-secondary-screen-setup\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings\x1fms[-]settings\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings\x1fms-settings\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings-airplanemode\x1fms[-]settings[-]airplanemode\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings-airplanemode\x1fms-settings-airplanemode\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings-bluetooth\x1fms[-]settings[-]bluetooth\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings-bluetooth\x1fms-settings-bluetooth\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings-camera\x1fms[-]settings[-]camera\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings-camera\x1fms-settings-camera\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings-cellular\x1fms[-]settings[-]cellular\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings-cellular\x1fms-settings-cellular\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings-cloudstorage\x1fms[-]settings[-]cloudstorage\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings-cloudstorage\x1fms-settings-cloudstorage\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings-connectabledevices\x1fms[-]settings[-]connectabledevices\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings-connectabledevices\x1fms-settings-connectabledevices\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings-displays-topology\x1fms[-]settings[-]displays[-]topology\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings-displays-topology\x1fms-settings-displays-topology\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings-emailandaccounts\x1fms[-]settings[-]emailandaccounts\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings-emailandaccounts\x1fms-settings-emailandaccounts\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings-language\x1fms[-]settings[-]language\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings-language\x1fms-settings-language\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings-location\x1fms[-]settings[-]location\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings-location\x1fms-settings-location\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings-lock\x1fms[-]settings[-]lock\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings-lock\x1fms-settings-lock\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings-nfctransactions\x1fms[-]settings[-]nfctransactions\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings-nfctransactions\x1fms-settings-nfctransactions\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings-notifications\x1fms[-]settings[-]notifications\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings-notifications\x1fms-settings-notifications\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings-power\x1fms[-]settings[-]power\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings-power\x1fms-settings-power\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings-privacy\x1fms[-]settings[-]privacy\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings-privacy\x1fms-settings-privacy\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings-proximity\x1fms[-]settings[-]proximity\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings-proximity\x1fms-settings-proximity\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings-screenrotation\x1fms[-]settings[-]screenrotation\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings-screenrotation\x1fms-settings-screenrotation\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings-wifi\x1fms[-]settings[-]wifi\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings-wifi\x1fms-settings-wifi\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-settings-workplace\x1fms[-]settings[-]workplace\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-settings-workplace\x1fms-settings-workplace\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-spd\x1fms[-]spd\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-spd\x1fms-spd\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-stickers\x1fms[-]stickers\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-stickers\x1fms-stickers\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-sttoverlay\x1fms[-]sttoverlay\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-sttoverlay\x1fms-sttoverlay\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-transit-to\x1fms[-]transit[-]to\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-transit-to\x1fms-transit-to\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-useractivityset\x1fms[-]useractivityset\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-useractivityset\x1fms-useractivityset\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-uup\x1fms[-]uup\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-uup\x1fms-uup\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-virtualtouchpad\x1fms[-]virtualtouchpad\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-virtualtouchpad\x1fms-virtualtouchpad\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-visio\x1fms[-]visio\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-visio\x1fms-visio\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-walk-to\x1fms[-]walk[-]to\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-walk-to\x1fms-walk-to\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-whiteboard\x1fms[-]whiteboard\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-whiteboard\x1fms-whiteboard\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-whiteboard-cmd\x1fms[-]whiteboard[-]cmd\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-whiteboard-cmd\x1fms-whiteboard-cmd\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-widgetboard\x1fms[-]widgetboard\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-widgetboard\x1fms-widgetboard\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-widgets\x1fms[-]widgets\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-widgets\x1fms-widgets\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ms-word\x1fms[-]word\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ms-word\x1fms-word\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"msnim\x1fmxxim\x1fhttps://www.iana.org/assignments/uri-schemes/prov/msnim\x1fmsnim\x1fProvisional\x1f\x1f[Alexey_Melnikov]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"msrp\x1fmsxp\x1f\x1fMessage Session Relay Protocol\x1fPermanent\x1f\x1f[RFC4975]\x1f\x1ffalse\x1fmsrps\x1f\x1e" +
+		"msrps\x1fmxxps\x1f\x1fMessage Session Relay Protocol Secure\x1fPermanent\x1f\x1f[RFC4975][RFC8873]\x1f\x1ffalse\x1fmsrp\x1f\x1e" +
+		"mss\x1fmxx\x1fhttps://www.iana.org/assignments/uri-schemes/prov/mss\x1fmss\x1fProvisional\x1f\x1f[Jarmo_Miettinen]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"mt\x1fmx\x1fhttps://www.iana.org/assignments/uri-schemes/perm/mt\x1fMatter protocol on-boarding payloads that are encoded for use in QR Codes and/or NFC Tags\x1fPermanent\x1f\x1f[Connectivity_Standards_Alliance]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"mtqp\x1fmtxp\x1f\x1fMessage Tracking Query Protocol\x1fPermanent\x1f\x1f[RFC3887]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"mtrust\x1fmxxust\x1fhttps://www.iana.org/assignments/uri-schemes/prov/mtrust\x1fmtrust\x1fProvisional\x1f\x1f[Egbert_von_Frankenberg]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"mumble\x1fmxxble\x1fhttps://www.iana.org/assignments/uri-schemes/prov/mumble\x1fmumble\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"mupdate\x1fmxxdate\x1f\x1fMailbox Update (MUPDATE) Protocol\x1fPermanent\x1f\x1f[RFC3656]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"mvn\x1fmxn\x1fhttps://www.iana.org/assignments/uri-schemes/prov/mvn\x1fmvn\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"mvrp\x1fmvxp\x1fhttps://www.iana.org/assignments/uri-schemes/prov/mvrp\x1fmvrp\n      (see [reviewer notes])\x1fProvisional\x1f\x1f[Antonio_Walker]\x1f\x1ffalse\x1fmvrps\x1f\x1e" +
+		"mvrps\x1fmxxxs\x1fhttps://www.iana.org/assignments/uri-schemes/prov/mvrps\x1fmvrps\n      (see [reviewer notes])\x1fProvisional\x1f\x1f[Antonio_Walker]\x1f\x1ffalse\x1fmvrp\x1f\x1e" +
+		"news\x1fnexs\x1f\x1fUSENET news\x1fPermanent\x1f\x1f[RFC5538]\x1f\x1ffalse\x1f\x1fnews:comp.lang.go\x1e" +
+		"nfs\x1fnxs\x1f\x1fnetwork file system protocol\x1fPermanent\x1f\x1f[RFC2224]\x1f\x1ffalse\x1f\x1fnfs://fileserver.example.com/export/home\x1e" +
+		"ni\x1fnx\x1f\x1fni\x1fPermanent\x1f\x1f[RFC6920]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"nih\x1fnxh\x1f\x1fnih\x1fPermanent\x1f\x1f[RFC6920]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"nntp\x1fnnxp\x1f\x1fUSENET news using NNTP access\x1fPermanent\x1f\x1f[RFC5538]\x1f\x1ffalse\x1f\x1fnntp://news.example.com/comp.lang.go\x1e" +
+		"notes\x1fnxxes\x1fhttps://www.iana.org/assignments/uri-schemes/prov/notes\x1fnotes\x1fProvisional\x1f\x1f[draft-dconmy-notes-uri-scheme-02]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"num\x1fnxm\x1fhttps://www.iana.org/assignments/uri-schemes/prov/num\x1fNamespace Utility Modules\x1fProvisional\x1f\x1f[Elliott_Brown][https://www.numprotocol.com/specification]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ocf\x1foxf\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ocf\x1focf\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"oid\x1foxd\x1fhttps://www.iana.org/assignments/uri-schemes/prov/oid\x1foid\x1fProvisional\x1f\x1f[draft-larmouth-oid-iri-04]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"onenote\x1foxxnote\x1fhttps://www.iana.org/assignments/uri-schemes/prov/onenote\x1fonenote\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"onenote-cmd\x1fonenote[-]cmd\x1fhttps://www.iana.org/assignments/uri-schemes/prov/onenote-cmd\x1fonenote-cmd\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"opaquelocktoken\x1foxxquelocktoken\x1f\x1fopaquelocktokent\x1fPermanent\x1f\x1f[RFC4918]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"openid\x1foxxnid\x1fhttps://www.iana.org/assignments/uri-schemes/prov/openid\x1fOpenID Connect\x1fProvisional\x1f\x1f[OpenID_Foundation_Artifact_Binding_Working_Group][OpenID Connect Core 1.0, Section 7.3]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"openpgp4fpr\x1foxxnpgp4fpr\x1fhttps://www.iana.org/assignments/uri-schemes/prov/openpgp4fpr\x1fopenpgp4fpr\x1fProvisional\x1f\x1f[Wiktor_Kwapisiewicz]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"otpauth\x1foxxauth\x1fhttps://www.iana.org/assignments/uri-schemes/prov/otpauth\x1fotpauth\x1fProvisional\x1f\x1f[Frédéric_Wang][Thomas_Habets]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"p1\x1fpx\x1fhttps://www.iana.org/assignments/uri-schemes/historic/p1\x1fp1\x1fHistorical\x1f\x1f[IESG]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"pack\x1fpaxk\x1fhttps://www.iana.org/assignments/uri-schemes/historic/pack\x1fpack\x1fHistorical\x1f\x1f[draft-shur-pack-uri-scheme-05]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"palm\x1fpaxm\x1fhttps://www.iana.org/assignments/uri-schemes/prov/palm\x1fpalm\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"paparazzi\x1fpxxarazzi\x1fhttps://www.iana.org/assignments/uri-schemes/prov/paparazzi\x1fpaparazzi\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"payment\x1fpxxment\x1fhttps://www.iana.org/assignments/uri-schemes/historic/payment\x1fpayment\x1fHistorical\x1f\x1f[IESG]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"payto\x1fpxxto\x1fhttps://www.iana.org/assignments/uri-schemes/prov/payto\x1fpayto\x1fProvisional\x1f\x1f[RFC8905]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"pkcs11\x1fpxxs11\x1f\x1fPKCS#11\x1fPermanent\x1f\x1f[RFC7512]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"platform\x1fpxxtform\x1fhttps://www.iana.org/assignments/uri-schemes/prov/platform\x1fplatform\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"pop\x1fpxp\x1f\x1fPost Office Protocol v3\x1fPermanent\x1f\x1f[RFC2384]\x1f\x1ffalse\x1f\x1fpop://user@mail.example.com\x1e" +
+		"pres\x1fprxs\x1f\x1fPresence\x1fPermanent\x1f\x1f[RFC3859]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"prospero\x1fpxxspero\x1f\x1fProspero Directory Service\x1fHistorical\x1f\x1f[RFC4157]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"proxy\x1fpxxxy\x1fhttps://www.iana.org/assignments/uri-schemes/prov/proxy\x1fproxy\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"psyc\x1fpsxc\x1fhttps://www.iana.org/assignments/uri-schemes/prov/psyc\x1fpsyc\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"pttp\x1fptxp\x1fhttps://www.iana.org/assignments/uri-schemes/prov/pttp\x1fpttp\x1fProvisional\x1f\x1f[Tony_Deng][Tuan_Hoang][Bob_Hinkle][Mark_Chen]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"pwid\x1fpwxd\x1fhttps://www.iana.org/assignments/uri-schemes/prov/pwid\x1fpwid\x1fProvisional\x1f\x1f[Eld_Zierau]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"qb\x1fqx\x1fhttps://www.iana.org/assignments/uri-schemes/prov/qb\x1fqb\x1fProvisional\x1f\x1f[Jan_Pokorny]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"query\x1fqxxry\x1fhttps://www.iana.org/assignments/uri-schemes/prov/query\x1fquery\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"quic-transport\x1fquic[-]transport\x1fhttps://www.iana.org/assignments/uri-schemes/prov/quic-transport\x1fquic-transport\x1fProvisional\x1f\x1f[draft-vvv-webtransport-quic-00]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"redis\x1frxxis\x1fhttps://www.iana.org/assignments/uri-schemes/prov/redis\x1fredis\x1fProvisional\x1f\x1f[Chris_Rebert]\x1f\x1ffalse\x1frediss\x1f\x1e" +
+		"rediss\x1frxxiss\x1fhttps://www.iana.org/assignments/uri-schemes/prov/rediss\x1frediss\x1fProvisional\x1f\x1f[Chris_Rebert]\x1f\x1ffalse\x1fredis\x1f\x1e" +
+		"reload\x1frxxoad\x1f\x1freload\x1fPermanent\x1f\x1f[RFC6940]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"res\x1frxs\x1fhttps://www.iana.org/assignments/uri-schemes/prov/res\x1fres\x1fProvisional\x1f\x1f[Alexey_Melnikov]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"resource\x1frxxource\x1fhttps://www.iana.org/assignments/uri-schemes/prov/resource\x1fresource\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"rmi\x1frxi\x1fhttps://www.iana.org/assignments/uri-schemes/prov/rmi\x1frmi\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"rsync\x1frxxnc\x1f\x1frsync\x1fProvisional\x1f\x1f[RFC5781]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"rtmfp\x1frxxfp\x1fhttps://www.iana.org/assignments/uri-schemes/prov/rtmfp\x1frtmfp\x1fProvisional\x1f\x1f[RFC7425]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"rtmp\x1frxxp\x1fhttps://www.iana.org/assignments/uri-schemes/prov/rtmp\x1frtmp\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"rtsp\x1frtxp\x1f\x1fReal-Time Streaming Protocol (RTSP)\x1fPermanent\x1f\x1f[RFC2326][RFC7826]\x1f\x1ffalse\x1frtsps\x1frtsp://camera.example.com:554/stream\x1e" +
+		"rtsps\x1frxxps\x1f\x1fReal-Time Streaming Protocol (RTSP) over TLS\x1fPermanent\x1f\x1f[RFC2326][RFC7826]\x1f\x1ffalse\x1frtsp\x1f\x1e" +
+		"rtspu\x1frxxpu\x1f\x1fReal-Time Streaming Protocol (RTSP) over unreliable datagram transport\x1fPermanent\x1f\x1f[RFC2326]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"sarif\x1fsxxif\x1fhttps://www.iana.org/assignments/uri-schemes/prov/sarif\x1fsarif\x1fProvisional\x1f\x1f[OASIS_Open][Michael_C_Fanning][David_Keaton]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"secondlife\x1fsxxondlife\x1fhttps://www.iana.org/assignments/uri-schemes/prov/secondlife\x1fquery\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"secret-token\x1fsecret[-]token\x1fhttps://www.iana.org/assignments/uri-schemes/prov/secret-token\x1fsecret-token\x1fProvisional\x1f\x1f[RFC8959]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"service\x1fsxxvice\x1f\x1fservice location\x1fPermanent\x1f\x1f[RFC2609]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"session\x1fsxxsion\x1f\x1fsession\x1fPermanent\x1f\x1f[RFC6787]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"sftp\x1fsfxp\x1fhttps://www.iana.org/assignments/uri-schemes/prov/sftp\x1fquery\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"sgn\x1fsxn\x1fhttps://www.iana.org/assignments/uri-schemes/prov/sgn\x1fsgn\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"shc\x1fsxc\x1fhttps://www.iana.org/assignments/uri-schemes/prov/shc\x1fshc\x1fProvisional\x1f\x1f[Josh_Mandel]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"shelter\x1fsxxlter\x1fhttps://www.iana.org/assignments/uri-schemes/prov/shelter\x1fshelter\x1fProvisional\x1f\x1f[okTurtles_Foundation]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"shttp\x1fsxxtp\x1f\x1fSecure Hypertext Transfer Protocol\x1fPermanent\x1f\x1f[RFC2660][Status change of HTTP experiments to Historic]\x1f\x1ftrue\x1fhttps\x1f\x1e" +
+		"sieve\x1fsxxve\x1f\x1fManageSieve Protocol\x1fPermanent\x1f\x1f[RFC5804]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"simpleledger\x1fsxxpleledger\x1fhttps://www.iana.org/assignments/uri-schemes/prov/simpleledger\x1fsimpleledger\x1fProvisional\x1f\x1f[James_Cramer]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"simplex\x1fsxxplex\x1fhttps://www.iana.org/assignments/uri-schemes/prov/simplex\x1fsimplex\x1fProvisional\x1f\x1f[Evgeny_Poberezkin]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"sip\x1fsxp\x1f\x1fsession initiation protocol\x1fPermanent\x1f\x1f[RFC3261]\x1f\x1ffalse\x1fsips\x1fsip:alice@example.com\x1e" +
+		"sips\x1fsixs\x1f\x1fsecure session initiation protocol\x1fPermanent\x1f\x1f[RFC3261]\x1f\x1ffalse\x1fsip\x1fsips:alice@example.com:5061\x1e" +
+		"skype\x1fsxxpe\x1fhttps://www.iana.org/assignments/uri-schemes/prov/skype\x1fskype\x1fProvisional\x1f\x1f[Alexey_Melnikov]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"smb\x1fsxb\x1fhttps://www.iana.org/assignments/uri-schemes/prov/smb\x1fsmb\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1fsmb://fileserver.example.com/share/\x1e" +
+		"smp\x1fsxx\x1fhttps://www.iana.org/assignments/uri-schemes/prov/smp\x1fsmp\x1fProvisional\x1f\x1f[Evgeny_Poberezkin]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"sms\x1fsxs\x1f\x1fShort Message Service\x1fPermanent\x1f\x1f[RFC5724]\x1f\x1ffalse\x1f\x1fsms:+447700900123?body=hello\x1e" +
+		"smtp\x1fsmxp\x1fhttps://www.iana.org/assignments/uri-schemes/prov/smtp\x1fsmtp\x1fProvisional\x1f\x1f[draft-melnikov-smime-msa-to-mda-03]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"snews\x1fsxxws\x1f\x1fNNTP over SSL/TLS\x1fHistorical\x1f\x1f[RFC5538]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"snmp\x1fsnxp\x1f\x1fSimple Network Management Protocol\x1fPermanent\x1f\x1f[RFC4088]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"soap.beep\x1fsoap[.]beep\x1f\x1fsoap.beep\x1fPermanent\x1f\x1f[RFC4227]\x1f\x1ffalse\x1fsoap.beeps\x1f\x1e" +
+		"soap.beeps\x1fsoap[.]beeps\x1f\x1fsoap.beeps\x1fPermanent\x1f\x1f[RFC4227]\x1f\x1ffalse\x1fsoap.beep\x1f\x1e" +
+		"soldat\x1fsxxdat\x1fhttps://www.iana.org/assignments/uri-schemes/prov/soldat\x1fsoldat\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"spiffe\x1fsxxffe\x1fhttps://www.iana.org/assignments/uri-schemes/prov/spiffe\x1fspiffe\x1fProvisional\x1f\x1f[Evan_Gilman]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"spotify\x1fsxxtify\x1fhttps://www.iana.org/assignments/uri-schemes/prov/spotify\x1fspotify\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ssb\x1fs[s]b\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ssb\x1fssb\x1fProvisional\x1f\x1f[Frédéric_Wang][Secure_Scuttlebutt_Consortium]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ssh\x1fsxh\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ssh\x1fssh\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1fssh://user@host.example.com:2222\x1e" +
+		"starknet\x1fsxxrknet\x1fhttps://www.iana.org/assignments/uri-schemes/prov/starknet\x1fstarknet\x1fProvisional\x1f\x1f[Abraham_Makovetsky]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"steam\x1fsxxam\x1fhttps://www.iana.org/assignments/uri-schemes/prov/steam\x1fsteam\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"stun\x1fstxn\x1f\x1fstun\x1fPermanent\x1f\x1f[RFC7064]\x1f\x1ffalse\x1fstuns\x1fstun:stun.example.com:3478\x1e" +
+		"stuns\x1fsxxns\x1f\x1fstuns\x1fPermanent\x1f\x1f[RFC7064]\x1f\x1ffalse\x1fstun\x1f\x1e" +
+		"submit\x1fsxxmit\x1fhttps://www.iana.org/assignments/uri-schemes/prov/submit\x1fsubmit\x1fProvisional\x1f\x1f[draft-melnikov-smime-msa-to-mda-03]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"svn\x1fs[v]n\x1fhttps://www.iana.org/assignments/uri-schemes/prov/svn\x1fsvn\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"swh\x1fs[w]h\x1fhttps://www.iana.org/assignments/uri-schemes/prov/swh\x1fswh\x1fProvisional\x1f\x1f[Software_Heritage][Stefano_Zacchiroli]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"swid\x1fswxd\x1fhttps://www.iana.org/assignments/uri-schemes/prov/swid\x1fswid \n\n      (see [reviewer notes])\x1fProvisional\x1f\x1f[RFC9393, Section 5.1]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"swidpath\x1fsxxdpath\x1fhttps://www.iana.org/assignments/uri-schemes/prov/swidpath\x1fswidpath \n\n      (see [reviewer notes])\x1fProvisional\x1f\x1f[RFC9393, Section 5.2]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"tag\x1ftxg\x1f\x1ftag\x1fPermanent\x1f\x1f[RFC4151]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"taler\x1ftxxer\x1fhttps://www.iana.org/assignments/uri-schemes/prov/taler\x1ftaler\x1fProvisional\x1f\x1f[draft-grothoff-taler-01]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"teamspeak\x1ftxxmspeak\x1fhttps://www.iana.org/assignments/uri-schemes/prov/teamspeak\x1fteamspeak\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"teapot\x1ftxxpot\x1fhttps://www.iana.org/assignments/uri-schemes/prov/teapot\x1fteapot\x1fProvisional\x1f\x1f[Karwan_Stark]\x1f\x1ffalse\x1fteapots\x1f\x1e" +
+		"teapots\x1ftxxpots\x1fhttps://www.iana.org/assignments/uri-schemes/prov/teapots\x1fteapots\x1fProvisional\x1f\x1f[Karwan_Stark]\x1f\x1ffalse\x1fteapot\x1f\x1e" +
+		"tel\x1ftxl\x1f\x1ftelephone\x1fPermanent\x1f\x1f[RFC3966][RFC5341]\x1f\x1ffalse\x1ffax modem\x1ftel:+447700900123\x1e" +
+		"teliaeid\x1ftxxiaeid\x1fhttps://www.iana.org/assignments/uri-schemes/prov/teliaeid\x1fteliaeid\x1fProvisional\x1f\x1f[Peter_Lewandowski]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"telnet\x1ftxxnet\x1f\x1fReference to interactive sessions\x1fPermanent\x1f\x1f[RFC4248]\x1f\x1ffalse\x1f\x1ftelnet://192.0.2.1:23/\x1e" +
+		"tftp\x1ftfxp\x1f\x1fTrivial File Transfer Protocol\x1fPermanent\x1f\x1f[RFC3617]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"things\x1ftxxngs\x1fhttps://www.iana.org/assignments/uri-schemes/prov/things\x1fthings\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"thismessage\x1ftxxsmessage\x1fhttps://www.iana.org/assignments/uri-schemes/perm/thismessage\x1fmultipart/related relative reference resolution\x1fPermanent\x1f\x1f[RFC2557]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"thzp\x1fthxp\x1fhttps://www.iana.org/assignments/uri-schemes/historic/thzp\x1fthzp\x1fHistorical\x1f\x1f[IESG]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"tip\x1ftxp\x1f\x1fTransaction Internet Protocol\x1fPermanent\x1f\x1f[RFC2371]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"tn3270\x1ftxx270\x1f\x1fInteractive 3270 emulation sessions\x1fPermanent\x1f\x1f[RFC6270]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"tool\x1ftoxl\x1fhttps://www.iana.org/assignments/uri-schemes/prov/tool\x1ftool\x1fProvisional\x1f\x1f[Matthias_Merkel]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"turn\x1ftuxn\x1f\x1fturn\x1fPermanent\x1f\x1f[RFC7065]\x1f\x1ffalse\x1fturns\x1fturn:turn.example.com?transport=udp\x1e" +
+		"turns\x1ftxxns\x1f\x1fturns\x1fPermanent\x1f\x1f[RFC7065]\x1f\x1ffalse\x1fturn\x1f\x1e" +
+		"tv\x1ftx\x1f\x1fTV Broadcasts\x1fPermanent\x1f\x1f[RFC2838]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"udp\x1fuxp\x1fhttps://www.iana.org/assignments/uri-schemes/prov/udp\x1fudp\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"unreal\x1fuxxeal\x1fhttps://www.iana.org/assignments/uri-schemes/prov/unreal\x1funreal\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"upt\x1fuxt\x1fhttps://www.iana.org/assignments/uri-schemes/historic/upt\x1fupt\x1fHistorical\x1f\x1f[IESG]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"urn\x1fuxn\x1f\x1fUniform Resource Names\x1fPermanent\x1f\x1f[RFC8141][IANA registryurn-namespaces]\x1f\x1ffalse\x1f\x1furn:isbn:0451450523 urn:ietf:rfc:3986\x1e" +
+		"ut2004\x1fuxx004\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ut2004\x1fut2004\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"uuid-in-package\x1fuuid[-]in[-]package\x1fhttps://www.iana.org/assignments/uri-schemes/prov/uuid-in-package\x1fuuid-in-package\x1fProvisional\x1f\x1f[Kunihiko_Sakamoto]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"v-event\x1fv[-]event\x1fhttps://www.iana.org/assignments/uri-schemes/prov/v-event\x1fv-event\x1fProvisional\x1f\x1f[draft-menderico-v-event-uri-00]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"vemmi\x1fvxxmi\x1f\x1fversatile multimedia interface\x1fPermanent\x1f\x1f[RFC2122]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ventrilo\x1fvxxtrilo\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ventrilo\x1fventrilo\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ves\x1fvxs\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ves\x1fves\x1fProvisional\x1f\x1f[Jim_Zubov]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"videotex\x1fvxxeotex\x1fhttps://www.iana.org/assignments/uri-schemes/historic/videotex\x1fvideotex\x1fHistorical\x1f\x1f[draft-mavrakis-videotex-url-spec-01][RFC2122][RFC3986]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"view-source\x1fview[-]source\x1fhttps://www.iana.org/assignments/uri-schemes/prov/view-source\x1fview-source\x1fProvisional\x1f\x1f[Mykyta_Yevstifeyev]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"vnc\x1fvxc\x1f\x1fRemote Framebuffer Protocol\x1fPermanent\x1f\x1f[RFC7869]\x1f\x1ffalse\x1f\x1fvnc://host.example.com:5900\x1e" +
+		"vscode\x1fvxxode\x1fhttps://www.iana.org/assignments/uri-schemes/prov/vscode\x1fvscode\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"vscode-insiders\x1fvscode[-]insiders\x1fhttps://www.iana.org/assignments/uri-schemes/prov/vscode-insiders\x1fvscode-insiders\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"vsls\x1fvsxs\x1fhttps://www.iana.org/assignments/uri-schemes/prov/vsls\x1fvsls\x1fProvisional\x1f\x1f[urischemeowners_at_microsoft.com]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"w3\x1fw[3]\x1fhttps://www.iana.org/assignments/uri-schemes/prov/w3\x1fw3 \n      (see [reviewer notes])\x1fProvisional\x1f\x1f[Qi_Zhou]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"wais\x1fwaxs\x1f\x1fWide Area Information Servers\x1fHistorical\x1f\x1f[RFC4156]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"wasm\x1fwaxm\x1fhttps://www.iana.org/assignments/uri-schemes/prov/wasm\x1fwasm\x1fProvisional\x1f\x1f[W3C_WebAssembly_Community_Group]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"wasm-js\x1fwasm[-]js\x1fhttps://www.iana.org/assignments/uri-schemes/prov/wasm-js\x1fwasm-js\x1fProvisional\x1f\x1f[W3C_WebAssembly_Community_Group]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"wcr\x1fwxr\x1fhttps://www.iana.org/assignments/uri-schemes/prov/wcr\x1fwcr\x1fProvisional\x1f\x1f[Jason_Dzubak]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"web+ap\x1fweb[+]ap\x1fhttps://www.iana.org/assignments/uri-schemes/prov/web+ap\x1fweb+ap\x1fProvisional\x1f\x1f[Soni_L.]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"web3\x1fwex3\x1fhttps://www.iana.org/assignments/uri-schemes/prov/web3\x1fweb3\x1fProvisional\x1f\x1f[Qi_Zhou]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"webcal\x1fwxxcal\x1fhttps://www.iana.org/assignments/uri-schemes/prov/webcal\x1fwebcal\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1fwebcal://calendar.example.com/events.ics\x1e" +
+		"wifi\x1fwixi\x1fhttps://www.iana.org/assignments/uri-schemes/prov/wifi\x1fwifi\x1fProvisional\x1f\x1f[Wi-Fi_Alliance][Jun_Tian]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"wpid\x1fwpxd\x1fhttps://www.iana.org/assignments/uri-schemes/prov/wpid\x1fwpid\x1fHistorical\x1f\x1f[Eld_Zierau]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ws\x1fwx\x1f\x1fWebSocket connections\x1fPermanent\x1f[RFC8307]\x1f[RFC6455]\x1f\x1ffalse\x1fwss\x1fws://example.com/socket\x1e" +
+		"wss\x1fwxs\x1f\x1fEncrypted WebSocket connections\x1fPermanent\x1f[RFC8307]\x1f[RFC6455]\x1f\x1ffalse\x1fws\x1fwss://example.com/socket\x1e" +
+		"wtai\x1fwtxi\x1fhttps://www.iana.org/assignments/uri-schemes/prov/wtai\x1fwtai\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"wyciwyg\x1fwxxiwyg\x1fhttps://www.iana.org/assignments/uri-schemes/prov/wyciwyg\x1fwyciwyg\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"xcon\x1fxcxn\x1f\x1fxcon\x1fPermanent\x1f\x1f[RFC6501]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"xcon-userid\x1fxcon[-]userid\x1f\x1fxcon-userid\x1fPermanent\x1f\x1f[RFC6501]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"xfire\x1fxxxre\x1fhttps://www.iana.org/assignments/uri-schemes/prov/xfire\x1fxfire\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"xftp\x1fxfxp\x1fhttps://www.iana.org/assignments/uri-schemes/prov/xftp\x1fxftp\x1fProvisional\x1f\x1f[Evgeny_Poberezkin]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"xmlrpc.beep\x1fxmlrpc[.]beep\x1f\x1fxmlrpc.beep\x1fPermanent\x1f\x1f[RFC3529]\x1f\x1ffalse\x1fxmlrpc.beeps\x1f\x1e" +
+		"xmlrpc.beeps\x1fxmlrpc[.]beeps\x1f\x1fxmlrpc.beeps\x1fPermanent\x1f\x1f[RFC3529]\x1f\x1ffalse\x1fxmlrpc.beep\x1f\x1e" +
+		"xmpp\x1fxmxp\x1f\x1fExtensible Messaging and Presence Protocol\x1fPermanent\x1f\x1f[RFC5122]\x1f\x1ffalse\x1f\x1fxmpp:alice@example.com\x1e" +
+		"xrcp\x1fxrxp\x1fhttps://www.iana.org/assignments/uri-schemes/prov/xrcp\x1fxrcp\x1fProvisional\x1f\x1f[Evgeny_Poberezkin]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"xri\x1fxxi\x1fhttps://www.iana.org/assignments/uri-schemes/prov/xri\x1fxri\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"ymsgr\x1fyxxgr\x1fhttps://www.iana.org/assignments/uri-schemes/prov/ymsgr\x1fymsgr\x1fProvisional\x1f\x1f[Dave_Thaler]\x1f\x1ffalse\x1f\x1f\x1e" +
+		"z39.50\x1fz39[.]50\x1f\x1fZ39.50 information access\x1fHistorical\x1f\x1f[RFC1738][RFC2056]\x1f\x1ffalse\x1fz39.50r z39.50s\x1f\x1e" +
+		"z39.50r\x1fz39[.]50r\x1f\x1fZ39.50 Retrieval\x1fPermanent\x1f\x1f[RFC2056]\x1f\x1ffalse\x1fz39.50\x1f\x1e" +
+		"z39.50s\x1fz39[.]50s\x1f\x1fZ39.50 Session\x1fPermanent\x1f\x1f[RFC2056]\x1f\x1ffalse\x1fz39.50\x1f\x1e"
+}
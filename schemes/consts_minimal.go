@@ -0,0 +1,412 @@
+//go:build defang_schemes_minimal
+
+package schemes
+
+/*
+THIS FILE WAS AUTOMATICALLY GENERATED AT 2026-10-16 07:57:26
+
+Do not edit this file.  Run "go generate" to re-generate this file with an
+updated version of URI schemes from:
+    iana.org/assignments/uri-schemes/uri-schemes.xhtml.
+*/
+
+func init() {
+	Map = map[string]Scheme{
+		"aaa":                                  {Scheme: "aaa", DefangedScheme: "axa", Status: Permanent},
+		"aaas":                                 {Scheme: "aaas", DefangedScheme: "aaxs", Status: Permanent},
+		"about":                                {Scheme: "about", DefangedScheme: "axxut", Status: Permanent},
+		"acap":                                 {Scheme: "acap", DefangedScheme: "acxp", Status: Permanent},
+		"acct":                                 {Scheme: "acct", DefangedScheme: "acxt", Status: Permanent},
+		"acd":                                  {Scheme: "acd", DefangedScheme: "axd", Status: Provisional},
+		"acr":                                  {Scheme: "acr", DefangedScheme: "axr", Status: Provisional},
+		"adiumxtra":                            {Scheme: "adiumxtra", DefangedScheme: "axxumxtra", Status: Provisional},
+		"adt":                                  {Scheme: "adt", DefangedScheme: "axt", Status: Provisional},
+		"afp":                                  {Scheme: "afp", DefangedScheme: "axp", Status: Provisional},
+		"afs":                                  {Scheme: "afs", DefangedScheme: "axs", Status: Provisional},
+		"aim":                                  {Scheme: "aim", DefangedScheme: "axm", Status: Provisional},
+		"amss":                                 {Scheme: "amss", DefangedScheme: "amxs", Status: Provisional},
+		"android":                              {Scheme: "android", DefangedScheme: "axxroid", Status: Provisional},
+		"appdata":                              {Scheme: "appdata", DefangedScheme: "axxdata", Status: Provisional},
+		"apt":                                  {Scheme: "apt", DefangedScheme: "axx", Status: Provisional},
+		"ar":                                   {Scheme: "ar", DefangedScheme: "ax", Status: Provisional},
+		"ari":                                  {Scheme: "ari", DefangedScheme: "axi", Status: Provisional},
+		"ark":                                  {Scheme: "ark", DefangedScheme: "axk", Status: Provisional},
+		"at":                                   {Scheme: "at", DefangedScheme: "a[t]", Status: Provisional},
+		"attachment":                           {Scheme: "attachment", DefangedScheme: "axxachment", Status: Provisional},
+		"aw":                                   {Scheme: "aw", DefangedScheme: "a[w]", Status: Provisional},
+		"barion":                               {Scheme: "barion", DefangedScheme: "bxxion", Status: Provisional},
+		"bb":                                   {Scheme: "bb", DefangedScheme: "b[b]", Status: Historical},
+		"beshare":                              {Scheme: "beshare", DefangedScheme: "bxxhare", Status: Provisional},
+		"bitcoin":                              {Scheme: "bitcoin", DefangedScheme: "bxxcoin", Status: Provisional},
+		"bitcoincash":                          {Scheme: "bitcoincash", DefangedScheme: "bxxcoincash", Status: Provisional},
+		"bl":                                   {Scheme: "bl", DefangedScheme: "bx", Status: Provisional},
+		"blob":                                 {Scheme: "blob", DefangedScheme: "blxb", Status: Provisional},
+		"bluetooth":                            {Scheme: "bluetooth", DefangedScheme: "bxxetooth", Status: Provisional},
+		"bolo":                                 {Scheme: "bolo", DefangedScheme: "boxo", Status: Provisional},
+		"brid":                                 {Scheme: "brid", DefangedScheme: "brxd", Status: Provisional},
+		"browserext":                           {Scheme: "browserext", DefangedScheme: "bxxwserext", Status: Provisional},
+		"cabal":                                {Scheme: "cabal", DefangedScheme: "cxxal", Status: Provisional},
+		"calculator":                           {Scheme: "calculator", DefangedScheme: "cxxculator", Status: Provisional},
+		"callto":                               {Scheme: "callto", DefangedScheme: "cxxlto", Status: Provisional},
+		"cap":                                  {Scheme: "cap", DefangedScheme: "cxp", Status: Permanent},
+		"cast":                                 {Scheme: "cast", DefangedScheme: "caxt", Status: Provisional},
+		"casts":                                {Scheme: "casts", DefangedScheme: "cxxts", Status: Provisional},
+		"chrome":                               {Scheme: "chrome", DefangedScheme: "cxxome", Status: Provisional},
+		"chrome-extension":                     {Scheme: "chrome-extension", DefangedScheme: "chrome[-]extension", Status: Provisional},
+		"cid":                                  {Scheme: "cid", DefangedScheme: "cxd", Status: Permanent},
+		"coap":                                 {Scheme: "coap", DefangedScheme: "coxp", Status: Permanent},
+		"coap+tcp":                             {Scheme: "coap+tcp", DefangedScheme: "coap[+]tcp", Status: Permanent},
+		"coap+ws":                              {Scheme: "coap+ws", DefangedScheme: "coap[+]ws", Status: Permanent},
+		"coaps":                                {Scheme: "coaps", DefangedScheme: "cxxps", Status: Permanent},
+		"coaps+tcp":                            {Scheme: "coaps+tcp", DefangedScheme: "coaps[+]tcp", Status: Permanent},
+		"coaps+ws":                             {Scheme: "coaps+ws", DefangedScheme: "coaps[+]ws", Status: Permanent},
+		"com-eventbrite-attendee":              {Scheme: "com-eventbrite-attendee", DefangedScheme: "com[-]eventbrite[-]attendee", Status: Provisional},
+		"content":                              {Scheme: "content", DefangedScheme: "cxxtent", Status: Provisional},
+		"content-type":                         {Scheme: "content-type", DefangedScheme: "content[-]type", Status: Provisional},
+		"crid":                                 {Scheme: "crid", DefangedScheme: "crxd", Status: Permanent},
+		"cstr":                                 {Scheme: "cstr", DefangedScheme: "csxr", Status: Provisional},
+		"cvs":                                  {Scheme: "cvs", DefangedScheme: "cxs", Status: Provisional},
+		"dab":                                  {Scheme: "dab", DefangedScheme: "dxb", Status: Provisional},
+		"dat":                                  {Scheme: "dat", DefangedScheme: "dxt", Status: Provisional},
+		"data":                                 {Scheme: "data", DefangedScheme: "daxa", Status: Permanent},
+		"dav":                                  {Scheme: "dav", DefangedScheme: "dxv", Status: Permanent},
+		"dhttp":                                {Scheme: "dhttp", DefangedScheme: "dxxtp", Status: Provisional},
+		"diaspora":                             {Scheme: "diaspora", DefangedScheme: "dxxspora", Status: Provisional},
+		"dict":                                 {Scheme: "dict", DefangedScheme: "dixt", Status: Permanent},
+		"did":                                  {Scheme: "did", DefangedScheme: "dxd", Status: Provisional},
+		"dis":                                  {Scheme: "dis", DefangedScheme: "d[i]s", Status: Provisional},
+		"dlna-playcontainer":                   {Scheme: "dlna-playcontainer", DefangedScheme: "dlna[-]playcontainer", Status: Provisional},
+		"dlna-playsingle":                      {Scheme: "dlna-playsingle", DefangedScheme: "dlna[-]playsingle", Status: Provisional},
+		"dns":                                  {Scheme: "dns", DefangedScheme: "dxs", Status: Permanent},
+		"dntp":                                 {Scheme: "dntp", DefangedScheme: "dnxp", Status: Provisional},
+		"doi":                                  {Scheme: "doi", DefangedScheme: "dxi", Status: Permanent},
+		"dpp":                                  {Scheme: "dpp", DefangedScheme: "dxp", Status: Provisional},
+		"drm":                                  {Scheme: "drm", DefangedScheme: "dxm", Status: Provisional},
+		"drop":                                 {Scheme: "drop", DefangedScheme: "drxp", Status: Historical},
+		"dtmi":                                 {Scheme: "dtmi", DefangedScheme: "dtxi", Status: Provisional},
+		"dtn":                                  {Scheme: "dtn", DefangedScheme: "dxn", Status: Permanent},
+		"dvb":                                  {Scheme: "dvb", DefangedScheme: "d[v]b", Status: Provisional},
+		"dvx":                                  {Scheme: "dvx", DefangedScheme: "dxx", Status: Provisional},
+		"dweb":                                 {Scheme: "dweb", DefangedScheme: "dwxb", Status: Provisional},
+		"ed2k":                                 {Scheme: "ed2k", DefangedScheme: "edxk", Status: Provisional},
+		"eid":                                  {Scheme: "eid", DefangedScheme: "exd", Status: Provisional},
+		"elsi":                                 {Scheme: "elsi", DefangedScheme: "elxi", Status: Provisional},
+		"embedded":                             {Scheme: "embedded", DefangedScheme: "exxedded", Status: Provisional},
+		"ens":                                  {Scheme: "ens", DefangedScheme: "exs", Status: Provisional},
+		"ethereum":                             {Scheme: "ethereum", DefangedScheme: "exxereum", Status: Provisional},
+		"example":                              {Scheme: "example", DefangedScheme: "exxmple", Status: Permanent},
+		"facetime":                             {Scheme: "facetime", DefangedScheme: "fxxetime", Status: Provisional},
+		"fax":                                  {Scheme: "fax", DefangedScheme: "fxx", Status: Historical},
+		"feed":                                 {Scheme: "feed", DefangedScheme: "fexd", Status: Provisional},
+		"feedready":                            {Scheme: "feedready", DefangedScheme: "fxxdready", Status: Provisional},
+		"fido":                                 {Scheme: "fido", DefangedScheme: "fixo", Status: Provisional},
+		"file":                                 {Scheme: "file", DefangedScheme: "fixe", Status: Permanent},
+		"filesystem":                           {Scheme: "filesystem", DefangedScheme: "fxxesystem", Status: Historical},
+		"finger":                               {Scheme: "finger", DefangedScheme: "fxxger", Status: Provisional},
+		"first-run-pen-experience":             {Scheme: "first-run-pen-experience", DefangedScheme: "first[-]run[-]pen[-]experience", Status: Provisional},
+		"fish":                                 {Scheme: "fish", DefangedScheme: "fixh", Status: Provisional},
+		"fm":                                   {Scheme: "fm", DefangedScheme: "fx", Status: Provisional},
+		"ftp":                                  {Scheme: "ftp", DefangedScheme: "fxp", Status: Permanent},
+		"fuchsia-pkg":                          {Scheme: "fuchsia-pkg", DefangedScheme: "fuchsia[-]pkg", Status: Provisional},
+		"geo":                                  {Scheme: "geo", DefangedScheme: "gxo", Status: Permanent},
+		"gg":                                   {Scheme: "gg", DefangedScheme: "g[g]", Status: Provisional},
+		"git":                                  {Scheme: "git", DefangedScheme: "gxt", Status: Provisional},
+		"gitoid":                               {Scheme: "gitoid", DefangedScheme: "gxxoid", Status: Provisional},
+		"gizmoproject":                         {Scheme: "gizmoproject", DefangedScheme: "gxxmoproject", Status: Provisional},
+		"go":                                   {Scheme: "go", DefangedScheme: "gx", Status: Permanent},
+		"gopher":                               {Scheme: "gopher", DefangedScheme: "gxxher", Status: Permanent},
+		"graph":                                {Scheme: "graph", DefangedScheme: "gxxph", Status: Provisional},
+		"grd":                                  {Scheme: "grd", DefangedScheme: "gxd", Status: Historical},
+		"gtalk":                                {Scheme: "gtalk", DefangedScheme: "gxxlk", Status: Provisional},
+		"h323":                                 {Scheme: "h323", DefangedScheme: "h3x3", Status: Permanent},
+		"ham":                                  {Scheme: "ham", DefangedScheme: "hxm", Status: Provisional},
+		"hcap":                                 {Scheme: "hcap", DefangedScheme: "hcxp", Status: Provisional},
+		"hcp":                                  {Scheme: "hcp", DefangedScheme: "hxp", Status: Provisional},
+		"hs20":                                 {Scheme: "hs20", DefangedScheme: "hsx0", Status: Provisional},
+		"http":                                 {Scheme: "http", DefangedScheme: "hxxp", Status: Permanent},
+		"https":                                {Scheme: "https", DefangedScheme: "hxxps", Status: Permanent},
+		"hxxp":                                 {Scheme: "hxxp", DefangedScheme: "hxxx", Status: Provisional},
+		"hxxps":                                {Scheme: "hxxps", DefangedScheme: "hxxxs", Status: Provisional},
+		"hydrazone":                            {Scheme: "hydrazone", DefangedScheme: "hxxrazone", Status: Provisional},
+		"hyper":                                {Scheme: "hyper", DefangedScheme: "hxxer", Status: Provisional},
+		"iax":                                  {Scheme: "iax", DefangedScheme: "ixx", Status: Permanent},
+		"icap":                                 {Scheme: "icap", DefangedScheme: "icxp", Status: Permanent},
+		"icon":                                 {Scheme: "icon", DefangedScheme: "icxn", Status: Provisional},
+		"ilstring":                             {Scheme: "ilstring", DefangedScheme: "ixxtring", Status: Provisional},
+		"im":                                   {Scheme: "im", DefangedScheme: "ix", Status: Permanent},
+		"imap":                                 {Scheme: "imap", DefangedScheme: "imxp", Status: Permanent},
+		"info":                                 {Scheme: "info", DefangedScheme: "inxo", Status: Permanent},
+		"iotdisco":                             {Scheme: "iotdisco", DefangedScheme: "ixxdisco", Status: Provisional},
+		"ipfs":                                 {Scheme: "ipfs", DefangedScheme: "ixxs", Status: Provisional},
+		"ipn":                                  {Scheme: "ipn", DefangedScheme: "ixn", Status: Permanent},
+		"ipns":                                 {Scheme: "ipns", DefangedScheme: "ipxx", Status: Provisional},
+		"ipp":                                  {Scheme: "ipp", DefangedScheme: "ixp", Status: Permanent},
+		"ipps":                                 {Scheme: "ipps", DefangedScheme: "ipxs", Status: Permanent},
+		"irc":                                  {Scheme: "irc", DefangedScheme: "ixc", Status: Provisional},
+		"irc6":                                 {Scheme: "irc6", DefangedScheme: "irx6", Status: Provisional},
+		"ircs":                                 {Scheme: "ircs", DefangedScheme: "irxx", Status: Provisional},
+		"iris":                                 {Scheme: "iris", DefangedScheme: "irxs", Status: Permanent},
+		"iris.beep":                            {Scheme: "iris.beep", DefangedScheme: "iris[.]beep", Status: Permanent},
+		"iris.lwz":                             {Scheme: "iris.lwz", DefangedScheme: "iris[.]lwz", Status: Permanent},
+		"iris.xpc":                             {Scheme: "iris.xpc", DefangedScheme: "iris[.]xpc", Status: Permanent},
+		"iris.xpcs":                            {Scheme: "iris.xpcs", DefangedScheme: "iris[.]xpcs", Status: Permanent},
+		"isostore":                             {Scheme: "isostore", DefangedScheme: "ixxstore", Status: Provisional},
+		"itms":                                 {Scheme: "itms", DefangedScheme: "itxs", Status: Provisional},
+		"jabber":                               {Scheme: "jabber", DefangedScheme: "jxxber", Status: Permanent},
+		"jar":                                  {Scheme: "jar", DefangedScheme: "jxr", Status: Provisional},
+		"jms":                                  {Scheme: "jms", DefangedScheme: "jxs", Status: Provisional},
+		"keyparc":                              {Scheme: "keyparc", DefangedScheme: "kxxparc", Status: Provisional},
+		"lastfm":                               {Scheme: "lastfm", DefangedScheme: "lxxtfm", Status: Provisional},
+		"lbry":                                 {Scheme: "lbry", DefangedScheme: "lbxy", Status: Provisional},
+		"ldap":                                 {Scheme: "ldap", DefangedScheme: "ldxp", Status: Permanent},
+		"ldaps":                                {Scheme: "ldaps", DefangedScheme: "lxxps", Status: Provisional},
+		"leaptofrogans":                        {Scheme: "leaptofrogans", DefangedScheme: "lxxptofrogans", Status: Permanent},
+		"lid":                                  {Scheme: "lid", DefangedScheme: "lxd", Status: Provisional},
+		"lorawan":                              {Scheme: "lorawan", DefangedScheme: "lxxawan", Status: Provisional},
+		"lpa":                                  {Scheme: "lpa", DefangedScheme: "lxa", Status: Provisional},
+		"lvlt":                                 {Scheme: "lvlt", DefangedScheme: "lvxt", Status: Provisional},
+		"machineprovisioningprogressreporter":  {Scheme: "machineprovisioningprogressreporter", DefangedScheme: "mxxhineprovisioningprogressreporter", Status: Provisional},
+		"magnet":                               {Scheme: "magnet", DefangedScheme: "mxxnet", Status: Provisional},
+		"mailserver":                           {Scheme: "mailserver", DefangedScheme: "mxxlserver", Status: Historical},
+		"mailto":                               {Scheme: "mailto", DefangedScheme: "mxxlto", Status: Permanent},
+		"maps":                                 {Scheme: "maps", DefangedScheme: "maxs", Status: Provisional},
+		"market":                               {Scheme: "market", DefangedScheme: "mxxket", Status: Provisional},
+		"matrix":                               {Scheme: "matrix", DefangedScheme: "mxxrix", Status: Provisional},
+		"message":                              {Scheme: "message", DefangedScheme: "mxxsage", Status: Provisional},
+		"microsoft.windows.camera":             {Scheme: "microsoft.windows.camera", DefangedScheme: "microsoft[.]windows[.]camera", Status: Provisional},
+		"microsoft.windows.camera.multipicker": {Scheme: "microsoft.windows.camera.multipicker", DefangedScheme: "microsoft[.]windows[.]camera[.]multipicker", Status: Provisional},
+		"microsoft.windows.camera.picker":      {Scheme: "microsoft.windows.camera.picker", DefangedScheme: "microsoft[.]windows[.]camera[.]picker", Status: Provisional},
+		"mid":                                  {Scheme: "mid", DefangedScheme: "mxd", Status: Permanent},
+		"mms":                                  {Scheme: "mms", DefangedScheme: "mxs", Status: Provisional},
+		"modem":                                {Scheme: "modem", DefangedScheme: "mxxem", Status: Historical},
+		"mongodb":                              {Scheme: "mongodb", DefangedScheme: "mxxgodb", Status: Provisional},
+		"moz":                                  {Scheme: "moz", DefangedScheme: "mxz", Status: Provisional},
+		"ms-access":                            {Scheme: "ms-access", DefangedScheme: "ms[-]access", Status: Provisional},
+		"ms-appinstaller":                      {Scheme: "ms-appinstaller", DefangedScheme: "ms[-]appinstaller", Status: Provisional},
+		"ms-browser-extension":                 {Scheme: "ms-browser-extension", DefangedScheme: "ms[-]browser[-]extension", Status: Provisional},
+		"ms-calculator":                        {Scheme: "ms-calculator", DefangedScheme: "ms[-]calculator", Status: Provisional},
+		"ms-drive-to":                          {Scheme: "ms-drive-to", DefangedScheme: "ms[-]drive[-]to", Status: Provisional},
+		"ms-enrollment":                        {Scheme: "ms-enrollment", DefangedScheme: "ms[-]enrollment", Status: Provisional},
+		"ms-excel":                             {Scheme: "ms-excel", DefangedScheme: "ms[-]excel", Status: Provisional},
+		"ms-eyecontrolspeech":                  {Scheme: "ms-eyecontrolspeech", DefangedScheme: "ms[-]eyecontrolspeech", Status: Provisional},
+		"ms-gamebarservices":                   {Scheme: "ms-gamebarservices", DefangedScheme: "ms[-]gamebarservices", Status: Provisional},
+		"ms-gamingoverlay":                     {Scheme: "ms-gamingoverlay", DefangedScheme: "ms[-]gamingoverlay", Status: Provisional},
+		"ms-getoffice":                         {Scheme: "ms-getoffice", DefangedScheme: "ms[-]getoffice", Status: Provisional},
+		"ms-help":                              {Scheme: "ms-help", DefangedScheme: "ms[-]help", Status: Provisional},
+		"ms-infopath":                          {Scheme: "ms-infopath", DefangedScheme: "ms[-]infopath", Status: Provisional},
+		"ms-inputapp":                          {Scheme: "ms-inputapp", DefangedScheme: "ms[-]inputapp", Status: Provisional},
+		"ms-launchremotedesktop":               {Scheme: "ms-launchremotedesktop", DefangedScheme: "ms[-]launchremotedesktop", Status: Provisional},
+		"ms-lockscreencomponent-config":        {Scheme: "ms-lockscreencomponent-config", DefangedScheme: "ms[-]lockscreencomponent[-]config", Status: Provisional},
+		"ms-media-stream-id":                   {Scheme: "ms-media-stream-id", DefangedScheme: "ms[-]media[-]stream[-]id", Status: Provisional},
+		"ms-meetnow":                           {Scheme: "ms-meetnow", DefangedScheme: "ms[-]meetnow", Status: Provisional},
+		"ms-mixedrealitycapture":               {Scheme: "ms-mixedrealitycapture", DefangedScheme: "ms[-]mixedrealitycapture", Status: Provisional},
+		"ms-mobileplans":                       {Scheme: "ms-mobileplans", DefangedScheme: "ms[-]mobileplans", Status: Provisional},
+		"ms-newsandinterests":                  {Scheme: "ms-newsandinterests", DefangedScheme: "ms[-]newsandinterests", Status: Provisional},
+		"ms-officeapp":                         {Scheme: "ms-officeapp", DefangedScheme: "ms[-]officeapp", Status: Provisional},
+		"ms-people":                            {Scheme: "ms-people", DefangedScheme: "ms[-]people", Status: Provisional},
+		"ms-personacard":                       {Scheme: "ms-personacard", DefangedScheme: "ms[-]personacard", Status: Provisional},
+		"ms-powerpoint":                        {Scheme: "ms-powerpoint", DefangedScheme: "ms[-]powerpoint", Status: Provisional},
+		"ms-project":                           {Scheme: "ms-project", DefangedScheme: "ms[-]project", Status: Provisional},
+		"ms-publisher":                         {Scheme: "ms-publisher", DefangedScheme: "ms[-]publisher", Status: Provisional},
+		"ms-recall":                            {Scheme: "ms-recall", DefangedScheme: "ms[-]recall", Status: Provisional},
+		"ms-remotedesktop":                     {Scheme: "ms-remotedesktop", DefangedScheme: "ms[-]remotedesktop", Status: Provisional},
+		"ms-remotedesktop-launch":              {Scheme: "ms-remotedesktop-launch", DefangedScheme: "ms[-]remotedesktop[-]launch", Status: Provisional},
+		"ms-restoretabcompanion":               {Scheme: "ms-restoretabcompanion", DefangedScheme: "ms[-]restoretabcompanion", Status: Provisional},
+		"ms-screenclip":                        {Scheme: "ms-screenclip", DefangedScheme: "ms[-]screenclip", Status: Provisional},
+		"ms-screensketch":                      {Scheme: "ms-screensketch", DefangedScheme: "ms[-]screensketch", Status: Provisional},
+		"ms-search":                            {Scheme: "ms-search", DefangedScheme: "ms[-]search", Status: Provisional},
+		"ms-search-repair":                     {Scheme: "ms-search-repair", DefangedScheme: "ms[-]search[-]repair", Status: Provisional},
+		"ms-secondary-screen-controller":       {Scheme: "ms-secondary-screen-controller", DefangedScheme: "ms[-]secondary[-]screen[-]controller", Status: Provisional},
+		"ms-secondary-screen-setup":            {Scheme: "ms-secondary-screen-setup", DefangedScheme: "ms[-]secondary[-]screen[-]setup", Status: Provisional},
+		"ms-settings":                          {Scheme: "ms-settings", DefangedScheme: "ms[-]settings", Status: Provisional},
+		"ms-settings-airplanemode":             {Scheme: "ms-settings-airplanemode", DefangedScheme: "ms[-]settings[-]airplanemode", Status: Provisional},
+		"ms-settings-bluetooth":                {Scheme: "ms-settings-bluetooth", DefangedScheme: "ms[-]settings[-]bluetooth", Status: Provisional},
+		"ms-settings-camera":                   {Scheme: "ms-settings-camera", DefangedScheme: "ms[-]settings[-]camera", Status: Provisional},
+		"ms-settings-cellular":                 {Scheme: "ms-settings-cellular", DefangedScheme: "ms[-]settings[-]cellular", Status: Provisional},
+		"ms-settings-cloudstorage":             {Scheme: "ms-settings-cloudstorage", DefangedScheme: "ms[-]settings[-]cloudstorage", Status: Provisional},
+		"ms-settings-connectabledevices":       {Scheme: "ms-settings-connectabledevices", DefangedScheme: "ms[-]settings[-]connectabledevices", Status: Provisional},
+		"ms-settings-displays-topology":        {Scheme: "ms-settings-displays-topology", DefangedScheme: "ms[-]settings[-]displays[-]topology", Status: Provisional},
+		"ms-settings-emailandaccounts":         {Scheme: "ms-settings-emailandaccounts", DefangedScheme: "ms[-]settings[-]emailandaccounts", Status: Provisional},
+		"ms-settings-language":                 {Scheme: "ms-settings-language", DefangedScheme: "ms[-]settings[-]language", Status: Provisional},
+		"ms-settings-location":                 {Scheme: "ms-settings-location", DefangedScheme: "ms[-]settings[-]location", Status: Provisional},
+		"ms-settings-lock":                     {Scheme: "ms-settings-lock", DefangedScheme: "ms[-]settings[-]lock", Status: Provisional},
+		"ms-settings-nfctransactions":          {Scheme: "ms-settings-nfctransactions", DefangedScheme: "ms[-]settings[-]nfctransactions", Status: Provisional},
+		"ms-settings-notifications":            {Scheme: "ms-settings-notifications", DefangedScheme: "ms[-]settings[-]notifications", Status: Provisional},
+		"ms-settings-power":                    {Scheme: "ms-settings-power", DefangedScheme: "ms[-]settings[-]power", Status: Provisional},
+		"ms-settings-privacy":                  {Scheme: "ms-settings-privacy", DefangedScheme: "ms[-]settings[-]privacy", Status: Provisional},
+		"ms-settings-proximity":                {Scheme: "ms-settings-proximity", DefangedScheme: "ms[-]settings[-]proximity", Status: Provisional},
+		"ms-settings-screenrotation":           {Scheme: "ms-settings-screenrotation", DefangedScheme: "ms[-]settings[-]screenrotation", Status: Provisional},
+		"ms-settings-wifi":                     {Scheme: "ms-settings-wifi", DefangedScheme: "ms[-]settings[-]wifi", Status: Provisional},
+		"ms-settings-workplace":                {Scheme: "ms-settings-workplace", DefangedScheme: "ms[-]settings[-]workplace", Status: Provisional},
+		"ms-spd":                               {Scheme: "ms-spd", DefangedScheme: "ms[-]spd", Status: Provisional},
+		"ms-stickers":                          {Scheme: "ms-stickers", DefangedScheme: "ms[-]stickers", Status: Provisional},
+		"ms-sttoverlay":                        {Scheme: "ms-sttoverlay", DefangedScheme: "ms[-]sttoverlay", Status: Provisional},
+		"ms-transit-to":                        {Scheme: "ms-transit-to", DefangedScheme: "ms[-]transit[-]to", Status: Provisional},
+		"ms-useractivityset":                   {Scheme: "ms-useractivityset", DefangedScheme: "ms[-]useractivityset", Status: Provisional},
+		"ms-uup":                               {Scheme: "ms-uup", DefangedScheme: "ms[-]uup", Status: Provisional},
+		"ms-virtualtouchpad":                   {Scheme: "ms-virtualtouchpad", DefangedScheme: "ms[-]virtualtouchpad", Status: Provisional},
+		"ms-visio":                             {Scheme: "ms-visio", DefangedScheme: "ms[-]visio", Status: Provisional},
+		"ms-walk-to":                           {Scheme: "ms-walk-to", DefangedScheme: "ms[-]walk[-]to", Status: Provisional},
+		"ms-whiteboard":                        {Scheme: "ms-whiteboard", DefangedScheme: "ms[-]whiteboard", Status: Provisional},
+		"ms-whiteboard-cmd":                    {Scheme: "ms-whiteboard-cmd", DefangedScheme: "ms[-]whiteboard[-]cmd", Status: Provisional},
+		"ms-widgetboard":                       {Scheme: "ms-widgetboard", DefangedScheme: "ms[-]widgetboard", Status: Provisional},
+		"ms-widgets":                           {Scheme: "ms-widgets", DefangedScheme: "ms[-]widgets", Status: Provisional},
+		"ms-word":                              {Scheme: "ms-word", DefangedScheme: "ms[-]word", Status: Provisional},
+		"msnim":                                {Scheme: "msnim", DefangedScheme: "mxxim", Status: Provisional},
+		"msrp":                                 {Scheme: "msrp", DefangedScheme: "msxp", Status: Permanent},
+		"msrps":                                {Scheme: "msrps", DefangedScheme: "mxxps", Status: Permanent},
+		"mss":                                  {Scheme: "mss", DefangedScheme: "mxx", Status: Provisional},
+		"mt":                                   {Scheme: "mt", DefangedScheme: "mx", Status: Permanent},
+		"mtqp":                                 {Scheme: "mtqp", DefangedScheme: "mtxp", Status: Permanent},
+		"mtrust":                               {Scheme: "mtrust", DefangedScheme: "mxxust", Status: Provisional},
+		"mumble":                               {Scheme: "mumble", DefangedScheme: "mxxble", Status: Provisional},
+		"mupdate":                              {Scheme: "mupdate", DefangedScheme: "mxxdate", Status: Permanent},
+		"mvn":                                  {Scheme: "mvn", DefangedScheme: "mxn", Status: Provisional},
+		"mvrp":                                 {Scheme: "mvrp", DefangedScheme: "mvxp", Status: Provisional},
+		"mvrps":                                {Scheme: "mvrps", DefangedScheme: "mxxxs", Status: Provisional},
+		"news":                                 {Scheme: "news", DefangedScheme: "nexs", Status: Permanent},
+		"nfs":                                  {Scheme: "nfs", DefangedScheme: "nxs", Status: Permanent},
+		"ni":                                   {Scheme: "ni", DefangedScheme: "nx", Status: Permanent},
+		"nih":                                  {Scheme: "nih", DefangedScheme: "nxh", Status: Permanent},
+		"nntp":                                 {Scheme: "nntp", DefangedScheme: "nnxp", Status: Permanent},
+		"notes":                                {Scheme: "notes", DefangedScheme: "nxxes", Status: Provisional},
+		"num":                                  {Scheme: "num", DefangedScheme: "nxm", Status: Provisional},
+		"ocf":                                  {Scheme: "ocf", DefangedScheme: "oxf", Status: Provisional},
+		"oid":                                  {Scheme: "oid", DefangedScheme: "oxd", Status: Provisional},
+		"onenote":                              {Scheme: "onenote", DefangedScheme: "oxxnote", Status: Provisional},
+		"onenote-cmd":                          {Scheme: "onenote-cmd", DefangedScheme: "onenote[-]cmd", Status: Provisional},
+		"opaquelocktoken":                      {Scheme: "opaquelocktoken", DefangedScheme: "oxxquelocktoken", Status: Permanent},
+		"openid":                               {Scheme: "openid", DefangedScheme: "oxxnid", Status: Provisional},
+		"openpgp4fpr":                          {Scheme: "openpgp4fpr", DefangedScheme: "oxxnpgp4fpr", Status: Provisional},
+		"otpauth":                              {Scheme: "otpauth", DefangedScheme: "oxxauth", Status: Provisional},
+		"p1":                                   {Scheme: "p1", DefangedScheme: "px", Status: Historical},
+		"pack":                                 {Scheme: "pack", DefangedScheme: "paxk", Status: Historical},
+		"palm":                                 {Scheme: "palm", DefangedScheme: "paxm", Status: Provisional},
+		"paparazzi":                            {Scheme: "paparazzi", DefangedScheme: "pxxarazzi", Status: Provisional},
+		"payment":                              {Scheme: "payment", DefangedScheme: "pxxment", Status: Historical},
+		"payto":                                {Scheme: "payto", DefangedScheme: "pxxto", Status: Provisional},
+		"pkcs11":                               {Scheme: "pkcs11", DefangedScheme: "pxxs11", Status: Permanent},
+		"platform":                             {Scheme: "platform", DefangedScheme: "pxxtform", Status: Provisional},
+		"pop":                                  {Scheme: "pop", DefangedScheme: "pxp", Status: Permanent},
+		"pres":                                 {Scheme: "pres", DefangedScheme: "prxs", Status: Permanent},
+		"prospero":                             {Scheme: "prospero", DefangedScheme: "pxxspero", Status: Historical},
+		"proxy":                                {Scheme: "proxy", DefangedScheme: "pxxxy", Status: Provisional},
+		"psyc":                                 {Scheme: "psyc", DefangedScheme: "psxc", Status: Provisional},
+		"pttp":                                 {Scheme: "pttp", DefangedScheme: "ptxp", Status: Provisional},
+		"pwid":                                 {Scheme: "pwid", DefangedScheme: "pwxd", Status: Provisional},
+		"qb":                                   {Scheme: "qb", DefangedScheme: "qx", Status: Provisional},
+		"query":                                {Scheme: "query", DefangedScheme: "qxxry", Status: Provisional},
+		"quic-transport":                       {Scheme: "quic-transport", DefangedScheme: "quic[-]transport", Status: Provisional},
+		"redis":                                {Scheme: "redis", DefangedScheme: "rxxis", Status: Provisional},
+		"rediss":                               {Scheme: "rediss", DefangedScheme: "rxxiss", Status: Provisional},
+		"reload":                               {Scheme: "reload", DefangedScheme: "rxxoad", Status: Permanent},
+		"res":                                  {Scheme: "res", DefangedScheme: "rxs", Status: Provisional},
+		"resource":                             {Scheme: "resource", DefangedScheme: "rxxource", Status: Provisional},
+		"rmi":                                  {Scheme: "rmi", DefangedScheme: "rxi", Status: Provisional},
+		"rsync":                                {Scheme: "rsync", DefangedScheme: "rxxnc", Status: Provisional},
+		"rtmfp":                                {Scheme: "rtmfp", DefangedScheme: "rxxfp", Status: Provisional},
+		"rtmp":                                 {Scheme: "rtmp", DefangedScheme: "rxxp", Status: Provisional},
+		"rtsp":                                 {Scheme: "rtsp", DefangedScheme: "rtxp", Status: Permanent},
+		"rtsps":                                {Scheme: "rtsps", DefangedScheme: "rxxps", Status: Permanent},
+		"rtspu":                                {Scheme: "rtspu", DefangedScheme: "rxxpu", Status: Permanent},
+		"sarif":                                {Scheme: "sarif", DefangedScheme: "sxxif", Status: Provisional},
+		"secondlife":                           {Scheme: "secondlife", DefangedScheme: "sxxondlife", Status: Provisional},
+		"secret-token":                         {Scheme: "secret-token", DefangedScheme: "secret[-]token", Status: Provisional},
+		"service":                              {Scheme: "service", DefangedScheme: "sxxvice", Status: Permanent},
+		"session":                              {Scheme: "session", DefangedScheme: "sxxsion", Status: Permanent},
+		"sftp":                                 {Scheme: "sftp", DefangedScheme: "sfxp", Status: Provisional},
+		"sgn":                                  {Scheme: "sgn", DefangedScheme: "sxn", Status: Provisional},
+		"shc":                                  {Scheme: "shc", DefangedScheme: "sxc", Status: Provisional},
+		"shelter":                              {Scheme: "shelter", DefangedScheme: "sxxlter", Status: Provisional},
+		"shttp":                                {Scheme: "shttp", DefangedScheme: "sxxtp", Status: Permanent},
+		"sieve":                                {Scheme: "sieve", DefangedScheme: "sxxve", Status: Permanent},
+		"simpleledger":                         {Scheme: "simpleledger", DefangedScheme: "sxxpleledger", Status: Provisional},
+		"simplex":                              {Scheme: "simplex", DefangedScheme: "sxxplex", Status: Provisional},
+		"sip":                                  {Scheme: "sip", DefangedScheme: "sxp", Status: Permanent},
+		"sips":                                 {Scheme: "sips", DefangedScheme: "sixs", Status: Permanent},
+		"skype":                                {Scheme: "skype", DefangedScheme: "sxxpe", Status: Provisional},
+		"smb":                                  {Scheme: "smb", DefangedScheme: "sxb", Status: Provisional},
+		"smp":                                  {Scheme: "smp", DefangedScheme: "sxx", Status: Provisional},
+		"sms":                                  {Scheme: "sms", DefangedScheme: "sxs", Status: Permanent},
+		"smtp":                                 {Scheme: "smtp", DefangedScheme: "smxp", Status: Provisional},
+		"snews":                                {Scheme: "snews", DefangedScheme: "sxxws", Status: Historical},
+		"snmp":                                 {Scheme: "snmp", DefangedScheme: "snxp", Status: Permanent},
+		"soap.beep":                            {Scheme: "soap.beep", DefangedScheme: "soap[.]beep", Status: Permanent},
+		"soap.beeps":                           {Scheme: "soap.beeps", DefangedScheme: "soap[.]beeps", Status: Permanent},
+		"soldat":                               {Scheme: "soldat", DefangedScheme: "sxxdat", Status: Provisional},
+		"spiffe":                               {Scheme: "spiffe", DefangedScheme: "sxxffe", Status: Provisional},
+		"spotify":                              {Scheme: "spotify", DefangedScheme: "sxxtify", Status: Provisional},
+		"ssb":                                  {Scheme: "ssb", DefangedScheme: "s[s]b", Status: Provisional},
+		"ssh":                                  {Scheme: "ssh", DefangedScheme: "sxh", Status: Provisional},
+		"starknet":                             {Scheme: "starknet", DefangedScheme: "sxxrknet", Status: Provisional},
+		"steam":                                {Scheme: "steam", DefangedScheme: "sxxam", Status: Provisional},
+		"stun":                                 {Scheme: "stun", DefangedScheme: "stxn", Status: Permanent},
+		"stuns":                                {Scheme: "stuns", DefangedScheme: "sxxns", Status: Permanent},
+		"submit":                               {Scheme: "submit", DefangedScheme: "sxxmit", Status: Provisional},
+		"svn":                                  {Scheme: "svn", DefangedScheme: "s[v]n", Status: Provisional},
+		"swh":                                  {Scheme: "swh", DefangedScheme: "s[w]h", Status: Provisional},
+		"swid":                                 {Scheme: "swid", DefangedScheme: "swxd", Status: Provisional},
+		"swidpath":                             {Scheme: "swidpath", DefangedScheme: "sxxdpath", Status: Provisional},
+		"tag":                                  {Scheme: "tag", DefangedScheme: "txg", Status: Permanent},
+		"taler":                                {Scheme: "taler", DefangedScheme: "txxer", Status: Provisional},
+		"teamspeak":                            {Scheme: "teamspeak", DefangedScheme: "txxmspeak", Status: Provisional},
+		"teapot":                               {Scheme: "teapot", DefangedScheme: "txxpot", Status: Provisional},
+		"teapots":                              {Scheme: "teapots", DefangedScheme: "txxpots", Status: Provisional},
+		"tel":                                  {Scheme: "tel", DefangedScheme: "txl", Status: Permanent},
+		"teliaeid":                             {Scheme: "teliaeid", DefangedScheme: "txxiaeid", Status: Provisional},
+		"telnet":                               {Scheme: "telnet", DefangedScheme: "txxnet", Status: Permanent},
+		"tftp":                                 {Scheme: "tftp", DefangedScheme: "tfxp", Status: Permanent},
+		"things":                               {Scheme: "things", DefangedScheme: "txxngs", Status: Provisional},
+		"thismessage":                          {Scheme: "thismessage", DefangedScheme: "txxsmessage", Status: Permanent},
+		"thzp":                                 {Scheme: "thzp", DefangedScheme: "thxp", Status: Historical},
+		"tip":                                  {Scheme: "tip", DefangedScheme: "txp", Status: Permanent},
+		"tn3270":                               {Scheme: "tn3270", DefangedScheme: "txx270", Status: Permanent},
+		"tool":                                 {Scheme: "tool", DefangedScheme: "toxl", Status: Provisional},
+		"turn":                                 {Scheme: "turn", DefangedScheme: "tuxn", Status: Permanent},
+		"turns":                                {Scheme: "turns", DefangedScheme: "txxns", Status: Permanent},
+		"tv":                                   {Scheme: "tv", DefangedScheme: "tx", Status: Permanent},
+		"udp":                                  {Scheme: "udp", DefangedScheme: "uxp", Status: Provisional},
+		"unreal":                               {Scheme: "unreal", DefangedScheme: "uxxeal", Status: Provisional},
+		"upt":                                  {Scheme: "upt", DefangedScheme: "uxt", Status: Historical},
+		"urn":                                  {Scheme: "urn", DefangedScheme: "uxn", Status: Permanent},
+		"ut2004":                               {Scheme: "ut2004", DefangedScheme: "uxx004", Status: Provisional},
+		"uuid-in-package":                      {Scheme: "uuid-in-package", DefangedScheme: "uuid[-]in[-]package", Status: Provisional},
+		"v-event":                              {Scheme: "v-event", DefangedScheme: "v[-]event", Status: Provisional},
+		"vemmi":                                {Scheme: "vemmi", DefangedScheme: "vxxmi", Status: Permanent},
+		"ventrilo":                             {Scheme: "ventrilo", DefangedScheme: "vxxtrilo", Status: Provisional},
+		"ves":                                  {Scheme: "ves", DefangedScheme: "vxs", Status: Provisional},
+		"videotex":                             {Scheme: "videotex", DefangedScheme: "vxxeotex", Status: Historical},
+		"view-source":                          {Scheme: "view-source", DefangedScheme: "view[-]source", Status: Provisional},
+		"vnc":                                  {Scheme: "vnc", DefangedScheme: "vxc", Status: Permanent},
+		"vscode":                               {Scheme: "vscode", DefangedScheme: "vxxode", Status: Provisional},
+		"vscode-insiders":                      {Scheme: "vscode-insiders", DefangedScheme: "vscode[-]insiders", Status: Provisional},
+		"vsls":                                 {Scheme: "vsls", DefangedScheme: "vsxs", Status: Provisional},
+		"w3":                                   {Scheme: "w3", DefangedScheme: "w[3]", Status: Provisional},
+		"wais":                                 {Scheme: "wais", DefangedScheme: "waxs", Status: Historical},
+		"wasm":                                 {Scheme: "wasm", DefangedScheme: "waxm", Status: Provisional},
+		"wasm-js":                              {Scheme: "wasm-js", DefangedScheme: "wasm[-]js", Status: Provisional},
+		"wcr":                                  {Scheme: "wcr", DefangedScheme: "wxr", Status: Provisional},
+		"web+ap":                               {Scheme: "web+ap", DefangedScheme: "web[+]ap", Status: Provisional},
+		"web3":                                 {Scheme: "web3", DefangedScheme: "wex3", Status: Provisional},
+		"webcal":                               {Scheme: "webcal", DefangedScheme: "wxxcal", Status: Provisional},
+		"wifi":                                 {Scheme: "wifi", DefangedScheme: "wixi", Status: Provisional},
+		"wpid":                                 {Scheme: "wpid", DefangedScheme: "wpxd", Status: Historical},
+		"ws":                                   {Scheme: "ws", DefangedScheme: "wx", Status: Permanent},
+		"wss":                                  {Scheme: "wss", DefangedScheme: "wxs", Status: Permanent},
+		"wtai":                                 {Scheme: "wtai", DefangedScheme: "wtxi", Status: Provisional},
+		"wyciwyg":                              {Scheme: "wyciwyg", DefangedScheme: "wxxiwyg", Status: Provisional},
+		"xcon":                                 {Scheme: "xcon", DefangedScheme: "xcxn", Status: Permanent},
+		"xcon-userid":                          {Scheme: "xcon-userid", DefangedScheme: "xcon[-]userid", Status: Permanent},
+		"xfire":                                {Scheme: "xfire", DefangedScheme: "xxxre", Status: Provisional},
+		"xftp":                                 {Scheme: "xftp", DefangedScheme: "xfxp", Status: Provisional},
+		"xmlrpc.beep":                          {Scheme: "xmlrpc.beep", DefangedScheme: "xmlrpc[.]beep", Status: Permanent},
+		"xmlrpc.beeps":                         {Scheme: "xmlrpc.beeps", DefangedScheme: "xmlrpc[.]beeps", Status: Permanent},
+		"xmpp":                                 {Scheme: "xmpp", DefangedScheme: "xmxp", Status: Permanent},
+		"xrcp":                                 {Scheme: "xrcp", DefangedScheme: "xrxp", Status: Provisional},
+		"xri":                                  {Scheme: "xri", DefangedScheme: "xxi", Status: Provisional},
+		"ymsgr":                                {Scheme: "ymsgr", DefangedScheme: "yxxgr", Status: Provisional},
+		"z39.50":                               {Scheme: "z39.50", DefangedScheme: "z39[.]50", Status: Historical},
+		"z39.50r":                              {Scheme: "z39.50r", DefangedScheme: "z39[.]50r", Status: Permanent},
+		"z39.50s":                              {Scheme: "z39.50s", DefangedScheme: "z39[.]50s", Status: Permanent},
+	}
+}
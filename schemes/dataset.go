@@ -0,0 +1,46 @@
+//go:build (!defang_schemes_lazy && !defang_schemes_embed) || defang_schemes_minimal
+
+package schemes
+
+// The dataset of URI schemes, keyed by scheme, assigned by the generated consts.go (or, in
+// minimal builds, consts_minimal.go).  Nil if the data has not been generated
+var Map map[string]Scheme
+
+// Defanged forms of each scheme by style other than StyleHxx, and indexes over scheme
+// names, assigned by the generated styles_consts.go and index_consts.go.  Minimal builds
+// compute them on first access instead
+var (
+	styledDefangedSchemeMap map[Style]map[string]string
+	schemeIndexData         *SchemeIndex
+)
+
+// The dataset of URI schemes, keyed by scheme.  Code that may be built with the
+// defang_schemes_lazy build tag should use this rather than reading Map directly.  Panics
+// with ErrDataNotGenerated if the data has not been generated (see DataGenerated)
+func Schemes() map[string]Scheme {
+	if Map == nil {
+		return onDemandSchemes()
+	}
+	return Map
+}
+
+// In eager and minimal builds, the generated data is a map literal
+func generatedSchemes() map[string]Scheme {
+	return Map
+}
+
+// Generated defanged forms of each scheme, by style other than StyleHxx
+func styledDefangedSchemes() map[Style]map[string]string {
+	if styledDefangedSchemeMap == nil {
+		return styledDefangedSchemesOnce()
+	}
+	return styledDefangedSchemeMap
+}
+
+// Generated indexes over scheme names
+func schemeIndex() *SchemeIndex {
+	if schemeIndexData == nil {
+		return schemeIndexOnce()
+	}
+	return schemeIndexData
+}
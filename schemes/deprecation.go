@@ -0,0 +1,54 @@
+package schemes
+
+import "fmt"
+
+// Replacements for historical or obsolete schemes, as noted by the references given in
+// the IANA registry
+var SCHEME_REPLACEMENTS = map[string]string{
+	// RFC 3966 obsoletes RFC 2806, replacing fax and modem with tel
+	"fax":   "tel",
+	"modem": "tel",
+	// RFC 2660 (S-HTTP) was moved to Historic; HTTP over TLS superseded it
+	"shttp": "https",
+	// RFC 2056 defines z39.50r and z39.50s in place of RFC 1738's z39.50
+	"z39.50": "z39.50r",
+}
+
+// Why a scheme should no longer be used, as returned by DeprecationOf
+type Deprecation struct {
+	Scheme Scheme
+	// "historical" or "obsolete"
+	Reason string
+	// The scheme to use instead, if one is known
+	Replacement string
+}
+
+// Whether the scheme is historical, or annotated as obsolete in the IANA registry
+func (s Scheme) Deprecated() bool {
+	return s.Status == Historical || s.Obsolete
+}
+
+// Check whether a scheme is deprecated, so that tooling can nudge users away from it.
+// The second return value is false if the scheme is not registered, or is not deprecated
+func DeprecationOf(scheme string) (Deprecation, bool) {
+	known, ok := Lookup(scheme)
+	if !ok || !known.Deprecated() {
+		return Deprecation{}, false
+	}
+
+	reason := "obsolete"
+	if known.Status == Historical {
+		reason = "historical"
+	}
+
+	return Deprecation{Scheme: known, Reason: reason, Replacement: SCHEME_REPLACEMENTS[known.Scheme]}, true
+}
+
+// Warning message for the deprecated scheme
+func (d Deprecation) String() string {
+	msg := fmt.Sprintf("scheme \"%s\" is %s", d.Scheme.Scheme, d.Reason)
+	if d.Replacement != "" {
+		msg += fmt.Sprintf("; use \"%s\" instead", d.Replacement)
+	}
+	return msg
+}
@@ -0,0 +1,49 @@
+package schemes
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+)
+
+// A scheme whose defanged form is itself a registered scheme, so a URL defanged with it
+// remains a well-formed (if unlikely to be handled) URL.  The only such schemes in the
+// generated data are http[s], which defang into the provisional hxxp[s], as that is the
+// convention analysts expect
+type EdgeCase struct {
+	Scheme Scheme
+	// The registered scheme that Scheme defangs into, whose status consumers may wish to
+	// apply their own policy to
+	Registered Scheme
+}
+
+var edgeCasesOnce = sync.OnceValue(func() []EdgeCase {
+	var cases []EdgeCase
+	for _, scheme := range Schemes() {
+		if registered, ok := Schemes()[scheme.DefangedScheme]; ok {
+			cases = append(cases, EdgeCase{Scheme: scheme, Registered: registered})
+		}
+	}
+	sort.Slice(cases, func(i, j int) bool {
+		return cases[i].Scheme.Scheme < cases[j].Scheme.Scheme
+	})
+	return cases
+})
+
+// Schemes whose defanged forms are themselves registered, sorted by scheme
+func EdgeCases() []EdgeCase {
+	return append([]EdgeCase(nil), edgeCasesOnce()...)
+}
+
+// Whether the scheme is an edge case, or is the registered scheme of one (e.g., either
+// http or hxxp)
+func IsEdgeCase(scheme string) bool {
+	scheme = ascii.ToLower(scheme)
+	for _, c := range edgeCasesOnce() {
+		if c.Scheme.Scheme == scheme || c.Registered.Scheme == scheme {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+//go:build defang_schemes_embed && !defang_schemes_minimal
+
+package schemes
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+
+	"github.com/jakewilliami/defang-schemes/data"
+)
+
+// In embed builds, the dataset is decompressed and decoded from the data package's gzipped
+// JSON on first access, so that neither a map literal nor a string blob is compiled
+func loadSchemes() map[string]Scheme {
+	r, err := gzip.NewReader(bytes.NewReader(data.JSONGzip))
+	if err != nil {
+		panic(fmt.Sprintf("malformed generated data: %s", err))
+	}
+	schemes, err := LoadFromJSON(r)
+	if err != nil {
+		panic(fmt.Sprintf("malformed generated data: %s", err))
+	}
+	return schemes
+}
@@ -0,0 +1,7 @@
+package schemes
+
+import "errors"
+
+var ErrUnknownColumn = errors.New("unknown column")
+
+var ErrDataNotGenerated = errors.New(`scheme data has not been generated: run "go generate" in the defang_schemes module, or call GenerateData`)
@@ -0,0 +1,57 @@
+package schemes
+
+import (
+	"slices"
+
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+)
+
+// Curated examples of URIs in each scheme, for the Examples field.  These use reserved
+// names and addresses (RFC 2606, RFC 5737, and the fictional telephone numbers of Ofcom),
+// so that they are safe to show, and to use as fixtures.  Schemes not listed here have no
+// examples
+var SCHEME_EXAMPLES = map[string][]string{
+	"bitcoin": {"bitcoin:1BoatSLRHtKNngkdXEeobR76b53LETtpyT?amount=0.01"},
+	"coap":    {"coap://sensor.example.com/temperature"},
+	"data":    {"data:text/plain;base64,SGVsbG8=", "data:text/html,<b>hello</b>"},
+	"dns":     {"dns:example.com?type=A"},
+	"file":    {"file:///etc/hosts", "file://fileserver.example.com/share/report.docx"},
+	"ftp":     {"ftp://ftp.example.com/pub/file.txt", "ftp://user@ftp.example.com:2121/"},
+	"geo":     {"geo:51.5007,-0.1246"},
+	"git":     {"git://git.example.com/project.git"},
+	"gopher":  {"gopher://gopher.example.com/1/"},
+	"http":    {"http://example.com/", "http://192.0.2.1:8080/index.html"},
+	"https":   {"https://example.com/", "https://user@www.example.com/path?query=1#fragment"},
+	"imap":    {"imap://user@mail.example.com/INBOX"},
+	"irc":     {"irc://irc.example.com/channel"},
+	"ircs":    {"ircs://irc.example.com:6697/channel"},
+	"ldap":    {"ldap://ldap.example.com/dc=example,dc=com?cn?sub"},
+	"magnet":  {"magnet:?xt=urn:btih:c12fe1c06bba254a9dc9f519b335aa7c1367a88a"},
+	"mailto":  {"mailto:user@example.com", "mailto:user@example.com?subject=Hello"},
+	"news":    {"news:comp.lang.go"},
+	"nfs":     {"nfs://fileserver.example.com/export/home"},
+	"nntp":    {"nntp://news.example.com/comp.lang.go"},
+	"pop":     {"pop://user@mail.example.com"},
+	"rtsp":    {"rtsp://camera.example.com:554/stream"},
+	"sip":     {"sip:alice@example.com"},
+	"sips":    {"sips:alice@example.com:5061"},
+	"smb":     {"smb://fileserver.example.com/share/"},
+	"sms":     {"sms:+447700900123?body=hello"},
+	"ssh":     {"ssh://user@host.example.com:2222"},
+	"stun":    {"stun:stun.example.com:3478"},
+	"tel":     {"tel:+447700900123"},
+	"telnet":  {"telnet://192.0.2.1:23/"},
+	"turn":    {"turn:turn.example.com?transport=udp"},
+	"urn":     {"urn:isbn:0451450523", "urn:ietf:rfc:3986"},
+	"vnc":     {"vnc://host.example.com:5900"},
+	"webcal":  {"webcal://calendar.example.com/events.ics"},
+	"ws":      {"ws://example.com/socket"},
+	"wss":     {"wss://example.com/socket"},
+	"xmpp":    {"xmpp:alice@example.com"},
+}
+
+// The curated examples of the scheme, for the Examples field (a copy of its entry in
+// SCHEME_EXAMPLES, or nil)
+func SchemeExamples(scheme string) []string {
+	return slices.Clone(SCHEME_EXAMPLES[ascii.ToLower(scheme)])
+}
@@ -0,0 +1,40 @@
+package schemes
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Columns of the CSV dataset written by WriteCSV, matching the JSON dataset's field names
+var CSV_COLUMNS = []string{"scheme", "defanged_scheme", "template", "description", "status", "well_known_uri_support", "reference", "notes", "obsolete", "related", "examples"}
+
+// Write schemes as CSV, with a header row of CSV_COLUMNS, sorted by scheme
+func WriteCSV(w io.Writer, schemes map[string]Scheme) error {
+	names := make([]string, 0, len(schemes))
+	for name := range schemes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(CSV_COLUMNS); err != nil {
+		return err
+	}
+	for _, name := range names {
+		scheme := schemes[name]
+		if err := writer.Write(scheme.Record()); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// Fields of the scheme in the order of CSV_COLUMNS.  Related schemes and examples are
+// separated by spaces
+func (s Scheme) Record() []string {
+	return []string{s.Scheme, s.DefangedScheme, s.Template, s.Description, string(s.Status), s.WellKnownUriSupport, s.Reference, s.Notes, strconv.FormatBool(s.Obsolete), strings.Join(s.Related, " "), strings.Join(s.Examples, " ")}
+}
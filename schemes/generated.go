@@ -0,0 +1,53 @@
+package schemes
+
+import (
+	"sync"
+
+	"github.com/jakewilliami/defang-schemes/internal/algorithm"
+	"github.com/jakewilliami/defang-schemes/internal/ondemand"
+)
+
+// The dataset fetched by registry.GenerateData.  Panics with ErrDataNotGenerated if there
+// is none, rather than letting every lookup quietly fail
+func onDemandSchemes() map[string]Scheme {
+	if schemes := ondemand.Schemes.Load(); schemes != nil {
+		return schemes.(map[string]Scheme)
+	}
+	panic(ErrDataNotGenerated)
+}
+
+// Returns ErrDataNotGenerated if the package was built without generated data (for
+// example, from a fork in which "go generate" has not been run), and
+// registry.GenerateData has not been called.  Everything that consults the dataset panics
+// with ErrDataNotGenerated in that case, so callers that cannot rule it out should check
+// this first
+func DataGenerated() error {
+	if generatedSchemes() == nil && ondemand.Schemes.Load() == nil {
+		return ErrDataNotGenerated
+	}
+	return nil
+}
+
+// Defanged forms of each style and indexes over scheme names, computed from the dataset
+// as they are at generation time, where they have not been generated
+var (
+	styledDefangedSchemesOnce = sync.OnceValue(computeStyledDefangedSchemes)
+	schemeIndexOnce           = sync.OnceValue(func() *SchemeIndex {
+		return NewSchemeIndex(SchemeNames())
+	})
+)
+
+func computeStyledDefangedSchemes() map[Style]map[string]string {
+	styled := make(map[Style]map[string]string, len(STYLES))
+	for _, style := range STYLES {
+		if style == StyleHxx {
+			continue
+		}
+		forms := make(map[string]string, len(Schemes()))
+		for name := range Schemes() {
+			forms[name] = algorithm.DefangChanged(name, style.Options()...)
+		}
+		styled[style] = forms
+	}
+	return styled
+}
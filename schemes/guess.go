@@ -0,0 +1,101 @@
+package schemes
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+)
+
+// Maximum edit distance between damaged input and a scheme for it to be a candidate
+const GUESS_MAX_DISTANCE = 2
+
+// A candidate scheme for damaged input, as returned by GuessScheme
+type SchemeCandidate struct {
+	Scheme Scheme
+	// Whether the input was closest to the defanged, rather than fanged, scheme
+	Defanged bool
+	// Edit distance between the input and the (fanged or defanged) scheme
+	Distance int
+}
+
+// Characters lost in copy-paste tend to turn into placeholders (e.g., "hxx_s"), or
+// whitespace (e.g., "fx p").  Anything that cannot appear in a (defanged) scheme is
+// treated as a wildcard
+func isGuessWildcard(r rune) bool {
+	switch {
+	case 'a' <= r && r <= 'z', '0' <= r && r <= '9':
+		return false
+	case strings.ContainsRune("+-.[]", r):
+		return false
+	default:
+		return true
+	}
+}
+
+// Edit distance from damaged input to a scheme, where a wildcard in the input may stand
+// in for any single character at no cost
+func guessDistance(input, form []rune) int {
+	prev := make([]int, len(form)+1)
+	curr := make([]int, len(form)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(input); i++ {
+		curr[0] = i
+		for j := 1; j <= len(form); j++ {
+			substitution := prev[j-1]
+			if input[i-1] != form[j-1] && !isGuessWildcard(input[i-1]) {
+				substitution++
+			}
+			curr[j] = min(substitution, prev[j]+1, curr[j-1]+1)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(form)]
+}
+
+// Best-effort recovery of a scheme from partially damaged (fanged or defanged) input,
+// such as "hxx_s" or "fx p".  Returns candidates within GUESS_MAX_DISTANCE, ranked by
+// distance, then preferring permanent schemes, then by name
+func GuessScheme(damaged string) []SchemeCandidate {
+	input := []rune(ascii.ToLower(strings.TrimSpace(damaged)))
+	if len(input) == 0 {
+		return nil
+	}
+
+	// Defanged forms are no shorter than their schemes, so schemes longer than the input by
+	// more than GUESS_MAX_DISTANCE cannot be candidates in either form
+	var candidates []SchemeCandidate
+	for n := 1; n <= len(input)+GUESS_MAX_DISTANCE; n++ {
+		for _, name := range Index().ByLength[n] {
+			scheme := Schemes()[name]
+			distance := guessDistance(input, []rune(scheme.Scheme))
+			defanged := false
+			if d := guessDistance(input, []rune(scheme.DefangedScheme)); d < distance {
+				distance, defanged = d, true
+			}
+
+			// Do not let short schemes match anything of a similar length
+			if distance > GUESS_MAX_DISTANCE || distance >= len(scheme.Scheme) {
+				continue
+			}
+			candidates = append(candidates, SchemeCandidate{Scheme: scheme, Defanged: defanged, Distance: distance})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.Distance != b.Distance {
+			return a.Distance < b.Distance
+		}
+		if (a.Scheme.Status == Permanent) != (b.Scheme.Status == Permanent) {
+			return a.Scheme.Status == Permanent
+		}
+		return a.Scheme.Scheme < b.Scheme.Scheme
+	})
+
+	return candidates
+}
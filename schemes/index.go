@@ -0,0 +1,87 @@
+package schemes
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+)
+
+// Minimum length of the prefixes in SchemeIndex.ByPrefix
+const INDEX_MIN_PREFIX = 2
+
+// Auxiliary indexes over scheme names, for matchers and fuzzy search that need only
+// consider some of the schemes.  Each list of names is sorted
+type SchemeIndex struct {
+	// Schemes by length in bytes
+	ByLength map[int][]string
+	// Schemes by first letter
+	ByFirstLetter map[byte][]string
+	// Schemes by every prefix (of at least INDEX_MIN_PREFIX bytes) that they share with
+	// another scheme; e.g., "coap" → coap, coap+tcp, coap+ws, coaps, ...
+	ByPrefix map[string][]string
+}
+
+// Build the indexes over a set of (lower case) scheme names, such as those of a
+// registry.Registry. The indexes of the generated data are available from Index
+func NewSchemeIndex(names []string) *SchemeIndex {
+	names = slices.Clone(names)
+	slices.Sort(names)
+
+	index := &SchemeIndex{
+		ByLength:      make(map[int][]string),
+		ByFirstLetter: make(map[byte][]string),
+		ByPrefix:      make(map[string][]string),
+	}
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+		index.ByLength[len(name)] = append(index.ByLength[len(name)], name)
+		index.ByFirstLetter[name[0]] = append(index.ByFirstLetter[name[0]], name)
+		for n := INDEX_MIN_PREFIX; n <= len(name); n++ {
+			index.ByPrefix[name[:n]] = append(index.ByPrefix[name[:n]], name)
+		}
+	}
+	for prefix, shared := range index.ByPrefix {
+		if len(shared) < 2 {
+			delete(index.ByPrefix, prefix)
+		}
+	}
+	return index
+}
+
+// Indexes over the generated data.  These are shared, and must not be modified
+func Index() *SchemeIndex {
+	return schemeIndex()
+}
+
+// Schemes of the given length, sorted
+func (x *SchemeIndex) WithLength(n int) []string {
+	return slices.Clone(x.ByLength[n])
+}
+
+// Schemes starting with the given prefix (case-insensitively), sorted
+func (x *SchemeIndex) WithPrefix(prefix string) []string {
+	prefix = ascii.ToLower(prefix)
+	if prefix == "" {
+		var names []string
+		for _, bucket := range x.ByFirstLetter {
+			names = append(names, bucket...)
+		}
+		slices.Sort(names)
+		return names
+	}
+	if shared, ok := x.ByPrefix[prefix]; ok {
+		return slices.Clone(shared)
+	}
+
+	// Shorter than INDEX_MIN_PREFIX, or a prefix of at most one scheme
+	var names []string
+	for _, name := range x.ByFirstLetter[prefix[0]] {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,385 @@
+//go:build !defang_schemes_lazy && !defang_schemes_embed && !defang_schemes_minimal
+
+package schemes
+
+/*
+THIS FILE WAS AUTOMATICALLY GENERATED AT 2026-10-16 07:57:26
+
+Do not edit this file.  Run "go generate" to re-generate this file with an
+updated version of indexes of URI schemes from:
+    iana.org/assignments/uri-schemes/uri-schemes.xhtml.
+*/
+
+func init() {
+	schemeIndexData = &SchemeIndex{
+		ByLength: map[int][]string{
+			2:  {"ar", "at", "aw", "bb", "bl", "fm", "gg", "go", "im", "mt", "ni", "p1", "qb", "tv", "w3", "ws"},
+			3:  {"aaa", "acd", "acr", "adt", "afp", "afs", "aim", "apt", "ari", "ark", "cap", "cid", "cvs", "dab", "dat", "dav", "did", "dis", "dns", "doi", "dpp", "drm", "dtn", "dvb", "dvx", "eid", "ens", "fax", "ftp", "geo", "git", "grd", "ham", "hcp", "iax", "ipn", "ipp", "irc", "jar", "jms", "lid", "lpa", "mid", "mms", "moz", "mss", "mvn", "nfs", "nih", "num", "ocf", "oid", "pop", "res", "rmi", "sgn", "shc", "sip", "smb", "smp", "sms", "ssb", "ssh", "svn", "swh", "tag", "tel", "tip", "udp", "upt", "urn", "ves", "vnc", "wcr", "wss", "xri"},
+			4:  {"aaas", "acap", "acct", "amss", "blob", "bolo", "brid", "cast", "coap", "crid", "cstr", "data", "dict", "dntp", "drop", "dtmi", "dweb", "ed2k", "elsi", "feed", "fido", "file", "fish", "h323", "hcap", "hs20", "http", "hxxp", "icap", "icon", "imap", "info", "ipfs", "ipns", "ipps", "irc6", "ircs", "iris", "itms", "lbry", "ldap", "lvlt", "maps", "msrp", "mtqp", "mvrp", "news", "nntp", "pack", "palm", "pres", "psyc", "pttp", "pwid", "rtmp", "rtsp", "sftp", "sips", "smtp", "snmp", "stun", "swid", "tftp", "thzp", "tool", "turn", "vsls", "wais", "wasm", "web3", "wifi", "wpid", "wtai", "xcon", "xftp", "xmpp", "xrcp"},
+			5:  {"about", "cabal", "casts", "coaps", "dhttp", "graph", "gtalk", "https", "hxxps", "hyper", "ldaps", "modem", "msnim", "msrps", "mvrps", "notes", "payto", "proxy", "query", "redis", "rsync", "rtmfp", "rtsps", "rtspu", "sarif", "shttp", "sieve", "skype", "snews", "steam", "stuns", "taler", "turns", "vemmi", "xfire", "ymsgr"},
+			6:  {"barion", "callto", "chrome", "finger", "gitoid", "gopher", "jabber", "lastfm", "magnet", "mailto", "market", "matrix", "ms-spd", "ms-uup", "mtrust", "mumble", "openid", "pkcs11", "rediss", "reload", "soldat", "spiffe", "submit", "teapot", "telnet", "things", "tn3270", "unreal", "ut2004", "vscode", "web+ap", "webcal", "z39.50"},
+			7:  {"android", "appdata", "beshare", "bitcoin", "coap+ws", "content", "example", "keyparc", "lorawan", "message", "mongodb", "ms-help", "ms-word", "mupdate", "onenote", "otpauth", "payment", "service", "session", "shelter", "simplex", "spotify", "teapots", "v-event", "wasm-js", "wyciwyg", "z39.50r", "z39.50s"},
+			8:  {"coap+tcp", "coaps+ws", "diaspora", "embedded", "ethereum", "facetime", "ilstring", "iotdisco", "iris.lwz", "iris.xpc", "isostore", "ms-excel", "ms-visio", "platform", "prospero", "resource", "starknet", "swidpath", "teliaeid", "ventrilo", "videotex"},
+			9:  {"adiumxtra", "bluetooth", "coaps+tcp", "feedready", "hydrazone", "iris.beep", "iris.xpcs", "ms-access", "ms-people", "ms-recall", "ms-search", "paparazzi", "soap.beep", "teamspeak"},
+			10: {"attachment", "browserext", "calculator", "filesystem", "mailserver", "ms-meetnow", "ms-project", "ms-walk-to", "ms-widgets", "secondlife", "soap.beeps"},
+			11: {"bitcoincash", "fuchsia-pkg", "ms-drive-to", "ms-infopath", "ms-inputapp", "ms-settings", "ms-stickers", "onenote-cmd", "openpgp4fpr", "thismessage", "view-source", "xcon-userid", "xmlrpc.beep"},
+			12: {"content-type", "gizmoproject", "ms-getoffice", "ms-officeapp", "ms-publisher", "secret-token", "simpleledger", "xmlrpc.beeps"},
+			13: {"leaptofrogans", "ms-calculator", "ms-enrollment", "ms-powerpoint", "ms-screenclip", "ms-sttoverlay", "ms-transit-to", "ms-whiteboard"},
+			14: {"ms-mobileplans", "ms-personacard", "ms-widgetboard", "quic-transport"},
+			15: {"dlna-playsingle", "ms-appinstaller", "ms-screensketch", "opaquelocktoken", "uuid-in-package", "vscode-insiders"},
+			16: {"chrome-extension", "ms-gamingoverlay", "ms-remotedesktop", "ms-search-repair", "ms-settings-lock", "ms-settings-wifi"},
+			17: {"ms-settings-power", "ms-whiteboard-cmd"},
+			18: {"dlna-playcontainer", "ms-gamebarservices", "ms-media-stream-id", "ms-settings-camera", "ms-useractivityset", "ms-virtualtouchpad"},
+			19: {"ms-eyecontrolspeech", "ms-newsandinterests", "ms-settings-privacy"},
+			20: {"ms-browser-extension", "ms-settings-cellular", "ms-settings-language", "ms-settings-location"},
+			21: {"ms-settings-bluetooth", "ms-settings-proximity", "ms-settings-workplace"},
+			22: {"ms-launchremotedesktop", "ms-mixedrealitycapture", "ms-restoretabcompanion"},
+			23: {"com-eventbrite-attendee", "ms-remotedesktop-launch"},
+			24: {"first-run-pen-experience", "microsoft.windows.camera", "ms-settings-airplanemode", "ms-settings-cloudstorage"},
+			25: {"ms-secondary-screen-setup", "ms-settings-notifications"},
+			26: {"ms-settings-screenrotation"},
+			27: {"ms-settings-nfctransactions"},
+			28: {"ms-settings-emailandaccounts"},
+			29: {"ms-lockscreencomponent-config", "ms-settings-displays-topology"},
+			30: {"ms-secondary-screen-controller", "ms-settings-connectabledevices"},
+			31: {"microsoft.windows.camera.picker"},
+			35: {"machineprovisioningprogressreporter"},
+			36: {"microsoft.windows.camera.multipicker"},
+		},
+		ByFirstLetter: map[byte][]string{
+			'a': {"aaa", "aaas", "about", "acap", "acct", "acd", "acr", "adiumxtra", "adt", "afp", "afs", "aim", "amss", "android", "appdata", "apt", "ar", "ari", "ark", "at", "attachment", "aw"},
+			'b': {"barion", "bb", "beshare", "bitcoin", "bitcoincash", "bl", "blob", "bluetooth", "bolo", "brid", "browserext"},
+			'c': {"cabal", "calculator", "callto", "cap", "cast", "casts", "chrome", "chrome-extension", "cid", "coap", "coap+tcp", "coap+ws", "coaps", "coaps+tcp", "coaps+ws", "com-eventbrite-attendee", "content", "content-type", "crid", "cstr", "cvs"},
+			'd': {"dab", "dat", "data", "dav", "dhttp", "diaspora", "dict", "did", "dis", "dlna-playcontainer", "dlna-playsingle", "dns", "dntp", "doi", "dpp", "drm", "drop", "dtmi", "dtn", "dvb", "dvx", "dweb"},
+			'e': {"ed2k", "eid", "elsi", "embedded", "ens", "ethereum", "example"},
+			'f': {"facetime", "fax", "feed", "feedready", "fido", "file", "filesystem", "finger", "first-run-pen-experience", "fish", "fm", "ftp", "fuchsia-pkg"},
+			'g': {"geo", "gg", "git", "gitoid", "gizmoproject", "go", "gopher", "graph", "grd", "gtalk"},
+			'h': {"h323", "ham", "hcap", "hcp", "hs20", "http", "https", "hxxp", "hxxps", "hydrazone", "hyper"},
+			'i': {"iax", "icap", "icon", "ilstring", "im", "imap", "info", "iotdisco", "ipfs", "ipn", "ipns", "ipp", "ipps", "irc", "irc6", "ircs", "iris", "iris.beep", "iris.lwz", "iris.xpc", "iris.xpcs", "isostore", "itms"},
+			'j': {"jabber", "jar", "jms"},
+			'k': {"keyparc"},
+			'l': {"lastfm", "lbry", "ldap", "ldaps", "leaptofrogans", "lid", "lorawan", "lpa", "lvlt"},
+			'm': {"machineprovisioningprogressreporter", "magnet", "mailserver", "mailto", "maps", "market", "matrix", "message", "microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker", "mid", "mms", "modem", "mongodb", "moz", "ms-access", "ms-appinstaller", "ms-browser-extension", "ms-calculator", "ms-drive-to", "ms-enrollment", "ms-excel", "ms-eyecontrolspeech", "ms-gamebarservices", "ms-gamingoverlay", "ms-getoffice", "ms-help", "ms-infopath", "ms-inputapp", "ms-launchremotedesktop", "ms-lockscreencomponent-config", "ms-media-stream-id", "ms-meetnow", "ms-mixedrealitycapture", "ms-mobileplans", "ms-newsandinterests", "ms-officeapp", "ms-people", "ms-personacard", "ms-powerpoint", "ms-project", "ms-publisher", "ms-recall", "ms-remotedesktop", "ms-remotedesktop-launch", "ms-restoretabcompanion", "ms-screenclip", "ms-screensketch", "ms-search", "ms-search-repair", "ms-secondary-screen-controller", "ms-secondary-screen-setup", "ms-settings", "ms-settings-airplanemode", "ms-settings-bluetooth", "ms-settings-camera", "ms-settings-cellular", "ms-settings-cloudstorage", "ms-settings-connectabledevices", "ms-settings-displays-topology", "ms-settings-emailandaccounts", "ms-settings-language", "ms-settings-location", "ms-settings-lock", "ms-settings-nfctransactions", "ms-settings-notifications", "ms-settings-power", "ms-settings-privacy", "ms-settings-proximity", "ms-settings-screenrotation", "ms-settings-wifi", "ms-settings-workplace", "ms-spd", "ms-stickers", "ms-sttoverlay", "ms-transit-to", "ms-useractivityset", "ms-uup", "ms-virtualtouchpad", "ms-visio", "ms-walk-to", "ms-whiteboard", "ms-whiteboard-cmd", "ms-widgetboard", "ms-widgets", "ms-word", "msnim", "msrp", "msrps", "mss", "mt", "mtqp", "mtrust", "mumble", "mupdate", "mvn", "mvrp", "mvrps"},
+			'n': {"news", "nfs", "ni", "nih", "nntp", "notes", "num"},
+			'o': {"ocf", "oid", "onenote", "onenote-cmd", "opaquelocktoken", "openid", "openpgp4fpr", "otpauth"},
+			'p': {"p1", "pack", "palm", "paparazzi", "payment", "payto", "pkcs11", "platform", "pop", "pres", "prospero", "proxy", "psyc", "pttp", "pwid"},
+			'q': {"qb", "query", "quic-transport"},
+			'r': {"redis", "rediss", "reload", "res", "resource", "rmi", "rsync", "rtmfp", "rtmp", "rtsp", "rtsps", "rtspu"},
+			's': {"sarif", "secondlife", "secret-token", "service", "session", "sftp", "sgn", "shc", "shelter", "shttp", "sieve", "simpleledger", "simplex", "sip", "sips", "skype", "smb", "smp", "sms", "smtp", "snews", "snmp", "soap.beep", "soap.beeps", "soldat", "spiffe", "spotify", "ssb", "ssh", "starknet", "steam", "stun", "stuns", "submit", "svn", "swh", "swid", "swidpath"},
+			't': {"tag", "taler", "teamspeak", "teapot", "teapots", "tel", "teliaeid", "telnet", "tftp", "things", "thismessage", "thzp", "tip", "tn3270", "tool", "turn", "turns", "tv"},
+			'u': {"udp", "unreal", "upt", "urn", "ut2004", "uuid-in-package"},
+			'v': {"v-event", "vemmi", "ventrilo", "ves", "videotex", "view-source", "vnc", "vscode", "vscode-insiders", "vsls"},
+			'w': {"w3", "wais", "wasm", "wasm-js", "wcr", "web+ap", "web3", "webcal", "wifi", "wpid", "ws", "wss", "wtai", "wyciwyg"},
+			'x': {"xcon", "xcon-userid", "xfire", "xftp", "xmlrpc.beep", "xmlrpc.beeps", "xmpp", "xrcp", "xri"},
+			'y': {"ymsgr"},
+			'z': {"z39.50", "z39.50r", "z39.50s"},
+		},
+		ByPrefix: map[string][]string{
+			"aa":                        {"aaa", "aaas"},
+			"aaa":                       {"aaa", "aaas"},
+			"ac":                        {"acap", "acct", "acd", "acr"},
+			"ad":                        {"adiumxtra", "adt"},
+			"af":                        {"afp", "afs"},
+			"ap":                        {"appdata", "apt"},
+			"ar":                        {"ar", "ari", "ark"},
+			"at":                        {"at", "attachment"},
+			"bi":                        {"bitcoin", "bitcoincash"},
+			"bit":                       {"bitcoin", "bitcoincash"},
+			"bitc":                      {"bitcoin", "bitcoincash"},
+			"bitco":                     {"bitcoin", "bitcoincash"},
+			"bitcoi":                    {"bitcoin", "bitcoincash"},
+			"bitcoin":                   {"bitcoin", "bitcoincash"},
+			"bl":                        {"bl", "blob", "bluetooth"},
+			"br":                        {"brid", "browserext"},
+			"ca":                        {"cabal", "calculator", "callto", "cap", "cast", "casts"},
+			"cal":                       {"calculator", "callto"},
+			"cas":                       {"cast", "casts"},
+			"cast":                      {"cast", "casts"},
+			"ch":                        {"chrome", "chrome-extension"},
+			"chr":                       {"chrome", "chrome-extension"},
+			"chro":                      {"chrome", "chrome-extension"},
+			"chrom":                     {"chrome", "chrome-extension"},
+			"chrome":                    {"chrome", "chrome-extension"},
+			"co":                        {"coap", "coap+tcp", "coap+ws", "coaps", "coaps+tcp", "coaps+ws", "com-eventbrite-attendee", "content", "content-type"},
+			"coa":                       {"coap", "coap+tcp", "coap+ws", "coaps", "coaps+tcp", "coaps+ws"},
+			"coap":                      {"coap", "coap+tcp", "coap+ws", "coaps", "coaps+tcp", "coaps+ws"},
+			"coap+":                     {"coap+tcp", "coap+ws"},
+			"coaps":                     {"coaps", "coaps+tcp", "coaps+ws"},
+			"coaps+":                    {"coaps+tcp", "coaps+ws"},
+			"con":                       {"content", "content-type"},
+			"cont":                      {"content", "content-type"},
+			"conte":                     {"content", "content-type"},
+			"conten":                    {"content", "content-type"},
+			"content":                   {"content", "content-type"},
+			"da":                        {"dab", "dat", "data", "dav"},
+			"dat":                       {"dat", "data"},
+			"di":                        {"diaspora", "dict", "did", "dis"},
+			"dl":                        {"dlna-playcontainer", "dlna-playsingle"},
+			"dln":                       {"dlna-playcontainer", "dlna-playsingle"},
+			"dlna":                      {"dlna-playcontainer", "dlna-playsingle"},
+			"dlna-":                     {"dlna-playcontainer", "dlna-playsingle"},
+			"dlna-p":                    {"dlna-playcontainer", "dlna-playsingle"},
+			"dlna-pl":                   {"dlna-playcontainer", "dlna-playsingle"},
+			"dlna-pla":                  {"dlna-playcontainer", "dlna-playsingle"},
+			"dlna-play":                 {"dlna-playcontainer", "dlna-playsingle"},
+			"dn":                        {"dns", "dntp"},
+			"dr":                        {"drm", "drop"},
+			"dt":                        {"dtmi", "dtn"},
+			"dv":                        {"dvb", "dvx"},
+			"fa":                        {"facetime", "fax"},
+			"fe":                        {"feed", "feedready"},
+			"fee":                       {"feed", "feedready"},
+			"feed":                      {"feed", "feedready"},
+			"fi":                        {"fido", "file", "filesystem", "finger", "first-run-pen-experience", "fish"},
+			"fil":                       {"file", "filesystem"},
+			"file":                      {"file", "filesystem"},
+			"gi":                        {"git", "gitoid", "gizmoproject"},
+			"git":                       {"git", "gitoid"},
+			"go":                        {"go", "gopher"},
+			"gr":                        {"graph", "grd"},
+			"hc":                        {"hcap", "hcp"},
+			"ht":                        {"http", "https"},
+			"htt":                       {"http", "https"},
+			"http":                      {"http", "https"},
+			"hx":                        {"hxxp", "hxxps"},
+			"hxx":                       {"hxxp", "hxxps"},
+			"hxxp":                      {"hxxp", "hxxps"},
+			"hy":                        {"hydrazone", "hyper"},
+			"ic":                        {"icap", "icon"},
+			"im":                        {"im", "imap"},
+			"ip":                        {"ipfs", "ipn", "ipns", "ipp", "ipps"},
+			"ipn":                       {"ipn", "ipns"},
+			"ipp":                       {"ipp", "ipps"},
+			"ir":                        {"irc", "irc6", "ircs", "iris", "iris.beep", "iris.lwz", "iris.xpc", "iris.xpcs"},
+			"irc":                       {"irc", "irc6", "ircs"},
+			"iri":                       {"iris", "iris.beep", "iris.lwz", "iris.xpc", "iris.xpcs"},
+			"iris":                      {"iris", "iris.beep", "iris.lwz", "iris.xpc", "iris.xpcs"},
+			"iris.":                     {"iris.beep", "iris.lwz", "iris.xpc", "iris.xpcs"},
+			"iris.x":                    {"iris.xpc", "iris.xpcs"},
+			"iris.xp":                   {"iris.xpc", "iris.xpcs"},
+			"iris.xpc":                  {"iris.xpc", "iris.xpcs"},
+			"ja":                        {"jabber", "jar"},
+			"ld":                        {"ldap", "ldaps"},
+			"lda":                       {"ldap", "ldaps"},
+			"ldap":                      {"ldap", "ldaps"},
+			"ma":                        {"machineprovisioningprogressreporter", "magnet", "mailserver", "mailto", "maps", "market", "matrix"},
+			"mai":                       {"mailserver", "mailto"},
+			"mail":                      {"mailserver", "mailto"},
+			"mi":                        {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker", "mid"},
+			"mic":                       {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"micr":                      {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"micro":                     {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"micros":                    {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"microso":                   {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"microsof":                  {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"microsoft":                 {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"microsoft.":                {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"microsoft.w":               {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"microsoft.wi":              {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"microsoft.win":             {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"microsoft.wind":            {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"microsoft.windo":           {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"microsoft.window":          {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"microsoft.windows":         {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"microsoft.windows.":        {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"microsoft.windows.c":       {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"microsoft.windows.ca":      {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"microsoft.windows.cam":     {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"microsoft.windows.came":    {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"microsoft.windows.camer":   {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"microsoft.windows.camera":  {"microsoft.windows.camera", "microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"microsoft.windows.camera.": {"microsoft.windows.camera.multipicker", "microsoft.windows.camera.picker"},
+			"mo":                        {"modem", "mongodb", "moz"},
+			"ms":                        {"ms-access", "ms-appinstaller", "ms-browser-extension", "ms-calculator", "ms-drive-to", "ms-enrollment", "ms-excel", "ms-eyecontrolspeech", "ms-gamebarservices", "ms-gamingoverlay", "ms-getoffice", "ms-help", "ms-infopath", "ms-inputapp", "ms-launchremotedesktop", "ms-lockscreencomponent-config", "ms-media-stream-id", "ms-meetnow", "ms-mixedrealitycapture", "ms-mobileplans", "ms-newsandinterests", "ms-officeapp", "ms-people", "ms-personacard", "ms-powerpoint", "ms-project", "ms-publisher", "ms-recall", "ms-remotedesktop", "ms-remotedesktop-launch", "ms-restoretabcompanion", "ms-screenclip", "ms-screensketch", "ms-search", "ms-search-repair", "ms-secondary-screen-controller", "ms-secondary-screen-setup", "ms-settings", "ms-settings-airplanemode", "ms-settings-bluetooth", "ms-settings-camera", "ms-settings-cellular", "ms-settings-cloudstorage", "ms-settings-connectabledevices", "ms-settings-displays-topology", "ms-settings-emailandaccounts", "ms-settings-language", "ms-settings-location", "ms-settings-lock", "ms-settings-nfctransactions", "ms-settings-notifications", "ms-settings-power", "ms-settings-privacy", "ms-settings-proximity", "ms-settings-screenrotation", "ms-settings-wifi", "ms-settings-workplace", "ms-spd", "ms-stickers", "ms-sttoverlay", "ms-transit-to", "ms-useractivityset", "ms-uup", "ms-virtualtouchpad", "ms-visio", "ms-walk-to", "ms-whiteboard", "ms-whiteboard-cmd", "ms-widgetboard", "ms-widgets", "ms-word", "msnim", "msrp", "msrps", "mss"},
+			"ms-":                       {"ms-access", "ms-appinstaller", "ms-browser-extension", "ms-calculator", "ms-drive-to", "ms-enrollment", "ms-excel", "ms-eyecontrolspeech", "ms-gamebarservices", "ms-gamingoverlay", "ms-getoffice", "ms-help", "ms-infopath", "ms-inputapp", "ms-launchremotedesktop", "ms-lockscreencomponent-config", "ms-media-stream-id", "ms-meetnow", "ms-mixedrealitycapture", "ms-mobileplans", "ms-newsandinterests", "ms-officeapp", "ms-people", "ms-personacard", "ms-powerpoint", "ms-project", "ms-publisher", "ms-recall", "ms-remotedesktop", "ms-remotedesktop-launch", "ms-restoretabcompanion", "ms-screenclip", "ms-screensketch", "ms-search", "ms-search-repair", "ms-secondary-screen-controller", "ms-secondary-screen-setup", "ms-settings", "ms-settings-airplanemode", "ms-settings-bluetooth", "ms-settings-camera", "ms-settings-cellular", "ms-settings-cloudstorage", "ms-settings-connectabledevices", "ms-settings-displays-topology", "ms-settings-emailandaccounts", "ms-settings-language", "ms-settings-location", "ms-settings-lock", "ms-settings-nfctransactions", "ms-settings-notifications", "ms-settings-power", "ms-settings-privacy", "ms-settings-proximity", "ms-settings-screenrotation", "ms-settings-wifi", "ms-settings-workplace", "ms-spd", "ms-stickers", "ms-sttoverlay", "ms-transit-to", "ms-useractivityset", "ms-uup", "ms-virtualtouchpad", "ms-visio", "ms-walk-to", "ms-whiteboard", "ms-whiteboard-cmd", "ms-widgetboard", "ms-widgets", "ms-word"},
+			"ms-a":                      {"ms-access", "ms-appinstaller"},
+			"ms-e":                      {"ms-enrollment", "ms-excel", "ms-eyecontrolspeech"},
+			"ms-g":                      {"ms-gamebarservices", "ms-gamingoverlay", "ms-getoffice"},
+			"ms-ga":                     {"ms-gamebarservices", "ms-gamingoverlay"},
+			"ms-gam":                    {"ms-gamebarservices", "ms-gamingoverlay"},
+			"ms-i":                      {"ms-infopath", "ms-inputapp"},
+			"ms-in":                     {"ms-infopath", "ms-inputapp"},
+			"ms-l":                      {"ms-launchremotedesktop", "ms-lockscreencomponent-config"},
+			"ms-m":                      {"ms-media-stream-id", "ms-meetnow", "ms-mixedrealitycapture", "ms-mobileplans"},
+			"ms-me":                     {"ms-media-stream-id", "ms-meetnow"},
+			"ms-p":                      {"ms-people", "ms-personacard", "ms-powerpoint", "ms-project", "ms-publisher"},
+			"ms-pe":                     {"ms-people", "ms-personacard"},
+			"ms-r":                      {"ms-recall", "ms-remotedesktop", "ms-remotedesktop-launch", "ms-restoretabcompanion"},
+			"ms-re":                     {"ms-recall", "ms-remotedesktop", "ms-remotedesktop-launch", "ms-restoretabcompanion"},
+			"ms-rem":                    {"ms-remotedesktop", "ms-remotedesktop-launch"},
+			"ms-remo":                   {"ms-remotedesktop", "ms-remotedesktop-launch"},
+			"ms-remot":                  {"ms-remotedesktop", "ms-remotedesktop-launch"},
+			"ms-remote":                 {"ms-remotedesktop", "ms-remotedesktop-launch"},
+			"ms-remoted":                {"ms-remotedesktop", "ms-remotedesktop-launch"},
+			"ms-remotede":               {"ms-remotedesktop", "ms-remotedesktop-launch"},
+			"ms-remotedes":              {"ms-remotedesktop", "ms-remotedesktop-launch"},
+			"ms-remotedesk":             {"ms-remotedesktop", "ms-remotedesktop-launch"},
+			"ms-remotedeskt":            {"ms-remotedesktop", "ms-remotedesktop-launch"},
+			"ms-remotedeskto":           {"ms-remotedesktop", "ms-remotedesktop-launch"},
+			"ms-remotedesktop":          {"ms-remotedesktop", "ms-remotedesktop-launch"},
+			"ms-s":                      {"ms-screenclip", "ms-screensketch", "ms-search", "ms-search-repair", "ms-secondary-screen-controller", "ms-secondary-screen-setup", "ms-settings", "ms-settings-airplanemode", "ms-settings-bluetooth", "ms-settings-camera", "ms-settings-cellular", "ms-settings-cloudstorage", "ms-settings-connectabledevices", "ms-settings-displays-topology", "ms-settings-emailandaccounts", "ms-settings-language", "ms-settings-location", "ms-settings-lock", "ms-settings-nfctransactions", "ms-settings-notifications", "ms-settings-power", "ms-settings-privacy", "ms-settings-proximity", "ms-settings-screenrotation", "ms-settings-wifi", "ms-settings-workplace", "ms-spd", "ms-stickers", "ms-sttoverlay"},
+			"ms-sc":                     {"ms-screenclip", "ms-screensketch"},
+			"ms-scr":                    {"ms-screenclip", "ms-screensketch"},
+			"ms-scre":                   {"ms-screenclip", "ms-screensketch"},
+			"ms-scree":                  {"ms-screenclip", "ms-screensketch"},
+			"ms-screen":                 {"ms-screenclip", "ms-screensketch"},
+			"ms-se":                     {"ms-search", "ms-search-repair", "ms-secondary-screen-controller", "ms-secondary-screen-setup", "ms-settings", "ms-settings-airplanemode", "ms-settings-bluetooth", "ms-settings-camera", "ms-settings-cellular", "ms-settings-cloudstorage", "ms-settings-connectabledevices", "ms-settings-displays-topology", "ms-settings-emailandaccounts", "ms-settings-language", "ms-settings-location", "ms-settings-lock", "ms-settings-nfctransactions", "ms-settings-notifications", "ms-settings-power", "ms-settings-privacy", "ms-settings-proximity", "ms-settings-screenrotation", "ms-settings-wifi", "ms-settings-workplace"},
+			"ms-sea":                    {"ms-search", "ms-search-repair"},
+			"ms-sear":                   {"ms-search", "ms-search-repair"},
+			"ms-searc":                  {"ms-search", "ms-search-repair"},
+			"ms-search":                 {"ms-search", "ms-search-repair"},
+			"ms-sec":                    {"ms-secondary-screen-controller", "ms-secondary-screen-setup"},
+			"ms-seco":                   {"ms-secondary-screen-controller", "ms-secondary-screen-setup"},
+			"ms-secon":                  {"ms-secondary-screen-controller", "ms-secondary-screen-setup"},
+			"ms-second":                 {"ms-secondary-screen-controller", "ms-secondary-screen-setup"},
+			"ms-seconda":                {"ms-secondary-screen-controller", "ms-secondary-screen-setup"},
+			"ms-secondar":               {"ms-secondary-screen-controller", "ms-secondary-screen-setup"},
+			"ms-secondary":              {"ms-secondary-screen-controller", "ms-secondary-screen-setup"},
+			"ms-secondary-":             {"ms-secondary-screen-controller", "ms-secondary-screen-setup"},
+			"ms-secondary-s":            {"ms-secondary-screen-controller", "ms-secondary-screen-setup"},
+			"ms-secondary-sc":           {"ms-secondary-screen-controller", "ms-secondary-screen-setup"},
+			"ms-secondary-scr":          {"ms-secondary-screen-controller", "ms-secondary-screen-setup"},
+			"ms-secondary-scre":         {"ms-secondary-screen-controller", "ms-secondary-screen-setup"},
+			"ms-secondary-scree":        {"ms-secondary-screen-controller", "ms-secondary-screen-setup"},
+			"ms-secondary-screen":       {"ms-secondary-screen-controller", "ms-secondary-screen-setup"},
+			"ms-secondary-screen-":      {"ms-secondary-screen-controller", "ms-secondary-screen-setup"},
+			"ms-set":                    {"ms-settings", "ms-settings-airplanemode", "ms-settings-bluetooth", "ms-settings-camera", "ms-settings-cellular", "ms-settings-cloudstorage", "ms-settings-connectabledevices", "ms-settings-displays-topology", "ms-settings-emailandaccounts", "ms-settings-language", "ms-settings-location", "ms-settings-lock", "ms-settings-nfctransactions", "ms-settings-notifications", "ms-settings-power", "ms-settings-privacy", "ms-settings-proximity", "ms-settings-screenrotation", "ms-settings-wifi", "ms-settings-workplace"},
+			"ms-sett":                   {"ms-settings", "ms-settings-airplanemode", "ms-settings-bluetooth", "ms-settings-camera", "ms-settings-cellular", "ms-settings-cloudstorage", "ms-settings-connectabledevices", "ms-settings-displays-topology", "ms-settings-emailandaccounts", "ms-settings-language", "ms-settings-location", "ms-settings-lock", "ms-settings-nfctransactions", "ms-settings-notifications", "ms-settings-power", "ms-settings-privacy", "ms-settings-proximity", "ms-settings-screenrotation", "ms-settings-wifi", "ms-settings-workplace"},
+			"ms-setti":                  {"ms-settings", "ms-settings-airplanemode", "ms-settings-bluetooth", "ms-settings-camera", "ms-settings-cellular", "ms-settings-cloudstorage", "ms-settings-connectabledevices", "ms-settings-displays-topology", "ms-settings-emailandaccounts", "ms-settings-language", "ms-settings-location", "ms-settings-lock", "ms-settings-nfctransactions", "ms-settings-notifications", "ms-settings-power", "ms-settings-privacy", "ms-settings-proximity", "ms-settings-screenrotation", "ms-settings-wifi", "ms-settings-workplace"},
+			"ms-settin":                 {"ms-settings", "ms-settings-airplanemode", "ms-settings-bluetooth", "ms-settings-camera", "ms-settings-cellular", "ms-settings-cloudstorage", "ms-settings-connectabledevices", "ms-settings-displays-topology", "ms-settings-emailandaccounts", "ms-settings-language", "ms-settings-location", "ms-settings-lock", "ms-settings-nfctransactions", "ms-settings-notifications", "ms-settings-power", "ms-settings-privacy", "ms-settings-proximity", "ms-settings-screenrotation", "ms-settings-wifi", "ms-settings-workplace"},
+			"ms-setting":                {"ms-settings", "ms-settings-airplanemode", "ms-settings-bluetooth", "ms-settings-camera", "ms-settings-cellular", "ms-settings-cloudstorage", "ms-settings-connectabledevices", "ms-settings-displays-topology", "ms-settings-emailandaccounts", "ms-settings-language", "ms-settings-location", "ms-settings-lock", "ms-settings-nfctransactions", "ms-settings-notifications", "ms-settings-power", "ms-settings-privacy", "ms-settings-proximity", "ms-settings-screenrotation", "ms-settings-wifi", "ms-settings-workplace"},
+			"ms-settings":               {"ms-settings", "ms-settings-airplanemode", "ms-settings-bluetooth", "ms-settings-camera", "ms-settings-cellular", "ms-settings-cloudstorage", "ms-settings-connectabledevices", "ms-settings-displays-topology", "ms-settings-emailandaccounts", "ms-settings-language", "ms-settings-location", "ms-settings-lock", "ms-settings-nfctransactions", "ms-settings-notifications", "ms-settings-power", "ms-settings-privacy", "ms-settings-proximity", "ms-settings-screenrotation", "ms-settings-wifi", "ms-settings-workplace"},
+			"ms-settings-":              {"ms-settings-airplanemode", "ms-settings-bluetooth", "ms-settings-camera", "ms-settings-cellular", "ms-settings-cloudstorage", "ms-settings-connectabledevices", "ms-settings-displays-topology", "ms-settings-emailandaccounts", "ms-settings-language", "ms-settings-location", "ms-settings-lock", "ms-settings-nfctransactions", "ms-settings-notifications", "ms-settings-power", "ms-settings-privacy", "ms-settings-proximity", "ms-settings-screenrotation", "ms-settings-wifi", "ms-settings-workplace"},
+			"ms-settings-c":             {"ms-settings-camera", "ms-settings-cellular", "ms-settings-cloudstorage", "ms-settings-connectabledevices"},
+			"ms-settings-l":             {"ms-settings-language", "ms-settings-location", "ms-settings-lock"},
+			"ms-settings-lo":            {"ms-settings-location", "ms-settings-lock"},
+			"ms-settings-loc":           {"ms-settings-location", "ms-settings-lock"},
+			"ms-settings-n":             {"ms-settings-nfctransactions", "ms-settings-notifications"},
+			"ms-settings-p":             {"ms-settings-power", "ms-settings-privacy", "ms-settings-proximity"},
+			"ms-settings-pr":            {"ms-settings-privacy", "ms-settings-proximity"},
+			"ms-settings-w":             {"ms-settings-wifi", "ms-settings-workplace"},
+			"ms-st":                     {"ms-stickers", "ms-sttoverlay"},
+			"ms-u":                      {"ms-useractivityset", "ms-uup"},
+			"ms-v":                      {"ms-virtualtouchpad", "ms-visio"},
+			"ms-vi":                     {"ms-virtualtouchpad", "ms-visio"},
+			"ms-w":                      {"ms-walk-to", "ms-whiteboard", "ms-whiteboard-cmd", "ms-widgetboard", "ms-widgets", "ms-word"},
+			"ms-wh":                     {"ms-whiteboard", "ms-whiteboard-cmd"},
+			"ms-whi":                    {"ms-whiteboard", "ms-whiteboard-cmd"},
+			"ms-whit":                   {"ms-whiteboard", "ms-whiteboard-cmd"},
+			"ms-white":                  {"ms-whiteboard", "ms-whiteboard-cmd"},
+			"ms-whiteb":                 {"ms-whiteboard", "ms-whiteboard-cmd"},
+			"ms-whitebo":                {"ms-whiteboard", "ms-whiteboard-cmd"},
+			"ms-whiteboa":               {"ms-whiteboard", "ms-whiteboard-cmd"},
+			"ms-whiteboar":              {"ms-whiteboard", "ms-whiteboard-cmd"},
+			"ms-whiteboard":             {"ms-whiteboard", "ms-whiteboard-cmd"},
+			"ms-wi":                     {"ms-widgetboard", "ms-widgets"},
+			"ms-wid":                    {"ms-widgetboard", "ms-widgets"},
+			"ms-widg":                   {"ms-widgetboard", "ms-widgets"},
+			"ms-widge":                  {"ms-widgetboard", "ms-widgets"},
+			"ms-widget":                 {"ms-widgetboard", "ms-widgets"},
+			"msr":                       {"msrp", "msrps"},
+			"msrp":                      {"msrp", "msrps"},
+			"mt":                        {"mt", "mtqp", "mtrust"},
+			"mu":                        {"mumble", "mupdate"},
+			"mv":                        {"mvn", "mvrp", "mvrps"},
+			"mvr":                       {"mvrp", "mvrps"},
+			"mvrp":                      {"mvrp", "mvrps"},
+			"ni":                        {"ni", "nih"},
+			"on":                        {"onenote", "onenote-cmd"},
+			"one":                       {"onenote", "onenote-cmd"},
+			"onen":                      {"onenote", "onenote-cmd"},
+			"oneno":                     {"onenote", "onenote-cmd"},
+			"onenot":                    {"onenote", "onenote-cmd"},
+			"onenote":                   {"onenote", "onenote-cmd"},
+			"op":                        {"opaquelocktoken", "openid", "openpgp4fpr"},
+			"ope":                       {"openid", "openpgp4fpr"},
+			"open":                      {"openid", "openpgp4fpr"},
+			"pa":                        {"pack", "palm", "paparazzi", "payment", "payto"},
+			"pay":                       {"payment", "payto"},
+			"pr":                        {"pres", "prospero", "proxy"},
+			"pro":                       {"prospero", "proxy"},
+			"qu":                        {"query", "quic-transport"},
+			"re":                        {"redis", "rediss", "reload", "res", "resource"},
+			"red":                       {"redis", "rediss"},
+			"redi":                      {"redis", "rediss"},
+			"redis":                     {"redis", "rediss"},
+			"res":                       {"res", "resource"},
+			"rt":                        {"rtmfp", "rtmp", "rtsp", "rtsps", "rtspu"},
+			"rtm":                       {"rtmfp", "rtmp"},
+			"rts":                       {"rtsp", "rtsps", "rtspu"},
+			"rtsp":                      {"rtsp", "rtsps", "rtspu"},
+			"se":                        {"secondlife", "secret-token", "service", "session"},
+			"sec":                       {"secondlife", "secret-token"},
+			"sh":                        {"shc", "shelter", "shttp"},
+			"si":                        {"sieve", "simpleledger", "simplex", "sip", "sips"},
+			"sim":                       {"simpleledger", "simplex"},
+			"simp":                      {"simpleledger", "simplex"},
+			"simpl":                     {"simpleledger", "simplex"},
+			"simple":                    {"simpleledger", "simplex"},
+			"sip":                       {"sip", "sips"},
+			"sm":                        {"smb", "smp", "sms", "smtp"},
+			"sn":                        {"snews", "snmp"},
+			"so":                        {"soap.beep", "soap.beeps", "soldat"},
+			"soa":                       {"soap.beep", "soap.beeps"},
+			"soap":                      {"soap.beep", "soap.beeps"},
+			"soap.":                     {"soap.beep", "soap.beeps"},
+			"soap.b":                    {"soap.beep", "soap.beeps"},
+			"soap.be":                   {"soap.beep", "soap.beeps"},
+			"soap.bee":                  {"soap.beep", "soap.beeps"},
+			"soap.beep":                 {"soap.beep", "soap.beeps"},
+			"sp":                        {"spiffe", "spotify"},
+			"ss":                        {"ssb", "ssh"},
+			"st":                        {"starknet", "steam", "stun", "stuns"},
+			"stu":                       {"stun", "stuns"},
+			"stun":                      {"stun", "stuns"},
+			"sw":                        {"swh", "swid", "swidpath"},
+			"swi":                       {"swid", "swidpath"},
+			"swid":                      {"swid", "swidpath"},
+			"ta":                        {"tag", "taler"},
+			"te":                        {"teamspeak", "teapot", "teapots", "tel", "teliaeid", "telnet"},
+			"tea":                       {"teamspeak", "teapot", "teapots"},
+			"teap":                      {"teapot", "teapots"},
+			"teapo":                     {"teapot", "teapots"},
+			"teapot":                    {"teapot", "teapots"},
+			"tel":                       {"tel", "teliaeid", "telnet"},
+			"th":                        {"things", "thismessage", "thzp"},
+			"thi":                       {"things", "thismessage"},
+			"tu":                        {"turn", "turns"},
+			"tur":                       {"turn", "turns"},
+			"turn":                      {"turn", "turns"},
+			"ve":                        {"vemmi", "ventrilo", "ves"},
+			"vi":                        {"videotex", "view-source"},
+			"vs":                        {"vscode", "vscode-insiders", "vsls"},
+			"vsc":                       {"vscode", "vscode-insiders"},
+			"vsco":                      {"vscode", "vscode-insiders"},
+			"vscod":                     {"vscode", "vscode-insiders"},
+			"vscode":                    {"vscode", "vscode-insiders"},
+			"wa":                        {"wais", "wasm", "wasm-js"},
+			"was":                       {"wasm", "wasm-js"},
+			"wasm":                      {"wasm", "wasm-js"},
+			"we":                        {"web+ap", "web3", "webcal"},
+			"web":                       {"web+ap", "web3", "webcal"},
+			"ws":                        {"ws", "wss"},
+			"xc":                        {"xcon", "xcon-userid"},
+			"xco":                       {"xcon", "xcon-userid"},
+			"xcon":                      {"xcon", "xcon-userid"},
+			"xf":                        {"xfire", "xftp"},
+			"xm":                        {"xmlrpc.beep", "xmlrpc.beeps", "xmpp"},
+			"xml":                       {"xmlrpc.beep", "xmlrpc.beeps"},
+			"xmlr":                      {"xmlrpc.beep", "xmlrpc.beeps"},
+			"xmlrp":                     {"xmlrpc.beep", "xmlrpc.beeps"},
+			"xmlrpc":                    {"xmlrpc.beep", "xmlrpc.beeps"},
+			"xmlrpc.":                   {"xmlrpc.beep", "xmlrpc.beeps"},
+			"xmlrpc.b":                  {"xmlrpc.beep", "xmlrpc.beeps"},
+			"xmlrpc.be":                 {"xmlrpc.beep", "xmlrpc.beeps"},
+			"xmlrpc.bee":                {"xmlrpc.beep", "xmlrpc.beeps"},
+			"xmlrpc.beep":               {"xmlrpc.beep", "xmlrpc.beeps"},
+			"xr":                        {"xrcp", "xri"},
+			"z3":                        {"z39.50", "z39.50r", "z39.50s"},
+			"z39":                       {"z39.50", "z39.50r", "z39.50s"},
+			"z39.":                      {"z39.50", "z39.50r", "z39.50s"},
+			"z39.5":                     {"z39.50", "z39.50r", "z39.50s"},
+			"z39.50":                    {"z39.50", "z39.50r", "z39.50s"},
+		},
+	}
+}
@@ -0,0 +1,202 @@
+package schemes
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+)
+
+// Version of the JSON dataset schema written by WriteJSON.  Increment it, and add a
+// migration to DATASET_MIGRATIONS, whenever the schema changes
+const DATASET_SCHEMA_VERSION = 4
+
+// The JSON dataset document
+type datasetDocument struct {
+	SchemaVersion int             `json:"schema_version"`
+	Schemes       []datasetScheme `json:"schemes"`
+	// Names of schemes removed from a registry, as persisted by registry.Registry.SaveTo
+	Removed []string `json:"removed,omitempty"`
+}
+
+type datasetScheme struct {
+	Scheme              string   `json:"scheme"`
+	DefangedScheme      string   `json:"defanged_scheme"`
+	Template            string   `json:"template,omitempty"`
+	Description         string   `json:"description,omitempty"`
+	Status              Status   `json:"status"`
+	WellKnownUriSupport string   `json:"well_known_uri_support,omitempty"`
+	Reference           string   `json:"reference,omitempty"`
+	Notes               string   `json:"notes,omitempty"`
+	Obsolete            bool     `json:"obsolete,omitempty"`
+	Related             []string `json:"related,omitempty"`
+	Examples            []string `json:"examples,omitempty"`
+}
+
+// Migrations from each schema version to the next: DATASET_MIGRATIONS[v] takes a document
+// of version v, and returns it as version v + 1
+var DATASET_MIGRATIONS = map[int]func(json.RawMessage) (json.RawMessage, error){
+	0: migrateDatasetV0,
+	1: migrateDatasetV1,
+	2: migrateDatasetV2,
+	3: migrateDatasetV3,
+}
+
+// Version 0 is the unversioned dump of json.Marshal(Map) (or of a slice of schemes), with
+// Go field names
+func migrateDatasetV0(data json.RawMessage) (json.RawMessage, error) {
+	var schemes []Scheme
+	if err := json.Unmarshal(data, &schemes); err != nil {
+		var schemeMap map[string]Scheme
+		if err := json.Unmarshal(data, &schemeMap); err != nil {
+			return nil, fmt.Errorf("unversioned dataset is neither a list nor a map of schemes: %w", err)
+		}
+		for _, scheme := range schemeMap {
+			schemes = append(schemes, scheme)
+		}
+	}
+
+	document := datasetDocument{SchemaVersion: 1}
+	for _, scheme := range schemes {
+		document.Schemes = append(document.Schemes, datasetScheme(scheme))
+	}
+	return json.Marshal(document)
+}
+
+// Version 1 keeps the registry's "(OBSOLETE)" annotation as the scheme's notes; version 2
+// has the obsolete field in its place
+func migrateDatasetV1(data json.RawMessage) (json.RawMessage, error) {
+	var document datasetDocument
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	for i, scheme := range document.Schemes {
+		if ascii.EqualFold(scheme.Notes, "OBSOLETE") {
+			document.Schemes[i].Notes = ""
+			document.Schemes[i].Obsolete = true
+		}
+	}
+	document.SchemaVersion = 2
+	return json.Marshal(document)
+}
+
+// Version 3 adds the related field, which is derived from the dataset as at generation
+func migrateDatasetV2(data json.RawMessage) (json.RawMessage, error) {
+	var document datasetDocument
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	schemes := make(map[string]Scheme, len(document.Schemes))
+	for _, scheme := range document.Schemes {
+		schemes[scheme.Scheme] = Scheme(scheme)
+	}
+	related := RelateSchemes(schemes)
+	for i, scheme := range document.Schemes {
+		document.Schemes[i].Related = related[scheme.Scheme]
+	}
+	document.SchemaVersion = 3
+	return json.Marshal(document)
+}
+
+// Version 4 adds the examples field, which is curated in SCHEME_EXAMPLES
+func migrateDatasetV3(data json.RawMessage) (json.RawMessage, error) {
+	var document datasetDocument
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	for i, scheme := range document.Schemes {
+		document.Schemes[i].Examples = SchemeExamples(scheme.Scheme)
+	}
+	document.SchemaVersion = 4
+	return json.Marshal(document)
+}
+
+// Write schemes as a JSON dataset document, sorted by scheme
+func WriteJSON(w io.Writer, schemes map[string]Scheme) error {
+	return WriteDataset(w, schemes, nil)
+}
+
+// Write schemes as a JSON dataset document, with the names of any removed schemes
+func WriteDataset(w io.Writer, schemes map[string]Scheme, removed []string) error {
+	document := datasetDocument{
+		SchemaVersion: DATASET_SCHEMA_VERSION,
+		Schemes:       make([]datasetScheme, 0, len(schemes)),
+		Removed:       slices.Sorted(slices.Values(removed)),
+	}
+	for _, scheme := range schemes {
+		document.Schemes = append(document.Schemes, datasetScheme(scheme))
+	}
+	sort.Slice(document.Schemes, func(i, j int) bool {
+		return document.Schemes[i].Scheme < document.Schemes[j].Scheme
+	})
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(document)
+}
+
+// Load a JSON dataset, as written by WriteJSON by this or any earlier version of the
+// package.  Older documents are migrated to the current schema with DATASET_MIGRATIONS
+func LoadFromJSON(r io.Reader) (map[string]Scheme, error) {
+	schemes, _, err := LoadDataset(r)
+	return schemes, err
+}
+
+// Load a JSON dataset, with the names of any removed schemes
+func LoadDataset(r io.Reader) (map[string]Scheme, []string, error) {
+	var data json.RawMessage
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return nil, nil, fmt.Errorf("cannot decode dataset: %w", err)
+	}
+
+	for {
+		version, err := datasetSchemaVersion(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if version == DATASET_SCHEMA_VERSION {
+			break
+		}
+		if version > DATASET_SCHEMA_VERSION {
+			return nil, nil, fmt.Errorf("dataset schema version %d is newer than supported version %d", version, DATASET_SCHEMA_VERSION)
+		}
+
+		migrate, ok := DATASET_MIGRATIONS[version]
+		if !ok {
+			return nil, nil, fmt.Errorf("no migration from dataset schema version %d", version)
+		}
+		data, err = migrate(data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot migrate dataset from schema version %d: %w", version, err)
+		}
+	}
+
+	var document datasetDocument
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, nil, fmt.Errorf("cannot decode dataset: %w", err)
+	}
+
+	schemes := make(map[string]Scheme, len(document.Schemes))
+	for _, record := range document.Schemes {
+		scheme := Scheme(record)
+		if err := scheme.Validate(); err != nil {
+			return nil, nil, fmt.Errorf("invalid scheme %q in dataset: %w", record.Scheme, err)
+		}
+		schemes[scheme.Scheme] = scheme
+	}
+	return schemes, document.Removed, nil
+}
+
+// Schema version of a dataset document; documents without one are version 0
+func datasetSchemaVersion(data json.RawMessage) (int, error) {
+	var versioned struct {
+		SchemaVersion *int `json:"schema_version"`
+	}
+	if err := json.Unmarshal(data, &versioned); err != nil || versioned.SchemaVersion == nil {
+		return 0, nil
+	}
+	return *versioned.SchemaVersion, nil
+}
@@ -0,0 +1,40 @@
+//go:build (defang_schemes_lazy || defang_schemes_embed) && !defang_schemes_minimal
+
+package schemes
+
+import "sync"
+
+// In lazy and embed builds, Map is nil until the dataset is first accessed via Schemes (or
+// any other function that consults the dataset)
+var Map map[string]Scheme
+
+var schemesOnce = sync.OnceValue(func() map[string]Scheme {
+	Map = loadSchemes()
+	return Map
+})
+
+// The dataset of URI schemes, keyed by scheme.  Code that may be built with the
+// defang_schemes_lazy or defang_schemes_embed build tag should use this rather than
+// reading Map directly.  Panics with ErrDataNotGenerated if the data has not been
+// generated (see DataGenerated)
+func Schemes() map[string]Scheme {
+	if schemes := schemesOnce(); schemes != nil {
+		return schemes
+	}
+	return onDemandSchemes()
+}
+
+func generatedSchemes() map[string]Scheme {
+	return schemesOnce()
+}
+
+// In lazy and embed builds, the defanged forms of each style are computed on first
+// access, as they are at generation time
+func styledDefangedSchemes() map[Style]map[string]string {
+	return styledDefangedSchemesOnce()
+}
+
+// In lazy and embed builds, the indexes are built on first access, as they are at generation time
+func schemeIndex() *SchemeIndex {
+	return schemeIndexOnce()
+}
@@ -0,0 +1,49 @@
+//go:build defang_schemes_lazy && !defang_schemes_embed && !defang_schemes_minimal
+
+package schemes
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Separators used in the generated schemeBlob: one record per scheme, with fields in
+// the order of the Scheme struct
+const (
+	blobFieldSeparator  = "\x1f"
+	blobRecordSeparator = "\x1e"
+)
+
+// The dataset as a string blob, assigned by the generated consts_lazy.go
+var schemeBlob string
+
+// In lazy builds, the dataset is parsed from the generated string blob on first access.
+// Returns nil if the data has not been generated
+func loadSchemes() map[string]Scheme {
+	if schemeBlob == "" {
+		return nil
+	}
+	records := strings.Split(strings.TrimSuffix(schemeBlob, blobRecordSeparator), blobRecordSeparator)
+	schemeMap := make(map[string]Scheme, len(records))
+	for _, record := range records {
+		fields := strings.Split(record, blobFieldSeparator)
+		if len(fields) != 11 {
+			panic(fmt.Sprintf("malformed scheme record %q in generated data", record))
+		}
+		schemeMap[fields[0]] = Scheme{
+			Scheme:              fields[0],
+			DefangedScheme:      fields[1],
+			Template:            fields[2],
+			Description:         fields[3],
+			Status:              Status(fields[4]),
+			WellKnownUriSupport: fields[5],
+			Reference:           fields[6],
+			Notes:               fields[7],
+			Obsolete:            fields[8] == "true",
+			Related:             strings.Fields(fields[9]),
+			Examples:            strings.Fields(fields[10]),
+		}
+	}
+
+	return schemeMap
+}
@@ -0,0 +1,72 @@
+package schemes
+
+import (
+	"strings"
+
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+)
+
+// Scheme component of a URL, without its separator: everything before the first colon,
+// provided it is a syntactically valid scheme (RFC 3986, section 3.1)
+func urlScheme(raw string) (string, bool) {
+	raw = strings.TrimSpace(raw)
+
+	i := strings.IndexByte(raw, ':')
+	if i <= 0 {
+		return "", false
+	}
+
+	scheme := raw[:i]
+	if !IsValidScheme(scheme) {
+		return "", false
+	}
+
+	return scheme, true
+}
+
+// Whether s is a syntactically valid scheme, per the grammar of RFC 3986, section 3.1:
+//
+//	scheme = ALPHA *( ALPHA / DIGIT / "+" / "-" / "." )
+//
+// https://www.rfc-editor.org/rfc/rfc3986#section-3.1
+func IsValidScheme(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+		case i > 0 && ('0' <= c && c <= '9' || c == '+' || c == '-' || c == '.'):
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// Whether the URL's scheme component is this scheme.  Schemes are case-insensitive, so
+// "HTTPS://example.com" matches the https scheme
+func (s Scheme) MatchesURL(raw string) bool {
+	scheme, ok := urlScheme(raw)
+	return ok && ascii.EqualFold(scheme, s.Scheme)
+}
+
+// Look up a registered scheme by name.  Scheme names are case-insensitive and surrounding
+// whitespace is ignored, so " HTTPS" finds the https scheme
+func Lookup(scheme string) (Scheme, bool) {
+	known, ok := Schemes()[ascii.ToLower(strings.TrimSpace(scheme))]
+	return known, ok
+}
+
+// Look up the registered scheme of a URL.  The second return value is false if the URL
+// has no scheme, or if its scheme is not registered
+func SchemeOf(raw string) (Scheme, bool) {
+	scheme, ok := urlScheme(raw)
+	if !ok {
+		return Scheme{}, false
+	}
+	return Lookup(scheme)
+}
@@ -0,0 +1,7 @@
+//go:build defang_schemes_minimal
+
+package schemes
+
+// Whether the generated data keeps only Scheme, DefangedScheme, and Status, as in builds
+// with the defang_schemes_minimal build tag
+const MINIMAL_DATA = true
@@ -0,0 +1,38 @@
+package schemes
+
+import (
+	"iter"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// The dataset does not change at runtime, so its sorted names are computed once
+var schemeNamesOnce = sync.OnceValue(func() []string {
+	names := make([]string, 0, len(Schemes()))
+	for name := range Schemes() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+})
+
+// Names of all registered schemes, sorted
+func SchemeNames() []string {
+	return slices.Clone(schemeNamesOnce())
+}
+
+// Iterate over all registered schemes in name order, without copying the dataset:
+//
+//	for name, scheme := range schemes.All() {
+//		...
+//	}
+func All() iter.Seq2[string, Scheme] {
+	return func(yield func(string, Scheme) bool) {
+		for _, name := range schemeNamesOnce() {
+			if !yield(name, Schemes()[name]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package schemes
+
+import (
+	"regexp"
+	"sync"
+
+	"github.com/jakewilliami/defang-schemes/internal/algorithm"
+)
+
+// Shared compiled patterns, each built once on first use
+var schemeNamePatternOnce = sync.OnceValue(schemePattern)
+
+// Matches runs of the non-alphanumeric characters allowed in URI schemes
+func AdditionalAllowedSchemeCharsPattern() *regexp.Regexp {
+	return algorithm.AdditionalAllowedSchemeCharsPattern()
+}
+
+// Matches a scheme name (unanchored)
+func SchemeNamePattern() *regexp.Regexp {
+	return schemeNamePatternOnce()
+}
@@ -0,0 +1,87 @@
+package schemes
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// A bracketed reference of the registry ("[RFC9110, Section 4.2.1]")
+var referencePatternOnce = sync.OnceValue(func() *regexp.Regexp {
+	return regexp.MustCompile(`\[([^\]]+)\]`)
+})
+
+// Documents (or people) cited by a scheme's reference, without section numbers, so that
+// schemes defined by different sections of one document share a reference
+func referenceDocuments(reference string) map[string]bool {
+	documents := make(map[string]bool)
+	for _, match := range referencePatternOnce().FindAllStringSubmatch(reference, -1) {
+		document, _, _ := strings.Cut(match[1], ",")
+		documents[strings.TrimSpace(document)] = true
+	}
+	return documents
+}
+
+// Relate the schemes to each other, for the Related field: secure variants that share a
+// reference with their scheme (ws and wss, http and https), and the replacements of
+// SCHEME_REPLACEMENTS (shttp and https).  Relations are symmetric, and each list is
+// sorted.  Only registered schemes are related, so, for example, ftps (which is not) is
+// not related to ftp
+func RelateSchemes(schemes map[string]Scheme) map[string][]string {
+	related := make(map[string]map[string]bool)
+	relate := func(a, b string) {
+		for _, pair := range [][2]string{{a, b}, {b, a}} {
+			if related[pair[0]] == nil {
+				related[pair[0]] = make(map[string]bool)
+			}
+			related[pair[0]][pair[1]] = true
+		}
+	}
+
+	for name, scheme := range schemes {
+		secure, ok := schemes[name+"s"]
+		if !ok {
+			continue
+		}
+		documents := referenceDocuments(secure.Reference)
+		for document := range referenceDocuments(scheme.Reference) {
+			if documents[document] {
+				relate(name, secure.Scheme)
+				break
+			}
+		}
+	}
+	for name, replacement := range SCHEME_REPLACEMENTS {
+		_, nameOk := schemes[name]
+		_, replacementOk := schemes[replacement]
+		if nameOk && replacementOk {
+			relate(name, replacement)
+		}
+	}
+
+	relations := make(map[string][]string, len(related))
+	for name, others := range related {
+		for other := range others {
+			relations[name] = append(relations[name], other)
+		}
+		sort.Strings(relations[name])
+	}
+	return relations
+}
+
+// The registered schemes related to the given scheme (see RelateSchemes), so that enrichment
+// tools can pivot between them.  Returns nil if the scheme is not registered or has none
+func RelatedSchemes(scheme string) []Scheme {
+	known, ok := Lookup(scheme)
+	if !ok {
+		return nil
+	}
+	var related []Scheme
+	for _, name := range known.Related {
+		if other, ok := Lookup(name); ok {
+			related = append(related, other)
+		}
+	}
+	return related
+}
@@ -0,0 +1,1217 @@
+package schemes
+
+/*
+THIS FILE WAS AUTOMATICALLY GENERATED AT 2026-10-16 07:57:26
+
+Do not edit this file.  Run "go generate" to re-generate this file with an
+updated version of URI scheme names from:
+    iana.org/assignments/uri-schemes/uri-schemes.xhtml.
+*/
+
+// Number of registered URI schemes, in total and by status
+const (
+	NumSchemes     = 396
+	NumPermanent   = 99
+	NumProvisional = 279
+	NumHistorical  = 18
+)
+
+// Registered URI schemes
+const (
+	SCHEME_AAA                                  = "aaa"
+	SCHEME_AAAS                                 = "aaas"
+	SCHEME_ABOUT                                = "about"
+	SCHEME_ACAP                                 = "acap"
+	SCHEME_ACCT                                 = "acct"
+	SCHEME_ACD                                  = "acd"
+	SCHEME_ACR                                  = "acr"
+	SCHEME_ADIUMXTRA                            = "adiumxtra"
+	SCHEME_ADT                                  = "adt"
+	SCHEME_AFP                                  = "afp"
+	SCHEME_AFS                                  = "afs"
+	SCHEME_AIM                                  = "aim"
+	SCHEME_AMSS                                 = "amss"
+	SCHEME_ANDROID                              = "android"
+	SCHEME_APPDATA                              = "appdata"
+	SCHEME_APT                                  = "apt"
+	SCHEME_AR                                   = "ar"
+	SCHEME_ARI                                  = "ari"
+	SCHEME_ARK                                  = "ark"
+	SCHEME_AT                                   = "at"
+	SCHEME_ATTACHMENT                           = "attachment"
+	SCHEME_AW                                   = "aw"
+	SCHEME_BARION                               = "barion"
+	SCHEME_BB                                   = "bb"
+	SCHEME_BESHARE                              = "beshare"
+	SCHEME_BITCOIN                              = "bitcoin"
+	SCHEME_BITCOINCASH                          = "bitcoincash"
+	SCHEME_BL                                   = "bl"
+	SCHEME_BLOB                                 = "blob"
+	SCHEME_BLUETOOTH                            = "bluetooth"
+	SCHEME_BOLO                                 = "bolo"
+	SCHEME_BRID                                 = "brid"
+	SCHEME_BROWSEREXT                           = "browserext"
+	SCHEME_CABAL                                = "cabal"
+	SCHEME_CALCULATOR                           = "calculator"
+	SCHEME_CALLTO                               = "callto"
+	SCHEME_CAP                                  = "cap"
+	SCHEME_CAST                                 = "cast"
+	SCHEME_CASTS                                = "casts"
+	SCHEME_CHROME                               = "chrome"
+	SCHEME_CHROME_EXTENSION                     = "chrome-extension"
+	SCHEME_CID                                  = "cid"
+	SCHEME_COAP                                 = "coap"
+	SCHEME_COAP_TCP                             = "coap+tcp"
+	SCHEME_COAP_WS                              = "coap+ws"
+	SCHEME_COAPS                                = "coaps"
+	SCHEME_COAPS_TCP                            = "coaps+tcp"
+	SCHEME_COAPS_WS                             = "coaps+ws"
+	SCHEME_COM_EVENTBRITE_ATTENDEE              = "com-eventbrite-attendee"
+	SCHEME_CONTENT                              = "content"
+	SCHEME_CONTENT_TYPE                         = "content-type"
+	SCHEME_CRID                                 = "crid"
+	SCHEME_CSTR                                 = "cstr"
+	SCHEME_CVS                                  = "cvs"
+	SCHEME_DAB                                  = "dab"
+	SCHEME_DAT                                  = "dat"
+	SCHEME_DATA                                 = "data"
+	SCHEME_DAV                                  = "dav"
+	SCHEME_DHTTP                                = "dhttp"
+	SCHEME_DIASPORA                             = "diaspora"
+	SCHEME_DICT                                 = "dict"
+	SCHEME_DID                                  = "did"
+	SCHEME_DIS                                  = "dis"
+	SCHEME_DLNA_PLAYCONTAINER                   = "dlna-playcontainer"
+	SCHEME_DLNA_PLAYSINGLE                      = "dlna-playsingle"
+	SCHEME_DNS                                  = "dns"
+	SCHEME_DNTP                                 = "dntp"
+	SCHEME_DOI                                  = "doi"
+	SCHEME_DPP                                  = "dpp"
+	SCHEME_DRM                                  = "drm"
+	SCHEME_DROP                                 = "drop"
+	SCHEME_DTMI                                 = "dtmi"
+	SCHEME_DTN                                  = "dtn"
+	SCHEME_DVB                                  = "dvb"
+	SCHEME_DVX                                  = "dvx"
+	SCHEME_DWEB                                 = "dweb"
+	SCHEME_ED2K                                 = "ed2k"
+	SCHEME_EID                                  = "eid"
+	SCHEME_ELSI                                 = "elsi"
+	SCHEME_EMBEDDED                             = "embedded"
+	SCHEME_ENS                                  = "ens"
+	SCHEME_ETHEREUM                             = "ethereum"
+	SCHEME_EXAMPLE                              = "example"
+	SCHEME_FACETIME                             = "facetime"
+	SCHEME_FAX                                  = "fax"
+	SCHEME_FEED                                 = "feed"
+	SCHEME_FEEDREADY                            = "feedready"
+	SCHEME_FIDO                                 = "fido"
+	SCHEME_FILE                                 = "file"
+	SCHEME_FILESYSTEM                           = "filesystem"
+	SCHEME_FINGER                               = "finger"
+	SCHEME_FIRST_RUN_PEN_EXPERIENCE             = "first-run-pen-experience"
+	SCHEME_FISH                                 = "fish"
+	SCHEME_FM                                   = "fm"
+	SCHEME_FTP                                  = "ftp"
+	SCHEME_FUCHSIA_PKG                          = "fuchsia-pkg"
+	SCHEME_GEO                                  = "geo"
+	SCHEME_GG                                   = "gg"
+	SCHEME_GIT                                  = "git"
+	SCHEME_GITOID                               = "gitoid"
+	SCHEME_GIZMOPROJECT                         = "gizmoproject"
+	SCHEME_GO                                   = "go"
+	SCHEME_GOPHER                               = "gopher"
+	SCHEME_GRAPH                                = "graph"
+	SCHEME_GRD                                  = "grd"
+	SCHEME_GTALK                                = "gtalk"
+	SCHEME_H323                                 = "h323"
+	SCHEME_HAM                                  = "ham"
+	SCHEME_HCAP                                 = "hcap"
+	SCHEME_HCP                                  = "hcp"
+	SCHEME_HS20                                 = "hs20"
+	SCHEME_HTTP                                 = "http"
+	SCHEME_HTTPS                                = "https"
+	SCHEME_HXXP                                 = "hxxp"
+	SCHEME_HXXPS                                = "hxxps"
+	SCHEME_HYDRAZONE                            = "hydrazone"
+	SCHEME_HYPER                                = "hyper"
+	SCHEME_IAX                                  = "iax"
+	SCHEME_ICAP                                 = "icap"
+	SCHEME_ICON                                 = "icon"
+	SCHEME_ILSTRING                             = "ilstring"
+	SCHEME_IM                                   = "im"
+	SCHEME_IMAP                                 = "imap"
+	SCHEME_INFO                                 = "info"
+	SCHEME_IOTDISCO                             = "iotdisco"
+	SCHEME_IPFS                                 = "ipfs"
+	SCHEME_IPN                                  = "ipn"
+	SCHEME_IPNS                                 = "ipns"
+	SCHEME_IPP                                  = "ipp"
+	SCHEME_IPPS                                 = "ipps"
+	SCHEME_IRC                                  = "irc"
+	SCHEME_IRC6                                 = "irc6"
+	SCHEME_IRCS                                 = "ircs"
+	SCHEME_IRIS                                 = "iris"
+	SCHEME_IRIS_BEEP                            = "iris.beep"
+	SCHEME_IRIS_LWZ                             = "iris.lwz"
+	SCHEME_IRIS_XPC                             = "iris.xpc"
+	SCHEME_IRIS_XPCS                            = "iris.xpcs"
+	SCHEME_ISOSTORE                             = "isostore"
+	SCHEME_ITMS                                 = "itms"
+	SCHEME_JABBER                               = "jabber"
+	SCHEME_JAR                                  = "jar"
+	SCHEME_JMS                                  = "jms"
+	SCHEME_KEYPARC                              = "keyparc"
+	SCHEME_LASTFM                               = "lastfm"
+	SCHEME_LBRY                                 = "lbry"
+	SCHEME_LDAP                                 = "ldap"
+	SCHEME_LDAPS                                = "ldaps"
+	SCHEME_LEAPTOFROGANS                        = "leaptofrogans"
+	SCHEME_LID                                  = "lid"
+	SCHEME_LORAWAN                              = "lorawan"
+	SCHEME_LPA                                  = "lpa"
+	SCHEME_LVLT                                 = "lvlt"
+	SCHEME_MACHINEPROVISIONINGPROGRESSREPORTER  = "machineprovisioningprogressreporter"
+	SCHEME_MAGNET                               = "magnet"
+	SCHEME_MAILSERVER                           = "mailserver"
+	SCHEME_MAILTO                               = "mailto"
+	SCHEME_MAPS                                 = "maps"
+	SCHEME_MARKET                               = "market"
+	SCHEME_MATRIX                               = "matrix"
+	SCHEME_MESSAGE                              = "message"
+	SCHEME_MICROSOFT_WINDOWS_CAMERA             = "microsoft.windows.camera"
+	SCHEME_MICROSOFT_WINDOWS_CAMERA_MULTIPICKER = "microsoft.windows.camera.multipicker"
+	SCHEME_MICROSOFT_WINDOWS_CAMERA_PICKER      = "microsoft.windows.camera.picker"
+	SCHEME_MID                                  = "mid"
+	SCHEME_MMS                                  = "mms"
+	SCHEME_MODEM                                = "modem"
+	SCHEME_MONGODB                              = "mongodb"
+	SCHEME_MOZ                                  = "moz"
+	SCHEME_MS_ACCESS                            = "ms-access"
+	SCHEME_MS_APPINSTALLER                      = "ms-appinstaller"
+	SCHEME_MS_BROWSER_EXTENSION                 = "ms-browser-extension"
+	SCHEME_MS_CALCULATOR                        = "ms-calculator"
+	SCHEME_MS_DRIVE_TO                          = "ms-drive-to"
+	SCHEME_MS_ENROLLMENT                        = "ms-enrollment"
+	SCHEME_MS_EXCEL                             = "ms-excel"
+	SCHEME_MS_EYECONTROLSPEECH                  = "ms-eyecontrolspeech"
+	SCHEME_MS_GAMEBARSERVICES                   = "ms-gamebarservices"
+	SCHEME_MS_GAMINGOVERLAY                     = "ms-gamingoverlay"
+	SCHEME_MS_GETOFFICE                         = "ms-getoffice"
+	SCHEME_MS_HELP                              = "ms-help"
+	SCHEME_MS_INFOPATH                          = "ms-infopath"
+	SCHEME_MS_INPUTAPP                          = "ms-inputapp"
+	SCHEME_MS_LAUNCHREMOTEDESKTOP               = "ms-launchremotedesktop"
+	SCHEME_MS_LOCKSCREENCOMPONENT_CONFIG        = "ms-lockscreencomponent-config"
+	SCHEME_MS_MEDIA_STREAM_ID                   = "ms-media-stream-id"
+	SCHEME_MS_MEETNOW                           = "ms-meetnow"
+	SCHEME_MS_MIXEDREALITYCAPTURE               = "ms-mixedrealitycapture"
+	SCHEME_MS_MOBILEPLANS                       = "ms-mobileplans"
+	SCHEME_MS_NEWSANDINTERESTS                  = "ms-newsandinterests"
+	SCHEME_MS_OFFICEAPP                         = "ms-officeapp"
+	SCHEME_MS_PEOPLE                            = "ms-people"
+	SCHEME_MS_PERSONACARD                       = "ms-personacard"
+	SCHEME_MS_POWERPOINT                        = "ms-powerpoint"
+	SCHEME_MS_PROJECT                           = "ms-project"
+	SCHEME_MS_PUBLISHER                         = "ms-publisher"
+	SCHEME_MS_RECALL                            = "ms-recall"
+	SCHEME_MS_REMOTEDESKTOP                     = "ms-remotedesktop"
+	SCHEME_MS_REMOTEDESKTOP_LAUNCH              = "ms-remotedesktop-launch"
+	SCHEME_MS_RESTORETABCOMPANION               = "ms-restoretabcompanion"
+	SCHEME_MS_SCREENCLIP                        = "ms-screenclip"
+	SCHEME_MS_SCREENSKETCH                      = "ms-screensketch"
+	SCHEME_MS_SEARCH                            = "ms-search"
+	SCHEME_MS_SEARCH_REPAIR                     = "ms-search-repair"
+	SCHEME_MS_SECONDARY_SCREEN_CONTROLLER       = "ms-secondary-screen-controller"
+	SCHEME_MS_SECONDARY_SCREEN_SETUP            = "ms-secondary-screen-setup"
+	SCHEME_MS_SETTINGS                          = "ms-settings"
+	SCHEME_MS_SETTINGS_AIRPLANEMODE             = "ms-settings-airplanemode"
+	SCHEME_MS_SETTINGS_BLUETOOTH                = "ms-settings-bluetooth"
+	SCHEME_MS_SETTINGS_CAMERA                   = "ms-settings-camera"
+	SCHEME_MS_SETTINGS_CELLULAR                 = "ms-settings-cellular"
+	SCHEME_MS_SETTINGS_CLOUDSTORAGE             = "ms-settings-cloudstorage"
+	SCHEME_MS_SETTINGS_CONNECTABLEDEVICES       = "ms-settings-connectabledevices"
+	SCHEME_MS_SETTINGS_DISPLAYS_TOPOLOGY        = "ms-settings-displays-topology"
+	SCHEME_MS_SETTINGS_EMAILANDACCOUNTS         = "ms-settings-emailandaccounts"
+	SCHEME_MS_SETTINGS_LANGUAGE                 = "ms-settings-language"
+	SCHEME_MS_SETTINGS_LOCATION                 = "ms-settings-location"
+	SCHEME_MS_SETTINGS_LOCK                     = "ms-settings-lock"
+	SCHEME_MS_SETTINGS_NFCTRANSACTIONS          = "ms-settings-nfctransactions"
+	SCHEME_MS_SETTINGS_NOTIFICATIONS            = "ms-settings-notifications"
+	SCHEME_MS_SETTINGS_POWER                    = "ms-settings-power"
+	SCHEME_MS_SETTINGS_PRIVACY                  = "ms-settings-privacy"
+	SCHEME_MS_SETTINGS_PROXIMITY                = "ms-settings-proximity"
+	SCHEME_MS_SETTINGS_SCREENROTATION           = "ms-settings-screenrotation"
+	SCHEME_MS_SETTINGS_WIFI                     = "ms-settings-wifi"
+	SCHEME_MS_SETTINGS_WORKPLACE                = "ms-settings-workplace"
+	SCHEME_MS_SPD                               = "ms-spd"
+	SCHEME_MS_STICKERS                          = "ms-stickers"
+	SCHEME_MS_STTOVERLAY                        = "ms-sttoverlay"
+	SCHEME_MS_TRANSIT_TO                        = "ms-transit-to"
+	SCHEME_MS_USERACTIVITYSET                   = "ms-useractivityset"
+	SCHEME_MS_UUP                               = "ms-uup"
+	SCHEME_MS_VIRTUALTOUCHPAD                   = "ms-virtualtouchpad"
+	SCHEME_MS_VISIO                             = "ms-visio"
+	SCHEME_MS_WALK_TO                           = "ms-walk-to"
+	SCHEME_MS_WHITEBOARD                        = "ms-whiteboard"
+	SCHEME_MS_WHITEBOARD_CMD                    = "ms-whiteboard-cmd"
+	SCHEME_MS_WIDGETBOARD                       = "ms-widgetboard"
+	SCHEME_MS_WIDGETS                           = "ms-widgets"
+	SCHEME_MS_WORD                              = "ms-word"
+	SCHEME_MSNIM                                = "msnim"
+	SCHEME_MSRP                                 = "msrp"
+	SCHEME_MSRPS                                = "msrps"
+	SCHEME_MSS                                  = "mss"
+	SCHEME_MT                                   = "mt"
+	SCHEME_MTQP                                 = "mtqp"
+	SCHEME_MTRUST                               = "mtrust"
+	SCHEME_MUMBLE                               = "mumble"
+	SCHEME_MUPDATE                              = "mupdate"
+	SCHEME_MVN                                  = "mvn"
+	SCHEME_MVRP                                 = "mvrp"
+	SCHEME_MVRPS                                = "mvrps"
+	SCHEME_NEWS                                 = "news"
+	SCHEME_NFS                                  = "nfs"
+	SCHEME_NI                                   = "ni"
+	SCHEME_NIH                                  = "nih"
+	SCHEME_NNTP                                 = "nntp"
+	SCHEME_NOTES                                = "notes"
+	SCHEME_NUM                                  = "num"
+	SCHEME_OCF                                  = "ocf"
+	SCHEME_OID                                  = "oid"
+	SCHEME_ONENOTE                              = "onenote"
+	SCHEME_ONENOTE_CMD                          = "onenote-cmd"
+	SCHEME_OPAQUELOCKTOKEN                      = "opaquelocktoken"
+	SCHEME_OPENID                               = "openid"
+	SCHEME_OPENPGP4FPR                          = "openpgp4fpr"
+	SCHEME_OTPAUTH                              = "otpauth"
+	SCHEME_P1                                   = "p1"
+	SCHEME_PACK                                 = "pack"
+	SCHEME_PALM                                 = "palm"
+	SCHEME_PAPARAZZI                            = "paparazzi"
+	SCHEME_PAYMENT                              = "payment"
+	SCHEME_PAYTO                                = "payto"
+	SCHEME_PKCS11                               = "pkcs11"
+	SCHEME_PLATFORM                             = "platform"
+	SCHEME_POP                                  = "pop"
+	SCHEME_PRES                                 = "pres"
+	SCHEME_PROSPERO                             = "prospero"
+	SCHEME_PROXY                                = "proxy"
+	SCHEME_PSYC                                 = "psyc"
+	SCHEME_PTTP                                 = "pttp"
+	SCHEME_PWID                                 = "pwid"
+	SCHEME_QB                                   = "qb"
+	SCHEME_QUERY                                = "query"
+	SCHEME_QUIC_TRANSPORT                       = "quic-transport"
+	SCHEME_REDIS                                = "redis"
+	SCHEME_REDISS                               = "rediss"
+	SCHEME_RELOAD                               = "reload"
+	SCHEME_RES                                  = "res"
+	SCHEME_RESOURCE                             = "resource"
+	SCHEME_RMI                                  = "rmi"
+	SCHEME_RSYNC                                = "rsync"
+	SCHEME_RTMFP                                = "rtmfp"
+	SCHEME_RTMP                                 = "rtmp"
+	SCHEME_RTSP                                 = "rtsp"
+	SCHEME_RTSPS                                = "rtsps"
+	SCHEME_RTSPU                                = "rtspu"
+	SCHEME_SARIF                                = "sarif"
+	SCHEME_SECONDLIFE                           = "secondlife"
+	SCHEME_SECRET_TOKEN                         = "secret-token"
+	SCHEME_SERVICE                              = "service"
+	SCHEME_SESSION                              = "session"
+	SCHEME_SFTP                                 = "sftp"
+	SCHEME_SGN                                  = "sgn"
+	SCHEME_SHC                                  = "shc"
+	SCHEME_SHELTER                              = "shelter"
+	SCHEME_SHTTP                                = "shttp"
+	SCHEME_SIEVE                                = "sieve"
+	SCHEME_SIMPLELEDGER                         = "simpleledger"
+	SCHEME_SIMPLEX                              = "simplex"
+	SCHEME_SIP                                  = "sip"
+	SCHEME_SIPS                                 = "sips"
+	SCHEME_SKYPE                                = "skype"
+	SCHEME_SMB                                  = "smb"
+	SCHEME_SMP                                  = "smp"
+	SCHEME_SMS                                  = "sms"
+	SCHEME_SMTP                                 = "smtp"
+	SCHEME_SNEWS                                = "snews"
+	SCHEME_SNMP                                 = "snmp"
+	SCHEME_SOAP_BEEP                            = "soap.beep"
+	SCHEME_SOAP_BEEPS                           = "soap.beeps"
+	SCHEME_SOLDAT                               = "soldat"
+	SCHEME_SPIFFE                               = "spiffe"
+	SCHEME_SPOTIFY                              = "spotify"
+	SCHEME_SSB                                  = "ssb"
+	SCHEME_SSH                                  = "ssh"
+	SCHEME_STARKNET                             = "starknet"
+	SCHEME_STEAM                                = "steam"
+	SCHEME_STUN                                 = "stun"
+	SCHEME_STUNS                                = "stuns"
+	SCHEME_SUBMIT                               = "submit"
+	SCHEME_SVN                                  = "svn"
+	SCHEME_SWH                                  = "swh"
+	SCHEME_SWID                                 = "swid"
+	SCHEME_SWIDPATH                             = "swidpath"
+	SCHEME_TAG                                  = "tag"
+	SCHEME_TALER                                = "taler"
+	SCHEME_TEAMSPEAK                            = "teamspeak"
+	SCHEME_TEAPOT                               = "teapot"
+	SCHEME_TEAPOTS                              = "teapots"
+	SCHEME_TEL                                  = "tel"
+	SCHEME_TELIAEID                             = "teliaeid"
+	SCHEME_TELNET                               = "telnet"
+	SCHEME_TFTP                                 = "tftp"
+	SCHEME_THINGS                               = "things"
+	SCHEME_THISMESSAGE                          = "thismessage"
+	SCHEME_THZP                                 = "thzp"
+	SCHEME_TIP                                  = "tip"
+	SCHEME_TN3270                               = "tn3270"
+	SCHEME_TOOL                                 = "tool"
+	SCHEME_TURN                                 = "turn"
+	SCHEME_TURNS                                = "turns"
+	SCHEME_TV                                   = "tv"
+	SCHEME_UDP                                  = "udp"
+	SCHEME_UNREAL                               = "unreal"
+	SCHEME_UPT                                  = "upt"
+	SCHEME_URN                                  = "urn"
+	SCHEME_UT2004                               = "ut2004"
+	SCHEME_UUID_IN_PACKAGE                      = "uuid-in-package"
+	SCHEME_V_EVENT                              = "v-event"
+	SCHEME_VEMMI                                = "vemmi"
+	SCHEME_VENTRILO                             = "ventrilo"
+	SCHEME_VES                                  = "ves"
+	SCHEME_VIDEOTEX                             = "videotex"
+	SCHEME_VIEW_SOURCE                          = "view-source"
+	SCHEME_VNC                                  = "vnc"
+	SCHEME_VSCODE                               = "vscode"
+	SCHEME_VSCODE_INSIDERS                      = "vscode-insiders"
+	SCHEME_VSLS                                 = "vsls"
+	SCHEME_W3                                   = "w3"
+	SCHEME_WAIS                                 = "wais"
+	SCHEME_WASM                                 = "wasm"
+	SCHEME_WASM_JS                              = "wasm-js"
+	SCHEME_WCR                                  = "wcr"
+	SCHEME_WEB_AP                               = "web+ap"
+	SCHEME_WEB3                                 = "web3"
+	SCHEME_WEBCAL                               = "webcal"
+	SCHEME_WIFI                                 = "wifi"
+	SCHEME_WPID                                 = "wpid"
+	SCHEME_WS                                   = "ws"
+	SCHEME_WSS                                  = "wss"
+	SCHEME_WTAI                                 = "wtai"
+	SCHEME_WYCIWYG                              = "wyciwyg"
+	SCHEME_XCON                                 = "xcon"
+	SCHEME_XCON_USERID                          = "xcon-userid"
+	SCHEME_XFIRE                                = "xfire"
+	SCHEME_XFTP                                 = "xftp"
+	SCHEME_XMLRPC_BEEP                          = "xmlrpc.beep"
+	SCHEME_XMLRPC_BEEPS                         = "xmlrpc.beeps"
+	SCHEME_XMPP                                 = "xmpp"
+	SCHEME_XRCP                                 = "xrcp"
+	SCHEME_XRI                                  = "xri"
+	SCHEME_YMSGR                                = "ymsgr"
+	SCHEME_Z39_50                               = "z39.50"
+	SCHEME_Z39_50R                              = "z39.50r"
+	SCHEME_Z39_50S                              = "z39.50s"
+)
+
+// Defanged forms of the registered URI schemes
+const (
+	DEFANGED_SCHEME_AAA                                  = "axa"
+	DEFANGED_SCHEME_AAAS                                 = "aaxs"
+	DEFANGED_SCHEME_ABOUT                                = "axxut"
+	DEFANGED_SCHEME_ACAP                                 = "acxp"
+	DEFANGED_SCHEME_ACCT                                 = "acxt"
+	DEFANGED_SCHEME_ACD                                  = "axd"
+	DEFANGED_SCHEME_ACR                                  = "axr"
+	DEFANGED_SCHEME_ADIUMXTRA                            = "axxumxtra"
+	DEFANGED_SCHEME_ADT                                  = "axt"
+	DEFANGED_SCHEME_AFP                                  = "axp"
+	DEFANGED_SCHEME_AFS                                  = "axs"
+	DEFANGED_SCHEME_AIM                                  = "axm"
+	DEFANGED_SCHEME_AMSS                                 = "amxs"
+	DEFANGED_SCHEME_ANDROID                              = "axxroid"
+	DEFANGED_SCHEME_APPDATA                              = "axxdata"
+	DEFANGED_SCHEME_APT                                  = "axx"
+	DEFANGED_SCHEME_AR                                   = "ax"
+	DEFANGED_SCHEME_ARI                                  = "axi"
+	DEFANGED_SCHEME_ARK                                  = "axk"
+	DEFANGED_SCHEME_AT                                   = "a[t]"
+	DEFANGED_SCHEME_ATTACHMENT                           = "axxachment"
+	DEFANGED_SCHEME_AW                                   = "a[w]"
+	DEFANGED_SCHEME_BARION                               = "bxxion"
+	DEFANGED_SCHEME_BB                                   = "b[b]"
+	DEFANGED_SCHEME_BESHARE                              = "bxxhare"
+	DEFANGED_SCHEME_BITCOIN                              = "bxxcoin"
+	DEFANGED_SCHEME_BITCOINCASH                          = "bxxcoincash"
+	DEFANGED_SCHEME_BL                                   = "bx"
+	DEFANGED_SCHEME_BLOB                                 = "blxb"
+	DEFANGED_SCHEME_BLUETOOTH                            = "bxxetooth"
+	DEFANGED_SCHEME_BOLO                                 = "boxo"
+	DEFANGED_SCHEME_BRID                                 = "brxd"
+	DEFANGED_SCHEME_BROWSEREXT                           = "bxxwserext"
+	DEFANGED_SCHEME_CABAL                                = "cxxal"
+	DEFANGED_SCHEME_CALCULATOR                           = "cxxculator"
+	DEFANGED_SCHEME_CALLTO                               = "cxxlto"
+	DEFANGED_SCHEME_CAP                                  = "cxp"
+	DEFANGED_SCHEME_CAST                                 = "caxt"
+	DEFANGED_SCHEME_CASTS                                = "cxxts"
+	DEFANGED_SCHEME_CHROME                               = "cxxome"
+	DEFANGED_SCHEME_CHROME_EXTENSION                     = "chrome[-]extension"
+	DEFANGED_SCHEME_CID                                  = "cxd"
+	DEFANGED_SCHEME_COAP                                 = "coxp"
+	DEFANGED_SCHEME_COAP_TCP                             = "coap[+]tcp"
+	DEFANGED_SCHEME_COAP_WS                              = "coap[+]ws"
+	DEFANGED_SCHEME_COAPS                                = "cxxps"
+	DEFANGED_SCHEME_COAPS_TCP                            = "coaps[+]tcp"
+	DEFANGED_SCHEME_COAPS_WS                             = "coaps[+]ws"
+	DEFANGED_SCHEME_COM_EVENTBRITE_ATTENDEE              = "com[-]eventbrite[-]attendee"
+	DEFANGED_SCHEME_CONTENT                              = "cxxtent"
+	DEFANGED_SCHEME_CONTENT_TYPE                         = "content[-]type"
+	DEFANGED_SCHEME_CRID                                 = "crxd"
+	DEFANGED_SCHEME_CSTR                                 = "csxr"
+	DEFANGED_SCHEME_CVS                                  = "cxs"
+	DEFANGED_SCHEME_DAB                                  = "dxb"
+	DEFANGED_SCHEME_DAT                                  = "dxt"
+	DEFANGED_SCHEME_DATA                                 = "daxa"
+	DEFANGED_SCHEME_DAV                                  = "dxv"
+	DEFANGED_SCHEME_DHTTP                                = "dxxtp"
+	DEFANGED_SCHEME_DIASPORA                             = "dxxspora"
+	DEFANGED_SCHEME_DICT                                 = "dixt"
+	DEFANGED_SCHEME_DID                                  = "dxd"
+	DEFANGED_SCHEME_DIS                                  = "d[i]s"
+	DEFANGED_SCHEME_DLNA_PLAYCONTAINER                   = "dlna[-]playcontainer"
+	DEFANGED_SCHEME_DLNA_PLAYSINGLE                      = "dlna[-]playsingle"
+	DEFANGED_SCHEME_DNS                                  = "dxs"
+	DEFANGED_SCHEME_DNTP                                 = "dnxp"
+	DEFANGED_SCHEME_DOI                                  = "dxi"
+	DEFANGED_SCHEME_DPP                                  = "dxp"
+	DEFANGED_SCHEME_DRM                                  = "dxm"
+	DEFANGED_SCHEME_DROP                                 = "drxp"
+	DEFANGED_SCHEME_DTMI                                 = "dtxi"
+	DEFANGED_SCHEME_DTN                                  = "dxn"
+	DEFANGED_SCHEME_DVB                                  = "d[v]b"
+	DEFANGED_SCHEME_DVX                                  = "dxx"
+	DEFANGED_SCHEME_DWEB                                 = "dwxb"
+	DEFANGED_SCHEME_ED2K                                 = "edxk"
+	DEFANGED_SCHEME_EID                                  = "exd"
+	DEFANGED_SCHEME_ELSI                                 = "elxi"
+	DEFANGED_SCHEME_EMBEDDED                             = "exxedded"
+	DEFANGED_SCHEME_ENS                                  = "exs"
+	DEFANGED_SCHEME_ETHEREUM                             = "exxereum"
+	DEFANGED_SCHEME_EXAMPLE                              = "exxmple"
+	DEFANGED_SCHEME_FACETIME                             = "fxxetime"
+	DEFANGED_SCHEME_FAX                                  = "fxx"
+	DEFANGED_SCHEME_FEED                                 = "fexd"
+	DEFANGED_SCHEME_FEEDREADY                            = "fxxdready"
+	DEFANGED_SCHEME_FIDO                                 = "fixo"
+	DEFANGED_SCHEME_FILE                                 = "fixe"
+	DEFANGED_SCHEME_FILESYSTEM                           = "fxxesystem"
+	DEFANGED_SCHEME_FINGER                               = "fxxger"
+	DEFANGED_SCHEME_FIRST_RUN_PEN_EXPERIENCE             = "first[-]run[-]pen[-]experience"
+	DEFANGED_SCHEME_FISH                                 = "fixh"
+	DEFANGED_SCHEME_FM                                   = "fx"
+	DEFANGED_SCHEME_FTP                                  = "fxp"
+	DEFANGED_SCHEME_FUCHSIA_PKG                          = "fuchsia[-]pkg"
+	DEFANGED_SCHEME_GEO                                  = "gxo"
+	DEFANGED_SCHEME_GG                                   = "g[g]"
+	DEFANGED_SCHEME_GIT                                  = "gxt"
+	DEFANGED_SCHEME_GITOID                               = "gxxoid"
+	DEFANGED_SCHEME_GIZMOPROJECT                         = "gxxmoproject"
+	DEFANGED_SCHEME_GO                                   = "gx"
+	DEFANGED_SCHEME_GOPHER                               = "gxxher"
+	DEFANGED_SCHEME_GRAPH                                = "gxxph"
+	DEFANGED_SCHEME_GRD                                  = "gxd"
+	DEFANGED_SCHEME_GTALK                                = "gxxlk"
+	DEFANGED_SCHEME_H323                                 = "h3x3"
+	DEFANGED_SCHEME_HAM                                  = "hxm"
+	DEFANGED_SCHEME_HCAP                                 = "hcxp"
+	DEFANGED_SCHEME_HCP                                  = "hxp"
+	DEFANGED_SCHEME_HS20                                 = "hsx0"
+	DEFANGED_SCHEME_HTTP                                 = "hxxp"
+	DEFANGED_SCHEME_HTTPS                                = "hxxps"
+	DEFANGED_SCHEME_HXXP                                 = "hxxx"
+	DEFANGED_SCHEME_HXXPS                                = "hxxxs"
+	DEFANGED_SCHEME_HYDRAZONE                            = "hxxrazone"
+	DEFANGED_SCHEME_HYPER                                = "hxxer"
+	DEFANGED_SCHEME_IAX                                  = "ixx"
+	DEFANGED_SCHEME_ICAP                                 = "icxp"
+	DEFANGED_SCHEME_ICON                                 = "icxn"
+	DEFANGED_SCHEME_ILSTRING                             = "ixxtring"
+	DEFANGED_SCHEME_IM                                   = "ix"
+	DEFANGED_SCHEME_IMAP                                 = "imxp"
+	DEFANGED_SCHEME_INFO                                 = "inxo"
+	DEFANGED_SCHEME_IOTDISCO                             = "ixxdisco"
+	DEFANGED_SCHEME_IPFS                                 = "ixxs"
+	DEFANGED_SCHEME_IPN                                  = "ixn"
+	DEFANGED_SCHEME_IPNS                                 = "ipxx"
+	DEFANGED_SCHEME_IPP                                  = "ixp"
+	DEFANGED_SCHEME_IPPS                                 = "ipxs"
+	DEFANGED_SCHEME_IRC                                  = "ixc"
+	DEFANGED_SCHEME_IRC6                                 = "irx6"
+	DEFANGED_SCHEME_IRCS                                 = "irxx"
+	DEFANGED_SCHEME_IRIS                                 = "irxs"
+	DEFANGED_SCHEME_IRIS_BEEP                            = "iris[.]beep"
+	DEFANGED_SCHEME_IRIS_LWZ                             = "iris[.]lwz"
+	DEFANGED_SCHEME_IRIS_XPC                             = "iris[.]xpc"
+	DEFANGED_SCHEME_IRIS_XPCS                            = "iris[.]xpcs"
+	DEFANGED_SCHEME_ISOSTORE                             = "ixxstore"
+	DEFANGED_SCHEME_ITMS                                 = "itxs"
+	DEFANGED_SCHEME_JABBER                               = "jxxber"
+	DEFANGED_SCHEME_JAR                                  = "jxr"
+	DEFANGED_SCHEME_JMS                                  = "jxs"
+	DEFANGED_SCHEME_KEYPARC                              = "kxxparc"
+	DEFANGED_SCHEME_LASTFM                               = "lxxtfm"
+	DEFANGED_SCHEME_LBRY                                 = "lbxy"
+	DEFANGED_SCHEME_LDAP                                 = "ldxp"
+	DEFANGED_SCHEME_LDAPS                                = "lxxps"
+	DEFANGED_SCHEME_LEAPTOFROGANS                        = "lxxptofrogans"
+	DEFANGED_SCHEME_LID                                  = "lxd"
+	DEFANGED_SCHEME_LORAWAN                              = "lxxawan"
+	DEFANGED_SCHEME_LPA                                  = "lxa"
+	DEFANGED_SCHEME_LVLT                                 = "lvxt"
+	DEFANGED_SCHEME_MACHINEPROVISIONINGPROGRESSREPORTER  = "mxxhineprovisioningprogressreporter"
+	DEFANGED_SCHEME_MAGNET                               = "mxxnet"
+	DEFANGED_SCHEME_MAILSERVER                           = "mxxlserver"
+	DEFANGED_SCHEME_MAILTO                               = "mxxlto"
+	DEFANGED_SCHEME_MAPS                                 = "maxs"
+	DEFANGED_SCHEME_MARKET                               = "mxxket"
+	DEFANGED_SCHEME_MATRIX                               = "mxxrix"
+	DEFANGED_SCHEME_MESSAGE                              = "mxxsage"
+	DEFANGED_SCHEME_MICROSOFT_WINDOWS_CAMERA             = "microsoft[.]windows[.]camera"
+	DEFANGED_SCHEME_MICROSOFT_WINDOWS_CAMERA_MULTIPICKER = "microsoft[.]windows[.]camera[.]multipicker"
+	DEFANGED_SCHEME_MICROSOFT_WINDOWS_CAMERA_PICKER      = "microsoft[.]windows[.]camera[.]picker"
+	DEFANGED_SCHEME_MID                                  = "mxd"
+	DEFANGED_SCHEME_MMS                                  = "mxs"
+	DEFANGED_SCHEME_MODEM                                = "mxxem"
+	DEFANGED_SCHEME_MONGODB                              = "mxxgodb"
+	DEFANGED_SCHEME_MOZ                                  = "mxz"
+	DEFANGED_SCHEME_MS_ACCESS                            = "ms[-]access"
+	DEFANGED_SCHEME_MS_APPINSTALLER                      = "ms[-]appinstaller"
+	DEFANGED_SCHEME_MS_BROWSER_EXTENSION                 = "ms[-]browser[-]extension"
+	DEFANGED_SCHEME_MS_CALCULATOR                        = "ms[-]calculator"
+	DEFANGED_SCHEME_MS_DRIVE_TO                          = "ms[-]drive[-]to"
+	DEFANGED_SCHEME_MS_ENROLLMENT                        = "ms[-]enrollment"
+	DEFANGED_SCHEME_MS_EXCEL                             = "ms[-]excel"
+	DEFANGED_SCHEME_MS_EYECONTROLSPEECH                  = "ms[-]eyecontrolspeech"
+	DEFANGED_SCHEME_MS_GAMEBARSERVICES                   = "ms[-]gamebarservices"
+	DEFANGED_SCHEME_MS_GAMINGOVERLAY                     = "ms[-]gamingoverlay"
+	DEFANGED_SCHEME_MS_GETOFFICE                         = "ms[-]getoffice"
+	DEFANGED_SCHEME_MS_HELP                              = "ms[-]help"
+	DEFANGED_SCHEME_MS_INFOPATH                          = "ms[-]infopath"
+	DEFANGED_SCHEME_MS_INPUTAPP                          = "ms[-]inputapp"
+	DEFANGED_SCHEME_MS_LAUNCHREMOTEDESKTOP               = "ms[-]launchremotedesktop"
+	DEFANGED_SCHEME_MS_LOCKSCREENCOMPONENT_CONFIG        = "ms[-]lockscreencomponent[-]config"
+	DEFANGED_SCHEME_MS_MEDIA_STREAM_ID                   = "ms[-]media[-]stream[-]id"
+	DEFANGED_SCHEME_MS_MEETNOW                           = "ms[-]meetnow"
+	DEFANGED_SCHEME_MS_MIXEDREALITYCAPTURE               = "ms[-]mixedrealitycapture"
+	DEFANGED_SCHEME_MS_MOBILEPLANS                       = "ms[-]mobileplans"
+	DEFANGED_SCHEME_MS_NEWSANDINTERESTS                  = "ms[-]newsandinterests"
+	DEFANGED_SCHEME_MS_OFFICEAPP                         = "ms[-]officeapp"
+	DEFANGED_SCHEME_MS_PEOPLE                            = "ms[-]people"
+	DEFANGED_SCHEME_MS_PERSONACARD                       = "ms[-]personacard"
+	DEFANGED_SCHEME_MS_POWERPOINT                        = "ms[-]powerpoint"
+	DEFANGED_SCHEME_MS_PROJECT                           = "ms[-]project"
+	DEFANGED_SCHEME_MS_PUBLISHER                         = "ms[-]publisher"
+	DEFANGED_SCHEME_MS_RECALL                            = "ms[-]recall"
+	DEFANGED_SCHEME_MS_REMOTEDESKTOP                     = "ms[-]remotedesktop"
+	DEFANGED_SCHEME_MS_REMOTEDESKTOP_LAUNCH              = "ms[-]remotedesktop[-]launch"
+	DEFANGED_SCHEME_MS_RESTORETABCOMPANION               = "ms[-]restoretabcompanion"
+	DEFANGED_SCHEME_MS_SCREENCLIP                        = "ms[-]screenclip"
+	DEFANGED_SCHEME_MS_SCREENSKETCH                      = "ms[-]screensketch"
+	DEFANGED_SCHEME_MS_SEARCH                            = "ms[-]search"
+	DEFANGED_SCHEME_MS_SEARCH_REPAIR                     = "ms[-]search[-]repair"
+	DEFANGED_SCHEME_MS_SECONDARY_SCREEN_CONTROLLER       = "ms[-]secondary[-]screen[-]controller"
+	DEFANGED_SCHEME_MS_SECONDARY_SCREEN_SETUP            = "ms[-]secondary[-]screen[-]setup"
+	DEFANGED_SCHEME_MS_SETTINGS                          = "ms[-]settings"
+	DEFANGED_SCHEME_MS_SETTINGS_AIRPLANEMODE             = "ms[-]settings[-]airplanemode"
+	DEFANGED_SCHEME_MS_SETTINGS_BLUETOOTH                = "ms[-]settings[-]bluetooth"
+	DEFANGED_SCHEME_MS_SETTINGS_CAMERA                   = "ms[-]settings[-]camera"
+	DEFANGED_SCHEME_MS_SETTINGS_CELLULAR                 = "ms[-]settings[-]cellular"
+	DEFANGED_SCHEME_MS_SETTINGS_CLOUDSTORAGE             = "ms[-]settings[-]cloudstorage"
+	DEFANGED_SCHEME_MS_SETTINGS_CONNECTABLEDEVICES       = "ms[-]settings[-]connectabledevices"
+	DEFANGED_SCHEME_MS_SETTINGS_DISPLAYS_TOPOLOGY        = "ms[-]settings[-]displays[-]topology"
+	DEFANGED_SCHEME_MS_SETTINGS_EMAILANDACCOUNTS         = "ms[-]settings[-]emailandaccounts"
+	DEFANGED_SCHEME_MS_SETTINGS_LANGUAGE                 = "ms[-]settings[-]language"
+	DEFANGED_SCHEME_MS_SETTINGS_LOCATION                 = "ms[-]settings[-]location"
+	DEFANGED_SCHEME_MS_SETTINGS_LOCK                     = "ms[-]settings[-]lock"
+	DEFANGED_SCHEME_MS_SETTINGS_NFCTRANSACTIONS          = "ms[-]settings[-]nfctransactions"
+	DEFANGED_SCHEME_MS_SETTINGS_NOTIFICATIONS            = "ms[-]settings[-]notifications"
+	DEFANGED_SCHEME_MS_SETTINGS_POWER                    = "ms[-]settings[-]power"
+	DEFANGED_SCHEME_MS_SETTINGS_PRIVACY                  = "ms[-]settings[-]privacy"
+	DEFANGED_SCHEME_MS_SETTINGS_PROXIMITY                = "ms[-]settings[-]proximity"
+	DEFANGED_SCHEME_MS_SETTINGS_SCREENROTATION           = "ms[-]settings[-]screenrotation"
+	DEFANGED_SCHEME_MS_SETTINGS_WIFI                     = "ms[-]settings[-]wifi"
+	DEFANGED_SCHEME_MS_SETTINGS_WORKPLACE                = "ms[-]settings[-]workplace"
+	DEFANGED_SCHEME_MS_SPD                               = "ms[-]spd"
+	DEFANGED_SCHEME_MS_STICKERS                          = "ms[-]stickers"
+	DEFANGED_SCHEME_MS_STTOVERLAY                        = "ms[-]sttoverlay"
+	DEFANGED_SCHEME_MS_TRANSIT_TO                        = "ms[-]transit[-]to"
+	DEFANGED_SCHEME_MS_USERACTIVITYSET                   = "ms[-]useractivityset"
+	DEFANGED_SCHEME_MS_UUP                               = "ms[-]uup"
+	DEFANGED_SCHEME_MS_VIRTUALTOUCHPAD                   = "ms[-]virtualtouchpad"
+	DEFANGED_SCHEME_MS_VISIO                             = "ms[-]visio"
+	DEFANGED_SCHEME_MS_WALK_TO                           = "ms[-]walk[-]to"
+	DEFANGED_SCHEME_MS_WHITEBOARD                        = "ms[-]whiteboard"
+	DEFANGED_SCHEME_MS_WHITEBOARD_CMD                    = "ms[-]whiteboard[-]cmd"
+	DEFANGED_SCHEME_MS_WIDGETBOARD                       = "ms[-]widgetboard"
+	DEFANGED_SCHEME_MS_WIDGETS                           = "ms[-]widgets"
+	DEFANGED_SCHEME_MS_WORD                              = "ms[-]word"
+	DEFANGED_SCHEME_MSNIM                                = "mxxim"
+	DEFANGED_SCHEME_MSRP                                 = "msxp"
+	DEFANGED_SCHEME_MSRPS                                = "mxxps"
+	DEFANGED_SCHEME_MSS                                  = "mxx"
+	DEFANGED_SCHEME_MT                                   = "mx"
+	DEFANGED_SCHEME_MTQP                                 = "mtxp"
+	DEFANGED_SCHEME_MTRUST                               = "mxxust"
+	DEFANGED_SCHEME_MUMBLE                               = "mxxble"
+	DEFANGED_SCHEME_MUPDATE                              = "mxxdate"
+	DEFANGED_SCHEME_MVN                                  = "mxn"
+	DEFANGED_SCHEME_MVRP                                 = "mvxp"
+	DEFANGED_SCHEME_MVRPS                                = "mxxxs"
+	DEFANGED_SCHEME_NEWS                                 = "nexs"
+	DEFANGED_SCHEME_NFS                                  = "nxs"
+	DEFANGED_SCHEME_NI                                   = "nx"
+	DEFANGED_SCHEME_NIH                                  = "nxh"
+	DEFANGED_SCHEME_NNTP                                 = "nnxp"
+	DEFANGED_SCHEME_NOTES                                = "nxxes"
+	DEFANGED_SCHEME_NUM                                  = "nxm"
+	DEFANGED_SCHEME_OCF                                  = "oxf"
+	DEFANGED_SCHEME_OID                                  = "oxd"
+	DEFANGED_SCHEME_ONENOTE                              = "oxxnote"
+	DEFANGED_SCHEME_ONENOTE_CMD                          = "onenote[-]cmd"
+	DEFANGED_SCHEME_OPAQUELOCKTOKEN                      = "oxxquelocktoken"
+	DEFANGED_SCHEME_OPENID                               = "oxxnid"
+	DEFANGED_SCHEME_OPENPGP4FPR                          = "oxxnpgp4fpr"
+	DEFANGED_SCHEME_OTPAUTH                              = "oxxauth"
+	DEFANGED_SCHEME_P1                                   = "px"
+	DEFANGED_SCHEME_PACK                                 = "paxk"
+	DEFANGED_SCHEME_PALM                                 = "paxm"
+	DEFANGED_SCHEME_PAPARAZZI                            = "pxxarazzi"
+	DEFANGED_SCHEME_PAYMENT                              = "pxxment"
+	DEFANGED_SCHEME_PAYTO                                = "pxxto"
+	DEFANGED_SCHEME_PKCS11                               = "pxxs11"
+	DEFANGED_SCHEME_PLATFORM                             = "pxxtform"
+	DEFANGED_SCHEME_POP                                  = "pxp"
+	DEFANGED_SCHEME_PRES                                 = "prxs"
+	DEFANGED_SCHEME_PROSPERO                             = "pxxspero"
+	DEFANGED_SCHEME_PROXY                                = "pxxxy"
+	DEFANGED_SCHEME_PSYC                                 = "psxc"
+	DEFANGED_SCHEME_PTTP                                 = "ptxp"
+	DEFANGED_SCHEME_PWID                                 = "pwxd"
+	DEFANGED_SCHEME_QB                                   = "qx"
+	DEFANGED_SCHEME_QUERY                                = "qxxry"
+	DEFANGED_SCHEME_QUIC_TRANSPORT                       = "quic[-]transport"
+	DEFANGED_SCHEME_REDIS                                = "rxxis"
+	DEFANGED_SCHEME_REDISS                               = "rxxiss"
+	DEFANGED_SCHEME_RELOAD                               = "rxxoad"
+	DEFANGED_SCHEME_RES                                  = "rxs"
+	DEFANGED_SCHEME_RESOURCE                             = "rxxource"
+	DEFANGED_SCHEME_RMI                                  = "rxi"
+	DEFANGED_SCHEME_RSYNC                                = "rxxnc"
+	DEFANGED_SCHEME_RTMFP                                = "rxxfp"
+	DEFANGED_SCHEME_RTMP                                 = "rxxp"
+	DEFANGED_SCHEME_RTSP                                 = "rtxp"
+	DEFANGED_SCHEME_RTSPS                                = "rxxps"
+	DEFANGED_SCHEME_RTSPU                                = "rxxpu"
+	DEFANGED_SCHEME_SARIF                                = "sxxif"
+	DEFANGED_SCHEME_SECONDLIFE                           = "sxxondlife"
+	DEFANGED_SCHEME_SECRET_TOKEN                         = "secret[-]token"
+	DEFANGED_SCHEME_SERVICE                              = "sxxvice"
+	DEFANGED_SCHEME_SESSION                              = "sxxsion"
+	DEFANGED_SCHEME_SFTP                                 = "sfxp"
+	DEFANGED_SCHEME_SGN                                  = "sxn"
+	DEFANGED_SCHEME_SHC                                  = "sxc"
+	DEFANGED_SCHEME_SHELTER                              = "sxxlter"
+	DEFANGED_SCHEME_SHTTP                                = "sxxtp"
+	DEFANGED_SCHEME_SIEVE                                = "sxxve"
+	DEFANGED_SCHEME_SIMPLELEDGER                         = "sxxpleledger"
+	DEFANGED_SCHEME_SIMPLEX                              = "sxxplex"
+	DEFANGED_SCHEME_SIP                                  = "sxp"
+	DEFANGED_SCHEME_SIPS                                 = "sixs"
+	DEFANGED_SCHEME_SKYPE                                = "sxxpe"
+	DEFANGED_SCHEME_SMB                                  = "sxb"
+	DEFANGED_SCHEME_SMP                                  = "sxx"
+	DEFANGED_SCHEME_SMS                                  = "sxs"
+	DEFANGED_SCHEME_SMTP                                 = "smxp"
+	DEFANGED_SCHEME_SNEWS                                = "sxxws"
+	DEFANGED_SCHEME_SNMP                                 = "snxp"
+	DEFANGED_SCHEME_SOAP_BEEP                            = "soap[.]beep"
+	DEFANGED_SCHEME_SOAP_BEEPS                           = "soap[.]beeps"
+	DEFANGED_SCHEME_SOLDAT                               = "sxxdat"
+	DEFANGED_SCHEME_SPIFFE                               = "sxxffe"
+	DEFANGED_SCHEME_SPOTIFY                              = "sxxtify"
+	DEFANGED_SCHEME_SSB                                  = "s[s]b"
+	DEFANGED_SCHEME_SSH                                  = "sxh"
+	DEFANGED_SCHEME_STARKNET                             = "sxxrknet"
+	DEFANGED_SCHEME_STEAM                                = "sxxam"
+	DEFANGED_SCHEME_STUN                                 = "stxn"
+	DEFANGED_SCHEME_STUNS                                = "sxxns"
+	DEFANGED_SCHEME_SUBMIT                               = "sxxmit"
+	DEFANGED_SCHEME_SVN                                  = "s[v]n"
+	DEFANGED_SCHEME_SWH                                  = "s[w]h"
+	DEFANGED_SCHEME_SWID                                 = "swxd"
+	DEFANGED_SCHEME_SWIDPATH                             = "sxxdpath"
+	DEFANGED_SCHEME_TAG                                  = "txg"
+	DEFANGED_SCHEME_TALER                                = "txxer"
+	DEFANGED_SCHEME_TEAMSPEAK                            = "txxmspeak"
+	DEFANGED_SCHEME_TEAPOT                               = "txxpot"
+	DEFANGED_SCHEME_TEAPOTS                              = "txxpots"
+	DEFANGED_SCHEME_TEL                                  = "txl"
+	DEFANGED_SCHEME_TELIAEID                             = "txxiaeid"
+	DEFANGED_SCHEME_TELNET                               = "txxnet"
+	DEFANGED_SCHEME_TFTP                                 = "tfxp"
+	DEFANGED_SCHEME_THINGS                               = "txxngs"
+	DEFANGED_SCHEME_THISMESSAGE                          = "txxsmessage"
+	DEFANGED_SCHEME_THZP                                 = "thxp"
+	DEFANGED_SCHEME_TIP                                  = "txp"
+	DEFANGED_SCHEME_TN3270                               = "txx270"
+	DEFANGED_SCHEME_TOOL                                 = "toxl"
+	DEFANGED_SCHEME_TURN                                 = "tuxn"
+	DEFANGED_SCHEME_TURNS                                = "txxns"
+	DEFANGED_SCHEME_TV                                   = "tx"
+	DEFANGED_SCHEME_UDP                                  = "uxp"
+	DEFANGED_SCHEME_UNREAL                               = "uxxeal"
+	DEFANGED_SCHEME_UPT                                  = "uxt"
+	DEFANGED_SCHEME_URN                                  = "uxn"
+	DEFANGED_SCHEME_UT2004                               = "uxx004"
+	DEFANGED_SCHEME_UUID_IN_PACKAGE                      = "uuid[-]in[-]package"
+	DEFANGED_SCHEME_V_EVENT                              = "v[-]event"
+	DEFANGED_SCHEME_VEMMI                                = "vxxmi"
+	DEFANGED_SCHEME_VENTRILO                             = "vxxtrilo"
+	DEFANGED_SCHEME_VES                                  = "vxs"
+	DEFANGED_SCHEME_VIDEOTEX                             = "vxxeotex"
+	DEFANGED_SCHEME_VIEW_SOURCE                          = "view[-]source"
+	DEFANGED_SCHEME_VNC                                  = "vxc"
+	DEFANGED_SCHEME_VSCODE                               = "vxxode"
+	DEFANGED_SCHEME_VSCODE_INSIDERS                      = "vscode[-]insiders"
+	DEFANGED_SCHEME_VSLS                                 = "vsxs"
+	DEFANGED_SCHEME_W3                                   = "w[3]"
+	DEFANGED_SCHEME_WAIS                                 = "waxs"
+	DEFANGED_SCHEME_WASM                                 = "waxm"
+	DEFANGED_SCHEME_WASM_JS                              = "wasm[-]js"
+	DEFANGED_SCHEME_WCR                                  = "wxr"
+	DEFANGED_SCHEME_WEB_AP                               = "web[+]ap"
+	DEFANGED_SCHEME_WEB3                                 = "wex3"
+	DEFANGED_SCHEME_WEBCAL                               = "wxxcal"
+	DEFANGED_SCHEME_WIFI                                 = "wixi"
+	DEFANGED_SCHEME_WPID                                 = "wpxd"
+	DEFANGED_SCHEME_WS                                   = "wx"
+	DEFANGED_SCHEME_WSS                                  = "wxs"
+	DEFANGED_SCHEME_WTAI                                 = "wtxi"
+	DEFANGED_SCHEME_WYCIWYG                              = "wxxiwyg"
+	DEFANGED_SCHEME_XCON                                 = "xcxn"
+	DEFANGED_SCHEME_XCON_USERID                          = "xcon[-]userid"
+	DEFANGED_SCHEME_XFIRE                                = "xxxre"
+	DEFANGED_SCHEME_XFTP                                 = "xfxp"
+	DEFANGED_SCHEME_XMLRPC_BEEP                          = "xmlrpc[.]beep"
+	DEFANGED_SCHEME_XMLRPC_BEEPS                         = "xmlrpc[.]beeps"
+	DEFANGED_SCHEME_XMPP                                 = "xmxp"
+	DEFANGED_SCHEME_XRCP                                 = "xrxp"
+	DEFANGED_SCHEME_XRI                                  = "xxi"
+	DEFANGED_SCHEME_YMSGR                                = "yxxgr"
+	DEFANGED_SCHEME_Z39_50                               = "z39[.]50"
+	DEFANGED_SCHEME_Z39_50R                              = "z39[.]50r"
+	DEFANGED_SCHEME_Z39_50S                              = "z39[.]50s"
+)
+
+// Defanged forms of all registered URI schemes, sorted and deduplicated
+var DefangedSchemeNames = []string{
+	"a[t]",
+	"a[w]",
+	"aaxs",
+	"acxp",
+	"acxt",
+	"amxs",
+	"ax",
+	"axa",
+	"axd",
+	"axi",
+	"axk",
+	"axm",
+	"axp",
+	"axr",
+	"axs",
+	"axt",
+	"axx",
+	"axxachment",
+	"axxdata",
+	"axxroid",
+	"axxumxtra",
+	"axxut",
+	"b[b]",
+	"blxb",
+	"boxo",
+	"brxd",
+	"bx",
+	"bxxcoin",
+	"bxxcoincash",
+	"bxxetooth",
+	"bxxhare",
+	"bxxion",
+	"bxxwserext",
+	"caxt",
+	"chrome[-]extension",
+	"coap[+]tcp",
+	"coap[+]ws",
+	"coaps[+]tcp",
+	"coaps[+]ws",
+	"com[-]eventbrite[-]attendee",
+	"content[-]type",
+	"coxp",
+	"crxd",
+	"csxr",
+	"cxd",
+	"cxp",
+	"cxs",
+	"cxxal",
+	"cxxculator",
+	"cxxlto",
+	"cxxome",
+	"cxxps",
+	"cxxtent",
+	"cxxts",
+	"d[i]s",
+	"d[v]b",
+	"daxa",
+	"dixt",
+	"dlna[-]playcontainer",
+	"dlna[-]playsingle",
+	"dnxp",
+	"drxp",
+	"dtxi",
+	"dwxb",
+	"dxb",
+	"dxd",
+	"dxi",
+	"dxm",
+	"dxn",
+	"dxp",
+	"dxs",
+	"dxt",
+	"dxv",
+	"dxx",
+	"dxxspora",
+	"dxxtp",
+	"edxk",
+	"elxi",
+	"exd",
+	"exs",
+	"exxedded",
+	"exxereum",
+	"exxmple",
+	"fexd",
+	"first[-]run[-]pen[-]experience",
+	"fixe",
+	"fixh",
+	"fixo",
+	"fuchsia[-]pkg",
+	"fx",
+	"fxp",
+	"fxx",
+	"fxxdready",
+	"fxxesystem",
+	"fxxetime",
+	"fxxger",
+	"g[g]",
+	"gx",
+	"gxd",
+	"gxo",
+	"gxt",
+	"gxxher",
+	"gxxlk",
+	"gxxmoproject",
+	"gxxoid",
+	"gxxph",
+	"h3x3",
+	"hcxp",
+	"hsx0",
+	"hxm",
+	"hxp",
+	"hxxer",
+	"hxxp",  // Defanged form of http, but also a registered (provisional) scheme
+	"hxxps", // Defanged form of https, but also a registered (provisional) scheme
+	"hxxrazone",
+	"hxxx",
+	"hxxxs",
+	"icxn",
+	"icxp",
+	"imxp",
+	"inxo",
+	"ipxs",
+	"ipxx",
+	"iris[.]beep",
+	"iris[.]lwz",
+	"iris[.]xpc",
+	"iris[.]xpcs",
+	"irx6",
+	"irxs",
+	"irxx",
+	"itxs",
+	"ix",
+	"ixc",
+	"ixn",
+	"ixp",
+	"ixx",
+	"ixxdisco",
+	"ixxs",
+	"ixxstore",
+	"ixxtring",
+	"jxr",
+	"jxs",
+	"jxxber",
+	"kxxparc",
+	"lbxy",
+	"ldxp",
+	"lvxt",
+	"lxa",
+	"lxd",
+	"lxxawan",
+	"lxxps",
+	"lxxptofrogans",
+	"lxxtfm",
+	"maxs",
+	"microsoft[.]windows[.]camera",
+	"microsoft[.]windows[.]camera[.]multipicker",
+	"microsoft[.]windows[.]camera[.]picker",
+	"ms[-]access",
+	"ms[-]appinstaller",
+	"ms[-]browser[-]extension",
+	"ms[-]calculator",
+	"ms[-]drive[-]to",
+	"ms[-]enrollment",
+	"ms[-]excel",
+	"ms[-]eyecontrolspeech",
+	"ms[-]gamebarservices",
+	"ms[-]gamingoverlay",
+	"ms[-]getoffice",
+	"ms[-]help",
+	"ms[-]infopath",
+	"ms[-]inputapp",
+	"ms[-]launchremotedesktop",
+	"ms[-]lockscreencomponent[-]config",
+	"ms[-]media[-]stream[-]id",
+	"ms[-]meetnow",
+	"ms[-]mixedrealitycapture",
+	"ms[-]mobileplans",
+	"ms[-]newsandinterests",
+	"ms[-]officeapp",
+	"ms[-]people",
+	"ms[-]personacard",
+	"ms[-]powerpoint",
+	"ms[-]project",
+	"ms[-]publisher",
+	"ms[-]recall",
+	"ms[-]remotedesktop",
+	"ms[-]remotedesktop[-]launch",
+	"ms[-]restoretabcompanion",
+	"ms[-]screenclip",
+	"ms[-]screensketch",
+	"ms[-]search",
+	"ms[-]search[-]repair",
+	"ms[-]secondary[-]screen[-]controller",
+	"ms[-]secondary[-]screen[-]setup",
+	"ms[-]settings",
+	"ms[-]settings[-]airplanemode",
+	"ms[-]settings[-]bluetooth",
+	"ms[-]settings[-]camera",
+	"ms[-]settings[-]cellular",
+	"ms[-]settings[-]cloudstorage",
+	"ms[-]settings[-]connectabledevices",
+	"ms[-]settings[-]displays[-]topology",
+	"ms[-]settings[-]emailandaccounts",
+	"ms[-]settings[-]language",
+	"ms[-]settings[-]location",
+	"ms[-]settings[-]lock",
+	"ms[-]settings[-]nfctransactions",
+	"ms[-]settings[-]notifications",
+	"ms[-]settings[-]power",
+	"ms[-]settings[-]privacy",
+	"ms[-]settings[-]proximity",
+	"ms[-]settings[-]screenrotation",
+	"ms[-]settings[-]wifi",
+	"ms[-]settings[-]workplace",
+	"ms[-]spd",
+	"ms[-]stickers",
+	"ms[-]sttoverlay",
+	"ms[-]transit[-]to",
+	"ms[-]useractivityset",
+	"ms[-]uup",
+	"ms[-]virtualtouchpad",
+	"ms[-]visio",
+	"ms[-]walk[-]to",
+	"ms[-]whiteboard",
+	"ms[-]whiteboard[-]cmd",
+	"ms[-]widgetboard",
+	"ms[-]widgets",
+	"ms[-]word",
+	"msxp",
+	"mtxp",
+	"mvxp",
+	"mx",
+	"mxd",
+	"mxn",
+	"mxs",
+	"mxx",
+	"mxxble",
+	"mxxdate",
+	"mxxem",
+	"mxxgodb",
+	"mxxhineprovisioningprogressreporter",
+	"mxxim",
+	"mxxket",
+	"mxxlserver",
+	"mxxlto",
+	"mxxnet",
+	"mxxps",
+	"mxxrix",
+	"mxxsage",
+	"mxxust",
+	"mxxxs",
+	"mxz",
+	"nexs",
+	"nnxp",
+	"nx",
+	"nxh",
+	"nxm",
+	"nxs",
+	"nxxes",
+	"onenote[-]cmd",
+	"oxd",
+	"oxf",
+	"oxxauth",
+	"oxxnid",
+	"oxxnote",
+	"oxxnpgp4fpr",
+	"oxxquelocktoken",
+	"paxk",
+	"paxm",
+	"prxs",
+	"psxc",
+	"ptxp",
+	"pwxd",
+	"px",
+	"pxp",
+	"pxxarazzi",
+	"pxxment",
+	"pxxs11",
+	"pxxspero",
+	"pxxtform",
+	"pxxto",
+	"pxxxy",
+	"quic[-]transport",
+	"qx",
+	"qxxry",
+	"rtxp",
+	"rxi",
+	"rxs",
+	"rxxfp",
+	"rxxis",
+	"rxxiss",
+	"rxxnc",
+	"rxxoad",
+	"rxxource",
+	"rxxp",
+	"rxxps",
+	"rxxpu",
+	"s[s]b",
+	"s[v]n",
+	"s[w]h",
+	"secret[-]token",
+	"sfxp",
+	"sixs",
+	"smxp",
+	"snxp",
+	"soap[.]beep",
+	"soap[.]beeps",
+	"stxn",
+	"swxd",
+	"sxb",
+	"sxc",
+	"sxh",
+	"sxn",
+	"sxp",
+	"sxs",
+	"sxx",
+	"sxxam",
+	"sxxdat",
+	"sxxdpath",
+	"sxxffe",
+	"sxxif",
+	"sxxlter",
+	"sxxmit",
+	"sxxns",
+	"sxxondlife",
+	"sxxpe",
+	"sxxpleledger",
+	"sxxplex",
+	"sxxrknet",
+	"sxxsion",
+	"sxxtify",
+	"sxxtp",
+	"sxxve",
+	"sxxvice",
+	"sxxws",
+	"tfxp",
+	"thxp",
+	"toxl",
+	"tuxn",
+	"tx",
+	"txg",
+	"txl",
+	"txp",
+	"txx270",
+	"txxer",
+	"txxiaeid",
+	"txxmspeak",
+	"txxnet",
+	"txxngs",
+	"txxns",
+	"txxpot",
+	"txxpots",
+	"txxsmessage",
+	"uuid[-]in[-]package",
+	"uxn",
+	"uxp",
+	"uxt",
+	"uxx004",
+	"uxxeal",
+	"v[-]event",
+	"view[-]source",
+	"vscode[-]insiders",
+	"vsxs",
+	"vxc",
+	"vxs",
+	"vxxeotex",
+	"vxxmi",
+	"vxxode",
+	"vxxtrilo",
+	"w[3]",
+	"wasm[-]js",
+	"waxm",
+	"waxs",
+	"web[+]ap",
+	"wex3",
+	"wixi",
+	"wpxd",
+	"wtxi",
+	"wx",
+	"wxr",
+	"wxs",
+	"wxxcal",
+	"wxxiwyg",
+	"xcon[-]userid",
+	"xcxn",
+	"xfxp",
+	"xmlrpc[.]beep",
+	"xmlrpc[.]beeps",
+	"xmxp",
+	"xrxp",
+	"xxi",
+	"xxxre",
+	"yxxgr",
+	"z39[.]50",
+	"z39[.]50r",
+	"z39[.]50s",
+}
@@ -0,0 +1,81 @@
+// The dataset of URI schemes registered with IANA, and lookups over it.  The dataset is
+// generated by tools/writeconsts; see the defang package to defang schemes and URLs
+package schemes
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/jakewilliami/defang-schemes/internal/algorithm"
+)
+
+// Status types
+// https://stackoverflow.com/a/71934535
+type Status string
+
+const (
+	Permanent   Status = "Permanent"
+	Provisional Status = "Provisional"
+	Historical  Status = "Historical"
+)
+
+type Scheme struct {
+	Scheme              string `validate:"required"`
+	DefangedScheme      string `validate:"required"`
+	Template            string // Absolute URL of the registration template; see TemplateURL
+	Description         string
+	Status              Status `validate:"oneof=Permanent Provisional Historical"`
+	WellKnownUriSupport string
+	Reference           string
+	Notes               string
+	Obsolete            bool     // Annotated "(OBSOLETE)" in the registry; see Deprecated
+	Related             []string // Related schemes, sorted; see RelateSchemes
+	Examples            []string // Curated example URIs; see SCHEME_EXAMPLES
+}
+
+// As well as [a-z], these characters are allowed in URI schemes
+// https://github.com/JuliaWeb/URIs.jl/blob/dce395c3/src/URIs.jl#L91-L108
+var ADDITIONAL_ALLOWED_SCHEME_CHARS = algorithm.ADDITIONAL_ALLOWED_SCHEME_CHARS
+
+// Deprecated: use AdditionalAllowedSchemeCharsPattern, which is compiled once on first use
+var ADDITIONAL_ALLOWED_SCHEME_CHARS_PATTERN = AdditionalAllowedSchemeCharsPattern()
+
+// Deprecated: use SchemeNamePattern, which is compiled once on first use
+var SCHEME_PATTERN = SchemeNamePattern()
+
+// Validate Scheme struct
+// https://stackoverflow.com/a/71934231
+func (s *Scheme) Validate() error {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	return validate.Struct(s)
+}
+
+// Whether the schemes are identical, field by field.  Schemes are not comparable with ==,
+// as Related and Examples are slices
+func (s Scheme) Equal(other Scheme) bool {
+	return s.Scheme == other.Scheme &&
+		s.DefangedScheme == other.DefangedScheme &&
+		s.Template == other.Template &&
+		s.Description == other.Description &&
+		s.Status == other.Status &&
+		s.WellKnownUriSupport == other.WellKnownUriSupport &&
+		s.Reference == other.Reference &&
+		s.Notes == other.Notes &&
+		s.Obsolete == other.Obsolete &&
+		slices.Equal(s.Related, other.Related) &&
+		slices.Equal(s.Examples, other.Examples)
+}
+
+// Construct scheme pattern to use in validation/cleaning step, per the grammar of RFC 3986
+// (see IsValidScheme)
+func schemePattern() *regexp.Regexp {
+	var allowedChars string
+	for _, char := range ADDITIONAL_ALLOWED_SCHEME_CHARS {
+		allowedChars += string(char)
+	}
+	pattern := fmt.Sprintf(`[[:alpha:]][[:alnum:]%s]*`, regexp.QuoteMeta(allowedChars))
+	return regexp.MustCompile(pattern)
+}
@@ -0,0 +1,32 @@
+package schemes
+
+import "sort"
+
+// Schemes with the given status, sorted by scheme
+func SchemesByStatus(status Status) []Scheme {
+	var schemes []Scheme
+	for _, scheme := range Schemes() {
+		if scheme.Status == status {
+			schemes = append(schemes, scheme)
+		}
+	}
+	sort.Slice(schemes, func(i, j int) bool {
+		return schemes[i].Scheme < schemes[j].Scheme
+	})
+	return schemes
+}
+
+// Permanent schemes, sorted by scheme
+func PermanentSchemes() []Scheme {
+	return SchemesByStatus(Permanent)
+}
+
+// Provisional schemes, sorted by scheme
+func ProvisionalSchemes() []Scheme {
+	return SchemesByStatus(Provisional)
+}
+
+// Historical schemes, sorted by scheme
+func HistoricalSchemes() []Scheme {
+	return SchemesByStatus(Historical)
+}
@@ -0,0 +1,59 @@
+package schemes
+
+import (
+	"fmt"
+
+	"github.com/jakewilliami/defang-schemes/internal/algorithm"
+)
+
+// A defang convention, for which defanged forms are generated for every scheme, so that
+// switching style at runtime is a lookup rather than a recomputation
+type Style int
+
+const (
+	// Characters replaced with 'x' ("hxxps"); the DefangedScheme field
+	StyleHxx Style = iota
+	// Characters bracketed ("h[tt]ps"), as with defang.WithBracketStyle
+	StyleBrackets
+)
+
+// All styles, in order
+var STYLES = []Style{StyleHxx, StyleBrackets}
+
+func (s Style) String() string {
+	switch s {
+	case StyleHxx:
+		return "hxx"
+	case StyleBrackets:
+		return "brackets"
+	default:
+		return fmt.Sprintf("Style(%d)", int(s))
+	}
+}
+
+// The defang.DefangScheme options producing the style, for schemes without a generated form
+func (s Style) Options() []algorithm.Option {
+	switch s {
+	case StyleBrackets:
+		return []algorithm.Option{algorithm.WithBracketStyle()}
+	default:
+		return nil
+	}
+}
+
+// Defanged form of the scheme in the given style.  Registered schemes have generated forms;
+// other schemes are defanged as defang.DefangScheme would with the style's options
+func (s Scheme) DefangedAs(style Style) string {
+	if style == StyleHxx && s.DefangedScheme != "" {
+		return s.DefangedScheme
+	}
+	if style == StyleHxx && DataGenerated() == nil {
+		if known, ok := Lookup(s.Scheme); ok {
+			return known.DefangedScheme
+		}
+	}
+	if form, ok := styledDefangedSchemes()[style][s.Scheme]; ok {
+		return form
+	}
+	return algorithm.DefangChanged(s.Scheme, style.Options()...)
+}
@@ -0,0 +1,414 @@
+//go:build !defang_schemes_lazy && !defang_schemes_embed && !defang_schemes_minimal
+
+package schemes
+
+/*
+THIS FILE WAS AUTOMATICALLY GENERATED AT 2026-10-16 07:57:26
+
+Do not edit this file.  Run "go generate" to re-generate this file with an
+updated version of defanged forms of URI schemes by style from:
+    iana.org/assignments/uri-schemes/uri-schemes.xhtml.
+*/
+
+func init() {
+	styledDefangedSchemeMap = map[Style]map[string]string{
+		StyleBrackets: {
+			"aaa":                                  "a[a]a",
+			"aaas":                                 "aa[a]s",
+			"about":                                "a[bo]ut",
+			"acap":                                 "ac[a]p",
+			"acct":                                 "ac[c]t",
+			"acd":                                  "a[c]d",
+			"acr":                                  "a[c]r",
+			"adiumxtra":                            "a[di]umxtra",
+			"adt":                                  "a[d]t",
+			"afp":                                  "a[f]p",
+			"afs":                                  "a[f]s",
+			"aim":                                  "a[i]m",
+			"amss":                                 "am[s]s",
+			"android":                              "a[nd]roid",
+			"appdata":                              "a[pp]data",
+			"apt":                                  "a[p]t",
+			"ar":                                   "a[r]",
+			"ari":                                  "a[r]i",
+			"ark":                                  "a[r]k",
+			"at":                                   "a[t]",
+			"attachment":                           "a[tt]achment",
+			"aw":                                   "a[w]",
+			"barion":                               "b[ar]ion",
+			"bb":                                   "b[b]",
+			"beshare":                              "b[es]hare",
+			"bitcoin":                              "b[it]coin",
+			"bitcoincash":                          "b[it]coincash",
+			"bl":                                   "b[l]",
+			"blob":                                 "bl[o]b",
+			"bluetooth":                            "b[lu]etooth",
+			"bolo":                                 "bo[l]o",
+			"brid":                                 "br[i]d",
+			"browserext":                           "b[ro]wserext",
+			"cabal":                                "c[ab]al",
+			"calculator":                           "c[al]culator",
+			"callto":                               "c[al]lto",
+			"cap":                                  "c[a]p",
+			"cast":                                 "ca[s]t",
+			"casts":                                "c[as]ts",
+			"chrome":                               "c[hr]ome",
+			"chrome-extension":                     "chrome[-]extension",
+			"cid":                                  "c[i]d",
+			"coap":                                 "co[a]p",
+			"coap+tcp":                             "coap[+]tcp",
+			"coap+ws":                              "coap[+]ws",
+			"coaps":                                "c[oa]ps",
+			"coaps+tcp":                            "coaps[+]tcp",
+			"coaps+ws":                             "coaps[+]ws",
+			"com-eventbrite-attendee":              "com[-]eventbrite[-]attendee",
+			"content":                              "c[on]tent",
+			"content-type":                         "content[-]type",
+			"crid":                                 "cr[i]d",
+			"cstr":                                 "cs[t]r",
+			"cvs":                                  "c[v]s",
+			"dab":                                  "d[a]b",
+			"dat":                                  "d[a]t",
+			"data":                                 "da[t]a",
+			"dav":                                  "d[a]v",
+			"dhttp":                                "d[ht]tp",
+			"diaspora":                             "d[ia]spora",
+			"dict":                                 "di[c]t",
+			"did":                                  "d[i]d",
+			"dis":                                  "d[i]s",
+			"dlna-playcontainer":                   "dlna[-]playcontainer",
+			"dlna-playsingle":                      "dlna[-]playsingle",
+			"dns":                                  "d[n]s",
+			"dntp":                                 "dn[t]p",
+			"doi":                                  "d[o]i",
+			"dpp":                                  "d[p]p",
+			"drm":                                  "d[r]m",
+			"drop":                                 "dr[o]p",
+			"dtmi":                                 "dt[m]i",
+			"dtn":                                  "d[t]n",
+			"dvb":                                  "d[v]b",
+			"dvx":                                  "d[v]x",
+			"dweb":                                 "dw[e]b",
+			"ed2k":                                 "ed[2]k",
+			"eid":                                  "e[i]d",
+			"elsi":                                 "el[s]i",
+			"embedded":                             "e[mb]edded",
+			"ens":                                  "e[n]s",
+			"ethereum":                             "e[th]ereum",
+			"example":                              "e[xa]mple",
+			"facetime":                             "f[ac]etime",
+			"fax":                                  "f[a]x",
+			"feed":                                 "fe[e]d",
+			"feedready":                            "f[ee]dready",
+			"fido":                                 "fi[d]o",
+			"file":                                 "fi[l]e",
+			"filesystem":                           "f[il]esystem",
+			"finger":                               "f[in]ger",
+			"first-run-pen-experience":             "first[-]run[-]pen[-]experience",
+			"fish":                                 "fi[s]h",
+			"fm":                                   "f[m]",
+			"ftp":                                  "f[t]p",
+			"fuchsia-pkg":                          "fuchsia[-]pkg",
+			"geo":                                  "g[e]o",
+			"gg":                                   "g[g]",
+			"git":                                  "g[i]t",
+			"gitoid":                               "g[it]oid",
+			"gizmoproject":                         "g[iz]moproject",
+			"go":                                   "g[o]",
+			"gopher":                               "g[op]her",
+			"graph":                                "g[ra]ph",
+			"grd":                                  "g[r]d",
+			"gtalk":                                "g[ta]lk",
+			"h323":                                 "h3[2]3",
+			"ham":                                  "h[a]m",
+			"hcap":                                 "hc[a]p",
+			"hcp":                                  "h[c]p",
+			"hs20":                                 "hs[2]0",
+			"http":                                 "h[tt]p",
+			"https":                                "h[tt]ps",
+			"hxxp":                                 "hx[x]p",
+			"hxxps":                                "h[xx]ps",
+			"hydrazone":                            "h[yd]razone",
+			"hyper":                                "h[yp]er",
+			"iax":                                  "i[a]x",
+			"icap":                                 "ic[a]p",
+			"icon":                                 "ic[o]n",
+			"ilstring":                             "i[ls]tring",
+			"im":                                   "i[m]",
+			"imap":                                 "im[a]p",
+			"info":                                 "in[f]o",
+			"iotdisco":                             "i[ot]disco",
+			"ipfs":                                 "ip[f]s",
+			"ipn":                                  "i[p]n",
+			"ipns":                                 "ip[n]s",
+			"ipp":                                  "i[p]p",
+			"ipps":                                 "ip[p]s",
+			"irc":                                  "i[r]c",
+			"irc6":                                 "ir[c]6",
+			"ircs":                                 "ir[c]s",
+			"iris":                                 "ir[i]s",
+			"iris.beep":                            "iris[.]beep",
+			"iris.lwz":                             "iris[.]lwz",
+			"iris.xpc":                             "iris[.]xpc",
+			"iris.xpcs":                            "iris[.]xpcs",
+			"isostore":                             "i[so]store",
+			"itms":                                 "it[m]s",
+			"jabber":                               "j[ab]ber",
+			"jar":                                  "j[a]r",
+			"jms":                                  "j[m]s",
+			"keyparc":                              "k[ey]parc",
+			"lastfm":                               "l[as]tfm",
+			"lbry":                                 "lb[r]y",
+			"ldap":                                 "ld[a]p",
+			"ldaps":                                "l[da]ps",
+			"leaptofrogans":                        "l[ea]ptofrogans",
+			"lid":                                  "l[i]d",
+			"lorawan":                              "l[or]awan",
+			"lpa":                                  "l[p]a",
+			"lvlt":                                 "lv[l]t",
+			"machineprovisioningprogressreporter":  "m[ac]hineprovisioningprogressreporter",
+			"magnet":                               "m[ag]net",
+			"mailserver":                           "m[ai]lserver",
+			"mailto":                               "m[ai]lto",
+			"maps":                                 "ma[p]s",
+			"market":                               "m[ar]ket",
+			"matrix":                               "m[at]rix",
+			"message":                              "m[es]sage",
+			"microsoft.windows.camera":             "microsoft[.]windows[.]camera",
+			"microsoft.windows.camera.multipicker": "microsoft[.]windows[.]camera[.]multipicker",
+			"microsoft.windows.camera.picker":      "microsoft[.]windows[.]camera[.]picker",
+			"mid":                                  "m[i]d",
+			"mms":                                  "m[m]s",
+			"modem":                                "m[od]em",
+			"mongodb":                              "m[on]godb",
+			"moz":                                  "m[o]z",
+			"ms-access":                            "ms[-]access",
+			"ms-appinstaller":                      "ms[-]appinstaller",
+			"ms-browser-extension":                 "ms[-]browser[-]extension",
+			"ms-calculator":                        "ms[-]calculator",
+			"ms-drive-to":                          "ms[-]drive[-]to",
+			"ms-enrollment":                        "ms[-]enrollment",
+			"ms-excel":                             "ms[-]excel",
+			"ms-eyecontrolspeech":                  "ms[-]eyecontrolspeech",
+			"ms-gamebarservices":                   "ms[-]gamebarservices",
+			"ms-gamingoverlay":                     "ms[-]gamingoverlay",
+			"ms-getoffice":                         "ms[-]getoffice",
+			"ms-help":                              "ms[-]help",
+			"ms-infopath":                          "ms[-]infopath",
+			"ms-inputapp":                          "ms[-]inputapp",
+			"ms-launchremotedesktop":               "ms[-]launchremotedesktop",
+			"ms-lockscreencomponent-config":        "ms[-]lockscreencomponent[-]config",
+			"ms-media-stream-id":                   "ms[-]media[-]stream[-]id",
+			"ms-meetnow":                           "ms[-]meetnow",
+			"ms-mixedrealitycapture":               "ms[-]mixedrealitycapture",
+			"ms-mobileplans":                       "ms[-]mobileplans",
+			"ms-newsandinterests":                  "ms[-]newsandinterests",
+			"ms-officeapp":                         "ms[-]officeapp",
+			"ms-people":                            "ms[-]people",
+			"ms-personacard":                       "ms[-]personacard",
+			"ms-powerpoint":                        "ms[-]powerpoint",
+			"ms-project":                           "ms[-]project",
+			"ms-publisher":                         "ms[-]publisher",
+			"ms-recall":                            "ms[-]recall",
+			"ms-remotedesktop":                     "ms[-]remotedesktop",
+			"ms-remotedesktop-launch":              "ms[-]remotedesktop[-]launch",
+			"ms-restoretabcompanion":               "ms[-]restoretabcompanion",
+			"ms-screenclip":                        "ms[-]screenclip",
+			"ms-screensketch":                      "ms[-]screensketch",
+			"ms-search":                            "ms[-]search",
+			"ms-search-repair":                     "ms[-]search[-]repair",
+			"ms-secondary-screen-controller":       "ms[-]secondary[-]screen[-]controller",
+			"ms-secondary-screen-setup":            "ms[-]secondary[-]screen[-]setup",
+			"ms-settings":                          "ms[-]settings",
+			"ms-settings-airplanemode":             "ms[-]settings[-]airplanemode",
+			"ms-settings-bluetooth":                "ms[-]settings[-]bluetooth",
+			"ms-settings-camera":                   "ms[-]settings[-]camera",
+			"ms-settings-cellular":                 "ms[-]settings[-]cellular",
+			"ms-settings-cloudstorage":             "ms[-]settings[-]cloudstorage",
+			"ms-settings-connectabledevices":       "ms[-]settings[-]connectabledevices",
+			"ms-settings-displays-topology":        "ms[-]settings[-]displays[-]topology",
+			"ms-settings-emailandaccounts":         "ms[-]settings[-]emailandaccounts",
+			"ms-settings-language":                 "ms[-]settings[-]language",
+			"ms-settings-location":                 "ms[-]settings[-]location",
+			"ms-settings-lock":                     "ms[-]settings[-]lock",
+			"ms-settings-nfctransactions":          "ms[-]settings[-]nfctransactions",
+			"ms-settings-notifications":            "ms[-]settings[-]notifications",
+			"ms-settings-power":                    "ms[-]settings[-]power",
+			"ms-settings-privacy":                  "ms[-]settings[-]privacy",
+			"ms-settings-proximity":                "ms[-]settings[-]proximity",
+			"ms-settings-screenrotation":           "ms[-]settings[-]screenrotation",
+			"ms-settings-wifi":                     "ms[-]settings[-]wifi",
+			"ms-settings-workplace":                "ms[-]settings[-]workplace",
+			"ms-spd":                               "ms[-]spd",
+			"ms-stickers":                          "ms[-]stickers",
+			"ms-sttoverlay":                        "ms[-]sttoverlay",
+			"ms-transit-to":                        "ms[-]transit[-]to",
+			"ms-useractivityset":                   "ms[-]useractivityset",
+			"ms-uup":                               "ms[-]uup",
+			"ms-virtualtouchpad":                   "ms[-]virtualtouchpad",
+			"ms-visio":                             "ms[-]visio",
+			"ms-walk-to":                           "ms[-]walk[-]to",
+			"ms-whiteboard":                        "ms[-]whiteboard",
+			"ms-whiteboard-cmd":                    "ms[-]whiteboard[-]cmd",
+			"ms-widgetboard":                       "ms[-]widgetboard",
+			"ms-widgets":                           "ms[-]widgets",
+			"ms-word":                              "ms[-]word",
+			"msnim":                                "m[sn]im",
+			"msrp":                                 "ms[r]p",
+			"msrps":                                "m[sr]ps",
+			"mss":                                  "m[s]s",
+			"mt":                                   "m[t]",
+			"mtqp":                                 "mt[q]p",
+			"mtrust":                               "m[tr]ust",
+			"mumble":                               "m[um]ble",
+			"mupdate":                              "m[up]date",
+			"mvn":                                  "m[v]n",
+			"mvrp":                                 "mv[r]p",
+			"mvrps":                                "m[vr]ps",
+			"news":                                 "ne[w]s",
+			"nfs":                                  "n[f]s",
+			"ni":                                   "n[i]",
+			"nih":                                  "n[i]h",
+			"nntp":                                 "nn[t]p",
+			"notes":                                "n[ot]es",
+			"num":                                  "n[u]m",
+			"ocf":                                  "o[c]f",
+			"oid":                                  "o[i]d",
+			"onenote":                              "o[ne]note",
+			"onenote-cmd":                          "onenote[-]cmd",
+			"opaquelocktoken":                      "o[pa]quelocktoken",
+			"openid":                               "o[pe]nid",
+			"openpgp4fpr":                          "o[pe]npgp4fpr",
+			"otpauth":                              "o[tp]auth",
+			"p1":                                   "p[1]",
+			"pack":                                 "pa[c]k",
+			"palm":                                 "pa[l]m",
+			"paparazzi":                            "p[ap]arazzi",
+			"payment":                              "p[ay]ment",
+			"payto":                                "p[ay]to",
+			"pkcs11":                               "p[kc]s11",
+			"platform":                             "p[la]tform",
+			"pop":                                  "p[o]p",
+			"pres":                                 "pr[e]s",
+			"prospero":                             "p[ro]spero",
+			"proxy":                                "p[ro]xy",
+			"psyc":                                 "ps[y]c",
+			"pttp":                                 "pt[t]p",
+			"pwid":                                 "pw[i]d",
+			"qb":                                   "q[b]",
+			"query":                                "q[ue]ry",
+			"quic-transport":                       "quic[-]transport",
+			"redis":                                "r[ed]is",
+			"rediss":                               "r[ed]iss",
+			"reload":                               "r[el]oad",
+			"res":                                  "r[e]s",
+			"resource":                             "r[es]ource",
+			"rmi":                                  "r[m]i",
+			"rsync":                                "r[sy]nc",
+			"rtmfp":                                "r[tm]fp",
+			"rtmp":                                 "rt[m]p",
+			"rtsp":                                 "rt[s]p",
+			"rtsps":                                "r[ts]ps",
+			"rtspu":                                "r[ts]pu",
+			"sarif":                                "s[ar]if",
+			"secondlife":                           "s[ec]ondlife",
+			"secret-token":                         "secret[-]token",
+			"service":                              "s[er]vice",
+			"session":                              "s[es]sion",
+			"sftp":                                 "sf[t]p",
+			"sgn":                                  "s[g]n",
+			"shc":                                  "s[h]c",
+			"shelter":                              "s[he]lter",
+			"shttp":                                "s[ht]tp",
+			"sieve":                                "s[ie]ve",
+			"simpleledger":                         "s[im]pleledger",
+			"simplex":                              "s[im]plex",
+			"sip":                                  "s[i]p",
+			"sips":                                 "si[p]s",
+			"skype":                                "s[ky]pe",
+			"smb":                                  "s[m]b",
+			"smp":                                  "s[m]p",
+			"sms":                                  "s[m]s",
+			"smtp":                                 "sm[t]p",
+			"snews":                                "s[ne]ws",
+			"snmp":                                 "sn[m]p",
+			"soap.beep":                            "soap[.]beep",
+			"soap.beeps":                           "soap[.]beeps",
+			"soldat":                               "s[ol]dat",
+			"spiffe":                               "s[pi]ffe",
+			"spotify":                              "s[po]tify",
+			"ssb":                                  "s[s]b",
+			"ssh":                                  "s[s]h",
+			"starknet":                             "s[ta]rknet",
+			"steam":                                "s[te]am",
+			"stun":                                 "st[u]n",
+			"stuns":                                "s[tu]ns",
+			"submit":                               "s[ub]mit",
+			"svn":                                  "s[v]n",
+			"swh":                                  "s[w]h",
+			"swid":                                 "sw[i]d",
+			"swidpath":                             "s[wi]dpath",
+			"tag":                                  "t[a]g",
+			"taler":                                "t[al]er",
+			"teamspeak":                            "t[ea]mspeak",
+			"teapot":                               "t[ea]pot",
+			"teapots":                              "t[ea]pots",
+			"tel":                                  "t[e]l",
+			"teliaeid":                             "t[el]iaeid",
+			"telnet":                               "t[el]net",
+			"tftp":                                 "tf[t]p",
+			"things":                               "t[hi]ngs",
+			"thismessage":                          "t[hi]smessage",
+			"thzp":                                 "th[z]p",
+			"tip":                                  "t[i]p",
+			"tn3270":                               "t[n3]270",
+			"tool":                                 "to[o]l",
+			"turn":                                 "tu[r]n",
+			"turns":                                "t[ur]ns",
+			"tv":                                   "t[v]",
+			"udp":                                  "u[d]p",
+			"unreal":                               "u[nr]eal",
+			"upt":                                  "u[p]t",
+			"urn":                                  "u[r]n",
+			"ut2004":                               "u[t2]004",
+			"uuid-in-package":                      "uuid[-]in[-]package",
+			"v-event":                              "v[-]event",
+			"vemmi":                                "v[em]mi",
+			"ventrilo":                             "v[en]trilo",
+			"ves":                                  "v[e]s",
+			"videotex":                             "v[id]eotex",
+			"view-source":                          "view[-]source",
+			"vnc":                                  "v[n]c",
+			"vscode":                               "v[sc]ode",
+			"vscode-insiders":                      "vscode[-]insiders",
+			"vsls":                                 "vs[l]s",
+			"w3":                                   "w[3]",
+			"wais":                                 "wa[i]s",
+			"wasm":                                 "wa[s]m",
+			"wasm-js":                              "wasm[-]js",
+			"wcr":                                  "w[c]r",
+			"web+ap":                               "web[+]ap",
+			"web3":                                 "we[b]3",
+			"webcal":                               "w[eb]cal",
+			"wifi":                                 "wi[f]i",
+			"wpid":                                 "wp[i]d",
+			"ws":                                   "w[s]",
+			"wss":                                  "w[s]s",
+			"wtai":                                 "wt[a]i",
+			"wyciwyg":                              "w[yc]iwyg",
+			"xcon":                                 "xc[o]n",
+			"xcon-userid":                          "xcon[-]userid",
+			"xfire":                                "x[fi]re",
+			"xftp":                                 "xf[t]p",
+			"xmlrpc.beep":                          "xmlrpc[.]beep",
+			"xmlrpc.beeps":                         "xmlrpc[.]beeps",
+			"xmpp":                                 "xm[p]p",
+			"xrcp":                                 "xr[c]p",
+			"xri":                                  "x[r]i",
+			"ymsgr":                                "y[ms]gr",
+			"z39.50":                               "z39[.]50",
+			"z39.50r":                              "z39[.]50r",
+			"z39.50s":                              "z39[.]50s",
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package schemes
+
+import "net/url"
+
+// Registration templates are published relative to the URI schemes registry
+// https://www.iana.org/assignments/uri-schemes/uri-schemes.xhtml
+const TEMPLATE_BASE_URL = "https://www.iana.org/assignments/uri-schemes/"
+
+// Resolve a registration template reference from the IANA registry ("prov/ymsgr") to an
+// absolute URL.  Returns the empty string if there is no template, or the reference is
+// not a URL
+func ResolveTemplate(template string) string {
+	u := resolveTemplate(template)
+	if u == nil {
+		return ""
+	}
+	return u.String()
+}
+
+func resolveTemplate(template string) *url.URL {
+	if template == "" {
+		return nil
+	}
+	base, _ := url.Parse(TEMPLATE_BASE_URL)
+	ref, err := url.Parse(template)
+	if err != nil {
+		return nil
+	}
+	return base.ResolveReference(ref)
+}
+
+// Absolute URL of the scheme's registration template, or nil if it has none.  The Template
+// field of the generated data is already absolute; older snapshots may hold references
+// relative to TEMPLATE_BASE_URL, which are resolved
+func (s Scheme) TemplateURL() *url.URL {
+	return resolveTemplate(s.Template)
+}
@@ -0,0 +1,72 @@
+package schemes
+
+import (
+	"sort"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Well-known URIs are served under this path prefix on schemes that support them
+// https://www.rfc-editor.org/rfc/rfc8615#section-3
+const WELL_KNOWN_PATH_PREFIX = "/.well-known/"
+
+// A URI suffix registered in the IANA Well-Known URIs registry
+// https://www.iana.org/assignments/well-known-uris/well-known-uris.xhtml
+type WellKnownURI struct {
+	Suffix             string `validate:"required"`
+	ChangeController   string
+	Reference          string
+	Status             Status `validate:"oneof=Permanent Provisional"`
+	RelatedInformation string
+}
+
+// Registered well-known URIs, keyed by suffix, assigned by the generated
+// well_known_consts.go
+var WellKnownMap map[string]WellKnownURI
+
+// Validate WellKnownURI struct
+func (w *WellKnownURI) Validate() error {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	return validate.Struct(w)
+}
+
+// Path component of the well-known URI; for example, "/.well-known/security.txt"
+func (w WellKnownURI) Path() string {
+	return WELL_KNOWN_PATH_PREFIX + w.Suffix
+}
+
+// The IANA registry declares well-known URI support for a scheme by referencing the
+// specification that defines it (e.g., "[RFC8615]" for http[s]).  Any scheme with such
+// a reference may serve the registered well-known URIs.
+func (s Scheme) SupportsWellKnownURIs() bool {
+	return s.WellKnownUriSupport != ""
+}
+
+// Registered well-known URIs that may be served under the scheme, sorted by suffix.
+// Returns nil if the scheme does not support well-known URIs
+func (s Scheme) WellKnownURIs() []WellKnownURI {
+	if !s.SupportsWellKnownURIs() {
+		return nil
+	}
+
+	wellKnownURIs := make([]WellKnownURI, 0, len(WellKnownMap))
+	for _, wellKnown := range WellKnownMap {
+		wellKnownURIs = append(wellKnownURIs, wellKnown)
+	}
+	sort.Slice(wellKnownURIs, func(i, j int) bool {
+		return wellKnownURIs[i].Suffix < wellKnownURIs[j].Suffix
+	})
+
+	return wellKnownURIs
+}
+
+// Look up a registered well-known URI for the scheme by its suffix.  The second return
+// value is false if the suffix is not registered, or if the scheme does not support
+// well-known URIs
+func (s Scheme) WellKnownURI(suffix string) (WellKnownURI, bool) {
+	if !s.SupportsWellKnownURIs() {
+		return WellKnownURI{}, false
+	}
+	wellKnown, ok := WellKnownMap[suffix]
+	return wellKnown, ok
+}
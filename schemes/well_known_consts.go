@@ -0,0 +1,294 @@
+package schemes
+
+/*
+THIS FILE WAS AUTOMATICALLY GENERATED AT 2026-10-16 03:55:29
+
+Do not edit this file.  Run "go generate" to re-generate this file with an
+updated version of well-known URIs from:
+    iana.org/assignments/well-known-uris/well-known-uris.xhtml.
+*/
+
+func init() {
+	WellKnownMap = map[string]WellKnownURI{
+		"acme-challenge": WellKnownURI{
+			Suffix:             "acme-challenge",
+			ChangeController:   "IETF",
+			Reference:          "[RFC8555]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"apple-app-site-association": WellKnownURI{
+			Suffix:             "apple-app-site-association",
+			ChangeController:   "Apple",
+			Reference:          "[Apple Universal Links]",
+			Status:             Provisional,
+			RelatedInformation: "",
+		},
+		"ashrae": WellKnownURI{
+			Suffix:             "ashrae",
+			ChangeController:   "ASHRAE",
+			Reference:          "[ASHRAE BACnet Committee]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"assetlinks.json": WellKnownURI{
+			Suffix:             "assetlinks.json",
+			ChangeController:   "Google",
+			Reference:          "[Digital Asset Links]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"brski": WellKnownURI{
+			Suffix:             "brski",
+			ChangeController:   "IETF",
+			Reference:          "[RFC8995]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"caldav": WellKnownURI{
+			Suffix:             "caldav",
+			ChangeController:   "IETF",
+			Reference:          "[RFC6764]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"carddav": WellKnownURI{
+			Suffix:             "carddav",
+			ChangeController:   "IETF",
+			Reference:          "[RFC6764]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"change-password": WellKnownURI{
+			Suffix:             "change-password",
+			ChangeController:   "W3C",
+			Reference:          "[A Well-Known URL for Changing Passwords]",
+			Status:             Provisional,
+			RelatedInformation: "",
+		},
+		"coap": WellKnownURI{
+			Suffix:             "coap",
+			ChangeController:   "IETF",
+			Reference:          "[RFC8323]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"core": WellKnownURI{
+			Suffix:             "core",
+			ChangeController:   "IETF",
+			Reference:          "[RFC6690]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"dnt": WellKnownURI{
+			Suffix:             "dnt",
+			ChangeController:   "W3C",
+			Reference:          "[Tracking Preference Expression (DNT)]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"dnt-policy.txt": WellKnownURI{
+			Suffix:             "dnt-policy.txt",
+			ChangeController:   "EFF",
+			Reference:          "[Do Not Track Compliance Policy]",
+			Status:             Provisional,
+			RelatedInformation: "",
+		},
+		"est": WellKnownURI{
+			Suffix:             "est",
+			ChangeController:   "IETF",
+			Reference:          "[RFC7030]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"genid": WellKnownURI{
+			Suffix:             "genid",
+			ChangeController:   "W3C",
+			Reference:          "[RDF 1.1 Concepts and Abstract Syntax]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"gpc.json": WellKnownURI{
+			Suffix:             "gpc.json",
+			ChangeController:   "Global Privacy Control",
+			Reference:          "[Global Privacy Control]",
+			Status:             Provisional,
+			RelatedInformation: "",
+		},
+		"hoba": WellKnownURI{
+			Suffix:             "hoba",
+			ChangeController:   "IETF",
+			Reference:          "[RFC7486]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"host-meta": WellKnownURI{
+			Suffix:             "host-meta",
+			ChangeController:   "IETF",
+			Reference:          "[RFC6415]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"host-meta.json": WellKnownURI{
+			Suffix:             "host-meta.json",
+			ChangeController:   "IETF",
+			Reference:          "[RFC6415]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"http-opportunistic": WellKnownURI{
+			Suffix:             "http-opportunistic",
+			ChangeController:   "IETF",
+			Reference:          "[RFC8164]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"keybase.txt": WellKnownURI{
+			Suffix:             "keybase.txt",
+			ChangeController:   "Keybase",
+			Reference:          "[Keybase Proofs]",
+			Status:             Provisional,
+			RelatedInformation: "",
+		},
+		"looking-glass": WellKnownURI{
+			Suffix:             "looking-glass",
+			ChangeController:   "IETF",
+			Reference:          "[RFC8522]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"matrix": WellKnownURI{
+			Suffix:             "matrix",
+			ChangeController:   "The Matrix.org Foundation",
+			Reference:          "[Matrix Specification]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"mta-sts.txt": WellKnownURI{
+			Suffix:             "mta-sts.txt",
+			ChangeController:   "IETF",
+			Reference:          "[RFC8461]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"ni": WellKnownURI{
+			Suffix:             "ni",
+			ChangeController:   "IETF",
+			Reference:          "[RFC6920]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"nodeinfo": WellKnownURI{
+			Suffix:             "nodeinfo",
+			ChangeController:   "NodeInfo",
+			Reference:          "[NodeInfo Protocol]",
+			Status:             Provisional,
+			RelatedInformation: "",
+		},
+		"oauth-authorization-server": WellKnownURI{
+			Suffix:             "oauth-authorization-server",
+			ChangeController:   "IETF",
+			Reference:          "[RFC8414]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"oauth-protected-resource": WellKnownURI{
+			Suffix:             "oauth-protected-resource",
+			ChangeController:   "IETF",
+			Reference:          "[RFC9728]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"ohttp-gateway": WellKnownURI{
+			Suffix:             "ohttp-gateway",
+			ChangeController:   "IETF",
+			Reference:          "[RFC9540]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"openid-configuration": WellKnownURI{
+			Suffix:             "openid-configuration",
+			ChangeController:   "OpenID Foundation",
+			Reference:          "[OpenID Connect Discovery 1.0]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"openpgpkey": WellKnownURI{
+			Suffix:             "openpgpkey",
+			ChangeController:   "IETF",
+			Reference:          "[OpenPGP Web Key Directory]",
+			Status:             Provisional,
+			RelatedInformation: "",
+		},
+		"pki-validation": WellKnownURI{
+			Suffix:             "pki-validation",
+			ChangeController:   "CA/Browser Forum",
+			Reference:          "[CA/Browser Forum Baseline Requirements]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"posh": WellKnownURI{
+			Suffix:             "posh",
+			ChangeController:   "IETF",
+			Reference:          "[RFC7711]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"reload-config": WellKnownURI{
+			Suffix:             "reload-config",
+			ChangeController:   "IETF",
+			Reference:          "[RFC6940]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"repute-template": WellKnownURI{
+			Suffix:             "repute-template",
+			ChangeController:   "IETF",
+			Reference:          "[RFC7072]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"security.txt": WellKnownURI{
+			Suffix:             "security.txt",
+			ChangeController:   "IETF",
+			Reference:          "[RFC9116]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"stun-key": WellKnownURI{
+			Suffix:             "stun-key",
+			ChangeController:   "IETF",
+			Reference:          "[draft-ietf-tram-stun-origin]",
+			Status:             Provisional,
+			RelatedInformation: "",
+		},
+		"timezone": WellKnownURI{
+			Suffix:             "timezone",
+			ChangeController:   "IETF",
+			Reference:          "[RFC7808]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"uma2-configuration": WellKnownURI{
+			Suffix:             "uma2-configuration",
+			ChangeController:   "Kantara Initiative",
+			Reference:          "[User-Managed Access (UMA) 2.0]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"void": WellKnownURI{
+			Suffix:             "void",
+			ChangeController:   "W3C",
+			Reference:          "[Describing Linked Datasets with the VoID Vocabulary]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+		"webfinger": WellKnownURI{
+			Suffix:             "webfinger",
+			ChangeController:   "IETF",
+			Reference:          "[RFC7033]",
+			Status:             Permanent,
+			RelatedInformation: "",
+		},
+	}
+}
@@ -0,0 +1,3980 @@
+package snapshots
+
+import "github.com/jakewilliami/defang-schemes"
+
+/*
+THIS FILE WAS AUTOMATICALLY GENERATED AT 2026-10-16 00:52:51
+
+Do not edit this file.  Run "go generate" to re-generate this file with an
+updated version of URI schemes from:
+    iana.org/assignments/uri-schemes/uri-schemes.xhtml.
+*/
+
+func init() {
+	register("2025_08", snapshot_2025_08)
+}
+
+func snapshot_2025_08() map[string]defang_schemes.Scheme {
+	return map[string]defang_schemes.Scheme{
+		"aaa": defang_schemes.Scheme{
+			Scheme:              "aaa",
+			DefangedScheme:      "axa",
+			Template:            "",
+			Description:         "Diameter Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6733]",
+			Notes:               "",
+		},
+		"aaas": defang_schemes.Scheme{
+			Scheme:              "aaas",
+			DefangedScheme:      "aaxs",
+			Template:            "",
+			Description:         "Diameter Protocol with Secure Transport",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6733]",
+			Notes:               "",
+		},
+		"about": defang_schemes.Scheme{
+			Scheme:              "about",
+			DefangedScheme:      "axxut",
+			Template:            "",
+			Description:         "about",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6694]",
+			Notes:               "",
+		},
+		"acap": defang_schemes.Scheme{
+			Scheme:              "acap",
+			DefangedScheme:      "acxp",
+			Template:            "",
+			Description:         "application configuration access protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2244]",
+			Notes:               "",
+		},
+		"acct": defang_schemes.Scheme{
+			Scheme:              "acct",
+			DefangedScheme:      "acxt",
+			Template:            "",
+			Description:         "acct",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7565]",
+			Notes:               "",
+		},
+		"acd": defang_schemes.Scheme{
+			Scheme:              "acd",
+			DefangedScheme:      "axd",
+			Template:            "prov/acd",
+			Description:         "acd",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Michael_Hedenus]",
+			Notes:               "",
+		},
+		"acr": defang_schemes.Scheme{
+			Scheme:              "acr",
+			DefangedScheme:      "axr",
+			Template:            "prov/acr",
+			Description:         "acr",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[OMA-OMNA]",
+			Notes:               "",
+		},
+		"adiumxtra": defang_schemes.Scheme{
+			Scheme:              "adiumxtra",
+			DefangedScheme:      "axxumxtra",
+			Template:            "prov/adiumxtra",
+			Description:         "adiumxtra",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"adt": defang_schemes.Scheme{
+			Scheme:              "adt",
+			DefangedScheme:      "axt",
+			Template:            "prov/adt",
+			Description:         "adt",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[SAP_SE]",
+			Notes:               "",
+		},
+		"afp": defang_schemes.Scheme{
+			Scheme:              "afp",
+			DefangedScheme:      "axp",
+			Template:            "prov/afp",
+			Description:         "afp",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"afs": defang_schemes.Scheme{
+			Scheme:              "afs",
+			DefangedScheme:      "axs",
+			Template:            "",
+			Description:         "Andrew File System global file names",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC1738]",
+			Notes:               "",
+		},
+		"aim": defang_schemes.Scheme{
+			Scheme:              "aim",
+			DefangedScheme:      "axm",
+			Template:            "prov/aim",
+			Description:         "aim",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"amss": defang_schemes.Scheme{
+			Scheme:              "amss",
+			DefangedScheme:      "amxs",
+			Template:            "prov/amss",
+			Description:         "amss",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RadioDNS_Project]",
+			Notes:               "",
+		},
+		"android": defang_schemes.Scheme{
+			Scheme:              "android",
+			DefangedScheme:      "axxroid",
+			Template:            "prov/android",
+			Description:         "android",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Adam_Barth][https://developer.android.com/guide/topics/manifest/manifest-intro]",
+			Notes:               "",
+		},
+		"appdata": defang_schemes.Scheme{
+			Scheme:              "appdata",
+			DefangedScheme:      "axxdata",
+			Template:            "prov/appdata",
+			Description:         "appdata",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"apt": defang_schemes.Scheme{
+			Scheme:              "apt",
+			DefangedScheme:      "axt",
+			Template:            "prov/apt",
+			Description:         "apt",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"ar": defang_schemes.Scheme{
+			Scheme:              "ar",
+			DefangedScheme:      "ax",
+			Template:            "prov/ar",
+			Description:         "ar",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Arweave_Team]",
+			Notes:               "",
+		},
+		"ari": defang_schemes.Scheme{
+			Scheme:              "ari",
+			DefangedScheme:      "axi",
+			Template:            "prov/ari",
+			Description:         "ari",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-ietf-dtn-ari-04]",
+			Notes:               "",
+		},
+		"ark": defang_schemes.Scheme{
+			Scheme:              "ark",
+			DefangedScheme:      "axk",
+			Template:            "prov/ark",
+			Description:         "ark",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[ARK_agency][https://n2t.net/ark:/21206/10015]",
+			Notes:               "",
+		},
+		"at": defang_schemes.Scheme{
+			Scheme:              "at",
+			DefangedScheme:      "ax",
+			Template:            "prov/at",
+			Description:         "at \n      (see [reviewer notes])",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Bluesky_PBLLC][Paul_Frazee]",
+			Notes:               "",
+		},
+		"attachment": defang_schemes.Scheme{
+			Scheme:              "attachment",
+			DefangedScheme:      "axxachment",
+			Template:            "prov/attachment",
+			Description:         "attachment",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"aw": defang_schemes.Scheme{
+			Scheme:              "aw",
+			DefangedScheme:      "ax",
+			Template:            "prov/aw",
+			Description:         "aw",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"barion": defang_schemes.Scheme{
+			Scheme:              "barion",
+			DefangedScheme:      "bxxion",
+			Template:            "prov/barion",
+			Description:         "barion",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Bíró_Tamás]",
+			Notes:               "",
+		},
+		"bb": defang_schemes.Scheme{
+			Scheme:              "bb",
+			DefangedScheme:      "bx",
+			Template:            "historic/bb",
+			Description:         "bb",
+			Status:              defang_schemes.Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[IESG]",
+			Notes:               "",
+		},
+		"beshare": defang_schemes.Scheme{
+			Scheme:              "beshare",
+			DefangedScheme:      "bxxhare",
+			Template:            "prov/beshare",
+			Description:         "beshare",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"bitcoin": defang_schemes.Scheme{
+			Scheme:              "bitcoin",
+			DefangedScheme:      "bxxcoin",
+			Template:            "prov/bitcoin",
+			Description:         "bitcoin",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"bitcoincash": defang_schemes.Scheme{
+			Scheme:              "bitcoincash",
+			DefangedScheme:      "bxxcoincash",
+			Template:            "prov/bitcoincash",
+			Description:         "bitcoincash",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Corentin_Mercier]",
+			Notes:               "",
+		},
+		"bl": defang_schemes.Scheme{
+			Scheme:              "bl",
+			DefangedScheme:      "bx",
+			Template:            "prov/bl",
+			Description:         "bluetooth (shortened)",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Daniel_Cowling]",
+			Notes:               "",
+		},
+		"blob": defang_schemes.Scheme{
+			Scheme:              "blob",
+			DefangedScheme:      "blxb",
+			Template:            "prov/blob",
+			Description:         "blob",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[W3C_WebApps_Working_Group][Chris_Rebert]",
+			Notes:               "",
+		},
+		"bluetooth": defang_schemes.Scheme{
+			Scheme:              "bluetooth",
+			DefangedScheme:      "bxxetooth",
+			Template:            "prov/bluetooth",
+			Description:         "bluetooth",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Daniel_Cowling]",
+			Notes:               "",
+		},
+		"bolo": defang_schemes.Scheme{
+			Scheme:              "bolo",
+			DefangedScheme:      "boxo",
+			Template:            "prov/bolo",
+			Description:         "bolo",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"brid": defang_schemes.Scheme{
+			Scheme:              "brid",
+			DefangedScheme:      "brxd",
+			Template:            "prov/brid",
+			Description:         "brid",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Jürgen_Grupp][Michael_Ranft][Sophie_Schenkel]",
+			Notes:               "",
+		},
+		"browserext": defang_schemes.Scheme{
+			Scheme:              "browserext",
+			DefangedScheme:      "bxxwserext",
+			Template:            "prov/browserext",
+			Description:         "browserext",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Mike_Pietraszak]",
+			Notes:               "",
+		},
+		"cabal": defang_schemes.Scheme{
+			Scheme:              "cabal",
+			DefangedScheme:      "cxxal",
+			Template:            "prov/cabal",
+			Description:         "cabal",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Frédéric_Wang][Cabal_Club]",
+			Notes:               "",
+		},
+		"calculator": defang_schemes.Scheme{
+			Scheme:              "calculator",
+			DefangedScheme:      "cxxculator",
+			Template:            "prov/calculator",
+			Description:         "calculator",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"callto": defang_schemes.Scheme{
+			Scheme:              "callto",
+			DefangedScheme:      "cxxlto",
+			Template:            "prov/callto",
+			Description:         "callto",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alexey_Melnikov]",
+			Notes:               "",
+		},
+		"cap": defang_schemes.Scheme{
+			Scheme:              "cap",
+			DefangedScheme:      "cxp",
+			Template:            "",
+			Description:         "Calendar Access Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4324]",
+			Notes:               "",
+		},
+		"cast": defang_schemes.Scheme{
+			Scheme:              "cast",
+			DefangedScheme:      "caxt",
+			Template:            "prov/cast",
+			Description:         "cast",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Adam_Barth][https://developers.google.com/cast/docs/registration]",
+			Notes:               "",
+		},
+		"casts": defang_schemes.Scheme{
+			Scheme:              "casts",
+			DefangedScheme:      "cxxts",
+			Template:            "prov/casts",
+			Description:         "casts",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Adam_Barth][https://developers.google.com/cast/docs/registration]",
+			Notes:               "",
+		},
+		"chrome": defang_schemes.Scheme{
+			Scheme:              "chrome",
+			DefangedScheme:      "cxxome",
+			Template:            "prov/chrome",
+			Description:         "chrome",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"chrome-extension": defang_schemes.Scheme{
+			Scheme:              "chrome-extension",
+			DefangedScheme:      "chrome[-]extension",
+			Template:            "prov/chrome-extension",
+			Description:         "chrome-extension",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"cid": defang_schemes.Scheme{
+			Scheme:              "cid",
+			DefangedScheme:      "cxd",
+			Template:            "",
+			Description:         "content identifier",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2392]",
+			Notes:               "",
+		},
+		"coap": defang_schemes.Scheme{
+			Scheme:              "coap",
+			DefangedScheme:      "coxp",
+			Template:            "",
+			Description:         "coap",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC7252]",
+			Reference:           "[RFC7252]",
+			Notes:               "",
+		},
+		"coap+tcp": defang_schemes.Scheme{
+			Scheme:              "coap+tcp",
+			DefangedScheme:      "coap[+]tcp",
+			Template:            "",
+			Description:         "coap+tcp \n      (see [reviewer notes])",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC8323]",
+			Reference:           "[RFC8323]",
+			Notes:               "",
+		},
+		"coap+ws": defang_schemes.Scheme{
+			Scheme:              "coap+ws",
+			DefangedScheme:      "coap[+]ws",
+			Template:            "",
+			Description:         "coap+ws \n      (see [reviewer notes])",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC8323]",
+			Reference:           "[RFC8323]",
+			Notes:               "",
+		},
+		"coaps": defang_schemes.Scheme{
+			Scheme:              "coaps",
+			DefangedScheme:      "cxxps",
+			Template:            "",
+			Description:         "coaps",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC7252]",
+			Reference:           "[RFC7252]",
+			Notes:               "",
+		},
+		"coaps+tcp": defang_schemes.Scheme{
+			Scheme:              "coaps+tcp",
+			DefangedScheme:      "coaps[+]tcp",
+			Template:            "",
+			Description:         "coaps+tcp \n      (see [reviewer notes])",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC8323]",
+			Reference:           "[RFC8323]",
+			Notes:               "",
+		},
+		"coaps+ws": defang_schemes.Scheme{
+			Scheme:              "coaps+ws",
+			DefangedScheme:      "coaps[+]ws",
+			Template:            "",
+			Description:         "coaps+ws \n      (see [reviewer notes])",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC8323]",
+			Reference:           "[RFC8323]",
+			Notes:               "",
+		},
+		"com-eventbrite-attendee": defang_schemes.Scheme{
+			Scheme:              "com-eventbrite-attendee",
+			DefangedScheme:      "com[-]eventbrite[-]attendee",
+			Template:            "prov/com-eventbrite-attendee",
+			Description:         "com-eventbrite-attendee",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Bob_Van_Zant]",
+			Notes:               "",
+		},
+		"content": defang_schemes.Scheme{
+			Scheme:              "content",
+			DefangedScheme:      "cxxtent",
+			Template:            "prov/content",
+			Description:         "content",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"content-type": defang_schemes.Scheme{
+			Scheme:              "content-type",
+			DefangedScheme:      "content[-]type",
+			Template:            "prov/content-type",
+			Description:         "content-type",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Donald_Eastlake]",
+			Notes:               "",
+		},
+		"crid": defang_schemes.Scheme{
+			Scheme:              "crid",
+			DefangedScheme:      "crxd",
+			Template:            "",
+			Description:         "TV-Anytime Content Reference Identifier",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4078]",
+			Notes:               "",
+		},
+		"cstr": defang_schemes.Scheme{
+			Scheme:              "cstr",
+			DefangedScheme:      "csxr",
+			Template:            "prov/cstr",
+			Description:         "cstr",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Wang_Shu]",
+			Notes:               "",
+		},
+		"cvs": defang_schemes.Scheme{
+			Scheme:              "cvs",
+			DefangedScheme:      "cxs",
+			Template:            "prov/cvs",
+			Description:         "cvs",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"dab": defang_schemes.Scheme{
+			Scheme:              "dab",
+			DefangedScheme:      "dxb",
+			Template:            "prov/dab",
+			Description:         "dab",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RadioDNS_Project]",
+			Notes:               "",
+		},
+		"dat": defang_schemes.Scheme{
+			Scheme:              "dat",
+			DefangedScheme:      "dxt",
+			Template:            "prov/dat",
+			Description:         "dat",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Frédéric_Wang][Paul_Frazee]",
+			Notes:               "",
+		},
+		"data": defang_schemes.Scheme{
+			Scheme:              "data",
+			DefangedScheme:      "daxa",
+			Template:            "",
+			Description:         "data",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2397]",
+			Notes:               "",
+		},
+		"dav": defang_schemes.Scheme{
+			Scheme:              "dav",
+			DefangedScheme:      "dxv",
+			Template:            "",
+			Description:         "dav",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4918]",
+			Notes:               "",
+		},
+		"dhttp": defang_schemes.Scheme{
+			Scheme:              "dhttp",
+			DefangedScheme:      "dxxtp",
+			Template:            "prov/dhttp",
+			Description:         "dhttp \n      (see [reviewer notes])",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Qi_Zhou]",
+			Notes:               "",
+		},
+		"diaspora": defang_schemes.Scheme{
+			Scheme:              "diaspora",
+			DefangedScheme:      "dxxspora",
+			Template:            "prov/diaspora",
+			Description:         "diaspora",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dennis_Schubert]",
+			Notes:               "",
+		},
+		"dict": defang_schemes.Scheme{
+			Scheme:              "dict",
+			DefangedScheme:      "dixt",
+			Template:            "",
+			Description:         "dictionary service protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2229]",
+			Notes:               "",
+		},
+		"did": defang_schemes.Scheme{
+			Scheme:              "did",
+			DefangedScheme:      "dxd",
+			Template:            "prov/did",
+			Description:         "did",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[W3C_Decentralized_Identifier_Working_Group][Manu_Sporny][Ivan_Herman]",
+			Notes:               "",
+		},
+		"dis": defang_schemes.Scheme{
+			Scheme:              "dis",
+			DefangedScheme:      "dxs",
+			Template:            "prov/dis",
+			Description:         "dis",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Christophe_Meessen]",
+			Notes:               "",
+		},
+		"dlna-playcontainer": defang_schemes.Scheme{
+			Scheme:              "dlna-playcontainer",
+			DefangedScheme:      "dlna[-]playcontainer",
+			Template:            "prov/dlna-playcontainer",
+			Description:         "dlna-playcontainer",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[DLNA]",
+			Notes:               "",
+		},
+		"dlna-playsingle": defang_schemes.Scheme{
+			Scheme:              "dlna-playsingle",
+			DefangedScheme:      "dlna[-]playsingle",
+			Template:            "prov/dlna-playsingle",
+			Description:         "dlna-playsingle",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[DLNA]",
+			Notes:               "",
+		},
+		"dns": defang_schemes.Scheme{
+			Scheme:              "dns",
+			DefangedScheme:      "dxs",
+			Template:            "",
+			Description:         "Domain Name System",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4501]",
+			Notes:               "",
+		},
+		"dntp": defang_schemes.Scheme{
+			Scheme:              "dntp",
+			DefangedScheme:      "dnxp",
+			Template:            "prov/dntp",
+			Description:         "dntp",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Hans-Dieter_A._Hiep]",
+			Notes:               "",
+		},
+		"doi": defang_schemes.Scheme{
+			Scheme:              "doi",
+			DefangedScheme:      "dxi",
+			Template:            "",
+			Description:         "doi",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[DOI URI Scheme][Pierre-Anthony_Lemieux][DOI_Foundation]",
+			Notes:               "",
+		},
+		"dpp": defang_schemes.Scheme{
+			Scheme:              "dpp",
+			DefangedScheme:      "dxp",
+			Template:            "prov/dpp",
+			Description:         "dpp",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Gaurav_Jain][Wi-Fi_Alliance]",
+			Notes:               "",
+		},
+		"drm": defang_schemes.Scheme{
+			Scheme:              "drm",
+			DefangedScheme:      "dxm",
+			Template:            "prov/drm",
+			Description:         "drm",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RadioDNS_Project]",
+			Notes:               "",
+		},
+		"drop": defang_schemes.Scheme{
+			Scheme:              "drop",
+			DefangedScheme:      "drxp",
+			Template:            "historic/drop",
+			Description:         "drop",
+			Status:              defang_schemes.Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[IESG]",
+			Notes:               "",
+		},
+		"dtmi": defang_schemes.Scheme{
+			Scheme:              "dtmi",
+			DefangedScheme:      "dtxi",
+			Template:            "prov/dtmi",
+			Description:         "dtmi",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"dtn": defang_schemes.Scheme{
+			Scheme:              "dtn",
+			DefangedScheme:      "dxn",
+			Template:            "",
+			Description:         "DTNRG research and development",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC9171]",
+			Notes:               "",
+		},
+		"dvb": defang_schemes.Scheme{
+			Scheme:              "dvb",
+			DefangedScheme:      "dxb",
+			Template:            "",
+			Description:         "dvb",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-mcroberts-uri-dvb-09]",
+			Notes:               "",
+		},
+		"dvx": defang_schemes.Scheme{
+			Scheme:              "dvx",
+			DefangedScheme:      "dxx",
+			Template:            "prov/dvx",
+			Description:         "dvx",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Clemens_Bastian]",
+			Notes:               "",
+		},
+		"dweb": defang_schemes.Scheme{
+			Scheme:              "dweb",
+			DefangedScheme:      "dwxb",
+			Template:            "prov/dweb",
+			Description:         "dweb",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Frédéric_Wang][Protocol_Labs]",
+			Notes:               "",
+		},
+		"ed2k": defang_schemes.Scheme{
+			Scheme:              "ed2k",
+			DefangedScheme:      "edxk",
+			Template:            "prov/ed2k",
+			Description:         "ed2k",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"eid": defang_schemes.Scheme{
+			Scheme:              "eid",
+			DefangedScheme:      "exd",
+			Template:            "prov/eid",
+			Description:         "eid",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[eSIM_Group_GSM_Association]",
+			Notes:               "",
+		},
+		"elsi": defang_schemes.Scheme{
+			Scheme:              "elsi",
+			DefangedScheme:      "elxi",
+			Template:            "prov/elsi",
+			Description:         "elsi",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Kimmo_Lindholm]",
+			Notes:               "",
+		},
+		"embedded": defang_schemes.Scheme{
+			Scheme:              "embedded",
+			DefangedScheme:      "exxedded",
+			Template:            "prov/embedded",
+			Description:         "embedded",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Peter_Hoddie]",
+			Notes:               "",
+		},
+		"ens": defang_schemes.Scheme{
+			Scheme:              "ens",
+			DefangedScheme:      "exs",
+			Template:            "prov/ens",
+			Description:         "ens",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Ricky_Bloomfield][Bradley_Nelson]",
+			Notes:               "",
+		},
+		"ethereum": defang_schemes.Scheme{
+			Scheme:              "ethereum",
+			DefangedScheme:      "exxereum",
+			Template:            "prov/ethereum",
+			Description:         "ethereum",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Frédéric_Wang][ligi]",
+			Notes:               "",
+		},
+		"example": defang_schemes.Scheme{
+			Scheme:              "example",
+			DefangedScheme:      "exxmple",
+			Template:            "",
+			Description:         "example",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7595]",
+			Notes:               "",
+		},
+		"facetime": defang_schemes.Scheme{
+			Scheme:              "facetime",
+			DefangedScheme:      "fxxetime",
+			Template:            "prov/facetime",
+			Description:         "facetime",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"fax": defang_schemes.Scheme{
+			Scheme:              "fax",
+			DefangedScheme:      "fxx",
+			Template:            "",
+			Description:         "fax",
+			Status:              defang_schemes.Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2806][RFC3966]",
+			Notes:               "",
+		},
+		"feed": defang_schemes.Scheme{
+			Scheme:              "feed",
+			DefangedScheme:      "fexd",
+			Template:            "prov/feed",
+			Description:         "feed",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"feedready": defang_schemes.Scheme{
+			Scheme:              "feedready",
+			DefangedScheme:      "fxxdready",
+			Template:            "prov/feedready",
+			Description:         "feedready",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Mirko_Nosenzo]",
+			Notes:               "",
+		},
+		"fido": defang_schemes.Scheme{
+			Scheme:              "fido",
+			DefangedScheme:      "fixo",
+			Template:            "prov/fido",
+			Description:         "fido",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Adam_Langley]",
+			Notes:               "",
+		},
+		"file": defang_schemes.Scheme{
+			Scheme:              "file",
+			DefangedScheme:      "fixe",
+			Template:            "",
+			Description:         "Host-specific file names",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC8089]",
+			Notes:               "",
+		},
+		"filesystem": defang_schemes.Scheme{
+			Scheme:              "filesystem",
+			DefangedScheme:      "fxxesystem",
+			Template:            "historic/filesystem",
+			Description:         "filesystem",
+			Status:              defang_schemes.Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[W3C_WebApps_Working_Group][Chris_Rebert]",
+			Notes:               "",
+		},
+		"finger": defang_schemes.Scheme{
+			Scheme:              "finger",
+			DefangedScheme:      "fxxger",
+			Template:            "prov/finger",
+			Description:         "finger",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"first-run-pen-experience": defang_schemes.Scheme{
+			Scheme:              "first-run-pen-experience",
+			DefangedScheme:      "first[-]run[-]pen[-]experience",
+			Template:            "prov/first-run-pen-experience",
+			Description:         "first-run-pen-experience",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"fish": defang_schemes.Scheme{
+			Scheme:              "fish",
+			DefangedScheme:      "fixh",
+			Template:            "prov/fish",
+			Description:         "fish",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"fm": defang_schemes.Scheme{
+			Scheme:              "fm",
+			DefangedScheme:      "fx",
+			Template:            "prov/fm",
+			Description:         "fm",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RadioDNS_Project]",
+			Notes:               "",
+		},
+		"ftp": defang_schemes.Scheme{
+			Scheme:              "ftp",
+			DefangedScheme:      "fxp",
+			Template:            "",
+			Description:         "File Transfer Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC1738]",
+			Notes:               "",
+		},
+		"fuchsia-pkg": defang_schemes.Scheme{
+			Scheme:              "fuchsia-pkg",
+			DefangedScheme:      "fuchsia[-]pkg",
+			Template:            "prov/fuchsia-pkg",
+			Description:         "fuchsia-pkg",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Adam_Barth][https://fuchsia.googlesource.com/fuchsia/]",
+			Notes:               "",
+		},
+		"geo": defang_schemes.Scheme{
+			Scheme:              "geo",
+			DefangedScheme:      "gxo",
+			Template:            "",
+			Description:         "Geographic Locations",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5870]",
+			Notes:               "",
+		},
+		"gg": defang_schemes.Scheme{
+			Scheme:              "gg",
+			DefangedScheme:      "gx",
+			Template:            "prov/gg",
+			Description:         "gg",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"git": defang_schemes.Scheme{
+			Scheme:              "git",
+			DefangedScheme:      "gxt",
+			Template:            "prov/git",
+			Description:         "git",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"gitoid": defang_schemes.Scheme{
+			Scheme:              "gitoid",
+			DefangedScheme:      "gxxoid",
+			Template:            "prov/gitoid",
+			Description:         "gitoid",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Ed_Warnicke]",
+			Notes:               "",
+		},
+		"gizmoproject": defang_schemes.Scheme{
+			Scheme:              "gizmoproject",
+			DefangedScheme:      "gxxmoproject",
+			Template:            "prov/gizmoproject",
+			Description:         "gizmoproject",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"go": defang_schemes.Scheme{
+			Scheme:              "go",
+			DefangedScheme:      "gx",
+			Template:            "",
+			Description:         "go",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3368]",
+			Notes:               "",
+		},
+		"gopher": defang_schemes.Scheme{
+			Scheme:              "gopher",
+			DefangedScheme:      "gxxher",
+			Template:            "",
+			Description:         "The Gopher Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4266]",
+			Notes:               "",
+		},
+		"graph": defang_schemes.Scheme{
+			Scheme:              "graph",
+			DefangedScheme:      "gxxph",
+			Template:            "prov/graph",
+			Description:         "graph",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alastair_Green]",
+			Notes:               "",
+		},
+		"grd": defang_schemes.Scheme{
+			Scheme:              "grd",
+			DefangedScheme:      "gxd",
+			Template:            "historic/grd",
+			Description:         "grd",
+			Status:              defang_schemes.Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[IESG]",
+			Notes:               "",
+		},
+		"gtalk": defang_schemes.Scheme{
+			Scheme:              "gtalk",
+			DefangedScheme:      "gxxlk",
+			Template:            "prov/gtalk",
+			Description:         "gtalk",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"h323": defang_schemes.Scheme{
+			Scheme:              "h323",
+			DefangedScheme:      "h3x3",
+			Template:            "",
+			Description:         "H.323",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3508]",
+			Notes:               "",
+		},
+		"ham": defang_schemes.Scheme{
+			Scheme:              "ham",
+			DefangedScheme:      "hxm",
+			Template:            "",
+			Description:         "ham",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7046]",
+			Notes:               "",
+		},
+		"hcap": defang_schemes.Scheme{
+			Scheme:              "hcap",
+			DefangedScheme:      "hcxp",
+			Template:            "prov/hcap",
+			Description:         "hcap",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"hcp": defang_schemes.Scheme{
+			Scheme:              "hcp",
+			DefangedScheme:      "hxp",
+			Template:            "prov/hcp",
+			Description:         "hcp",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alexey_Melnikov]",
+			Notes:               "",
+		},
+		"hs20": defang_schemes.Scheme{
+			Scheme:              "hs20",
+			DefangedScheme:      "hsx0",
+			Template:            "prov/hs20",
+			Description:         "hs20",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Bruno_Tomas]",
+			Notes:               "",
+		},
+		"http": defang_schemes.Scheme{
+			Scheme:              "http",
+			DefangedScheme:      "hxxp",
+			Template:            "",
+			Description:         "Hypertext Transfer Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC8615]",
+			Reference:           "[RFC9110, Section 4.2.1]",
+			Notes:               "",
+		},
+		"https": defang_schemes.Scheme{
+			Scheme:              "https",
+			DefangedScheme:      "hxxps",
+			Template:            "",
+			Description:         "Hypertext Transfer Protocol Secure",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC8615]",
+			Reference:           "[RFC9110, Section 4.2.2]",
+			Notes:               "",
+		},
+		"hxxp": defang_schemes.Scheme{
+			Scheme:              "hxxp",
+			DefangedScheme:      "hxxp",
+			Template:            "prov/hxxp",
+			Description:         "hxxp",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-salgado-hxxp-01]",
+			Notes:               "",
+		},
+		"hxxps": defang_schemes.Scheme{
+			Scheme:              "hxxps",
+			DefangedScheme:      "hxxps",
+			Template:            "prov/hxxps",
+			Description:         "hxxps",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-salgado-hxxp-01]",
+			Notes:               "",
+		},
+		"hydrazone": defang_schemes.Scheme{
+			Scheme:              "hydrazone",
+			DefangedScheme:      "hxxrazone",
+			Template:            "prov/hydrazone",
+			Description:         "hydrazone",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Matthias_Merkel][https://tech.hydrazone.pro/uri/specification/hydrazone.txt]",
+			Notes:               "",
+		},
+		"hyper": defang_schemes.Scheme{
+			Scheme:              "hyper",
+			DefangedScheme:      "hxxer",
+			Template:            "prov/hyper",
+			Description:         "hyper",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Frédéric_Wang][Paul_Frazee]",
+			Notes:               "",
+		},
+		"iax": defang_schemes.Scheme{
+			Scheme:              "iax",
+			DefangedScheme:      "ixx",
+			Template:            "",
+			Description:         "Inter-Asterisk eXchange Version 2",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5456]",
+			Notes:               "",
+		},
+		"icap": defang_schemes.Scheme{
+			Scheme:              "icap",
+			DefangedScheme:      "icxp",
+			Template:            "",
+			Description:         "Internet Content Adaptation Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3507]",
+			Notes:               "",
+		},
+		"icon": defang_schemes.Scheme{
+			Scheme:              "icon",
+			DefangedScheme:      "icxn",
+			Template:            "",
+			Description:         "icon",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-lafayette-icon-uri-scheme-01]",
+			Notes:               "",
+		},
+		"ilstring": defang_schemes.Scheme{
+			Scheme:              "ilstring",
+			DefangedScheme:      "ixxtring",
+			Template:            "prov/ilstring",
+			Description:         "ilstring",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[OPC_Foundation][https://webstore.iec.ch/en/publication/77973]",
+			Notes:               "",
+		},
+		"im": defang_schemes.Scheme{
+			Scheme:              "im",
+			DefangedScheme:      "ix",
+			Template:            "",
+			Description:         "Instant Messaging",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3860]",
+			Notes:               "",
+		},
+		"imap": defang_schemes.Scheme{
+			Scheme:              "imap",
+			DefangedScheme:      "imxp",
+			Template:            "",
+			Description:         "internet message access protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5092]",
+			Notes:               "",
+		},
+		"info": defang_schemes.Scheme{
+			Scheme:              "info",
+			DefangedScheme:      "inxo",
+			Template:            "",
+			Description:         "Information Assets with Identifiers in Public Namespaces. \n      [RFC4452] (section 3) defines an \"info\" registry \n        of public namespaces, which is maintained by NISO and can be accessed \n        from [http://info-uri.info/].",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4452]",
+			Notes:               "",
+		},
+		"iotdisco": defang_schemes.Scheme{
+			Scheme:              "iotdisco",
+			DefangedScheme:      "ixxdisco",
+			Template:            "prov/iotdisco",
+			Description:         "iotdisco",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Peter_Waher][https://www.iana.org/assignments/uri-schemes/prov/iotdisco.pdf]",
+			Notes:               "",
+		},
+		"ipfs": defang_schemes.Scheme{
+			Scheme:              "ipfs",
+			DefangedScheme:      "ipxs",
+			Template:            "prov/ipfs",
+			Description:         "ipfs",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Frédéric_Wang][Protocol_Labs]",
+			Notes:               "",
+		},
+		"ipn": defang_schemes.Scheme{
+			Scheme:              "ipn",
+			DefangedScheme:      "ixn",
+			Template:            "",
+			Description:         "ipn",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC9758]",
+			Notes:               "",
+		},
+		"ipns": defang_schemes.Scheme{
+			Scheme:              "ipns",
+			DefangedScheme:      "ipxs",
+			Template:            "prov/ipns",
+			Description:         "ipns",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Frédéric_Wang][Protocol_Labs]",
+			Notes:               "",
+		},
+		"ipp": defang_schemes.Scheme{
+			Scheme:              "ipp",
+			DefangedScheme:      "ixp",
+			Template:            "",
+			Description:         "Internet Printing Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3510]",
+			Notes:               "",
+		},
+		"ipps": defang_schemes.Scheme{
+			Scheme:              "ipps",
+			DefangedScheme:      "ipxs",
+			Template:            "",
+			Description:         "Internet Printing Protocol over HTTPS",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7472]",
+			Notes:               "",
+		},
+		"irc": defang_schemes.Scheme{
+			Scheme:              "irc",
+			DefangedScheme:      "ixc",
+			Template:            "prov/irc",
+			Description:         "irc",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"irc6": defang_schemes.Scheme{
+			Scheme:              "irc6",
+			DefangedScheme:      "irx6",
+			Template:            "prov/irc6",
+			Description:         "irc6",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"ircs": defang_schemes.Scheme{
+			Scheme:              "ircs",
+			DefangedScheme:      "irxs",
+			Template:            "prov/ircs",
+			Description:         "ircs",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"iris": defang_schemes.Scheme{
+			Scheme:              "iris",
+			DefangedScheme:      "irxs",
+			Template:            "",
+			Description:         "Internet Registry Information Service",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3981]",
+			Notes:               "",
+		},
+		"iris.beep": defang_schemes.Scheme{
+			Scheme:              "iris.beep",
+			DefangedScheme:      "iris[.]beep",
+			Template:            "",
+			Description:         "iris.beep",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3983]",
+			Notes:               "",
+		},
+		"iris.lwz": defang_schemes.Scheme{
+			Scheme:              "iris.lwz",
+			DefangedScheme:      "iris[.]lwz",
+			Template:            "",
+			Description:         "iris.lwz",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4993]",
+			Notes:               "",
+		},
+		"iris.xpc": defang_schemes.Scheme{
+			Scheme:              "iris.xpc",
+			DefangedScheme:      "iris[.]xpc",
+			Template:            "",
+			Description:         "iris.xpc",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4992]",
+			Notes:               "",
+		},
+		"iris.xpcs": defang_schemes.Scheme{
+			Scheme:              "iris.xpcs",
+			DefangedScheme:      "iris[.]xpcs",
+			Template:            "",
+			Description:         "iris.xpcs",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4992]",
+			Notes:               "",
+		},
+		"isostore": defang_schemes.Scheme{
+			Scheme:              "isostore",
+			DefangedScheme:      "ixxstore",
+			Template:            "prov/isostore",
+			Description:         "isostore",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"itms": defang_schemes.Scheme{
+			Scheme:              "itms",
+			DefangedScheme:      "itxs",
+			Template:            "prov/itms",
+			Description:         "itms",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"jabber": defang_schemes.Scheme{
+			Scheme:              "jabber",
+			DefangedScheme:      "jxxber",
+			Template:            "perm/jabber",
+			Description:         "jabber",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[Peter_Saint-Andre]",
+			Notes:               "",
+		},
+		"jar": defang_schemes.Scheme{
+			Scheme:              "jar",
+			DefangedScheme:      "jxr",
+			Template:            "prov/jar",
+			Description:         "jar",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"jms": defang_schemes.Scheme{
+			Scheme:              "jms",
+			DefangedScheme:      "jxs",
+			Template:            "",
+			Description:         "Java Message Service",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6167]",
+			Notes:               "",
+		},
+		"keyparc": defang_schemes.Scheme{
+			Scheme:              "keyparc",
+			DefangedScheme:      "kxxparc",
+			Template:            "prov/keyparc",
+			Description:         "keyparc",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"lastfm": defang_schemes.Scheme{
+			Scheme:              "lastfm",
+			DefangedScheme:      "lxxtfm",
+			Template:            "prov/lastfm",
+			Description:         "lastfm",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"lbry": defang_schemes.Scheme{
+			Scheme:              "lbry",
+			DefangedScheme:      "lbxy",
+			Template:            "prov/lbry",
+			Description:         "lbry",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alex_Grintsvayg]",
+			Notes:               "",
+		},
+		"ldap": defang_schemes.Scheme{
+			Scheme:              "ldap",
+			DefangedScheme:      "ldxp",
+			Template:            "",
+			Description:         "Lightweight Directory Access Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4516]",
+			Notes:               "",
+		},
+		"ldaps": defang_schemes.Scheme{
+			Scheme:              "ldaps",
+			DefangedScheme:      "lxxps",
+			Template:            "prov/ldaps",
+			Description:         "ldaps",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"leaptofrogans": defang_schemes.Scheme{
+			Scheme:              "leaptofrogans",
+			DefangedScheme:      "lxxptofrogans",
+			Template:            "",
+			Description:         "leaptofrogans",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC8589]",
+			Notes:               "",
+		},
+		"lid": defang_schemes.Scheme{
+			Scheme:              "lid",
+			DefangedScheme:      "lxd",
+			Template:            "prov/lid",
+			Description:         "lid",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[IS4]",
+			Notes:               "",
+		},
+		"lorawan": defang_schemes.Scheme{
+			Scheme:              "lorawan",
+			DefangedScheme:      "lxxawan",
+			Template:            "prov/lorawan",
+			Description:         "lorawan",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[OMA-DMSE]",
+			Notes:               "",
+		},
+		"lpa": defang_schemes.Scheme{
+			Scheme:              "lpa",
+			DefangedScheme:      "lxa",
+			Template:            "prov/lpa",
+			Description:         "lpa",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[eSIM_Group_GSM_Association]",
+			Notes:               "",
+		},
+		"lvlt": defang_schemes.Scheme{
+			Scheme:              "lvlt",
+			DefangedScheme:      "lvxt",
+			Template:            "prov/lvlt",
+			Description:         "lvlt",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alexander_Shishenko]",
+			Notes:               "",
+		},
+		"machineprovisioningprogressreporter": defang_schemes.Scheme{
+			Scheme:              "machineprovisioningprogressreporter",
+			DefangedScheme:      "mxxhineprovisioningprogressreporter",
+			Template:            "prov/machineProvisioningProgressReporter",
+			Description:         "Windows Autopilot Modern Device Management status updates",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"magnet": defang_schemes.Scheme{
+			Scheme:              "magnet",
+			DefangedScheme:      "mxxnet",
+			Template:            "prov/magnet",
+			Description:         "magnet",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"mailserver": defang_schemes.Scheme{
+			Scheme:              "mailserver",
+			DefangedScheme:      "mxxlserver",
+			Template:            "",
+			Description:         "Access to data available from mail servers",
+			Status:              defang_schemes.Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6196]",
+			Notes:               "",
+		},
+		"mailto": defang_schemes.Scheme{
+			Scheme:              "mailto",
+			DefangedScheme:      "mxxlto",
+			Template:            "",
+			Description:         "Electronic mail address",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6068]",
+			Notes:               "",
+		},
+		"maps": defang_schemes.Scheme{
+			Scheme:              "maps",
+			DefangedScheme:      "maxs",
+			Template:            "prov/maps",
+			Description:         "maps",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"market": defang_schemes.Scheme{
+			Scheme:              "market",
+			DefangedScheme:      "mxxket",
+			Template:            "prov/market",
+			Description:         "market",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"matrix": defang_schemes.Scheme{
+			Scheme:              "matrix",
+			DefangedScheme:      "mxxrix",
+			Template:            "prov/matrix",
+			Description:         "matrix",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Hubert_Chathi]",
+			Notes:               "",
+		},
+		"message": defang_schemes.Scheme{
+			Scheme:              "message",
+			DefangedScheme:      "mxxsage",
+			Template:            "prov/message",
+			Description:         "message",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"microsoft.windows.camera": defang_schemes.Scheme{
+			Scheme:              "microsoft.windows.camera",
+			DefangedScheme:      "microsoft[.]windows[.]camera",
+			Template:            "prov/microsoft.windows.camera",
+			Description:         "microsoft.windows.camera",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"microsoft.windows.camera.multipicker": defang_schemes.Scheme{
+			Scheme:              "microsoft.windows.camera.multipicker",
+			DefangedScheme:      "microsoft[.]windows[.]camera[.]multipicker",
+			Template:            "prov/microsoft.windows.camera.multipicker",
+			Description:         "microsoft.windows.camera.multipicker",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"microsoft.windows.camera.picker": defang_schemes.Scheme{
+			Scheme:              "microsoft.windows.camera.picker",
+			DefangedScheme:      "microsoft[.]windows[.]camera[.]picker",
+			Template:            "prov/microsoft.windows.camera.picker",
+			Description:         "microsoft.windows.camera.picker",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"mid": defang_schemes.Scheme{
+			Scheme:              "mid",
+			DefangedScheme:      "mxd",
+			Template:            "",
+			Description:         "message identifier",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2392]",
+			Notes:               "",
+		},
+		"mms": defang_schemes.Scheme{
+			Scheme:              "mms",
+			DefangedScheme:      "mxs",
+			Template:            "prov/mms",
+			Description:         "mms",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alexey_Melnikov]",
+			Notes:               "",
+		},
+		"modem": defang_schemes.Scheme{
+			Scheme:              "modem",
+			DefangedScheme:      "mxxem",
+			Template:            "",
+			Description:         "modem",
+			Status:              defang_schemes.Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2806][RFC3966]",
+			Notes:               "",
+		},
+		"mongodb": defang_schemes.Scheme{
+			Scheme:              "mongodb",
+			DefangedScheme:      "mxxgodb",
+			Template:            "prov/mongodb",
+			Description:         "mongodb",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Ignacio_Losiggio][Mongo_DB_Inc]",
+			Notes:               "",
+		},
+		"moz": defang_schemes.Scheme{
+			Scheme:              "moz",
+			DefangedScheme:      "mxz",
+			Template:            "prov/moz",
+			Description:         "moz",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Joe_Hildebrand]",
+			Notes:               "",
+		},
+		"ms-access": defang_schemes.Scheme{
+			Scheme:              "ms-access",
+			DefangedScheme:      "ms[-]access",
+			Template:            "prov/ms-access",
+			Description:         "ms-access",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-appinstaller": defang_schemes.Scheme{
+			Scheme:              "ms-appinstaller",
+			DefangedScheme:      "ms[-]appinstaller",
+			Template:            "prov/ms-appinstaller",
+			Description:         "ms-appinstaller",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-browser-extension": defang_schemes.Scheme{
+			Scheme:              "ms-browser-extension",
+			DefangedScheme:      "ms[-]browser[-]extension",
+			Template:            "prov/ms-browser-extension",
+			Description:         "ms-browser-extension",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-calculator": defang_schemes.Scheme{
+			Scheme:              "ms-calculator",
+			DefangedScheme:      "ms[-]calculator",
+			Template:            "prov/ms-calculator",
+			Description:         "ms-calculator",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-drive-to": defang_schemes.Scheme{
+			Scheme:              "ms-drive-to",
+			DefangedScheme:      "ms[-]drive[-]to",
+			Template:            "prov/ms-drive-to",
+			Description:         "ms-drive-to",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-enrollment": defang_schemes.Scheme{
+			Scheme:              "ms-enrollment",
+			DefangedScheme:      "ms[-]enrollment",
+			Template:            "prov/ms-enrollment",
+			Description:         "ms-enrollment",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-excel": defang_schemes.Scheme{
+			Scheme:              "ms-excel",
+			DefangedScheme:      "ms[-]excel",
+			Template:            "prov/ms-excel",
+			Description:         "ms-excel",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-eyecontrolspeech": defang_schemes.Scheme{
+			Scheme:              "ms-eyecontrolspeech",
+			DefangedScheme:      "ms[-]eyecontrolspeech",
+			Template:            "prov/ms-eyecontrolspeech",
+			Description:         "ms-eyecontrolspeech",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-gamebarservices": defang_schemes.Scheme{
+			Scheme:              "ms-gamebarservices",
+			DefangedScheme:      "ms[-]gamebarservices",
+			Template:            "prov/ms-gamebarservices",
+			Description:         "ms-gamebarservices",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-gamingoverlay": defang_schemes.Scheme{
+			Scheme:              "ms-gamingoverlay",
+			DefangedScheme:      "ms[-]gamingoverlay",
+			Template:            "prov/ms-gamingoverlay",
+			Description:         "ms-gamingoverlay",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-getoffice": defang_schemes.Scheme{
+			Scheme:              "ms-getoffice",
+			DefangedScheme:      "ms[-]getoffice",
+			Template:            "prov/ms-getoffice",
+			Description:         "ms-getoffice",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-help": defang_schemes.Scheme{
+			Scheme:              "ms-help",
+			DefangedScheme:      "ms[-]help",
+			Template:            "prov/ms-help",
+			Description:         "ms-help",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alexey_Melnikov]",
+			Notes:               "",
+		},
+		"ms-infopath": defang_schemes.Scheme{
+			Scheme:              "ms-infopath",
+			DefangedScheme:      "ms[-]infopath",
+			Template:            "prov/ms-infopath",
+			Description:         "ms-infopath",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-inputapp": defang_schemes.Scheme{
+			Scheme:              "ms-inputapp",
+			DefangedScheme:      "ms[-]inputapp",
+			Template:            "prov/ms-inputapp",
+			Description:         "ms-inputapp",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-launchremotedesktop": defang_schemes.Scheme{
+			Scheme:              "ms-launchremotedesktop",
+			DefangedScheme:      "ms[-]launchremotedesktop",
+			Template:            "prov/ms-launchremotedesktop",
+			Description:         "ms-launchremotedesktop",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-lockscreencomponent-config": defang_schemes.Scheme{
+			Scheme:              "ms-lockscreencomponent-config",
+			DefangedScheme:      "ms[-]lockscreencomponent[-]config",
+			Template:            "prov/ms-lockscreencomponent-config",
+			Description:         "ms-lockscreencomponent-config",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-media-stream-id": defang_schemes.Scheme{
+			Scheme:              "ms-media-stream-id",
+			DefangedScheme:      "ms[-]media[-]stream[-]id",
+			Template:            "prov/ms-media-stream-id",
+			Description:         "ms-media-stream-id",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-meetnow": defang_schemes.Scheme{
+			Scheme:              "ms-meetnow",
+			DefangedScheme:      "ms[-]meetnow",
+			Template:            "prov/ms-meetnow",
+			Description:         "ms-meetnow",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-mixedrealitycapture": defang_schemes.Scheme{
+			Scheme:              "ms-mixedrealitycapture",
+			DefangedScheme:      "ms[-]mixedrealitycapture",
+			Template:            "prov/ms-mixedrealitycapture",
+			Description:         "ms-mixedrealitycapture",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-mobileplans": defang_schemes.Scheme{
+			Scheme:              "ms-mobileplans",
+			DefangedScheme:      "ms[-]mobileplans",
+			Template:            "prov/ms-mobileplans",
+			Description:         "ms-mobileplans",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-newsandinterests": defang_schemes.Scheme{
+			Scheme:              "ms-newsandinterests",
+			DefangedScheme:      "ms[-]newsandinterests",
+			Template:            "prov/ms-newsandinterests",
+			Description:         "ms-newsandinterests",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-officeapp": defang_schemes.Scheme{
+			Scheme:              "ms-officeapp",
+			DefangedScheme:      "ms[-]officeapp",
+			Template:            "prov/ms-officeapp",
+			Description:         "ms-officeapp",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-people": defang_schemes.Scheme{
+			Scheme:              "ms-people",
+			DefangedScheme:      "ms[-]people",
+			Template:            "prov/ms-people",
+			Description:         "ms-people",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-personacard": defang_schemes.Scheme{
+			Scheme:              "ms-personacard",
+			DefangedScheme:      "ms[-]personacard",
+			Template:            "prov/ms-personacard",
+			Description:         "ms-personacard",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-powerpoint": defang_schemes.Scheme{
+			Scheme:              "ms-powerpoint",
+			DefangedScheme:      "ms[-]powerpoint",
+			Template:            "prov/ms-powerpoint",
+			Description:         "ms-powerpoint",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-project": defang_schemes.Scheme{
+			Scheme:              "ms-project",
+			DefangedScheme:      "ms[-]project",
+			Template:            "prov/ms-project",
+			Description:         "ms-project",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-publisher": defang_schemes.Scheme{
+			Scheme:              "ms-publisher",
+			DefangedScheme:      "ms[-]publisher",
+			Template:            "prov/ms-publisher",
+			Description:         "ms-publisher",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-recall": defang_schemes.Scheme{
+			Scheme:              "ms-recall",
+			DefangedScheme:      "ms[-]recall",
+			Template:            "prov/ms-recall",
+			Description:         "ms-recall",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-remotedesktop": defang_schemes.Scheme{
+			Scheme:              "ms-remotedesktop",
+			DefangedScheme:      "ms[-]remotedesktop",
+			Template:            "prov/ms-remotedesktop",
+			Description:         "ms-remotedesktop",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-remotedesktop-launch": defang_schemes.Scheme{
+			Scheme:              "ms-remotedesktop-launch",
+			DefangedScheme:      "ms[-]remotedesktop[-]launch",
+			Template:            "prov/ms-remotedesktop-launch",
+			Description:         "ms-remotedesktop-launch",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-restoretabcompanion": defang_schemes.Scheme{
+			Scheme:              "ms-restoretabcompanion",
+			DefangedScheme:      "ms[-]restoretabcompanion",
+			Template:            "prov/ms-restoretabcompanion",
+			Description:         "ms-restoretabcompanion",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-screenclip": defang_schemes.Scheme{
+			Scheme:              "ms-screenclip",
+			DefangedScheme:      "ms[-]screenclip",
+			Template:            "prov/ms-screenclip",
+			Description:         "ms-screenclip",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-screensketch": defang_schemes.Scheme{
+			Scheme:              "ms-screensketch",
+			DefangedScheme:      "ms[-]screensketch",
+			Template:            "prov/ms-screensketch",
+			Description:         "ms-screensketch",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-search": defang_schemes.Scheme{
+			Scheme:              "ms-search",
+			DefangedScheme:      "ms[-]search",
+			Template:            "prov/ms-search",
+			Description:         "ms-search",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-search-repair": defang_schemes.Scheme{
+			Scheme:              "ms-search-repair",
+			DefangedScheme:      "ms[-]search[-]repair",
+			Template:            "prov/ms-search-repair",
+			Description:         "ms-search-repair",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-secondary-screen-controller": defang_schemes.Scheme{
+			Scheme:              "ms-secondary-screen-controller",
+			DefangedScheme:      "ms[-]secondary[-]screen[-]controller",
+			Template:            "prov/ms-secondary-screen-controller",
+			Description:         "ms-secondary-screen-controller",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-secondary-screen-setup": defang_schemes.Scheme{
+			Scheme:              "ms-secondary-screen-setup",
+			DefangedScheme:      "ms[-]secondary[-]screen[-]setup",
+			Template:            "prov/ms-secondary-screen-setup",
+			Description:         "ms-secondary-screen-setup",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings": defang_schemes.Scheme{
+			Scheme:              "ms-settings",
+			DefangedScheme:      "ms[-]settings",
+			Template:            "prov/ms-settings",
+			Description:         "ms-settings",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-airplanemode": defang_schemes.Scheme{
+			Scheme:              "ms-settings-airplanemode",
+			DefangedScheme:      "ms[-]settings[-]airplanemode",
+			Template:            "prov/ms-settings-airplanemode",
+			Description:         "ms-settings-airplanemode",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-bluetooth": defang_schemes.Scheme{
+			Scheme:              "ms-settings-bluetooth",
+			DefangedScheme:      "ms[-]settings[-]bluetooth",
+			Template:            "prov/ms-settings-bluetooth",
+			Description:         "ms-settings-bluetooth",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-camera": defang_schemes.Scheme{
+			Scheme:              "ms-settings-camera",
+			DefangedScheme:      "ms[-]settings[-]camera",
+			Template:            "prov/ms-settings-camera",
+			Description:         "ms-settings-camera",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-cellular": defang_schemes.Scheme{
+			Scheme:              "ms-settings-cellular",
+			DefangedScheme:      "ms[-]settings[-]cellular",
+			Template:            "prov/ms-settings-cellular",
+			Description:         "ms-settings-cellular",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-cloudstorage": defang_schemes.Scheme{
+			Scheme:              "ms-settings-cloudstorage",
+			DefangedScheme:      "ms[-]settings[-]cloudstorage",
+			Template:            "prov/ms-settings-cloudstorage",
+			Description:         "ms-settings-cloudstorage",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-connectabledevices": defang_schemes.Scheme{
+			Scheme:              "ms-settings-connectabledevices",
+			DefangedScheme:      "ms[-]settings[-]connectabledevices",
+			Template:            "prov/ms-settings-connectabledevices",
+			Description:         "ms-settings-connectabledevices",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-displays-topology": defang_schemes.Scheme{
+			Scheme:              "ms-settings-displays-topology",
+			DefangedScheme:      "ms[-]settings[-]displays[-]topology",
+			Template:            "prov/ms-settings-displays-topology",
+			Description:         "ms-settings-displays-topology",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-emailandaccounts": defang_schemes.Scheme{
+			Scheme:              "ms-settings-emailandaccounts",
+			DefangedScheme:      "ms[-]settings[-]emailandaccounts",
+			Template:            "prov/ms-settings-emailandaccounts",
+			Description:         "ms-settings-emailandaccounts",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-language": defang_schemes.Scheme{
+			Scheme:              "ms-settings-language",
+			DefangedScheme:      "ms[-]settings[-]language",
+			Template:            "prov/ms-settings-language",
+			Description:         "ms-settings-language",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-location": defang_schemes.Scheme{
+			Scheme:              "ms-settings-location",
+			DefangedScheme:      "ms[-]settings[-]location",
+			Template:            "prov/ms-settings-location",
+			Description:         "ms-settings-location",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-lock": defang_schemes.Scheme{
+			Scheme:              "ms-settings-lock",
+			DefangedScheme:      "ms[-]settings[-]lock",
+			Template:            "prov/ms-settings-lock",
+			Description:         "ms-settings-lock",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-nfctransactions": defang_schemes.Scheme{
+			Scheme:              "ms-settings-nfctransactions",
+			DefangedScheme:      "ms[-]settings[-]nfctransactions",
+			Template:            "prov/ms-settings-nfctransactions",
+			Description:         "ms-settings-nfctransactions",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-notifications": defang_schemes.Scheme{
+			Scheme:              "ms-settings-notifications",
+			DefangedScheme:      "ms[-]settings[-]notifications",
+			Template:            "prov/ms-settings-notifications",
+			Description:         "ms-settings-notifications",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-power": defang_schemes.Scheme{
+			Scheme:              "ms-settings-power",
+			DefangedScheme:      "ms[-]settings[-]power",
+			Template:            "prov/ms-settings-power",
+			Description:         "ms-settings-power",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-privacy": defang_schemes.Scheme{
+			Scheme:              "ms-settings-privacy",
+			DefangedScheme:      "ms[-]settings[-]privacy",
+			Template:            "prov/ms-settings-privacy",
+			Description:         "ms-settings-privacy",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-proximity": defang_schemes.Scheme{
+			Scheme:              "ms-settings-proximity",
+			DefangedScheme:      "ms[-]settings[-]proximity",
+			Template:            "prov/ms-settings-proximity",
+			Description:         "ms-settings-proximity",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-screenrotation": defang_schemes.Scheme{
+			Scheme:              "ms-settings-screenrotation",
+			DefangedScheme:      "ms[-]settings[-]screenrotation",
+			Template:            "prov/ms-settings-screenrotation",
+			Description:         "ms-settings-screenrotation",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-wifi": defang_schemes.Scheme{
+			Scheme:              "ms-settings-wifi",
+			DefangedScheme:      "ms[-]settings[-]wifi",
+			Template:            "prov/ms-settings-wifi",
+			Description:         "ms-settings-wifi",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-settings-workplace": defang_schemes.Scheme{
+			Scheme:              "ms-settings-workplace",
+			DefangedScheme:      "ms[-]settings[-]workplace",
+			Template:            "prov/ms-settings-workplace",
+			Description:         "ms-settings-workplace",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-spd": defang_schemes.Scheme{
+			Scheme:              "ms-spd",
+			DefangedScheme:      "ms[-]spd",
+			Template:            "prov/ms-spd",
+			Description:         "ms-spd",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-stickers": defang_schemes.Scheme{
+			Scheme:              "ms-stickers",
+			DefangedScheme:      "ms[-]stickers",
+			Template:            "prov/ms-stickers",
+			Description:         "ms-stickers",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-sttoverlay": defang_schemes.Scheme{
+			Scheme:              "ms-sttoverlay",
+			DefangedScheme:      "ms[-]sttoverlay",
+			Template:            "prov/ms-sttoverlay",
+			Description:         "ms-sttoverlay",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-transit-to": defang_schemes.Scheme{
+			Scheme:              "ms-transit-to",
+			DefangedScheme:      "ms[-]transit[-]to",
+			Template:            "prov/ms-transit-to",
+			Description:         "ms-transit-to",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-useractivityset": defang_schemes.Scheme{
+			Scheme:              "ms-useractivityset",
+			DefangedScheme:      "ms[-]useractivityset",
+			Template:            "prov/ms-useractivityset",
+			Description:         "ms-useractivityset",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-uup": defang_schemes.Scheme{
+			Scheme:              "ms-uup",
+			DefangedScheme:      "ms[-]uup",
+			Template:            "prov/ms-uup",
+			Description:         "ms-uup",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-virtualtouchpad": defang_schemes.Scheme{
+			Scheme:              "ms-virtualtouchpad",
+			DefangedScheme:      "ms[-]virtualtouchpad",
+			Template:            "prov/ms-virtualtouchpad",
+			Description:         "ms-virtualtouchpad",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-visio": defang_schemes.Scheme{
+			Scheme:              "ms-visio",
+			DefangedScheme:      "ms[-]visio",
+			Template:            "prov/ms-visio",
+			Description:         "ms-visio",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-walk-to": defang_schemes.Scheme{
+			Scheme:              "ms-walk-to",
+			DefangedScheme:      "ms[-]walk[-]to",
+			Template:            "prov/ms-walk-to",
+			Description:         "ms-walk-to",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-whiteboard": defang_schemes.Scheme{
+			Scheme:              "ms-whiteboard",
+			DefangedScheme:      "ms[-]whiteboard",
+			Template:            "prov/ms-whiteboard",
+			Description:         "ms-whiteboard",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-whiteboard-cmd": defang_schemes.Scheme{
+			Scheme:              "ms-whiteboard-cmd",
+			DefangedScheme:      "ms[-]whiteboard[-]cmd",
+			Template:            "prov/ms-whiteboard-cmd",
+			Description:         "ms-whiteboard-cmd",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-widgetboard": defang_schemes.Scheme{
+			Scheme:              "ms-widgetboard",
+			DefangedScheme:      "ms[-]widgetboard",
+			Template:            "prov/ms-widgetboard",
+			Description:         "ms-widgetboard",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-widgets": defang_schemes.Scheme{
+			Scheme:              "ms-widgets",
+			DefangedScheme:      "ms[-]widgets",
+			Template:            "prov/ms-widgets",
+			Description:         "ms-widgets",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"ms-word": defang_schemes.Scheme{
+			Scheme:              "ms-word",
+			DefangedScheme:      "ms[-]word",
+			Template:            "prov/ms-word",
+			Description:         "ms-word",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"msnim": defang_schemes.Scheme{
+			Scheme:              "msnim",
+			DefangedScheme:      "mxxim",
+			Template:            "prov/msnim",
+			Description:         "msnim",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alexey_Melnikov]",
+			Notes:               "",
+		},
+		"msrp": defang_schemes.Scheme{
+			Scheme:              "msrp",
+			DefangedScheme:      "msxp",
+			Template:            "",
+			Description:         "Message Session Relay Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4975]",
+			Notes:               "",
+		},
+		"msrps": defang_schemes.Scheme{
+			Scheme:              "msrps",
+			DefangedScheme:      "mxxps",
+			Template:            "",
+			Description:         "Message Session Relay Protocol Secure",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4975][RFC8873]",
+			Notes:               "",
+		},
+		"mss": defang_schemes.Scheme{
+			Scheme:              "mss",
+			DefangedScheme:      "mxs",
+			Template:            "prov/mss",
+			Description:         "mss",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Jarmo_Miettinen]",
+			Notes:               "",
+		},
+		"mt": defang_schemes.Scheme{
+			Scheme:              "mt",
+			DefangedScheme:      "mx",
+			Template:            "perm/mt",
+			Description:         "Matter protocol on-boarding payloads that are encoded for use in QR Codes and/or NFC Tags",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[Connectivity_Standards_Alliance]",
+			Notes:               "",
+		},
+		"mtqp": defang_schemes.Scheme{
+			Scheme:              "mtqp",
+			DefangedScheme:      "mtxp",
+			Template:            "",
+			Description:         "Message Tracking Query Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3887]",
+			Notes:               "",
+		},
+		"mtrust": defang_schemes.Scheme{
+			Scheme:              "mtrust",
+			DefangedScheme:      "mxxust",
+			Template:            "prov/mtrust",
+			Description:         "mtrust",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Egbert_von_Frankenberg]",
+			Notes:               "",
+		},
+		"mumble": defang_schemes.Scheme{
+			Scheme:              "mumble",
+			DefangedScheme:      "mxxble",
+			Template:            "prov/mumble",
+			Description:         "mumble",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"mupdate": defang_schemes.Scheme{
+			Scheme:              "mupdate",
+			DefangedScheme:      "mxxdate",
+			Template:            "",
+			Description:         "Mailbox Update (MUPDATE) Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3656]",
+			Notes:               "",
+		},
+		"mvn": defang_schemes.Scheme{
+			Scheme:              "mvn",
+			DefangedScheme:      "mxn",
+			Template:            "prov/mvn",
+			Description:         "mvn",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"mvrp": defang_schemes.Scheme{
+			Scheme:              "mvrp",
+			DefangedScheme:      "mvxp",
+			Template:            "prov/mvrp",
+			Description:         "mvrp\n      (see [reviewer notes])",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Antonio_Walker]",
+			Notes:               "",
+		},
+		"mvrps": defang_schemes.Scheme{
+			Scheme:              "mvrps",
+			DefangedScheme:      "mxxps",
+			Template:            "prov/mvrps",
+			Description:         "mvrps\n      (see [reviewer notes])",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Antonio_Walker]",
+			Notes:               "",
+		},
+		"news": defang_schemes.Scheme{
+			Scheme:              "news",
+			DefangedScheme:      "nexs",
+			Template:            "",
+			Description:         "USENET news",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5538]",
+			Notes:               "",
+		},
+		"nfs": defang_schemes.Scheme{
+			Scheme:              "nfs",
+			DefangedScheme:      "nxs",
+			Template:            "",
+			Description:         "network file system protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2224]",
+			Notes:               "",
+		},
+		"ni": defang_schemes.Scheme{
+			Scheme:              "ni",
+			DefangedScheme:      "nx",
+			Template:            "",
+			Description:         "ni",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6920]",
+			Notes:               "",
+		},
+		"nih": defang_schemes.Scheme{
+			Scheme:              "nih",
+			DefangedScheme:      "nxh",
+			Template:            "",
+			Description:         "nih",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6920]",
+			Notes:               "",
+		},
+		"nntp": defang_schemes.Scheme{
+			Scheme:              "nntp",
+			DefangedScheme:      "nnxp",
+			Template:            "",
+			Description:         "USENET news using NNTP access",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5538]",
+			Notes:               "",
+		},
+		"notes": defang_schemes.Scheme{
+			Scheme:              "notes",
+			DefangedScheme:      "nxxes",
+			Template:            "prov/notes",
+			Description:         "notes",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-dconmy-notes-uri-scheme-02]",
+			Notes:               "",
+		},
+		"num": defang_schemes.Scheme{
+			Scheme:              "num",
+			DefangedScheme:      "nxm",
+			Template:            "prov/num",
+			Description:         "Namespace Utility Modules",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Elliott_Brown][https://www.numprotocol.com/specification]",
+			Notes:               "",
+		},
+		"ocf": defang_schemes.Scheme{
+			Scheme:              "ocf",
+			DefangedScheme:      "oxf",
+			Template:            "prov/ocf",
+			Description:         "ocf",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"oid": defang_schemes.Scheme{
+			Scheme:              "oid",
+			DefangedScheme:      "oxd",
+			Template:            "prov/oid",
+			Description:         "oid",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-larmouth-oid-iri-04]",
+			Notes:               "",
+		},
+		"onenote": defang_schemes.Scheme{
+			Scheme:              "onenote",
+			DefangedScheme:      "oxxnote",
+			Template:            "prov/onenote",
+			Description:         "onenote",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"onenote-cmd": defang_schemes.Scheme{
+			Scheme:              "onenote-cmd",
+			DefangedScheme:      "onenote[-]cmd",
+			Template:            "prov/onenote-cmd",
+			Description:         "onenote-cmd",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"opaquelocktoken": defang_schemes.Scheme{
+			Scheme:              "opaquelocktoken",
+			DefangedScheme:      "oxxquelocktoken",
+			Template:            "",
+			Description:         "opaquelocktokent",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4918]",
+			Notes:               "",
+		},
+		"openid": defang_schemes.Scheme{
+			Scheme:              "openid",
+			DefangedScheme:      "oxxnid",
+			Template:            "prov/openid",
+			Description:         "OpenID Connect",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[OpenID_Foundation_Artifact_Binding_Working_Group][OpenID Connect Core 1.0, Section 7.3]",
+			Notes:               "",
+		},
+		"openpgp4fpr": defang_schemes.Scheme{
+			Scheme:              "openpgp4fpr",
+			DefangedScheme:      "oxxnpgp4fpr",
+			Template:            "prov/openpgp4fpr",
+			Description:         "openpgp4fpr",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Wiktor_Kwapisiewicz]",
+			Notes:               "",
+		},
+		"otpauth": defang_schemes.Scheme{
+			Scheme:              "otpauth",
+			DefangedScheme:      "oxxauth",
+			Template:            "prov/otpauth",
+			Description:         "otpauth",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Frédéric_Wang][Thomas_Habets]",
+			Notes:               "",
+		},
+		"p1": defang_schemes.Scheme{
+			Scheme:              "p1",
+			DefangedScheme:      "px",
+			Template:            "historic/p1",
+			Description:         "p1",
+			Status:              defang_schemes.Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[IESG]",
+			Notes:               "",
+		},
+		"pack": defang_schemes.Scheme{
+			Scheme:              "pack",
+			DefangedScheme:      "paxk",
+			Template:            "historic/pack",
+			Description:         "pack",
+			Status:              defang_schemes.Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-shur-pack-uri-scheme-05]",
+			Notes:               "",
+		},
+		"palm": defang_schemes.Scheme{
+			Scheme:              "palm",
+			DefangedScheme:      "paxm",
+			Template:            "prov/palm",
+			Description:         "palm",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"paparazzi": defang_schemes.Scheme{
+			Scheme:              "paparazzi",
+			DefangedScheme:      "pxxarazzi",
+			Template:            "prov/paparazzi",
+			Description:         "paparazzi",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"payment": defang_schemes.Scheme{
+			Scheme:              "payment",
+			DefangedScheme:      "pxxment",
+			Template:            "historic/payment",
+			Description:         "payment",
+			Status:              defang_schemes.Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[IESG]",
+			Notes:               "",
+		},
+		"payto": defang_schemes.Scheme{
+			Scheme:              "payto",
+			DefangedScheme:      "pxxto",
+			Template:            "prov/payto",
+			Description:         "payto",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC8905]",
+			Notes:               "",
+		},
+		"pkcs11": defang_schemes.Scheme{
+			Scheme:              "pkcs11",
+			DefangedScheme:      "pxxs11",
+			Template:            "",
+			Description:         "PKCS#11",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7512]",
+			Notes:               "",
+		},
+		"platform": defang_schemes.Scheme{
+			Scheme:              "platform",
+			DefangedScheme:      "pxxtform",
+			Template:            "prov/platform",
+			Description:         "platform",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"pop": defang_schemes.Scheme{
+			Scheme:              "pop",
+			DefangedScheme:      "pxp",
+			Template:            "",
+			Description:         "Post Office Protocol v3",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2384]",
+			Notes:               "",
+		},
+		"pres": defang_schemes.Scheme{
+			Scheme:              "pres",
+			DefangedScheme:      "prxs",
+			Template:            "",
+			Description:         "Presence",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3859]",
+			Notes:               "",
+		},
+		"prospero": defang_schemes.Scheme{
+			Scheme:              "prospero",
+			DefangedScheme:      "pxxspero",
+			Template:            "",
+			Description:         "Prospero Directory Service",
+			Status:              defang_schemes.Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4157]",
+			Notes:               "",
+		},
+		"proxy": defang_schemes.Scheme{
+			Scheme:              "proxy",
+			DefangedScheme:      "pxxxy",
+			Template:            "prov/proxy",
+			Description:         "proxy",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"psyc": defang_schemes.Scheme{
+			Scheme:              "psyc",
+			DefangedScheme:      "psxc",
+			Template:            "prov/psyc",
+			Description:         "psyc",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"pttp": defang_schemes.Scheme{
+			Scheme:              "pttp",
+			DefangedScheme:      "ptxp",
+			Template:            "prov/pttp",
+			Description:         "pttp",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Tony_Deng][Tuan_Hoang][Bob_Hinkle][Mark_Chen]",
+			Notes:               "",
+		},
+		"pwid": defang_schemes.Scheme{
+			Scheme:              "pwid",
+			DefangedScheme:      "pwxd",
+			Template:            "prov/pwid",
+			Description:         "pwid",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Eld_Zierau]",
+			Notes:               "",
+		},
+		"qb": defang_schemes.Scheme{
+			Scheme:              "qb",
+			DefangedScheme:      "qx",
+			Template:            "prov/qb",
+			Description:         "qb",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Jan_Pokorny]",
+			Notes:               "",
+		},
+		"query": defang_schemes.Scheme{
+			Scheme:              "query",
+			DefangedScheme:      "qxxry",
+			Template:            "prov/query",
+			Description:         "query",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"quic-transport": defang_schemes.Scheme{
+			Scheme:              "quic-transport",
+			DefangedScheme:      "quic[-]transport",
+			Template:            "prov/quic-transport",
+			Description:         "quic-transport",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-vvv-webtransport-quic-00]",
+			Notes:               "",
+		},
+		"redis": defang_schemes.Scheme{
+			Scheme:              "redis",
+			DefangedScheme:      "rxxis",
+			Template:            "prov/redis",
+			Description:         "redis",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Chris_Rebert]",
+			Notes:               "",
+		},
+		"rediss": defang_schemes.Scheme{
+			Scheme:              "rediss",
+			DefangedScheme:      "rxxiss",
+			Template:            "prov/rediss",
+			Description:         "rediss",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Chris_Rebert]",
+			Notes:               "",
+		},
+		"reload": defang_schemes.Scheme{
+			Scheme:              "reload",
+			DefangedScheme:      "rxxoad",
+			Template:            "",
+			Description:         "reload",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6940]",
+			Notes:               "",
+		},
+		"res": defang_schemes.Scheme{
+			Scheme:              "res",
+			DefangedScheme:      "rxs",
+			Template:            "prov/res",
+			Description:         "res",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alexey_Melnikov]",
+			Notes:               "",
+		},
+		"resource": defang_schemes.Scheme{
+			Scheme:              "resource",
+			DefangedScheme:      "rxxource",
+			Template:            "prov/resource",
+			Description:         "resource",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"rmi": defang_schemes.Scheme{
+			Scheme:              "rmi",
+			DefangedScheme:      "rxi",
+			Template:            "prov/rmi",
+			Description:         "rmi",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"rsync": defang_schemes.Scheme{
+			Scheme:              "rsync",
+			DefangedScheme:      "rxxnc",
+			Template:            "",
+			Description:         "rsync",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5781]",
+			Notes:               "",
+		},
+		"rtmfp": defang_schemes.Scheme{
+			Scheme:              "rtmfp",
+			DefangedScheme:      "rxxfp",
+			Template:            "prov/rtmfp",
+			Description:         "rtmfp",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7425]",
+			Notes:               "",
+		},
+		"rtmp": defang_schemes.Scheme{
+			Scheme:              "rtmp",
+			DefangedScheme:      "rtxp",
+			Template:            "prov/rtmp",
+			Description:         "rtmp",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"rtsp": defang_schemes.Scheme{
+			Scheme:              "rtsp",
+			DefangedScheme:      "rtxp",
+			Template:            "",
+			Description:         "Real-Time Streaming Protocol (RTSP)",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2326][RFC7826]",
+			Notes:               "",
+		},
+		"rtsps": defang_schemes.Scheme{
+			Scheme:              "rtsps",
+			DefangedScheme:      "rxxps",
+			Template:            "",
+			Description:         "Real-Time Streaming Protocol (RTSP) over TLS",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2326][RFC7826]",
+			Notes:               "",
+		},
+		"rtspu": defang_schemes.Scheme{
+			Scheme:              "rtspu",
+			DefangedScheme:      "rxxpu",
+			Template:            "",
+			Description:         "Real-Time Streaming Protocol (RTSP) over unreliable datagram transport",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2326]",
+			Notes:               "",
+		},
+		"sarif": defang_schemes.Scheme{
+			Scheme:              "sarif",
+			DefangedScheme:      "sxxif",
+			Template:            "prov/sarif",
+			Description:         "sarif",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[OASIS_Open][Michael_C_Fanning][David_Keaton]",
+			Notes:               "",
+		},
+		"secondlife": defang_schemes.Scheme{
+			Scheme:              "secondlife",
+			DefangedScheme:      "sxxondlife",
+			Template:            "prov/secondlife",
+			Description:         "query",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"secret-token": defang_schemes.Scheme{
+			Scheme:              "secret-token",
+			DefangedScheme:      "secret[-]token",
+			Template:            "prov/secret-token",
+			Description:         "secret-token",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC8959]",
+			Notes:               "",
+		},
+		"service": defang_schemes.Scheme{
+			Scheme:              "service",
+			DefangedScheme:      "sxxvice",
+			Template:            "",
+			Description:         "service location",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2609]",
+			Notes:               "",
+		},
+		"session": defang_schemes.Scheme{
+			Scheme:              "session",
+			DefangedScheme:      "sxxsion",
+			Template:            "",
+			Description:         "session",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6787]",
+			Notes:               "",
+		},
+		"sftp": defang_schemes.Scheme{
+			Scheme:              "sftp",
+			DefangedScheme:      "sfxp",
+			Template:            "prov/sftp",
+			Description:         "query",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"sgn": defang_schemes.Scheme{
+			Scheme:              "sgn",
+			DefangedScheme:      "sxn",
+			Template:            "prov/sgn",
+			Description:         "sgn",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"shc": defang_schemes.Scheme{
+			Scheme:              "shc",
+			DefangedScheme:      "sxc",
+			Template:            "prov/shc",
+			Description:         "shc",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Josh_Mandel]",
+			Notes:               "",
+		},
+		"shelter": defang_schemes.Scheme{
+			Scheme:              "shelter",
+			DefangedScheme:      "sxxlter",
+			Template:            "prov/shelter",
+			Description:         "shelter",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[okTurtles_Foundation]",
+			Notes:               "",
+		},
+		"shttp": defang_schemes.Scheme{
+			Scheme:              "shttp",
+			DefangedScheme:      "sxxtp",
+			Template:            "",
+			Description:         "Secure Hypertext Transfer Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2660][Status change of HTTP experiments to Historic]",
+			Notes:               "OBSOLETE",
+		},
+		"sieve": defang_schemes.Scheme{
+			Scheme:              "sieve",
+			DefangedScheme:      "sxxve",
+			Template:            "",
+			Description:         "ManageSieve Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5804]",
+			Notes:               "",
+		},
+		"simpleledger": defang_schemes.Scheme{
+			Scheme:              "simpleledger",
+			DefangedScheme:      "sxxpleledger",
+			Template:            "prov/simpleledger",
+			Description:         "simpleledger",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[James_Cramer]",
+			Notes:               "",
+		},
+		"simplex": defang_schemes.Scheme{
+			Scheme:              "simplex",
+			DefangedScheme:      "sxxplex",
+			Template:            "prov/simplex",
+			Description:         "simplex",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Evgeny_Poberezkin]",
+			Notes:               "",
+		},
+		"sip": defang_schemes.Scheme{
+			Scheme:              "sip",
+			DefangedScheme:      "sxp",
+			Template:            "",
+			Description:         "session initiation protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3261]",
+			Notes:               "",
+		},
+		"sips": defang_schemes.Scheme{
+			Scheme:              "sips",
+			DefangedScheme:      "sixs",
+			Template:            "",
+			Description:         "secure session initiation protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3261]",
+			Notes:               "",
+		},
+		"skype": defang_schemes.Scheme{
+			Scheme:              "skype",
+			DefangedScheme:      "sxxpe",
+			Template:            "prov/skype",
+			Description:         "skype",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Alexey_Melnikov]",
+			Notes:               "",
+		},
+		"smb": defang_schemes.Scheme{
+			Scheme:              "smb",
+			DefangedScheme:      "sxb",
+			Template:            "prov/smb",
+			Description:         "smb",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"smp": defang_schemes.Scheme{
+			Scheme:              "smp",
+			DefangedScheme:      "sxp",
+			Template:            "prov/smp",
+			Description:         "smp",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Evgeny_Poberezkin]",
+			Notes:               "",
+		},
+		"sms": defang_schemes.Scheme{
+			Scheme:              "sms",
+			DefangedScheme:      "sxs",
+			Template:            "",
+			Description:         "Short Message Service",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5724]",
+			Notes:               "",
+		},
+		"smtp": defang_schemes.Scheme{
+			Scheme:              "smtp",
+			DefangedScheme:      "smxp",
+			Template:            "prov/smtp",
+			Description:         "smtp",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-melnikov-smime-msa-to-mda-03]",
+			Notes:               "",
+		},
+		"snews": defang_schemes.Scheme{
+			Scheme:              "snews",
+			DefangedScheme:      "sxxws",
+			Template:            "",
+			Description:         "NNTP over SSL/TLS",
+			Status:              defang_schemes.Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5538]",
+			Notes:               "",
+		},
+		"snmp": defang_schemes.Scheme{
+			Scheme:              "snmp",
+			DefangedScheme:      "snxp",
+			Template:            "",
+			Description:         "Simple Network Management Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4088]",
+			Notes:               "",
+		},
+		"soap.beep": defang_schemes.Scheme{
+			Scheme:              "soap.beep",
+			DefangedScheme:      "soap[.]beep",
+			Template:            "",
+			Description:         "soap.beep",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4227]",
+			Notes:               "",
+		},
+		"soap.beeps": defang_schemes.Scheme{
+			Scheme:              "soap.beeps",
+			DefangedScheme:      "soap[.]beeps",
+			Template:            "",
+			Description:         "soap.beeps",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4227]",
+			Notes:               "",
+		},
+		"soldat": defang_schemes.Scheme{
+			Scheme:              "soldat",
+			DefangedScheme:      "sxxdat",
+			Template:            "prov/soldat",
+			Description:         "soldat",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"spiffe": defang_schemes.Scheme{
+			Scheme:              "spiffe",
+			DefangedScheme:      "sxxffe",
+			Template:            "prov/spiffe",
+			Description:         "spiffe",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Evan_Gilman]",
+			Notes:               "",
+		},
+		"spotify": defang_schemes.Scheme{
+			Scheme:              "spotify",
+			DefangedScheme:      "sxxtify",
+			Template:            "prov/spotify",
+			Description:         "spotify",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"ssb": defang_schemes.Scheme{
+			Scheme:              "ssb",
+			DefangedScheme:      "sxb",
+			Template:            "prov/ssb",
+			Description:         "ssb",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Frédéric_Wang][Secure_Scuttlebutt_Consortium]",
+			Notes:               "",
+		},
+		"ssh": defang_schemes.Scheme{
+			Scheme:              "ssh",
+			DefangedScheme:      "sxh",
+			Template:            "prov/ssh",
+			Description:         "ssh",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"starknet": defang_schemes.Scheme{
+			Scheme:              "starknet",
+			DefangedScheme:      "sxxrknet",
+			Template:            "prov/starknet",
+			Description:         "starknet",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Abraham_Makovetsky]",
+			Notes:               "",
+		},
+		"steam": defang_schemes.Scheme{
+			Scheme:              "steam",
+			DefangedScheme:      "sxxam",
+			Template:            "prov/steam",
+			Description:         "steam",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"stun": defang_schemes.Scheme{
+			Scheme:              "stun",
+			DefangedScheme:      "stxn",
+			Template:            "",
+			Description:         "stun",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7064]",
+			Notes:               "",
+		},
+		"stuns": defang_schemes.Scheme{
+			Scheme:              "stuns",
+			DefangedScheme:      "sxxns",
+			Template:            "",
+			Description:         "stuns",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7064]",
+			Notes:               "",
+		},
+		"submit": defang_schemes.Scheme{
+			Scheme:              "submit",
+			DefangedScheme:      "sxxmit",
+			Template:            "prov/submit",
+			Description:         "submit",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-melnikov-smime-msa-to-mda-03]",
+			Notes:               "",
+		},
+		"svn": defang_schemes.Scheme{
+			Scheme:              "svn",
+			DefangedScheme:      "sxn",
+			Template:            "prov/svn",
+			Description:         "svn",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"swh": defang_schemes.Scheme{
+			Scheme:              "swh",
+			DefangedScheme:      "sxh",
+			Template:            "prov/swh",
+			Description:         "swh",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Software_Heritage][Stefano_Zacchiroli]",
+			Notes:               "",
+		},
+		"swid": defang_schemes.Scheme{
+			Scheme:              "swid",
+			DefangedScheme:      "swxd",
+			Template:            "prov/swid",
+			Description:         "swid \n\n      (see [reviewer notes])",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC9393, Section 5.1]",
+			Notes:               "",
+		},
+		"swidpath": defang_schemes.Scheme{
+			Scheme:              "swidpath",
+			DefangedScheme:      "sxxdpath",
+			Template:            "prov/swidpath",
+			Description:         "swidpath \n\n      (see [reviewer notes])",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC9393, Section 5.2]",
+			Notes:               "",
+		},
+		"tag": defang_schemes.Scheme{
+			Scheme:              "tag",
+			DefangedScheme:      "txg",
+			Template:            "",
+			Description:         "tag",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4151]",
+			Notes:               "",
+		},
+		"taler": defang_schemes.Scheme{
+			Scheme:              "taler",
+			DefangedScheme:      "txxer",
+			Template:            "prov/taler",
+			Description:         "taler",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-grothoff-taler-01]",
+			Notes:               "",
+		},
+		"teamspeak": defang_schemes.Scheme{
+			Scheme:              "teamspeak",
+			DefangedScheme:      "txxmspeak",
+			Template:            "prov/teamspeak",
+			Description:         "teamspeak",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"teapot": defang_schemes.Scheme{
+			Scheme:              "teapot",
+			DefangedScheme:      "txxpot",
+			Template:            "prov/teapot",
+			Description:         "teapot",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Karwan_Stark]",
+			Notes:               "",
+		},
+		"teapots": defang_schemes.Scheme{
+			Scheme:              "teapots",
+			DefangedScheme:      "txxpots",
+			Template:            "prov/teapots",
+			Description:         "teapots",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Karwan_Stark]",
+			Notes:               "",
+		},
+		"tel": defang_schemes.Scheme{
+			Scheme:              "tel",
+			DefangedScheme:      "txl",
+			Template:            "",
+			Description:         "telephone",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3966][RFC5341]",
+			Notes:               "",
+		},
+		"teliaeid": defang_schemes.Scheme{
+			Scheme:              "teliaeid",
+			DefangedScheme:      "txxiaeid",
+			Template:            "prov/teliaeid",
+			Description:         "teliaeid",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Peter_Lewandowski]",
+			Notes:               "",
+		},
+		"telnet": defang_schemes.Scheme{
+			Scheme:              "telnet",
+			DefangedScheme:      "txxnet",
+			Template:            "",
+			Description:         "Reference to interactive sessions",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4248]",
+			Notes:               "",
+		},
+		"tftp": defang_schemes.Scheme{
+			Scheme:              "tftp",
+			DefangedScheme:      "tfxp",
+			Template:            "",
+			Description:         "Trivial File Transfer Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3617]",
+			Notes:               "",
+		},
+		"things": defang_schemes.Scheme{
+			Scheme:              "things",
+			DefangedScheme:      "txxngs",
+			Template:            "prov/things",
+			Description:         "things",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"thismessage": defang_schemes.Scheme{
+			Scheme:              "thismessage",
+			DefangedScheme:      "txxsmessage",
+			Template:            "perm/thismessage",
+			Description:         "multipart/related relative reference resolution",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2557]",
+			Notes:               "",
+		},
+		"thzp": defang_schemes.Scheme{
+			Scheme:              "thzp",
+			DefangedScheme:      "thxp",
+			Template:            "historic/thzp",
+			Description:         "thzp",
+			Status:              defang_schemes.Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[IESG]",
+			Notes:               "",
+		},
+		"tip": defang_schemes.Scheme{
+			Scheme:              "tip",
+			DefangedScheme:      "txp",
+			Template:            "",
+			Description:         "Transaction Internet Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2371]",
+			Notes:               "",
+		},
+		"tn3270": defang_schemes.Scheme{
+			Scheme:              "tn3270",
+			DefangedScheme:      "txx270",
+			Template:            "",
+			Description:         "Interactive 3270 emulation sessions",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6270]",
+			Notes:               "",
+		},
+		"tool": defang_schemes.Scheme{
+			Scheme:              "tool",
+			DefangedScheme:      "toxl",
+			Template:            "prov/tool",
+			Description:         "tool",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Matthias_Merkel]",
+			Notes:               "",
+		},
+		"turn": defang_schemes.Scheme{
+			Scheme:              "turn",
+			DefangedScheme:      "tuxn",
+			Template:            "",
+			Description:         "turn",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7065]",
+			Notes:               "",
+		},
+		"turns": defang_schemes.Scheme{
+			Scheme:              "turns",
+			DefangedScheme:      "txxns",
+			Template:            "",
+			Description:         "turns",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7065]",
+			Notes:               "",
+		},
+		"tv": defang_schemes.Scheme{
+			Scheme:              "tv",
+			DefangedScheme:      "tx",
+			Template:            "",
+			Description:         "TV Broadcasts",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2838]",
+			Notes:               "",
+		},
+		"udp": defang_schemes.Scheme{
+			Scheme:              "udp",
+			DefangedScheme:      "uxp",
+			Template:            "prov/udp",
+			Description:         "udp",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"unreal": defang_schemes.Scheme{
+			Scheme:              "unreal",
+			DefangedScheme:      "uxxeal",
+			Template:            "prov/unreal",
+			Description:         "unreal",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"upt": defang_schemes.Scheme{
+			Scheme:              "upt",
+			DefangedScheme:      "uxt",
+			Template:            "historic/upt",
+			Description:         "upt",
+			Status:              defang_schemes.Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[IESG]",
+			Notes:               "",
+		},
+		"urn": defang_schemes.Scheme{
+			Scheme:              "urn",
+			DefangedScheme:      "uxn",
+			Template:            "",
+			Description:         "Uniform Resource Names",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC8141][IANA registryurn-namespaces]",
+			Notes:               "",
+		},
+		"ut2004": defang_schemes.Scheme{
+			Scheme:              "ut2004",
+			DefangedScheme:      "uxx004",
+			Template:            "prov/ut2004",
+			Description:         "ut2004",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"uuid-in-package": defang_schemes.Scheme{
+			Scheme:              "uuid-in-package",
+			DefangedScheme:      "uuid[-]in[-]package",
+			Template:            "prov/uuid-in-package",
+			Description:         "uuid-in-package",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Kunihiko_Sakamoto]",
+			Notes:               "",
+		},
+		"v-event": defang_schemes.Scheme{
+			Scheme:              "v-event",
+			DefangedScheme:      "v[-]event",
+			Template:            "prov/v-event",
+			Description:         "v-event",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-menderico-v-event-uri-00]",
+			Notes:               "",
+		},
+		"vemmi": defang_schemes.Scheme{
+			Scheme:              "vemmi",
+			DefangedScheme:      "vxxmi",
+			Template:            "",
+			Description:         "versatile multimedia interface",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2122]",
+			Notes:               "",
+		},
+		"ventrilo": defang_schemes.Scheme{
+			Scheme:              "ventrilo",
+			DefangedScheme:      "vxxtrilo",
+			Template:            "prov/ventrilo",
+			Description:         "ventrilo",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"ves": defang_schemes.Scheme{
+			Scheme:              "ves",
+			DefangedScheme:      "vxs",
+			Template:            "prov/ves",
+			Description:         "ves",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Jim_Zubov]",
+			Notes:               "",
+		},
+		"videotex": defang_schemes.Scheme{
+			Scheme:              "videotex",
+			DefangedScheme:      "vxxeotex",
+			Template:            "historic/videotex",
+			Description:         "videotex",
+			Status:              defang_schemes.Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[draft-mavrakis-videotex-url-spec-01][RFC2122][RFC3986]",
+			Notes:               "",
+		},
+		"view-source": defang_schemes.Scheme{
+			Scheme:              "view-source",
+			DefangedScheme:      "view[-]source",
+			Template:            "prov/view-source",
+			Description:         "view-source",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Mykyta_Yevstifeyev]",
+			Notes:               "",
+		},
+		"vnc": defang_schemes.Scheme{
+			Scheme:              "vnc",
+			DefangedScheme:      "vxc",
+			Template:            "",
+			Description:         "Remote Framebuffer Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC7869]",
+			Notes:               "",
+		},
+		"vscode": defang_schemes.Scheme{
+			Scheme:              "vscode",
+			DefangedScheme:      "vxxode",
+			Template:            "prov/vscode",
+			Description:         "vscode",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"vscode-insiders": defang_schemes.Scheme{
+			Scheme:              "vscode-insiders",
+			DefangedScheme:      "vscode[-]insiders",
+			Template:            "prov/vscode-insiders",
+			Description:         "vscode-insiders",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"vsls": defang_schemes.Scheme{
+			Scheme:              "vsls",
+			DefangedScheme:      "vsxs",
+			Template:            "prov/vsls",
+			Description:         "vsls",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[urischemeowners_at_microsoft.com]",
+			Notes:               "",
+		},
+		"w3": defang_schemes.Scheme{
+			Scheme:              "w3",
+			DefangedScheme:      "wx",
+			Template:            "prov/w3",
+			Description:         "w3 \n      (see [reviewer notes])",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Qi_Zhou]",
+			Notes:               "",
+		},
+		"wais": defang_schemes.Scheme{
+			Scheme:              "wais",
+			DefangedScheme:      "waxs",
+			Template:            "",
+			Description:         "Wide Area Information Servers",
+			Status:              defang_schemes.Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC4156]",
+			Notes:               "",
+		},
+		"wasm": defang_schemes.Scheme{
+			Scheme:              "wasm",
+			DefangedScheme:      "waxm",
+			Template:            "prov/wasm",
+			Description:         "wasm",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[W3C_WebAssembly_Community_Group]",
+			Notes:               "",
+		},
+		"wasm-js": defang_schemes.Scheme{
+			Scheme:              "wasm-js",
+			DefangedScheme:      "wasm[-]js",
+			Template:            "prov/wasm-js",
+			Description:         "wasm-js",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[W3C_WebAssembly_Community_Group]",
+			Notes:               "",
+		},
+		"wcr": defang_schemes.Scheme{
+			Scheme:              "wcr",
+			DefangedScheme:      "wxr",
+			Template:            "prov/wcr",
+			Description:         "wcr",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Jason_Dzubak]",
+			Notes:               "",
+		},
+		"web+ap": defang_schemes.Scheme{
+			Scheme:              "web+ap",
+			DefangedScheme:      "web[+]ap",
+			Template:            "prov/web+ap",
+			Description:         "web+ap",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Soni_L.]",
+			Notes:               "",
+		},
+		"web3": defang_schemes.Scheme{
+			Scheme:              "web3",
+			DefangedScheme:      "wex3",
+			Template:            "prov/web3",
+			Description:         "web3",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Qi_Zhou]",
+			Notes:               "",
+		},
+		"webcal": defang_schemes.Scheme{
+			Scheme:              "webcal",
+			DefangedScheme:      "wxxcal",
+			Template:            "prov/webcal",
+			Description:         "webcal",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"wifi": defang_schemes.Scheme{
+			Scheme:              "wifi",
+			DefangedScheme:      "wixi",
+			Template:            "prov/wifi",
+			Description:         "wifi",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Wi-Fi_Alliance][Jun_Tian]",
+			Notes:               "",
+		},
+		"wpid": defang_schemes.Scheme{
+			Scheme:              "wpid",
+			DefangedScheme:      "wpxd",
+			Template:            "prov/wpid",
+			Description:         "wpid",
+			Status:              defang_schemes.Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[Eld_Zierau]",
+			Notes:               "",
+		},
+		"ws": defang_schemes.Scheme{
+			Scheme:              "ws",
+			DefangedScheme:      "wx",
+			Template:            "",
+			Description:         "WebSocket connections",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC8307]",
+			Reference:           "[RFC6455]",
+			Notes:               "",
+		},
+		"wss": defang_schemes.Scheme{
+			Scheme:              "wss",
+			DefangedScheme:      "wxs",
+			Template:            "",
+			Description:         "Encrypted WebSocket connections",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "[RFC8307]",
+			Reference:           "[RFC6455]",
+			Notes:               "",
+		},
+		"wtai": defang_schemes.Scheme{
+			Scheme:              "wtai",
+			DefangedScheme:      "wtxi",
+			Template:            "prov/wtai",
+			Description:         "wtai",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"wyciwyg": defang_schemes.Scheme{
+			Scheme:              "wyciwyg",
+			DefangedScheme:      "wxxiwyg",
+			Template:            "prov/wyciwyg",
+			Description:         "wyciwyg",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"xcon": defang_schemes.Scheme{
+			Scheme:              "xcon",
+			DefangedScheme:      "xcxn",
+			Template:            "",
+			Description:         "xcon",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6501]",
+			Notes:               "",
+		},
+		"xcon-userid": defang_schemes.Scheme{
+			Scheme:              "xcon-userid",
+			DefangedScheme:      "xcon[-]userid",
+			Template:            "",
+			Description:         "xcon-userid",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC6501]",
+			Notes:               "",
+		},
+		"xfire": defang_schemes.Scheme{
+			Scheme:              "xfire",
+			DefangedScheme:      "xxxre",
+			Template:            "prov/xfire",
+			Description:         "xfire",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"xftp": defang_schemes.Scheme{
+			Scheme:              "xftp",
+			DefangedScheme:      "xfxp",
+			Template:            "prov/xftp",
+			Description:         "xftp",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Evgeny_Poberezkin]",
+			Notes:               "",
+		},
+		"xmlrpc.beep": defang_schemes.Scheme{
+			Scheme:              "xmlrpc.beep",
+			DefangedScheme:      "xmlrpc[.]beep",
+			Template:            "",
+			Description:         "xmlrpc.beep",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3529]",
+			Notes:               "",
+		},
+		"xmlrpc.beeps": defang_schemes.Scheme{
+			Scheme:              "xmlrpc.beeps",
+			DefangedScheme:      "xmlrpc[.]beeps",
+			Template:            "",
+			Description:         "xmlrpc.beeps",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC3529]",
+			Notes:               "",
+		},
+		"xmpp": defang_schemes.Scheme{
+			Scheme:              "xmpp",
+			DefangedScheme:      "xmxp",
+			Template:            "",
+			Description:         "Extensible Messaging and Presence Protocol",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC5122]",
+			Notes:               "",
+		},
+		"xrcp": defang_schemes.Scheme{
+			Scheme:              "xrcp",
+			DefangedScheme:      "xrxp",
+			Template:            "prov/xrcp",
+			Description:         "xrcp",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Evgeny_Poberezkin]",
+			Notes:               "",
+		},
+		"xri": defang_schemes.Scheme{
+			Scheme:              "xri",
+			DefangedScheme:      "xxi",
+			Template:            "prov/xri",
+			Description:         "xri",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"ymsgr": defang_schemes.Scheme{
+			Scheme:              "ymsgr",
+			DefangedScheme:      "yxxgr",
+			Template:            "prov/ymsgr",
+			Description:         "ymsgr",
+			Status:              defang_schemes.Provisional,
+			WellKnownUriSupport: "",
+			Reference:           "[Dave_Thaler]",
+			Notes:               "",
+		},
+		"z39.50": defang_schemes.Scheme{
+			Scheme:              "z39.50",
+			DefangedScheme:      "z39[.]50",
+			Template:            "",
+			Description:         "Z39.50 information access",
+			Status:              defang_schemes.Historical,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC1738][RFC2056]",
+			Notes:               "",
+		},
+		"z39.50r": defang_schemes.Scheme{
+			Scheme:              "z39.50r",
+			DefangedScheme:      "z39[.]50r",
+			Template:            "",
+			Description:         "Z39.50 Retrieval",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2056]",
+			Notes:               "",
+		},
+		"z39.50s": defang_schemes.Scheme{
+			Scheme:              "z39.50s",
+			DefangedScheme:      "z39[.]50s",
+			Template:            "",
+			Description:         "Z39.50 Session",
+			Status:              defang_schemes.Permanent,
+			WellKnownUriSupport: "",
+			Reference:           "[RFC2056]",
+			Notes:               "",
+		},
+	}
+}
@@ -0,0 +1,62 @@
+// Dated snapshots of the URI scheme dataset
+//
+// Detections and reports can be reproduced against the scheme registry (and defang
+// algorithm) as they were at a given time, by selecting a snapshot at runtime:
+//
+//	schemes, ok := snapshots.Get("2025_08")
+//
+// Snapshots are named YYYY_MM, and are written by `go run tools/writeconsts/main.go -snapshot YYYY_MM`
+package snapshots
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+type snapshot struct {
+	once    sync.Once
+	build   func() map[string]defang_schemes.Scheme
+	schemes map[string]defang_schemes.Scheme
+}
+
+var registry = make(map[string]*snapshot)
+
+// Called by generated snapshot files
+func register(name string, build func() map[string]defang_schemes.Scheme) {
+	registry[name] = &snapshot{build: build}
+}
+
+// Names of the available snapshots, oldest first
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Name of the most recent snapshot
+func Latest() string {
+	names := Names()
+	if len(names) == 0 {
+		return ""
+	}
+	return names[len(names)-1]
+}
+
+// The dataset as of the named snapshot, built on first access.  The second return value
+// is false if there is no such snapshot.  The returned map is shared, and must not be
+// modified
+func Get(name string) (map[string]defang_schemes.Scheme, bool) {
+	s, ok := registry[name]
+	if !ok {
+		return nil, false
+	}
+	s.once.Do(func() {
+		s.schemes = s.build()
+	})
+	return s.schemes, true
+}
@@ -0,0 +1,47 @@
+package defang_schemes_test
+
+import (
+	"maps"
+	"path/filepath"
+	"testing"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+// A registry's changes, including removals, survive saving and loading
+func TestRegistryChangesPersist(t *testing.T) {
+	registry := defang_schemes.NewRegistry()
+	registry.Register(defang_schemes.Scheme{Scheme: "foo", DefangedScheme: "barz"})
+	gopher, _ := registry.Lookup("gopher")
+	gopher.Notes = "Overridden"
+	registry.Override(gopher)
+	registry.Remove("ftp")
+
+	store := defang_schemes.NewFileStore(filepath.Join(t.TempDir(), "schemes.json"))
+	if err := registry.SaveTo(store); err != nil {
+		t.Fatalf("SaveTo: %v", err)
+	}
+	loaded := defang_schemes.NewRegistry()
+	if err := loaded.LoadFrom(store); err != nil {
+		t.Fatalf("LoadFrom: %v", err)
+	}
+	if !maps.EqualFunc(loaded.Schemes(), registry.Schemes(), defang_schemes.Scheme.Equal) {
+		t.Error("registry loaded from its store differs from the registry saved")
+	}
+	if _, ok := loaded.Lookup("ftp"); ok {
+		t.Error("ftp, removed before saving, was registered on loading")
+	}
+}
+
+// Changes are found against the schemes the registry was created with, rather than the
+// generated data
+func TestRegistryChangesAgainstOwnSchemes(t *testing.T) {
+	store := defang_schemes.NewFileStore(filepath.Join(t.TempDir(), "schemes.json"))
+	schemes := map[string]defang_schemes.Scheme{"foo": {Scheme: "foo", DefangedScheme: "barz"}}
+	if err := defang_schemes.NewRegistry(defang_schemes.WithSchemes(schemes)).SaveTo(store); err != nil {
+		t.Fatalf("SaveTo: %v", err)
+	}
+	if changes, err := store.Load(); err != nil || len(changes.Schemes) != 0 || len(changes.Removed) != 0 {
+		t.Errorf("unchanged registry saved changes %+v (error: %v)", changes, err)
+	}
+}
@@ -0,0 +1,96 @@
+package defang_schemes_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/jakewilliami/defang-schemes"
+	"github.com/jakewilliami/defang-schemes/corpus"
+)
+
+// Streaming gives the same result as transforming the whole text, however it is split into
+// writes and reads
+func TestStreamsAgreeWithText(t *testing.T) {
+	var text strings.Builder
+	for _, c := range corpus.Cases() {
+		text.WriteString(c.Refanged + "\n")
+	}
+	// A line longer than the stream buffer, which must be split between URLs
+	for text.Len() < 3*defang_schemes.STREAM_BUFFER_SIZE {
+		text.WriteString("https://evil.test/x ")
+	}
+	text.WriteString("ftp://last.test")
+
+	var defanged bytes.Buffer
+	w := defang_schemes.NewDefangWriter(&defanged, nil)
+	if _, err := io.Copy(w, iotest.OneByteReader(strings.NewReader(text.String()))); err != nil {
+		t.Fatalf("streaming through DefangWriter: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("closing DefangWriter: %v", err)
+	}
+	want := defang_schemes.DefangText(text.String())
+	if defanged.String() != want {
+		t.Errorf("DefangWriter output differs from DefangText")
+	}
+
+	refanged, err := io.ReadAll(iotest.OneByteReader(defang_schemes.NewRefangReader(iotest.HalfReader(&defanged), nil)))
+	if err != nil {
+		t.Fatalf("streaming through RefangReader: %v", err)
+	}
+	if string(refanged) != defang_schemes.RefangText(want) {
+		t.Errorf("RefangReader output differs from RefangText")
+	}
+}
+
+// Flush writes out everything but a trailing partial indicator
+func TestDefangWriterFlush(t *testing.T) {
+	var defanged bytes.Buffer
+	w := defang_schemes.NewDefangWriter(&defanged, nil)
+	io.WriteString(w, "Beacon to https://evil.test/x then https://evil.te")
+	if err := w.Flush(); err != nil || defanged.String() != "Beacon to hxxps[://]evil[.]test/x then " {
+		t.Errorf("Flush wrote %q, %v, want %q", defanged.String(), err, "Beacon to hxxps[://]evil[.]test/x then ")
+	}
+}
+
+// An indicator truncated by the end of the stream is transformed or passed through as per
+// the TruncationPolicy
+func TestStreamTruncationPolicy(t *testing.T) {
+	cases := []struct {
+		policy         defang_schemes.TruncationPolicy
+		defang, refang string
+	}{
+		{defang_schemes.TruncationTransform, "Beacon to hxxps[://]evil[.]test/x then hxxps[://]evil[.]te", "see https://a.test then https://evil.te"},
+		{defang_schemes.TruncationPassThrough, "Beacon to hxxps[://]evil[.]test/x then https://evil.te", "see https://a.test then hxxps[://]evil[.]te"},
+	}
+	for _, c := range cases {
+		var defanged bytes.Buffer
+		w := defang_schemes.NewDefangWriter(&defanged, nil, defang_schemes.WithTruncationPolicy(c.policy))
+		io.WriteString(w, "Beacon to https://evil.test/x then https://evil.te")
+		if err := w.Close(); err != nil || defanged.String() != c.defang {
+			t.Errorf("DefangWriter with the %s policy wrote %q, %v, want %q", c.policy, defanged.String(), err, c.defang)
+		}
+
+		r := defang_schemes.NewRefangReader(strings.NewReader("see hxxps[://]a[.]test then hxxps[://]evil[.]te"), nil, defang_schemes.WithTruncationPolicy(c.policy))
+		if refanged, err := io.ReadAll(r); err != nil || string(refanged) != c.refang {
+			t.Errorf("RefangReader with the %s policy read %q, %v, want %q", c.policy, refanged, err, c.refang)
+		}
+	}
+}
+
+// A RefangReader returns content before the end of its line
+func TestRefangReaderDoesNotWaitForLine(t *testing.T) {
+	// The pipe is not closed until the first read returns, so the read must not wait for
+	// the end of the line
+	pr, pw := io.Pipe()
+	go io.WriteString(pw, "see hxxps[://]a[.]test then hxxps[://]evil[.]te")
+	buf := make([]byte, 64)
+	n, err := defang_schemes.NewRefangReader(pr, nil).Read(buf)
+	pw.Close()
+	if err != nil || string(buf[:n]) != "see https://a.test then " {
+		t.Errorf("Read returned %q, %v, want %q", buf[:n], err, "see https://a.test then ")
+	}
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jakewilliami/defang-schemes"
+	"github.com/jakewilliami/defang-schemes/corpus"
+)
+
+// A file of the bundle
+type BundleFile struct {
+	Name   string `json:"name"`
+	Size   int    `json:"size"`
+	SHA256 string `json:"sha256"`
+	data   []byte
+}
+
+// Describes the bundle, so that consumers can check they have a complete, consistent set
+type Manifest struct {
+	Version       string       `json:"version"`
+	SchemaVersion int          `json:"schema_version"`
+	Schemes       int          `json:"schemes"`
+	Files         []BundleFile `json:"files"`
+}
+
+// Scheme names, sorted
+func sortedNames(schemes map[string]defang_schemes.Scheme) []string {
+	names := make([]string, 0, len(schemes))
+	for name := range schemes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Write the dataset as YAML.  Every value is a double-quoted string (escaped as in JSON,
+// which is valid YAML), so no YAML library is needed
+func writeYAML(schemes map[string]defang_schemes.Scheme) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "schema_version: %d\nschemes:\n", defang_schemes.DATASET_SCHEMA_VERSION)
+	for _, name := range sortedNames(schemes) {
+		scheme := schemes[name]
+		fields := scheme.Record()
+		for i, column := range defang_schemes.CSV_COLUMNS {
+			prefix := "    "
+			if i == 0 {
+				prefix = "  - "
+			}
+			fmt.Fprintf(&b, "%s%s: %s\n", prefix, column, strconv.Quote(fields[i]))
+		}
+	}
+	return b.Bytes()
+}
+
+// Quote a string as an SQL literal
+func sqlQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// Write the dataset as an SQL script for SQLite (sqlite3 schemes.db < schemes.sql).  A
+// script, rather than a database file, keeps this tool free of a (cgo) SQLite driver
+func writeSQL(schemes map[string]defang_schemes.Scheme) []byte {
+	var b bytes.Buffer
+	b.WriteString("BEGIN TRANSACTION;\nCREATE TABLE schemes (\n")
+	for i, column := range defang_schemes.CSV_COLUMNS {
+		constraint := ""
+		switch column {
+		case "scheme":
+			constraint = " PRIMARY KEY"
+		case "defanged_scheme", "status":
+			constraint = " NOT NULL"
+		}
+		separator := ","
+		if i == len(defang_schemes.CSV_COLUMNS)-1 {
+			separator = ""
+		}
+		fmt.Fprintf(&b, "  %s TEXT%s%s\n", column, constraint, separator)
+	}
+	b.WriteString(");\n")
+	for _, name := range sortedNames(schemes) {
+		scheme := schemes[name]
+		fields := scheme.Record()
+		values := make([]string, len(fields))
+		for i, field := range fields {
+			values[i] = sqlQuote(field)
+		}
+		fmt.Fprintf(&b, "INSERT INTO schemes VALUES (%s);\n", strings.Join(values, ", "))
+	}
+	b.WriteString("COMMIT;\n")
+	return b.Bytes()
+}
+
+// Read a generated artifact embedded in the library
+func readArtifact(path string) []byte {
+	data, err := defang_schemes.Artifacts.ReadFile(path)
+	if err != nil {
+		fmt.Printf("[ERROR] Cannot read artifact \"%s\": %s\n", path, err)
+		os.Exit(1)
+	}
+	return data
+}
+
+func newBundleFile(name string, data []byte) BundleFile {
+	sum := sha256.Sum256(data)
+	return BundleFile{Name: name, Size: len(data), SHA256: hex.EncodeToString(sum[:]), data: data}
+}
+
+func main() {
+	version := flag.String("version", time.Now().Format("2006.01.02"), "version of the bundle, used in its file name and manifest")
+	outDir := flag.String("out", ".", "directory to write the bundle to")
+	flag.Parse()
+
+	schemes := defang_schemes.Schemes()
+	files := []BundleFile{
+		newBundleFile("dataset.json", readArtifact(defang_schemes.ARTIFACT_DATASET_JSON)),
+		newBundleFile("dataset.csv", readArtifact(defang_schemes.ARTIFACT_DATASET_CSV)),
+		newBundleFile("defang_rules.json", readArtifact(defang_schemes.ARTIFACT_DEFANG_RULES)),
+		newBundleFile("dataset.yaml", writeYAML(schemes)),
+		newBundleFile("schemes.sql", writeSQL(schemes)),
+		newBundleFile("defanged_schemes.regex", readArtifact(defang_schemes.ARTIFACT_DEFANGED_SCHEME_REGEX)),
+		newBundleFile("url.regex", readArtifact(defang_schemes.ARTIFACT_URL_REGEX)),
+		newBundleFile("test_vectors.json", corpus.JSON()),
+	}
+
+	manifest := Manifest{Version: *version, SchemaVersion: defang_schemes.DATASET_SCHEMA_VERSION, Schemes: len(schemes), Files: files}
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		fmt.Printf("[ERROR] Cannot encode manifest: %s\n", err)
+		os.Exit(1)
+	}
+	files = append(files, newBundleFile("MANIFEST.json", append(manifestData, '\n')))
+
+	// Write the archive, with every file under a versioned directory
+	name := "defang-schemes-" + *version
+	var archive bytes.Buffer
+	gz := gzip.NewWriter(&archive)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+	for _, file := range files {
+		header := &tar.Header{Name: name + "/" + file.Name, Mode: 0o644, Size: int64(file.Size), ModTime: now}
+		if err := tw.WriteHeader(header); err != nil {
+			fmt.Printf("[ERROR] Cannot write \"%s\" to archive: %s\n", file.Name, err)
+			os.Exit(1)
+		}
+		if _, err := tw.Write(file.data); err != nil {
+			fmt.Printf("[ERROR] Cannot write \"%s\" to archive: %s\n", file.Name, err)
+			os.Exit(1)
+		}
+		fmt.Printf("[INFO] Added %s (%d bytes)\n", file.Name, file.Size)
+	}
+	if err := tw.Close(); err != nil {
+		fmt.Printf("[ERROR] Cannot finish archive: %s\n", err)
+		os.Exit(1)
+	}
+	if err := gz.Close(); err != nil {
+		fmt.Printf("[ERROR] Cannot finish archive: %s\n", err)
+		os.Exit(1)
+	}
+
+	outFile := fmt.Sprintf("%s/%s.tar.gz", strings.TrimSuffix(*outDir, "/"), name)
+	if err := os.WriteFile(outFile, archive.Bytes(), 0o644); err != nil {
+		fmt.Printf("[ERROR] Cannot write \"%s\": %s\n", outFile, err)
+		os.Exit(1)
+	}
+	fmt.Printf("[INFO] Wrote %d bytes to \"%s\"\n", archive.Len(), outFile)
+}
@@ -1,99 +1,222 @@
 package main
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"maps"
 	"os"
+	"slices"
 	"strings"
+	"unicode"
 
 	"github.com/jakewilliami/defang-schemes"
+	"github.com/jakewilliami/defang-schemes/check"
+	"github.com/jakewilliami/defang-schemes/permanent"
 )
 
 type Scheme = defang_schemes.Scheme
 
-var SchemeMap = defang_schemes.Map
+// Schemes whose collisions are accepted, as given by the -allow flag
+var allowed []check.Option
 
-// Importantly, confirm that a defanged scheme is not still a valid scheme
-func defangedSchemeIsKnown(scheme Scheme, knownSchemes []Scheme) bool {
-	for _, knownScheme := range knownSchemes {
-		if scheme.DefangedScheme == knownScheme.Scheme {
-			return true
-		}
+// Importantly, confirm that no defanged schemes are known!
+func defangedSchemesAreNotValid(schemes []Scheme) []defang_schemes.Warning {
+	fmt.Println("[INFO] Checking that the defang algorithm does not produce any valid schemes")
+	return failOrWarn(check.DefangedSchemesAreNotValid(schemes, allowed...))
+}
+
+// Confirm that there exists a one-to-one mapping between a scheme and its defanged variant
+func defangedSchemesAreOneToOne(schemes []Scheme) []defang_schemes.Warning {
+	fmt.Println("[INFO] Checking that the defang algorithm is (kind of) invertible")
+	return failOrWarn(check.DefangedSchemesAreOneToOne(schemes, allowed...))
+}
+
+// Exit on the first violation that is not an allowed edge case (by default, HTTP[S] defangs
+// into the valid, albeit provisional, HXXP[S]; given that this is a common defang method,
+// we allow this), returning the rest as warnings
+func failOrWarn(report check.Report) []defang_schemes.Warning {
+	if failures := report.Failures(); len(failures) > 0 {
+		fmt.Printf("[ERROR] %s\n", failures[0])
+		os.Exit(1)
 	}
-	return false
+	return report.Warnings()
 }
 
-// Confirm that no defanged schemes are known!
-func defangedSchemesAreNotValid(schemes []Scheme) {
-	fmt.Println("[INFO] Checking that the defang algorithm does not produce any valid schemes")
-	http_warned := false
-	for _, scheme := range schemes {
-		if defangedSchemeIsKnown(scheme, schemes) {
-			// Warn on known edge-case
-			if scheme.Scheme == "http" || scheme.Scheme == "hxxp" || scheme.Scheme == "https" || scheme.Scheme == "hxxps" {
-				if !http_warned {
-					fmt.Println("[WARN] HTTP[S] defangs into a valid (albeit provisional) scheme.  Given that this is a common defang method, we will allow this")
-					http_warned = true
-				}
-			} else {
-				// Non-edge case error discovered.  Log and exit
-				fmt.Printf("[ERROR] Defanged scheme \"%s\" is still a valid scheme\n", scheme.DefangedScheme)
+// Report the warnings of a check; only the checks' driver prints
+func report(warnings []defang_schemes.Warning) {
+	for _, warning := range warnings {
+		fmt.Printf("[WARN] %s\n", warning.Message)
+	}
+}
+
+// Confirm that each curated example is of a registered scheme, is a single token (as the
+// CSV and lazy encodings assume), and round-trips through DefangURL and RefangURL
+func examplesRoundTrip() {
+	fmt.Println("[INFO] Checking that the curated scheme examples round-trip")
+	for name, examples := range defang_schemes.SCHEME_EXAMPLES {
+		if _, ok := defang_schemes.Lookup(name); !ok {
+			fmt.Printf("[WARN] Scheme \"%s\" has examples but is not in the generated data\n", name)
+			continue
+		}
+		for _, example := range examples {
+			if scheme, ok := defang_schemes.ExtractScheme(example); !ok || scheme != name || strings.ContainsFunc(example, unicode.IsSpace) {
+				fmt.Printf("[ERROR] Example \"%s\" is not a single URI of scheme \"%s\"\n", example, name)
+				os.Exit(1)
+			}
+			defanged, err := defang_schemes.DefangURL(example)
+			if err != nil {
+				fmt.Printf("[ERROR] Cannot defang example \"%s\": %s\n", example, err)
+				os.Exit(1)
+			}
+			if refanged, err := defang_schemes.RefangURL(defanged); err != nil || refanged != example {
+				fmt.Printf("[ERROR] Example \"%s\" defanged as \"%s\" but refanged as \"%s\" (error: %v)\n", example, defanged, refanged, err)
 				os.Exit(1)
 			}
 		}
 	}
 }
 
-// Confirm that there exists a one-to-one mapping between a scheme and its defanged variant
-func defangedSchemesAreOneToOne(schemes []Scheme) {
-	fmt.Println("[INFO] Checking that the defang algorithm is (kind of) invertible")
-	http_warned := false
-	seenDefangedSchemes := make(map[string]struct{})
+// Confirm that the defang rule of every generated scheme reproduces its defanged form, and
+// that no generated form falls outside DefangScheme and AlternativeDefangs
+func defangRulesReproduceSchemes(schemes []Scheme) {
+	fmt.Println("[INFO] Checking that the defang rules reproduce every defanged scheme")
 	for _, scheme := range schemes {
-		if _, exists := seenDefangedSchemes[scheme.DefangedScheme]; exists {
-			// Warn on known edge-case
-			if scheme.Scheme == "http" || scheme.Scheme == "hxxp" || scheme.Scheme == "https" || scheme.Scheme == "hxxps" {
-				if !http_warned {
-					fmt.Println("[WARN] HTTP[S] defanges into HXXP[S], which are valid (albeit provisional) schemes.  Given that these are provisional, we will allow this edge case")
-					http_warned = true
-				}
-			} else {
-				// Non-edge case error discovered
-				//
-				// Collect duplicate schemes for logging
-				var duplicateSchemes []string
-				for _, scheme1 := range schemes {
-					if scheme1.DefangedScheme == scheme.DefangedScheme {
-						duplicateSchemes = append(duplicateSchemes, scheme1.Scheme)
-					}
-				}
-				duplicates := strings.Join(duplicateSchemes, ", ")
-
-				// Log duplicates error
-				fmt.Printf("[ERROR] Defanged scheme \"%s\" is duplicated, meaning that re-fanging would be ambiguous due to the following offenders: %s\n", scheme.DefangedScheme, duplicates)
-				os.Exit(1)
-			}
+		rule, err := defang_schemes.DescribeDefang(scheme.Scheme, scheme.DefangedScheme)
+		if err != nil {
+			fmt.Printf("[ERROR] Cannot describe defang rule: %s\n", err)
+			os.Exit(1)
+		}
+		if applied := rule.Apply(); applied != scheme.DefangedScheme || rule.Case == defang_schemes.DefangCaseCustom {
+			fmt.Printf("[ERROR] Defang rule of \"%s\" (case %s) gives \"%s\", expected \"%s\"\n", scheme.Scheme, rule.Case, applied, scheme.DefangedScheme)
+			os.Exit(1)
 		}
-		seenDefangedSchemes[scheme.DefangedScheme] = struct{}{}
+	}
+}
+
+// Confirm that the embedded artifacts were generated from the current dataset
+func artifactsAreCurrent() {
+	fmt.Println("[INFO] Checking that the embedded artifacts are up to date")
+	embedded, err := defang_schemes.Artifacts.ReadFile(defang_schemes.ARTIFACT_DATASET_JSON)
+	if err != nil {
+		fmt.Printf("[ERROR] Cannot read embedded %s: %s\n", defang_schemes.ARTIFACT_DATASET_JSON, err)
+		os.Exit(1)
+	}
+	if defang_schemes.MINIMAL_DATA {
+		// The artifacts are written from the full data, so only the fields that minimal
+		// builds keep can be compared
+		artifact, err := defang_schemes.LoadFromJSON(bytes.NewReader(embedded))
+		if err != nil {
+			fmt.Printf("[ERROR] Cannot load embedded %s: %s\n", defang_schemes.ARTIFACT_DATASET_JSON, err)
+			os.Exit(1)
+		}
+		for name, scheme := range artifact {
+			artifact[name] = minimalFields(scheme)
+		}
+		if !maps.EqualFunc(artifact, defang_schemes.Schemes(), Scheme.Equal) {
+			fmt.Printf("[ERROR] Embedded %s is out of date; run tools/writeconsts\n", defang_schemes.ARTIFACT_DATASET_JSON)
+			os.Exit(1)
+		}
+	} else {
+		var dataset bytes.Buffer
+		if err := defang_schemes.WriteJSON(&dataset, defang_schemes.Schemes()); err != nil {
+			fmt.Printf("[ERROR] Cannot encode dataset as JSON: %s\n", err)
+			os.Exit(1)
+		}
+		if !bytes.Equal(embedded, dataset.Bytes()) {
+			fmt.Printf("[ERROR] Embedded %s is out of date; run tools/writeconsts\n", defang_schemes.ARTIFACT_DATASET_JSON)
+			os.Exit(1)
+		}
+	}
+	pattern, err := defang_schemes.Artifacts.ReadFile(defang_schemes.ARTIFACT_DEFANGED_SCHEME_REGEX)
+	if err != nil || strings.TrimSpace(string(pattern)) != defang_schemes.DefangedSchemePattern().String() {
+		fmt.Printf("[ERROR] Embedded %s is out of date (error: %v); run tools/writeartifacts\n", defang_schemes.ARTIFACT_DEFANGED_SCHEME_REGEX, err)
+		os.Exit(1)
+	}
+}
+
+// Only the fields of a scheme that builds with the defang_schemes_minimal tag keep
+func minimalFields(scheme Scheme) Scheme {
+	return Scheme{Scheme: scheme.Scheme, DefangedScheme: scheme.DefangedScheme, Status: scheme.Status}
+}
+
+// Confirm that the permanent subpackage holds exactly the Permanent schemes of the generated
+// data, and that the defanged forms of other schemes do not refang against it
+func permanentSubsetIsCurrent(permanentSchemes []Scheme) {
+	fmt.Println("[INFO] Checking that the permanent subpackage holds only the Permanent schemes")
+	if len(permanent.Schemes()) != len(permanentSchemes) {
+		fmt.Printf("[ERROR] permanent subpackage has %d schemes, but the generated data has %d Permanent schemes; run tools/writeconsts\n", len(permanent.Schemes()), len(permanentSchemes))
+		os.Exit(1)
+	}
+	for _, scheme := range permanentSchemes {
+		subset, ok := permanent.Lookup(scheme.Scheme)
+		if defang_schemes.MINIMAL_DATA {
+			// The subpackage keeps every field, but minimal builds of the generated data do not
+			subset = minimalFields(subset)
+		}
+		if !ok || !subset.Equal(scheme) {
+			fmt.Printf("[ERROR] Scheme \"%s\" in the permanent subpackage differs from the generated data; run tools/writeconsts\n", scheme.Scheme)
+			os.Exit(1)
+		}
+	}
+	for _, scheme := range slices.Concat(defang_schemes.ProvisionalSchemes(), defang_schemes.HistoricalSchemes()) {
+		if refanged, err := permanent.Refang(scheme.DefangedScheme); !errors.Is(err, defang_schemes.ErrUnknownDefangedScheme) {
+			fmt.Printf("[ERROR] Defanged form \"%s\" of %s scheme \"%s\" refangs as \"%s\" against the permanent subpackage\n", scheme.DefangedScheme, strings.ToLower(string(scheme.Status)), scheme.Scheme, refanged)
+			os.Exit(1)
+		}
+	}
+}
+
+// Confirm that the library was built with generated data, so that the checks below test
+// it rather than panicking, and that GenerateData leaves generated data alone
+func dataIsGenerated() {
+	fmt.Println("[INFO] Checking that the library was built with generated data")
+	if err := defang_schemes.DataGenerated(); err != nil {
+		fmt.Printf("[ERROR] %s\n", err)
+		os.Exit(1)
+	}
+	// GenerateData must not fetch anything when the data has been generated
+	if err := defang_schemes.GenerateData(context.Background(), defang_schemes.WithIANAURL("http://invalid.")); err != nil {
+		fmt.Printf("[ERROR] GenerateData fetched data although it has been generated: %s\n", err)
+		os.Exit(1)
 	}
 }
 
 func main() {
-	// Get schemes as list
-	schemes := make([]Scheme, 0, len(SchemeMap))
-	for _, scheme := range SchemeMap {
-		schemes = append(schemes, scheme)
+	allow := flag.String("allow", "", "comma-separated schemes whose collisions are accepted (default: the edge cases of the generated data, http[s] and hxxp[s])")
+	flag.Parse()
+	if *allow != "" {
+		allowed = append(allowed, check.WithAllowed(strings.Split(*allow, ",")...))
 	}
 
+	dataIsGenerated()
+
 	// Only check validity of permanent schemes (for now?)
 	fmt.Println("[WARN] Only checking validity of permanent URI schemes")
-	var permanentSchemes []Scheme
-	for _, scheme := range schemes {
-		if scheme.Status == defang_schemes.Permanent {
-			permanentSchemes = append(permanentSchemes, scheme)
-		}
-	}
+	permanentSchemes := defang_schemes.PermanentSchemes()
 
 	// Perform safety checks on defang algorithm
-	defangedSchemesAreNotValid(permanentSchemes)
-	defangedSchemesAreOneToOne(permanentSchemes)
+	report(defangedSchemesAreNotValid(permanentSchemes))
+	report(defangedSchemesAreOneToOne(permanentSchemes))
+
+	// Perform the same checks on the generated forms of other defang styles
+	for _, style := range defang_schemes.STYLES {
+		if style == defang_schemes.StyleHxx {
+			continue
+		}
+		fmt.Printf("[INFO] Checking defanged forms in the %s style\n", style)
+		styledSchemes := make([]Scheme, 0, len(permanentSchemes))
+		for _, scheme := range permanentSchemes {
+			scheme.DefangedScheme = scheme.DefangedAs(style)
+			styledSchemes = append(styledSchemes, scheme)
+		}
+		report(defangedSchemesAreNotValid(styledSchemes))
+		report(defangedSchemesAreOneToOne(styledSchemes))
+	}
+	examplesRoundTrip()
+	defangRulesReproduceSchemes(slices.Collect(maps.Values(defang_schemes.Schemes())))
+	permanentSubsetIsCurrent(permanentSchemes)
+	artifactsAreCurrent()
 }
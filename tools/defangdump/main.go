@@ -1,18 +1,25 @@
 package main
 
 import (
+	"bytes"
+	"flag"
 	"fmt"
+	"go/format"
+	"go/token"
 	"os"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/jakewilliami/defang-schemes"
 )
 
 type Scheme = defang_schemes.Scheme
 
-var SchemeMap = defang_schemes.Map
+var SchemeMap = defang_schemes.Schemes()
 
 type ByScheme []Scheme
 
@@ -21,13 +28,13 @@ func (a ByScheme) Len() int           { return len(a) }
 func (a ByScheme) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a ByScheme) Less(i, j int) bool { return a[i].Scheme < a[j].Scheme }
 
+// Regular expression to match camelCase words
+var CAMEL_CASE_PATTERN = regexp.MustCompile("([a-z])([A-Z])")
+
 // For formatting "constant" variables in Python
 func toScreamingSnake(input string) string {
-	// Regular expression to match camelCase words
-	re := regexp.MustCompile("([a-z])([A-Z])")
-
 	// Insert a space between camelCase words and replace spaces with underscores
-	snake := re.ReplaceAllString(input, "${1}_${2}")
+	snake := CAMEL_CASE_PATTERN.ReplaceAllString(input, "${1}_${2}")
 	snake = strings.ReplaceAll(snake, " ", "_")
 
 	// Convert to upper case
@@ -128,7 +135,71 @@ func constructPyDefangSchemeDict(schemes []Scheme, varName string) string {
 	return constructPyDict(rawSchemes, defangedSchemes, varName)
 }
 
+// Create Go source defining the schemes under the given package, so that other Go projects
+// can vendor a snapshot of the data without importing this module.  Exported identifiers
+// take the given prefix, so that the data can sit alongside an existing package's
+func constructGoFile(schemes []Scheme, packageName, importPath, prefix string) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by defangdump from github.com/jakewilliami/defang-schemes; DO NOT EDIT.\n")
+	fmt.Fprintf(&b, "// Generated on %s from %d schemes\n\n", time.Now().Format(time.DateOnly), len(schemes))
+	if importPath == "" {
+		fmt.Fprintf(&b, "package %s\n\n", packageName)
+	} else {
+		fmt.Fprintf(&b, "package %s // import %s\n\n", packageName, strconv.Quote(importPath))
+	}
+
+	fmt.Fprintf(&b, "// A URI scheme, as registered with IANA\n")
+	fmt.Fprintf(&b, "type %sScheme struct {\n", prefix)
+	fmt.Fprintf(&b, "Scheme, DefangedScheme, Template, Description, Status, WellKnownUriSupport, Reference, Notes string\nObsolete bool\nRelated, Examples []string\n}\n\n")
+
+	fmt.Fprintf(&b, "// Registered schemes, by name\n")
+	fmt.Fprintf(&b, "var %sSchemes = map[string]%sScheme{\n", prefix, prefix)
+	for _, scheme := range schemes {
+		fields := []string{scheme.Scheme, scheme.DefangedScheme, scheme.Template, scheme.Description, string(scheme.Status), scheme.WellKnownUriSupport, scheme.Reference, scheme.Notes}
+		quoted := make([]string, len(fields))
+		for i, field := range fields {
+			quoted[i] = strconv.Quote(field)
+		}
+		related, examples := "nil", "nil"
+		if len(scheme.Related) > 0 {
+			related = fmt.Sprintf("%#v", scheme.Related)
+		}
+		if len(scheme.Examples) > 0 {
+			examples = fmt.Sprintf("%#v", scheme.Examples)
+		}
+		fmt.Fprintf(&b, "%s: {%s, %t, %s, %s},\n", strconv.Quote(scheme.Scheme), strings.Join(quoted, ", "), scheme.Obsolete, related, examples)
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	fmt.Fprintf(&b, "// Defanged scheme → scheme\n")
+	fmt.Fprintf(&b, "var %sRefangedSchemes = map[string]string{\n", prefix)
+	seen := make(map[string]bool, len(schemes))
+	for _, scheme := range schemes {
+		// Keep the first scheme (in name order) of the known collisions, as Registry does
+		if seen[scheme.DefangedScheme] {
+			continue
+		}
+		seen[scheme.DefangedScheme] = true
+		fmt.Fprintf(&b, "%s: %s,\n", strconv.Quote(scheme.DefangedScheme), strconv.Quote(scheme.Scheme))
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	formatted, err := format.Source(b.Bytes())
+	if err != nil {
+		fmt.Printf("[ERROR] Cannot format generated Go code: %s\n", err)
+		os.Exit(1)
+	}
+	return formatted
+}
+
 func main() {
+	lang := flag.String("lang", "python", "language to dump code for (python or go)")
+	packageName := flag.String("package", "schemes", "package name of the generated Go code")
+	importPath := flag.String("import-path", "", "canonical import path of the generated Go package (optional)")
+	prefix := flag.String("prefix", "", "prefix of the exported identifiers of the generated Go code (e.g., \"URI\" for URISchemes)")
+	outFile := flag.String("o", "", "file to write the generated Go code to (default: standard output)")
+	flag.Parse()
+
 	// Get schemes as list
 	schemes := make([]Scheme, 0, len(SchemeMap))
 	for _, scheme := range SchemeMap {
@@ -136,6 +207,33 @@ func main() {
 	}
 	sort.Sort(ByScheme(schemes))
 
+	switch *lang {
+	case "python":
+	case "go":
+		if !token.IsIdentifier(*packageName) {
+			fmt.Printf("[ERROR] Invalid package name \"%s\"\n", *packageName)
+			os.Exit(1)
+		}
+		if *prefix != "" && (!token.IsIdentifier(*prefix) || !unicode.IsUpper([]rune(*prefix)[0])) {
+			fmt.Printf("[ERROR] Prefix \"%s\" must begin an exported identifier\n", *prefix)
+			os.Exit(1)
+		}
+		code := constructGoFile(schemes, *packageName, *importPath, *prefix)
+		if *outFile == "" {
+			fmt.Print(string(code))
+			return
+		}
+		if err := os.WriteFile(*outFile, code, 0o644); err != nil {
+			fmt.Printf("[ERROR] Cannot write \"%s\": %s\n", *outFile, err)
+			os.Exit(1)
+		}
+		fmt.Printf("[INFO] Wrote Go code for %d schemes to \"%s\"\n", len(schemes), *outFile)
+		return
+	default:
+		fmt.Printf("[ERROR] Unknown language \"%s\"; expected python or go\n", *lang)
+		os.Exit(1)
+	}
+
 	fmt.Print("Dumping Python code for defining schemes\n\n")
 	pyStr := constructPySchemeList(schemes, "schemes")
 	fmt.Print(pyStr, "\n\n")
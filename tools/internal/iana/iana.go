@@ -0,0 +1,281 @@
+// Shared access to the IANA URI schemes registry for the internal tools
+package iana
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	// https://stackoverflow.com/a/74328802
+	"github.com/nfx/go-htmltable"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+// IANA URI Schemes registry (based on RFC 7595)
+const URI_SCHEMES_URL = "https://www.iana.org/assignments/uri-schemes/uri-schemes.xhtml"
+
+// Row of the IANA URI Schemes registry
+type Scheme struct {
+	Scheme              string                `header:"URI Scheme"`
+	Template            string                `header:"Template"`
+	Description         string                `header:"Description"`
+	Status              defang_schemes.Status `header:"Status"`
+	WellKnownUriSupport string                `header:"Well-Known URI Support"`
+	Reference           string                `header:"Reference"`
+	Notes               string                `header:"Notes"`
+	// Parsed from the annotation of the scheme name, rather than a column of the table
+	Obsolete bool
+}
+
+func cleanNulls(scheme Scheme) Scheme {
+	val := reflect.ValueOf(&scheme).Elem()
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Field(i)
+		if field.Kind() == reflect.String && field.CanSet() {
+			if field.String() == "-" {
+				field.SetString("")
+			}
+		}
+	}
+	return scheme
+}
+
+var CLEAN_SCHEME_PATTERN = cleanSchemePattern()
+
+// Schemes from IANA can contain additional information in parentheses
+func cleanSchemePattern() *regexp.Regexp {
+	pattern := fmt.Sprintf(`^(%s)(?:\s+\((.*)\))?$`, defang_schemes.SchemeNamePattern())
+	return regexp.MustCompile(pattern)
+}
+
+// Mostly, the `URI Scheme` field is good, but there is a scheme called `shttp (OBSOLETE)`,
+// which we need to clean up.  Known annotations set the corresponding field; others are
+// kept in the notes
+func CleanScheme(scheme Scheme) (Scheme, error) {
+	scheme = cleanNulls(scheme)
+
+	schemeRaw := scheme.Scheme
+	matches := CLEAN_SCHEME_PATTERN.FindStringSubmatch(schemeRaw)
+
+	if matches == nil || len(matches) == 0 {
+		return scheme, fmt.Errorf("invalid scheme for \"%s\"", schemeRaw)
+	}
+
+	// Set the first match to the URI scheme
+	// NOTE: we start counting from 1 because the first element is the entire match
+	scheme.Scheme = matches[1]
+
+	// If the URI scheme holds additional information, parse it if known, or add it to notes
+	if len(matches) > 2 && matches[2] != "" {
+		switch annotation := strings.TrimSpace(matches[2]); strings.ToUpper(annotation) {
+		case "OBSOLETE":
+			scheme.Obsolete = true
+		default:
+			scheme.Notes = annotation
+		}
+	}
+
+	// Confirm we don't have any unhandled matching information
+	if len(matches) > 3 {
+		return scheme, fmt.Errorf("unhandled matching groups in scheme regex for \"%s\"", schemeRaw)
+	}
+
+	// Ensure scheme is lowercase
+	scheme.Scheme = strings.ToLower(scheme.Scheme)
+
+	// Return the (potentially modified) scheme
+	return scheme, nil
+}
+
+// Columns of the URI Schemes table that must have a value in every row.  The table parser
+// leaves a column empty if IANA renames (or removes) it, which would otherwise silently
+// produce a corrupt dataset
+var REQUIRED_COLUMNS = defang_schemes.IANA_REQUIRED_COLUMNS
+
+// Headers that IANA might use in place of those of Scheme.  go-htmltable maps columns by
+// struct tag, so these cannot be read directly; they are used to diagnose a renamed column,
+// whose header tag should then be updated
+var COLUMN_ALIASES = map[string][]string{
+	"URI Scheme":             {"Scheme", "URI Scheme Name", "Scheme Name"},
+	"Template":               {"Registration Template"},
+	"Description":            {"Name", "Scheme Description"},
+	"Well-Known URI Support": {"Well-Known URI", "Well-Known URIs"},
+	"Reference":              {"References"},
+}
+
+// Refuse to write a dataset with fewer schemes than this fraction of the current dataset;
+// IANA rarely removes schemes, so a large drop means the table was misread
+const MIN_SCHEME_FRACTION = defang_schemes.IANA_MIN_SCHEME_FRACTION
+
+// Headers of each table found on the page, as reported to htmltable.Logger
+type tableHeaders [][]string
+
+// Wrap htmltable.Logger to also record the columns of each table found, returning a
+// function that restores it
+func (t *tableHeaders) capture() func() {
+	logger := htmltable.Logger
+	htmltable.Logger = func(ctx context.Context, msg string, fields ...any) {
+		for i := 0; i+1 < len(fields); i += 2 {
+			if fields[i] == "columns" {
+				if columns, ok := fields[i+1].([]string); ok {
+					*t = append(*t, columns)
+				}
+			}
+		}
+		if logger != nil {
+			logger(ctx, msg, fields...)
+		}
+	}
+	return func() {
+		htmltable.Logger = logger
+	}
+}
+
+// Explain why the columns may be missing, from the tables found on the page
+func (t tableHeaders) diagnose(columns ...string) string {
+	for _, column := range columns {
+		for _, headers := range t {
+			// Other tables on the page may share headers with aliases (e.g., "Name")
+			if !resemblesSchemesTable(headers) {
+				continue
+			}
+			for _, header := range headers {
+				for _, alias := range COLUMN_ALIASES[column] {
+					if strings.EqualFold(header, alias) {
+						return fmt.Sprintf("IANA appears to have renamed column %q to %q; update the header tag in iana.Scheme", column, header)
+					}
+				}
+			}
+		}
+	}
+	if len(t) == 0 {
+		return "no table columns were reported by the parser"
+	}
+	return fmt.Sprintf("tables found have columns %v", [][]string(t))
+}
+
+// Whether a table has at least half of the columns of Scheme, so is likely the URI
+// Schemes table with some columns renamed
+func resemblesSchemesTable(headers []string) bool {
+	t := reflect.TypeOf(Scheme{})
+	shared, columns := 0, 0
+	for _, header := range headers {
+		if _, ok := fieldByHeader(t, header); ok {
+			shared++
+		}
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Tag.Get("header") != "" {
+			columns++
+		}
+	}
+	return 2*shared >= columns
+}
+
+// Check that each required column has a value in every row
+func checkColumns(table []Scheme, headers tableHeaders) error {
+	t := reflect.TypeOf(Scheme{})
+	for _, column := range REQUIRED_COLUMNS {
+		field, ok := fieldByHeader(t, column)
+		if !ok {
+			return fmt.Errorf("required column %q is not a field of iana.Scheme", column)
+		}
+
+		empty := 0
+		for _, row := range table {
+			if reflect.ValueOf(row).FieldByIndex(field.Index).String() == "" {
+				empty++
+			}
+		}
+		if empty > 0 {
+			return fmt.Errorf("required column %q is empty in %d of %d rows: %s", column, empty, len(table), headers.diagnose(column))
+		}
+	}
+	return nil
+}
+
+func fieldByHeader(t reflect.Type, header string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Tag.Get("header") == header {
+			return t.Field(i), true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// Get the URI Scheme table from IANA, and collect the cleaned, defanged, and validated
+// schemes into a map.  Changes to the format of the table (renamed columns, or missing
+// rows) are reported as errors rather than producing an empty or corrupt dataset
+// https://stackoverflow.com/a/42289198
+func FetchSchemes() (map[string]defang_schemes.Scheme, error) {
+	return FetchSchemesFrom(URI_SCHEMES_URL)
+}
+
+// As FetchSchemes, from a mirror (or fork) of the URI Scheme table at the given URL
+func FetchSchemesFrom(url string) (map[string]defang_schemes.Scheme, error) {
+	var headers tableHeaders
+	restore := headers.capture()
+	table, err := htmltable.NewSliceFromURL[Scheme](url)
+	restore()
+	if err != nil {
+		var columns []string
+		for column := range COLUMN_ALIASES {
+			columns = append(columns, column)
+		}
+		sort.Strings(columns)
+		return nil, fmt.Errorf("could not get table by %s: %w (%s)", url, err, headers.diagnose(columns...))
+	}
+
+	if current := len(defang_schemes.Schemes()); float64(len(table)) < MIN_SCHEME_FRACTION*float64(current) {
+		return nil, fmt.Errorf("table has only %d rows, but the current dataset has %d schemes: %s", len(table), current, headers.diagnose("URI Scheme"))
+	}
+	if err := checkColumns(table, headers); err != nil {
+		return nil, err
+	}
+
+	schemeMap := make(map[string]defang_schemes.Scheme, len(table))
+	for i := 0; i < len(table); i++ {
+		scheme, err := CleanScheme(table[i])
+		if err != nil {
+			return nil, err
+		}
+
+		schemeMap[scheme.Scheme] = defang_schemes.Scheme{
+			Scheme:              scheme.Scheme,
+			DefangedScheme:      defang_schemes.DefangScheme(scheme.Scheme),
+			Template:            defang_schemes.ResolveTemplate(scheme.Template),
+			Description:         scheme.Description,
+			Status:              scheme.Status,
+			WellKnownUriSupport: scheme.WellKnownUriSupport,
+			Reference:           scheme.Reference,
+			Notes:               scheme.Notes,
+			Obsolete:            scheme.Obsolete,
+		}
+		schemeToValidate := schemeMap[scheme.Scheme]
+		err = (&schemeToValidate).Validate()
+		if err != nil {
+			return nil, fmt.Errorf("invalid Scheme struct: %w; Scheme: %+v", err, scheme)
+		}
+	}
+
+	// Where DefangScheme's forms collide, choose alternatives such that refanging is
+	// unambiguous
+	defanged, unresolved := defang_schemes.DefangOneToOne(schemeMap)
+	if len(unresolved) > 0 {
+		return nil, fmt.Errorf("no collision-free defanged form for schemes: %s", strings.Join(unresolved, ", "))
+	}
+	// Relate schemes from their references, once every scheme is known
+	related := defang_schemes.RelateSchemes(schemeMap)
+	for name, scheme := range schemeMap {
+		scheme.DefangedScheme = defanged[name]
+		scheme.Related = related[name]
+		scheme.Examples = defang_schemes.SchemeExamples(name)
+		schemeMap[name] = scheme
+	}
+
+	return schemeMap, nil
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+// Cases of the fang/defang behaviour of ioc_fanger (https://github.com/ioc-fang/ioc_fanger),
+// with their provenance.  Fixtures in the same format (such as those converted from
+// ioc_fanger's own test suite) can be given with -fixtures
+//
+//go:embed fixtures.json
+var fixturesJSON []byte
+
+type Fixture struct {
+	Input    string `json:"input"`
+	Expected string `json:"expected"`
+	// A known difference from ioc_fanger's behaviour by default, which parity mode resolves
+	Difference string `json:"difference,omitempty"`
+}
+
+type Provenance struct {
+	Upstream string `json:"upstream"`
+	Source   string `json:"source"`
+	License  string `json:"license"`
+	// The upstream commit the fixtures were taken from, if they were
+	Commit string `json:"commit,omitempty"`
+}
+
+type Fixtures struct {
+	Provenance Provenance `json:"provenance"`
+	Fang       []Fixture  `json:"fang"`
+	Defang     []Fixture  `json:"defang"`
+}
+
+// ioc_fanger fangs every indicator it finds in the text, including bare domains
+// ("example[.]com"), as RefangAll does
+func fangForParity(s string) (string, error) {
+	return defang_schemes.RefangAll(s), nil
+}
+
+// ioc_fanger writes http(s) as "hXXp(s)", and leaves the scheme separator intact
+func defangForParity(s string) (string, error) {
+	defanged, err := defang_schemes.DefangURL(s)
+	if err != nil {
+		return "", err
+	}
+	defanged = strings.Replace(defanged, defang_schemes.DEFANGED_SCHEME_SEPARATOR, "://", 1)
+	if rest, ok := strings.CutPrefix(defanged, "hxxp"); ok {
+		defanged = "hXXp" + rest
+	}
+	return defanged, nil
+}
+
+// Fang or defang the fixture's input with f, reporting whether the output is as expected
+func runFixture(fixture Fixture, f func(string) (string, error)) (string, bool, error) {
+	actual, err := f(fixture.Input)
+	return actual, err == nil && actual == fixture.Expected, err
+}
+
+// The fang and defang functions of the library, or, in parity mode, as ioc_fanger does
+func modes(parity bool) (fang, defang func(string) (string, error)) {
+	if parity {
+		return fangForParity, defangForParity
+	}
+	return defang_schemes.RefangURL, func(s string) (string, error) {
+		return defang_schemes.DefangURL(s)
+	}
+}
+
+// Run each fixture, returning the number whose output matched, and the number which did
+// not match unexpectedly: in parity mode, every case should match
+func runFixtures(name string, fixtures []Fixture, f func(string) (string, error), parity bool) (int, int) {
+	matched, unexpected := 0, 0
+	for _, fixture := range fixtures {
+		actual, ok, err := runFixture(fixture, f)
+		if ok {
+			matched++
+			continue
+		}
+
+		level := "WARN"
+		if parity || fixture.Difference == "" {
+			level = "ERROR"
+			unexpected++
+		}
+		if err != nil {
+			fmt.Printf("[%s] %s %q: %s\n", level, name, fixture.Input, err)
+		} else {
+			fmt.Printf("[%s] %s %q: expected %q, got %q\n", level, name, fixture.Input, fixture.Expected, actual)
+		}
+		if fixture.Difference != "" && !parity {
+			fmt.Printf("[INFO] Known difference: %s\n", fixture.Difference)
+		}
+	}
+	fmt.Printf("[INFO] Matched %d of %d %s cases\n", matched, len(fixtures), name)
+	return matched, unexpected
+}
+
+func main() {
+	parity := flag.Bool("parity", false, "fang and defang as ioc_fanger does: fang every indicator in the text, and defang http(s) as hXXp(s) with an intact scheme separator")
+	fixturesPath := flag.String("fixtures", "", "read fixtures from this file, rather than those built in")
+	flag.Parse()
+
+	if *fixturesPath != "" {
+		var err error
+		fixturesJSON, err = os.ReadFile(*fixturesPath)
+		if err != nil {
+			fmt.Printf("[ERROR] Could not read fixtures: %s\n", err)
+			os.Exit(1)
+		}
+	}
+
+	var fixtures Fixtures
+	err := json.Unmarshal(fixturesJSON, &fixtures)
+	if err != nil {
+		fmt.Printf("[ERROR] Could not parse fixtures: %s\n", err)
+		os.Exit(1)
+	}
+
+	fang, defang := modes(*parity)
+
+	fmt.Printf("[INFO] Checking compatibility with ioc_fanger (%s: %s)\n", fixtures.Provenance.Upstream, fixtures.Provenance.Source)
+	_, unexpectedFang := runFixtures("fang", fixtures.Fang, fang, *parity)
+	_, unexpectedDefang := runFixtures("defang", fixtures.Defang, defang, *parity)
+	if unexpected := unexpectedFang + unexpectedDefang; unexpected > 0 {
+		fmt.Printf("[ERROR] %d cases did not match unexpectedly\n", unexpected)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// ioc_fanger's own test fixtures, converted to the format of fixtures.json, alongside the
+// licence they are distributed under; see testdata/README.md
+const upstreamFixturesPath = "testdata/ioc_fanger.json"
+
+// Cases in which this library differs from ioc_fanger by default, by direction and input.
+// In parity mode, every case must match
+var knownDeviations = map[string]string{
+	"fang example[.]com":                   "RefangURL requires a scheme; ioc_fanger fangs bare domains, as RefangAll does",
+	"defang http://example.com":            "DefangURL writes a lowercase scheme and brackets the scheme separator",
+	"defang https://example.com/path":      "DefangURL writes a lowercase scheme and brackets the scheme separator",
+	"defang https://sub.example.com:8080/": "DefangURL writes a lowercase scheme and brackets the scheme separator",
+}
+
+func parseFixtures(t *testing.T, data []byte) Fixtures {
+	t.Helper()
+	var fixtures Fixtures
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		t.Fatal(err)
+	}
+	return fixtures
+}
+
+// Check that the fixtures match but for the known deviations, and match in parity mode
+func checkFixtures(t *testing.T, fixtures Fixtures) {
+	t.Helper()
+	for _, parity := range []bool{false, true} {
+		fang, defang := modes(parity)
+		for _, direction := range []struct {
+			name     string
+			fixtures []Fixture
+			f        func(string) (string, error)
+		}{
+			{"fang", fixtures.Fang, fang},
+			{"defang", fixtures.Defang, defang},
+		} {
+			for _, fixture := range direction.fixtures {
+				actual, ok, err := runFixture(fixture, direction.f)
+				deviation, known := knownDeviations[direction.name+" "+fixture.Input]
+				switch {
+				case !ok && (parity || !known):
+					t.Errorf("%s %q (parity %t) = %q, %v, want %q", direction.name, fixture.Input, parity, actual, err, fixture.Expected)
+				case ok && known && !parity:
+					t.Errorf("%s %q matches ioc_fanger, but is a known deviation: %s", direction.name, fixture.Input, deviation)
+				case !parity && (fixture.Difference != "") != known:
+					t.Errorf("%s %q: the fixture's difference (%q) disagrees with knownDeviations", direction.name, fixture.Input, fixture.Difference)
+				}
+			}
+		}
+	}
+}
+
+func TestBuiltinFixtures(t *testing.T) {
+	checkFixtures(t, parseFixtures(t, fixturesJSON))
+}
+
+func TestUpstreamFixtures(t *testing.T) {
+	data, err := os.ReadFile(upstreamFixturesPath)
+	if errors.Is(err, os.ErrNotExist) {
+		t.Skipf("%s has not been vendored; see testdata/README.md", upstreamFixturesPath)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fixtures := parseFixtures(t, data)
+	if fixtures.Provenance.Commit == "" || fixtures.Provenance.License == "" {
+		t.Fatalf("%s must name the upstream commit and licence it was taken from", upstreamFixturesPath)
+	}
+	if _, err := os.Stat(filepath.Join(filepath.Dir(upstreamFixturesPath), "LICENSE.ioc_fanger")); err != nil {
+		t.Fatalf("ioc_fanger's licence must be vendored with its fixtures: %v", err)
+	}
+	checkFixtures(t, fixtures)
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+// Input sizes, in bytes, to benchmark the matchers over
+var SIZES = []int{8, 32, 128, 512, 2048, 8192, 32768, 131072}
+
+// Words of incident-report-like prose, with defanged IOCs mixed in
+var WORDS = []string{
+	"the", "beacon", "connected", "to", "host", "on", "port", "and", "downloaded", "a",
+	"second", "stage", "payload", "from", "hxxps[://]evil[.]example/stage2", "via",
+	"fxp[://]files[.]example[.]org", "see", "also", "sxh[://]10[.]0[.]0[.]1", "analyst",
+}
+
+// Generate deterministic text of (at least) the given size
+func generate(size int) string {
+	r := rand.New(rand.NewSource(int64(size)))
+	var b strings.Builder
+	for b.Len() < size {
+		b.WriteString(WORDS[r.Intn(len(WORDS))])
+		b.WriteByte(' ')
+	}
+	return b.String()[:size]
+}
+
+func nsPerOp(kind defang_schemes.MatcherKind, text string) int64 {
+	matcher := defang_schemes.NewMatcher(kind)
+	matcher.FindAll(text) // Build outside the timed loop
+	result := testing.Benchmark(func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			matcher.FindAll(text)
+		}
+	})
+	return result.NsPerOp()
+}
+
+// Time the first match, which builds the pattern or automaton
+func buildTime(kind defang_schemes.MatcherKind) time.Duration {
+	start := time.Now()
+	defang_schemes.NewMatcher(kind).FindAll("")
+	return time.Since(start)
+}
+
+func main() {
+	flag.Parse()
+
+	fmt.Println("[INFO] Timing matcher construction")
+	fmt.Printf("[INFO] regex: %v; aho-corasick: %v\n", buildTime(defang_schemes.MatcherRegex), buildTime(defang_schemes.MatcherAhoCorasick))
+
+	fmt.Println("[INFO] Benchmarking matchers over generated text")
+	fmt.Printf("%10s %14s %14s %s\n", "bytes", "regex ns/op", "aho ns/op", "faster")
+
+	var regexFaster []int
+	for _, size := range SIZES {
+		text := generate(size)
+		regex := nsPerOp(defang_schemes.MatcherRegex, text)
+		aho := nsPerOp(defang_schemes.MatcherAhoCorasick, text)
+
+		faster := defang_schemes.MatcherAhoCorasick
+		if regex < aho {
+			faster = defang_schemes.MatcherRegex
+			regexFaster = append(regexFaster, size)
+		}
+		fmt.Printf("%10d %14d %14d %s\n", size, regex, aho, faster)
+	}
+
+	if len(regexFaster) > 0 {
+		fmt.Printf("[WARN] The regex matcher was faster at sizes %v; MatcherAuto should be revisited\n", regexFaster)
+		return
+	}
+	fmt.Println("[INFO] The automaton was faster at every size, as MatcherAuto assumes")
+}
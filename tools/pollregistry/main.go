@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/nfx/go-htmltable"
+
+	"github.com/jakewilliami/defang-schemes"
+	"github.com/jakewilliami/defang-schemes/tools/internal/iana"
+)
+
+// Get file path at runtime
+// https://stackoverflow.com/a/38644571
+var (
+	_, b, _, _ = runtime.Caller(0)
+	basepath   = filepath.Dir(b)
+	rootpath   = filepath.Dir(filepath.Dir(basepath))
+)
+
+// Run a command from the base module path, echoing its output
+func runInRoot(name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Dir = rootpath
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// Regenerate the library files, and persist the resulting diff and structured changelog
+func writeUpdate(changelog defang_schemes.Changelog, patchFile, changelogFile string) error {
+	fmt.Println("[INFO] Regenerating library files")
+	err := runInRoot("go", "generate")
+	if err != nil {
+		return fmt.Errorf("could not regenerate library files: %w", err)
+	}
+
+	cmd := exec.Command("git", "diff", "--", ".")
+	cmd.Dir = rootpath
+	patch, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("could not compute patch: %w", err)
+	}
+	err = os.WriteFile(patchFile, patch, 0o644)
+	if err != nil {
+		return fmt.Errorf("could not write patch to \"%s\": %w", patchFile, err)
+	}
+	fmt.Printf("[INFO] Wrote patch for review to \"%s\"\n", patchFile)
+
+	changelogJSON, err := json.MarshalIndent(changelog, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode changelog: %w", err)
+	}
+	err = os.WriteFile(changelogFile, append(changelogJSON, '\n'), 0o644)
+	if err != nil {
+		return fmt.Errorf("could not write changelog to \"%s\": %w", changelogFile, err)
+	}
+	fmt.Printf("[INFO] Wrote changelog to \"%s\"\n", changelogFile)
+
+	return nil
+}
+
+func main() {
+	interval := flag.Duration("interval", 0, "poll IANA on this interval (e.g., 24h); if zero, poll once")
+	patchFile := flag.String("patch", filepath.Join(rootpath, "registry-update.patch"), "where to write the patch for review")
+	changelogFile := flag.String("changelog", filepath.Join(rootpath, "registry-changelog.json"), "where to write the structured changelog")
+	flag.Parse()
+
+	htmltable.Logger = func(_ context.Context, msg string, fields ...any) {}
+
+	// Compare against the dataset compiled into this tool, then against each update seen
+	baseline := defang_schemes.Schemes()
+	for {
+		fmt.Printf("[INFO] Polling %s\n", iana.URI_SCHEMES_URL)
+		fetched, err := iana.FetchSchemes()
+		if err != nil {
+			fmt.Printf("[ERROR] %s\n", err)
+			if *interval == 0 {
+				os.Exit(1)
+			}
+		} else if changelog := defang_schemes.DiffSchemes(baseline, fetched); changelog.Empty() {
+			fmt.Println("[INFO] No changes to the registry")
+		} else {
+			fmt.Printf("[INFO] Registry has changed: %d added, %d removed, %d changed\n", len(changelog.Added), len(changelog.Removed), len(changelog.Changed))
+			fmt.Print(changelog)
+			err = writeUpdate(changelog, *patchFile, *changelogFile)
+			if err != nil {
+				fmt.Printf("[ERROR] %s\n", err)
+				os.Exit(1)
+			}
+			baseline = fetched
+		}
+
+		if *interval == 0 {
+			break
+		}
+		time.Sleep(*interval)
+	}
+}
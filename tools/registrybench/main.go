@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+// Goroutines per CPU to benchmark lookups with, as with testing.B.SetParallelism
+var PARALLELISMS = []int{1, 4, 16, 64}
+
+// Schemes to look up, mixing registered and unregistered schemes and cases
+var LOOKUPS = []string{"https", "HTTP", "ftp", "ssh", "coap+tcp", "notascheme", "mailto", "z39.50s"}
+
+// A registry guarded by a read-write mutex, as a baseline for the copy-on-write Registry
+type rwRegistry struct {
+	mu      sync.RWMutex
+	schemes map[string]defang_schemes.Scheme
+}
+
+func (r *rwRegistry) Lookup(scheme string) (defang_schemes.Scheme, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	known, ok := r.schemes[strings.ToLower(strings.TrimSpace(scheme))]
+	return known, ok
+}
+
+// Time a lookup from many goroutines at once
+func nsPerLookup(parallelism int, lookup func(string) (defang_schemes.Scheme, bool)) int64 {
+	result := testing.Benchmark(func(b *testing.B) {
+		b.SetParallelism(parallelism)
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				lookup(LOOKUPS[i%len(LOOKUPS)])
+			}
+		})
+	})
+	return result.NsPerOp()
+}
+
+// Time a registration, which copies the registry
+func nsPerRegister() int64 {
+	result := testing.Benchmark(func(b *testing.B) {
+		registry := defang_schemes.NewRegistry()
+		for i := 0; i < b.N; i++ {
+			registry.Override(defang_schemes.Scheme{Scheme: "corp-sso", DefangedScheme: "cxxp-sso"})
+		}
+	})
+	return result.NsPerOp()
+}
+
+func main() {
+	flag.Parse()
+
+	registry := defang_schemes.NewRegistry()
+	baseline := &rwRegistry{schemes: defang_schemes.Schemes()}
+
+	fmt.Printf("[INFO] Benchmarking lookups from many goroutines (GOMAXPROCS=%d)\n", runtime.GOMAXPROCS(0))
+	fmt.Printf("%12s %14s %14s %14s\n", "goroutines", "global ns/op", "registry ns/op", "rwmutex ns/op")
+	for _, parallelism := range PARALLELISMS {
+		fmt.Printf("%12d %14d %14d %14d\n",
+			parallelism*runtime.GOMAXPROCS(0),
+			nsPerLookup(parallelism, defang_schemes.Lookup),
+			nsPerLookup(parallelism, registry.Lookup),
+			nsPerLookup(parallelism, baseline.Lookup),
+		)
+	}
+
+	fmt.Println("[INFO] Benchmarking registration, which copies the registry")
+	fmt.Printf("[INFO] Override: %d ns/op\n", nsPerRegister())
+}
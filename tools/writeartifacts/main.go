@@ -0,0 +1,48 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+// Get file path at runtime
+// https://stackoverflow.com/a/38644571
+var (
+	_, b, _, _ = runtime.Caller(0)
+	basepath   = filepath.Dir(b)
+	rootpath   = filepath.Dir(filepath.Dir(basepath))
+)
+
+// Write an artifact, relative to the module root
+func writeArtifact(path string, data []byte) {
+	outFile := filepath.Join(rootpath, path)
+	if err := os.MkdirAll(filepath.Dir(outFile), 0o755); err != nil {
+		fmt.Printf("[ERROR] Cannot create directory for \"%s\": %s\n", outFile, err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(outFile, data, 0o644); err != nil {
+		fmt.Printf("[ERROR] Cannot write \"%s\": %s\n", outFile, err)
+		os.Exit(1)
+	}
+	fmt.Printf("[INFO] Wrote %d bytes to \"%s\"\n", len(data), outFile)
+}
+
+func main() {
+	// This runs as a separate step after writeconsts, so that the library (and so its
+	// patterns) is built from the freshly generated dataset
+	// The dataset itself is written by writeconsts, to data/
+	var dataset bytes.Buffer
+	if err := defang_schemes.WriteDefangRules(&dataset, defang_schemes.Schemes()); err != nil {
+		fmt.Printf("[ERROR] Cannot describe defang rules: %s\n", err)
+		os.Exit(1)
+	}
+	writeArtifact(defang_schemes.ARTIFACT_DEFANG_RULES, dataset.Bytes())
+
+	writeArtifact(defang_schemes.ARTIFACT_DEFANGED_SCHEME_REGEX, []byte(defang_schemes.DefangedSchemePattern().String()+"\n"))
+	writeArtifact(defang_schemes.ARTIFACT_URL_REGEX, []byte(defang_schemes.URLPattern().String()+"\n"))
+}
@@ -2,13 +2,17 @@ package main
 
 import (
 	"bufio"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"reflect"
-	"regexp"
 	"runtime"
 	"sort"
 	"strconv"
@@ -19,6 +23,8 @@ import (
 	"github.com/nfx/go-htmltable"
 
 	"github.com/jakewilliami/defang-schemes"
+	"github.com/jakewilliami/defang-schemes/tools/internal/iana"
+	"github.com/jakewilliami/defang-schemes/urn"
 )
 
 // Get file path at runtime
@@ -29,35 +35,111 @@ var (
 	rootpath   = filepath.Dir(filepath.Dir(basepath))
 )
 
-type Scheme struct {
-	Scheme              string                `header:"URI Scheme"`
-	Template            string                `header:"Template"`
-	Description         string                `header:"Description"`
-	Status              defang_schemes.Status `header:"Status"`
-	WellKnownUriSupport string                `header:"Well-Known URI Support"`
-	Reference           string                `header:"Reference"`
-	Notes               string                `header:"Notes"`
+// Row of the IANA Well-Known URIs registry (RFC 8615)
+type WellKnownURI struct {
+	Suffix             string `header:"URI Suffix"`
+	ChangeController   string `header:"Change Controller"`
+	Reference          string `header:"Reference"`
+	Status             string `header:"Status"`
+	RelatedInformation string `header:"Related Information"`
 }
 
-func cleanNulls(scheme Scheme) Scheme {
-	val := reflect.ValueOf(&scheme).Elem()
-	for i := 0; i < val.NumField(); i++ {
-		field := val.Field(i)
-		if field.Kind() == reflect.String && field.CanSet() {
-			if field.String() == "-" {
-				field.SetString("")
-			}
+// Row of the IANA URN Namespaces registry (RFC 8141)
+type URNNamespace struct {
+	Namespace string `header:"Value"`
+	Reference string `header:"Reference"`
+	Template  string `header:"IANA Template"`
+}
+
+// Outputs that can be selected in Config.Formats, besides the scheme map itself
+var FORMATS = []string{"lazy", "minimal", "names", "styles", "indexes", "well-known", "urn", "data", "permanent"}
+
+// Generation settings, read from a JSON file checked into the repository (see
+// writeconsts.json in the module root) so that forks can customise generation from their
+// go:generate directive.  Flags given on the command line take precedence
+type Config struct {
+	// URL of the URI Scheme table
+	Source string `json:"source"`
+	// File to write the scheme map to, relative to the module root
+	Output string `json:"output"`
+	// Statuses of the schemes to include (default: all)
+	Statuses []defang_schemes.Status `json:"statuses,omitempty"`
+	// Secondary outputs to write, from FORMATS (default: all)
+	Formats []string `json:"formats,omitempty"`
+}
+
+// Read the configuration file, if any.  A missing file is only an error if it was asked
+// for by name
+func readConfig(path string, required bool) (Config, error) {
+	config := Config{Source: iana.URI_SCHEMES_URL, Output: "schemes/consts.go", Formats: FORMATS}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(rootpath, path)
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && !required {
+		return config, nil
+	}
+	if err != nil {
+		return config, err
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return config, fmt.Errorf("cannot parse %s: %w", path, err)
+	}
+	fmt.Printf("[INFO] Read configuration from %s\n", path)
+	return config, nil
+}
+
+// Check that the configuration names only statuses and formats that exist
+func (c Config) validate() error {
+	for _, status := range c.Statuses {
+		if status != defang_schemes.Permanent && status != defang_schemes.Provisional && status != defang_schemes.Historical {
+			return fmt.Errorf("unknown status \"%s\"", status)
+		}
+	}
+	for _, format := range c.Formats {
+		known := false
+		for _, f := range FORMATS {
+			known = known || format == f
+		}
+		if !known {
+			return fmt.Errorf("unknown format \"%s\" (expected one of %s)", format, strings.Join(FORMATS, ", "))
 		}
 	}
-	return scheme
+	return nil
 }
 
-var CLEAN_SCHEME_PATTERN = cleanSchemePattern()
+func (c Config) writes(format string) bool {
+	for _, f := range c.Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
 
-// Schemes from IANA can contain additional information in parentheses
-func cleanSchemePattern() *regexp.Regexp {
-	pattern := fmt.Sprintf(`^(%s)(?:\s+\((.*)\))?$`, defang_schemes.SCHEME_PATTERN)
-	return regexp.MustCompile(pattern)
+// Whether schemes with the given status are to be included
+func (c Config) includes(status defang_schemes.Status) bool {
+	if len(c.Statuses) == 0 {
+		return true
+	}
+	for _, s := range c.Statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// Split a comma-separated flag value, ignoring empty elements
+func splitList(list string) []string {
+	var elems []string
+	for _, elem := range strings.Split(list, ",") {
+		if elem = strings.TrimSpace(elem); elem != "" {
+			elems = append(elems, elem)
+		}
+	}
+	return elems
 }
 
 // Conveninence function to check for error after writing to file
@@ -68,78 +150,808 @@ func checkWriterErr(err error, file string) {
 	}
 }
 
-// Mostly, the `URI Scheme` field is good, but there is a scheme called `shttp (OBSOLETE)`,
-// which we need to clean up
-func cleanScheme(scheme Scheme) Scheme {
-	scheme = cleanNulls(scheme)
+// Write generated header
+//
+// Idea comes from Simon Sawert:
+// https://github.com/bombsimon/tld-validator/blob/c0d0fbf9/cmd/tld-generator/main.go#L19
+func writeGeneratedHeader(writer *bufio.Writer, outFile, dataName, source string) {
+	now := time.Now().Format("2006-01-02 15:04:05")
+	_, err := writer.WriteString("/*\nTHIS FILE WAS AUTOMATICALLY GENERATED AT " + now + "\n\nDo not edit this file.  Run \"go generate\" to re-generate this file with an\nupdated version of " + dataName + " from:\n    " + source + ".\n*/\n\n")
+	checkWriterErr(err, outFile)
+}
 
-	schemeRaw := scheme.Scheme
-	matches := CLEAN_SCHEME_PATTERN.FindStringSubmatch(schemeRaw)
+// Report the size of, and run `go fmt` on, a freshly written output file
+func formatFile(outFile string) {
+	fileInfo, err := os.Stat(outFile)
+	if err == nil {
+		fmt.Printf("[INFO] Wrote %d bytes to \"%s\"\n", fileInfo.Size(), outFile)
+	}
+
+	// TODO: Would like to do this without calling to external command
+	// Consider using: https://github.com/mvdan/gofumpt
+	cmd := exec.Command("go", "fmt", outFile)
+	err = cmd.Run()
+	if err != nil {
+		fmt.Printf("[WARNING] Failed to run `go fmt` on output file \"%s\": %s\n", outFile, err)
+	} else {
+		fmt.Printf("[INFO] Successfully ran `go fmt` on output file \"%s\"\n", outFile)
+	}
+}
+
+// The Well-Known URIs registry uses lower case status values, unlike the URI schemes
+// registry, so we normalise them to our Status type
+func parseWellKnownStatus(status string) defang_schemes.Status {
+	status = strings.TrimSpace(status)
+	if status == "" {
+		return ""
+	}
+	return defang_schemes.Status(strings.ToUpper(status[:1]) + strings.ToLower(status[1:]))
+}
+
+// Write the secondary dataset of well-known URI suffixes, so that schemes declaring
+// well-known URI support can be linked to the suffixes they may serve
+func writeWellKnownConsts(pkgName string) {
+	dataMapName := "WellKnownMap"
+	outFile := filepath.Join(rootpath, pkgName, "well_known_consts.go")
 
-	if matches == nil || len(matches) == 0 {
-		fmt.Printf("[ERROR] Invalid scheme for \"%s\"\n", schemeRaw)
+	// Get Well-Known URIs table from IANA (based on RFC 8615)
+	url := "https://www.iana.org/assignments/well-known-uris/well-known-uris.xhtml"
+	table, err := htmltable.NewSliceFromURL[WellKnownURI](url)
+	if err != nil {
+		fmt.Printf("[ERROR] Could not get table by %s: %s\n", url, err)
 		os.Exit(1)
 	}
 
-	// Set the first match to the URI scheme
-	// NOTE: we start counting from 1 because the first element is the entire match
-	scheme.Scheme = matches[1]
+	// Collect well-known URIs into a map
+	wellKnownMap := make(map[string]defang_schemes.WellKnownURI, len(table))
+	for _, row := range table {
+		wellKnown := defang_schemes.WellKnownURI{
+			Suffix:             strings.TrimSpace(row.Suffix),
+			ChangeController:   row.ChangeController,
+			Reference:          row.Reference,
+			Status:             parseWellKnownStatus(row.Status),
+			RelatedInformation: row.RelatedInformation,
+		}
+		if wellKnown.RelatedInformation == "-" {
+			wellKnown.RelatedInformation = ""
+		}
+		err := (&wellKnown).Validate()
+		if err != nil {
+			fmt.Printf("[ERROR] Invalid WellKnownURI struct: %s; WellKnownURI: %+v\n", err, row)
+			os.Exit(1)
+		}
+		wellKnownMap[wellKnown.Suffix] = wellKnown
+	}
 
-	// If the URI scheme holds additional information, add it to notes
-	if len(matches) > 2 && matches[2] != "" {
-		scheme.Notes = matches[2]
+	// Create a sorted list of suffixes
+	suffixes := make([]string, 0, len(wellKnownMap))
+	for suffix := range wellKnownMap {
+		suffixes = append(suffixes, suffix)
 	}
+	sort.Strings(suffixes)
 
-	// Confirm we don't have any unhandled matching information
-	if len(matches) > 3 {
-		fmt.Printf("[ERROR] Unhandled matching groups in scheme regex for \"%s\"\n", schemeRaw)
+	file, err := os.Create(outFile)
+	if err != nil {
+		fmt.Printf("[ERROR] Cannot open file \"%s\": %s\n", outFile, err)
 		os.Exit(1)
 	}
+	defer file.Close()
 
-	// Ensure scheme is lowercase
-	scheme.Scheme = strings.ToLower(scheme.Scheme)
+	writer := bufio.NewWriter(file)
 
-	// Return the (potentially modified) scheme
-	return scheme
-}
+	_, err = writer.WriteString(fmt.Sprintf("package %s\n\n", pkgName))
+	checkWriterErr(err, outFile)
 
-func main() {
-	fmt.Printf("[INFO] Found base module path at %s\n", rootpath)
+	writeGeneratedHeader(writer, outFile, "well-known URIs", "iana.org/assignments/well-known-uris/well-known-uris.xhtml")
 
-	htmltable.Logger = func(_ context.Context, msg string, fields ...any) {
-		fmt.Printf("[INFO] %s %v\n", msg, fields)
+	_, err = writer.WriteString("func init() {\n" + dataMapName + " = map[string]WellKnownURI{\n")
+	checkWriterErr(err, outFile)
+
+	for _, suffix := range suffixes {
+		wellKnown := wellKnownMap[suffix]
+		_, err = writer.WriteString(fmt.Sprintf("%s: WellKnownURI{\nSuffix: %s,\nChangeController: %s,\nReference: %s,\nStatus: %s,\nRelatedInformation: %s,\n},\n", strconv.Quote(suffix), strconv.Quote(suffix), strconv.Quote(wellKnown.ChangeController), strconv.Quote(wellKnown.Reference), wellKnown.Status, strconv.Quote(wellKnown.RelatedInformation)))
+		checkWriterErr(err, outFile)
 	}
 
-	// Get URI Scheme table from IANA (based on RFC 7595)
-	// https://stackoverflow.com/a/42289198
-	url := "https://www.iana.org/assignments/uri-schemes/uri-schemes.xhtml"
-	table, err := htmltable.NewSliceFromURL[Scheme](url)
+	_, err = writer.WriteString("}\n}\n")
+	checkWriterErr(err, outFile)
+
+	err = writer.Flush()
 	if err != nil {
-		fmt.Printf("[ERROR] Could not get table by %s: %s\n", url, err)
+		fmt.Printf("[ERROR] Could not flush file writer: %s", err)
 		os.Exit(1)
 	}
 
-	// Collect URI schemes into a map
-	schemeMap := make(map[string]defang_schemes.Scheme, len(table))
-	for i := 0; i < len(table); i++ {
-		scheme := cleanScheme(table[i])
+	formatFile(outFile)
+}
+
+// Write the optional URN namespace dataset into the urn subpackage
+func writeURNConsts() {
+	pkgName := "urn"
+	dataMapName := "Map"
+	outFile := filepath.Join(rootpath, "urn", "consts.go")
+
+	// Get URN Namespaces table from IANA (based on RFC 8141)
+	url := "https://www.iana.org/assignments/urn-namespaces/urn-namespaces.xhtml"
+	table, err := htmltable.NewSliceFromURL[URNNamespace](url)
+	if err != nil {
+		fmt.Printf("[ERROR] Could not get table by %s: %s\n", url, err)
+		os.Exit(1)
+	}
 
-		schemeMap[scheme.Scheme] = defang_schemes.Scheme{
-			Scheme:              scheme.Scheme,
-			DefangedScheme:      defang_schemes.DefangScheme(scheme.Scheme),
-			Template:            scheme.Template,
-			Description:         scheme.Description,
-			Status:              scheme.Status,
-			WellKnownUriSupport: scheme.WellKnownUriSupport,
-			Reference:           scheme.Reference,
-			Notes:               scheme.Notes,
+	// Collect URN namespaces into a map, keyed by lower case namespace identifier
+	namespaceMap := make(map[string]urn.Namespace, len(table))
+	for _, row := range table {
+		namespace := urn.Namespace{
+			Namespace: strings.ToLower(strings.TrimSpace(row.Namespace)),
+			Reference: row.Reference,
+			Template:  row.Template,
 		}
-		schemeToValidate := schemeMap[scheme.Scheme]
-		err := (&schemeToValidate).Validate()
+		if namespace.Template == "-" {
+			namespace.Template = ""
+		}
+		err := (&namespace).Validate()
 		if err != nil {
-			fmt.Printf("[ERROR] Invalid Scheme struct: %s; Scheme: %+v\n", err, scheme)
+			fmt.Printf("[ERROR] Invalid Namespace struct: %s; Namespace: %+v\n", err, row)
 			os.Exit(1)
 		}
+		namespaceMap[namespace.Namespace] = namespace
+	}
+
+	// Create a sorted list of namespace identifiers
+	nids := make([]string, 0, len(namespaceMap))
+	for nid := range namespaceMap {
+		nids = append(nids, nid)
+	}
+	sort.Strings(nids)
+
+	file, err := os.Create(outFile)
+	if err != nil {
+		fmt.Printf("[ERROR] Cannot open file \"%s\": %s\n", outFile, err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+
+	_, err = writer.WriteString(fmt.Sprintf("package %s\n\n", pkgName))
+	checkWriterErr(err, outFile)
+
+	writeGeneratedHeader(writer, outFile, "URN namespaces", "iana.org/assignments/urn-namespaces/urn-namespaces.xhtml")
+
+	_, err = writer.WriteString("func init() {\n" + dataMapName + " = map[string]Namespace{\n")
+	checkWriterErr(err, outFile)
+
+	for _, nid := range nids {
+		namespace := namespaceMap[nid]
+		_, err = writer.WriteString(fmt.Sprintf("%s: Namespace{\nNamespace: %s,\nReference: %s,\nTemplate: %s,\n},\n", strconv.Quote(nid), strconv.Quote(nid), strconv.Quote(namespace.Reference), strconv.Quote(namespace.Template)))
+		checkWriterErr(err, outFile)
+	}
+
+	_, err = writer.WriteString("}\n}\n")
+	checkWriterErr(err, outFile)
+
+	err = writer.Flush()
+	if err != nil {
+		fmt.Printf("[ERROR] Could not flush file writer: %s", err)
+		os.Exit(1)
+	}
+
+	formatFile(outFile)
+}
+
+// Write the entries of a scheme map literal, sorted by key.  The qualifier is prepended
+// to identifiers from the base library (e.g., "defang_schemes.") when writing to
+// another package
+func writeSchemeMapEntries(writer *bufio.Writer, outFile string, schemeMap map[string]defang_schemes.Scheme, keys []string, qualifier string) {
+	for _, key := range keys {
+		scheme := schemeMap[key]
+		// Only set the fields that few schemes have
+		optional := ""
+		if scheme.Obsolete {
+			optional += "Obsolete: true,\n"
+		}
+		if len(scheme.Related) > 0 {
+			optional += fmt.Sprintf("Related: %#v,\n", scheme.Related)
+		}
+		if len(scheme.Examples) > 0 {
+			optional += fmt.Sprintf("Examples: %#v,\n", scheme.Examples)
+		}
+		_, err := writer.WriteString(fmt.Sprintf("\"%s\": %sScheme{\nScheme: \"%s\",\nDefangedScheme: \"%s\",\nTemplate: %s,\nDescription: %s,\nStatus: %s%s,\nWellKnownUriSupport: %s,\nReference: %s,\nNotes: %s,\n%s},\n", scheme.Scheme, qualifier, scheme.Scheme, scheme.DefangedScheme, strconv.Quote(scheme.Template), strconv.Quote(scheme.Description), qualifier, scheme.Status, strconv.Quote(scheme.WellKnownUriSupport), strconv.Quote(scheme.Reference), strconv.Quote(scheme.Notes), optional))
+		checkWriterErr(err, outFile)
+	}
+}
+
+// Write a dated snapshot of the dataset into the snapshots subpackage, so that results
+// can be reproduced against the registry as it existed at the time.  The snapshot is a
+// function returning a map literal, so that it is only built if selected at runtime
+func writeSnapshot(name string, schemeMap map[string]defang_schemes.Scheme, keys []string) {
+	outFile := filepath.Join(rootpath, "snapshots", "snapshot_"+name+".go")
+	funcName := "snapshot_" + name
+
+	file, err := os.Create(outFile)
+	if err != nil {
+		fmt.Printf("[ERROR] Cannot open file \"%s\": %s\n", outFile, err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+
+	_, err = writer.WriteString("package snapshots\n\nimport \"github.com/jakewilliami/defang-schemes\"\n\n")
+	checkWriterErr(err, outFile)
+
+	writeGeneratedHeader(writer, outFile, "URI schemes", "iana.org/assignments/uri-schemes/uri-schemes.xhtml")
+
+	_, err = writer.WriteString(fmt.Sprintf("func init() {\nregister(%s, %s)\n}\n\nfunc %s() map[string]defang_schemes.Scheme {\nreturn map[string]defang_schemes.Scheme{\n", strconv.Quote(name), funcName, funcName))
+	checkWriterErr(err, outFile)
+
+	writeSchemeMapEntries(writer, outFile, schemeMap, keys, "defang_schemes.")
+
+	_, err = writer.WriteString("}\n}\n")
+	checkWriterErr(err, outFile)
+
+	err = writer.Flush()
+	if err != nil {
+		fmt.Printf("[ERROR] Could not flush file writer: %s", err)
+		os.Exit(1)
+	}
+
+	formatFile(outFile)
+}
+
+// Write the Permanent schemes into the permanent subpackage, keeping the defanged forms of
+// the full dataset, so that text defanged with either package refangs with the other
+func writePermanentConsts(schemeMap map[string]defang_schemes.Scheme, keys []string) {
+	outFile := filepath.Join(rootpath, "permanent", "consts.go")
+
+	file, err := os.Create(outFile)
+	if err != nil {
+		fmt.Printf("[ERROR] Cannot open file \"%s\": %s\n", outFile, err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+
+	_, err = writer.WriteString("package permanent\n\nimport \"github.com/jakewilliami/defang-schemes\"\n\n")
+	checkWriterErr(err, outFile)
+
+	writeGeneratedHeader(writer, outFile, "permanent URI schemes", "iana.org/assignments/uri-schemes/uri-schemes.xhtml")
+
+	_, err = writer.WriteString("func init() {\nMap = map[string]defang_schemes.Scheme{\n")
+	checkWriterErr(err, outFile)
+
+	permanentKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if schemeMap[key].Status == defang_schemes.Permanent {
+			permanentKeys = append(permanentKeys, key)
+		}
+	}
+	writeSchemeMapEntries(writer, outFile, schemeMap, permanentKeys, "defang_schemes.")
+
+	_, err = writer.WriteString("}\n}\n")
+	checkWriterErr(err, outFile)
+
+	err = writer.Flush()
+	if err != nil {
+		fmt.Printf("[ERROR] Could not flush file writer: %s", err)
+		os.Exit(1)
+	}
+
+	formatFile(outFile)
+}
+
+// Write the scheme map as data/schemes.json and data/schemes.csv, from which the data
+// package (and the library's Artifacts) embed them, so that non-Go consumers can vendor the dataset, and Go consumers
+// can embed it without the map literal.  The JSON is also written gzipped, for builds with
+// the embed build tag.  These are written from the fetched schemes, so unlike the
+// artifacts, do not wait for the library to be rebuilt
+func writeDataFiles(schemeMap map[string]defang_schemes.Scheme) {
+	for _, file := range []struct {
+		name  string
+		write func(io.Writer, map[string]defang_schemes.Scheme) error
+	}{
+		{"schemes.json", defang_schemes.WriteJSON},
+		{"schemes.csv", defang_schemes.WriteCSV},
+		{"schemes.json.gz", writeGzippedJSON},
+	} {
+		outFile := filepath.Join(rootpath, "data", file.name)
+		var b bytes.Buffer
+		if err := file.write(&b, schemeMap); err != nil {
+			fmt.Printf("[ERROR] Cannot encode \"%s\": %s\n", outFile, err)
+			os.Exit(1)
+		}
+		if err := os.WriteFile(outFile, b.Bytes(), 0o644); err != nil {
+			fmt.Printf("[ERROR] Cannot write file \"%s\": %s\n", outFile, err)
+			os.Exit(1)
+		}
+		fmt.Printf("[INFO] Wrote %d bytes to \"%s\"\n", b.Len(), outFile)
+	}
+}
+
+// As WriteJSON, compressed.  The gzip header carries no name or time, so the output only
+// changes with the dataset
+func writeGzippedJSON(w io.Writer, schemeMap map[string]defang_schemes.Scheme) error {
+	gz, err := gzip.NewWriterLevel(w, gzip.BestCompression)
+	if err != nil {
+		return err
+	}
+	if err := defang_schemes.WriteJSON(gz, schemeMap); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// Build tag selecting the lazily-parsed string blob over the map literal
+const lazyBuildTag = "defang_schemes_lazy"
+
+// Build tag selecting the gzipped JSON dataset, embedded from data/schemes.json.gz, over
+// both the map literal and the string blob
+const embedBuildTag = "defang_schemes_embed"
+
+// Build tag selecting the map literal without descriptive fields, over all of the above
+const minimalBuildTag = "defang_schemes_minimal"
+
+// Build constraints of the map literal (and the secondary outputs derived from it), of the
+// string blob, and of the minimal map literal
+var (
+	eagerBuildConstraint   = fmt.Sprintf("!%s && !%s && !%s", lazyBuildTag, embedBuildTag, minimalBuildTag)
+	lazyBuildConstraint    = fmt.Sprintf("%s && !%s && !%s", lazyBuildTag, embedBuildTag, minimalBuildTag)
+	minimalBuildConstraint = minimalBuildTag
+)
+
+// Separators used in the string blob; these must match those in lazy_blob.go
+const (
+	blobFieldSeparator  = "\x1f"
+	blobRecordSeparator = "\x1e"
+)
+
+// Write the dataset as one large string constant, parsed into structs on first access
+// in builds with the lazy build tag.  This avoids the thousands of small allocations
+// made at package initialisation by the map literal, which matter for short-lived CLIs
+func writeLazyConsts(pkgName string, schemeMap map[string]defang_schemes.Scheme, keys []string) {
+	outFile := filepath.Join(rootpath, pkgName, "consts_lazy.go")
+
+	file, err := os.Create(outFile)
+	if err != nil {
+		fmt.Printf("[ERROR] Cannot open file \"%s\": %s\n", outFile, err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+
+	_, err = writer.WriteString(fmt.Sprintf("//go:build %s\n\npackage %s\n\n", lazyBuildConstraint, pkgName))
+	checkWriterErr(err, outFile)
+
+	writeGeneratedHeader(writer, outFile, "URI schemes", "iana.org/assignments/uri-schemes/uri-schemes.xhtml")
+
+	_, err = writer.WriteString("func init() {\nschemeBlob = \"\" +\n")
+	checkWriterErr(err, outFile)
+
+	for i, key := range keys {
+		scheme := schemeMap[key]
+		record := strings.Join(scheme.Record(), blobFieldSeparator) + blobRecordSeparator
+
+		terminator := " +\n"
+		if i == len(keys)-1 {
+			terminator = "\n"
+		}
+		_, err = writer.WriteString(strconv.Quote(record) + terminator)
+		checkWriterErr(err, outFile)
+	}
+
+	_, err = writer.WriteString("}\n")
+	checkWriterErr(err, outFile)
+
+	err = writer.Flush()
+	if err != nil {
+		fmt.Printf("[ERROR] Could not flush file writer: %s", err)
+		os.Exit(1)
+	}
+
+	formatFile(outFile)
+}
+
+// Write the dataset as a map literal of only the fields needed to defang and refang
+// (Scheme, DefangedScheme, and Status), for builds with the minimal build tag.  Without the
+// descriptive fields, or the generated styles and indexes (which are computed on first
+// access instead), these builds are markedly smaller
+func writeMinimalConsts(pkgName string, schemeMap map[string]defang_schemes.Scheme, keys []string) {
+	outFile := filepath.Join(rootpath, pkgName, "consts_minimal.go")
+
+	file, err := os.Create(outFile)
+	if err != nil {
+		fmt.Printf("[ERROR] Cannot open file \"%s\": %s\n", outFile, err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+
+	_, err = writer.WriteString(fmt.Sprintf("//go:build %s\n\npackage %s\n\n", minimalBuildConstraint, pkgName))
+	checkWriterErr(err, outFile)
+
+	writeGeneratedHeader(writer, outFile, "URI schemes", "iana.org/assignments/uri-schemes/uri-schemes.xhtml")
+
+	_, err = writer.WriteString("func init() {\nMap = map[string]Scheme{\n")
+	checkWriterErr(err, outFile)
+
+	for _, key := range keys {
+		scheme := schemeMap[key]
+		_, err = writer.WriteString(fmt.Sprintf("%s: {Scheme: %s, DefangedScheme: %s, Status: %s},\n", strconv.Quote(key), strconv.Quote(scheme.Scheme), strconv.Quote(scheme.DefangedScheme), scheme.Status))
+		checkWriterErr(err, outFile)
+	}
+
+	_, err = writer.WriteString("}\n}\n")
+	checkWriterErr(err, outFile)
+
+	err = writer.Flush()
+	if err != nil {
+		fmt.Printf("[ERROR] Could not flush file writer: %s", err)
+		os.Exit(1)
+	}
+
+	formatFile(outFile)
+}
+
+// Write the auxiliary indexes over scheme names (see defang_schemes.SchemeIndex)
+func writeIndexConsts(pkgName string, keys []string) {
+	outFile := filepath.Join(rootpath, pkgName, "index_consts.go")
+
+	file, err := os.Create(outFile)
+	if err != nil {
+		fmt.Printf("[ERROR] Cannot open file \"%s\": %s\n", outFile, err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+
+	// Lazy builds compute these on first access instead
+	_, err = writer.WriteString(fmt.Sprintf("//go:build %s\n\npackage %s\n\n", eagerBuildConstraint, pkgName))
+	checkWriterErr(err, outFile)
+
+	writeGeneratedHeader(writer, outFile, "indexes of URI schemes", "iana.org/assignments/uri-schemes/uri-schemes.xhtml")
+
+	index := defang_schemes.NewSchemeIndex(keys)
+	quoted := func(names []string) string {
+		elems := make([]string, len(names))
+		for i, name := range names {
+			elems[i] = strconv.Quote(name)
+		}
+		return "{" + strings.Join(elems, ", ") + "}"
+	}
+
+	_, err = writer.WriteString("func init() {\nschemeIndexData = &SchemeIndex{\nByLength: map[int][]string{\n")
+	checkWriterErr(err, outFile)
+	lengths := make([]int, 0, len(index.ByLength))
+	for n := range index.ByLength {
+		lengths = append(lengths, n)
+	}
+	sort.Ints(lengths)
+	for _, n := range lengths {
+		_, err = writer.WriteString(fmt.Sprintf("%d: %s,\n", n, quoted(index.ByLength[n])))
+		checkWriterErr(err, outFile)
+	}
+
+	_, err = writer.WriteString("},\nByFirstLetter: map[byte][]string{\n")
+	checkWriterErr(err, outFile)
+	letters := make([]int, 0, len(index.ByFirstLetter))
+	for c := range index.ByFirstLetter {
+		letters = append(letters, int(c))
+	}
+	sort.Ints(letters)
+	for _, c := range letters {
+		_, err = writer.WriteString(fmt.Sprintf("%s: %s,\n", strconv.QuoteRune(rune(c)), quoted(index.ByFirstLetter[byte(c)])))
+		checkWriterErr(err, outFile)
+	}
+
+	_, err = writer.WriteString("},\nByPrefix: map[string][]string{\n")
+	checkWriterErr(err, outFile)
+	prefixes := make([]string, 0, len(index.ByPrefix))
+	for prefix := range index.ByPrefix {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	for _, prefix := range prefixes {
+		_, err = writer.WriteString(fmt.Sprintf("%s: %s,\n", strconv.Quote(prefix), quoted(index.ByPrefix[prefix])))
+		checkWriterErr(err, outFile)
+	}
+
+	_, err = writer.WriteString("},\n}\n}\n")
+	checkWriterErr(err, outFile)
+
+	err = writer.Flush()
+	if err != nil {
+		fmt.Printf("[ERROR] Could not flush file writer: %s", err)
+		os.Exit(1)
+	}
+
+	formatFile(outFile)
+}
+
+// Write the defanged form of each scheme in each style other than StyleHxx (which is the
+// DefangedScheme field)
+func writeStyleConsts(pkgName string, keys []string) {
+	outFile := filepath.Join(rootpath, pkgName, "styles_consts.go")
+
+	file, err := os.Create(outFile)
+	if err != nil {
+		fmt.Printf("[ERROR] Cannot open file \"%s\": %s\n", outFile, err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+
+	// Lazy builds compute these on first access instead
+	_, err = writer.WriteString(fmt.Sprintf("//go:build %s\n\npackage %s\n\n", eagerBuildConstraint, pkgName))
+	checkWriterErr(err, outFile)
+
+	writeGeneratedHeader(writer, outFile, "defanged forms of URI schemes by style", "iana.org/assignments/uri-schemes/uri-schemes.xhtml")
+
+	_, err = writer.WriteString("func init() {\nstyledDefangedSchemeMap = map[Style]map[string]string{\n")
+	checkWriterErr(err, outFile)
+
+	for _, style := range defang_schemes.STYLES {
+		if style == defang_schemes.StyleHxx {
+			continue
+		}
+
+		_, err = writer.WriteString(fmt.Sprintf("Style%s: {\n", styleConstSuffix(style)))
+		checkWriterErr(err, outFile)
+		for _, key := range keys {
+			form := defang_schemes.DefangScheme(key, style.Options()...)
+			_, err = writer.WriteString(fmt.Sprintf("%s: %s,\n", strconv.Quote(key), strconv.Quote(form)))
+			checkWriterErr(err, outFile)
+		}
+		_, err = writer.WriteString("},\n")
+		checkWriterErr(err, outFile)
+	}
+
+	_, err = writer.WriteString("}\n}\n")
+	checkWriterErr(err, outFile)
+
+	err = writer.Flush()
+	if err != nil {
+		fmt.Printf("[ERROR] Could not flush file writer: %s", err)
+		os.Exit(1)
+	}
+
+	formatFile(outFile)
+}
+
+// Name of the constant for a scheme, with the given prefix: SCREAMING_SNAKE_CASE, with
+// the additional allowed characters replaced by underscores (e.g., "coap+tcp" →
+// "SCHEME_COAP_TCP")
+func schemeConstName(prefix, scheme string) string {
+	name := defang_schemes.AdditionalAllowedSchemeCharsPattern().ReplaceAllString(scheme, "_")
+	return prefix + strings.ToUpper(name)
+}
+
+// Write a constant for the name and defanged form of every scheme, so that code (such as
+// detection rules) can reference them without magic strings.  These are not excluded from
+// lazy builds
+func writeSchemeNameConsts(pkgName string, schemeMap map[string]defang_schemes.Scheme, keys []string) {
+	outFile := filepath.Join(rootpath, pkgName, "scheme_consts.go")
+
+	file, err := os.Create(outFile)
+	if err != nil {
+		fmt.Printf("[ERROR] Cannot open file \"%s\": %s\n", outFile, err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+
+	_, err = writer.WriteString(fmt.Sprintf("package %s\n\n", pkgName))
+	checkWriterErr(err, outFile)
+
+	writeGeneratedHeader(writer, outFile, "URI scheme names", "iana.org/assignments/uri-schemes/uri-schemes.xhtml")
+
+	writeCountConsts(writer, outFile, schemeMap)
+
+	_, err = writer.WriteString("// Registered URI schemes\nconst (\n")
+	checkWriterErr(err, outFile)
+
+	seen := make(map[string]string, len(keys))
+	for _, key := range keys {
+		name := schemeConstName("SCHEME_", key)
+		if other, exists := seen[name]; exists {
+			fmt.Printf("[ERROR] Schemes \"%s\" and \"%s\" would both be named %s\n", other, key, name)
+			os.Exit(1)
+		}
+		seen[name] = key
+
+		_, err = writer.WriteString(fmt.Sprintf("%s = %s\n", name, strconv.Quote(key)))
+		checkWriterErr(err, outFile)
+	}
+
+	_, err = writer.WriteString(")\n\n// Defanged forms of the registered URI schemes\nconst (\n")
+	checkWriterErr(err, outFile)
+
+	for _, key := range keys {
+		_, err = writer.WriteString(fmt.Sprintf("%s = %s\n", schemeConstName("DEFANGED_SCHEME_", key), strconv.Quote(schemeMap[key].DefangedScheme)))
+		checkWriterErr(err, outFile)
+	}
+
+	_, err = writer.WriteString(")\n\n")
+	checkWriterErr(err, outFile)
+
+	writeDefangedSchemeNames(writer, outFile, schemeMap)
+
+	err = writer.Flush()
+	if err != nil {
+		fmt.Printf("[ERROR] Could not flush file writer: %s", err)
+		os.Exit(1)
+	}
+
+	formatFile(outFile)
+}
+
+// Re-export the constants of writeSchemeNameConsts from the library's root package, which
+// re-exports the common API of its packages
+func writeSchemeNameReexports(pkgName string, keys []string) {
+	outFile := filepath.Join(rootpath, "scheme_consts.go")
+
+	file, err := os.Create(outFile)
+	if err != nil {
+		fmt.Printf("[ERROR] Cannot open file \"%s\": %s\n", outFile, err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+
+	_, err = writer.WriteString(fmt.Sprintf("package defang_schemes\n\nimport \"github.com/jakewilliami/defang-schemes/%s\"\n\n", pkgName))
+	checkWriterErr(err, outFile)
+
+	writeGeneratedHeader(writer, outFile, "URI scheme names", "iana.org/assignments/uri-schemes/uri-schemes.xhtml")
+
+	_, err = writer.WriteString("// Number of registered URI schemes, in total and by status\nconst (\n")
+	checkWriterErr(err, outFile)
+	for _, name := range []string{"NumSchemes", "NumPermanent", "NumProvisional", "NumHistorical"} {
+		_, err = writer.WriteString(fmt.Sprintf("%s = %s.%s\n", name, pkgName, name))
+		checkWriterErr(err, outFile)
+	}
+
+	for _, group := range []struct{ doc, prefix string }{
+		{"Registered URI schemes", "SCHEME_"},
+		{"Defanged forms of the registered URI schemes", "DEFANGED_SCHEME_"},
+	} {
+		_, err = writer.WriteString(fmt.Sprintf(")\n\n// %s\nconst (\n", group.doc))
+		checkWriterErr(err, outFile)
+		for _, key := range keys {
+			name := schemeConstName(group.prefix, key)
+			_, err = writer.WriteString(fmt.Sprintf("%s = %s.%s\n", name, pkgName, name))
+			checkWriterErr(err, outFile)
+		}
+	}
+
+	_, err = writer.WriteString(fmt.Sprintf(")\n\n// Defanged forms of all registered URI schemes, sorted and deduplicated\nvar DefangedSchemeNames = %s.DefangedSchemeNames\n", pkgName))
+	checkWriterErr(err, outFile)
+
+	err = writer.Flush()
+	if err != nil {
+		fmt.Printf("[ERROR] Could not flush file writer: %s", err)
+		os.Exit(1)
+	}
+
+	formatFile(outFile)
+}
+
+// Write the number of schemes, in total and by status, for pre-sizing slices and maps
+func writeCountConsts(writer *bufio.Writer, outFile string, schemeMap map[string]defang_schemes.Scheme) {
+	counts := make(map[defang_schemes.Status]int)
+	for _, scheme := range schemeMap {
+		counts[scheme.Status]++
+	}
+
+	_, err := writer.WriteString(fmt.Sprintf(`// Number of registered URI schemes, in total and by status
+const (
+	NumSchemes     = %d
+	NumPermanent   = %d
+	NumProvisional = %d
+	NumHistorical  = %d
+)
+
+`, len(schemeMap), counts[defang_schemes.Permanent], counts[defang_schemes.Provisional], counts[defang_schemes.Historical]))
+	checkWriterErr(err, outFile)
+}
+
+// Write the complete defanged vocabulary as a sorted, deduplicated slice.  Defanged forms
+// that are also registered schemes (i.e., hxxp[s]) are annotated
+func writeDefangedSchemeNames(writer *bufio.Writer, outFile string, schemeMap map[string]defang_schemes.Scheme) {
+	defangedFrom := make(map[string][]string)
+	for _, scheme := range schemeMap {
+		defangedFrom[scheme.DefangedScheme] = append(defangedFrom[scheme.DefangedScheme], scheme.Scheme)
+	}
+	defangedNames := make([]string, 0, len(defangedFrom))
+	for defanged := range defangedFrom {
+		defangedNames = append(defangedNames, defanged)
+	}
+	sort.Strings(defangedNames)
+
+	_, err := writer.WriteString("// Defanged forms of all registered URI schemes, sorted and deduplicated\nvar DefangedSchemeNames = []string{\n")
+	checkWriterErr(err, outFile)
+
+	for _, defanged := range defangedNames {
+		annotation := ""
+		if scheme, ok := schemeMap[defanged]; ok {
+			sort.Strings(defangedFrom[defanged])
+			annotation = fmt.Sprintf(" // Defanged form of %s, but also a registered (%s) scheme", strings.Join(defangedFrom[defanged], ", "), strings.ToLower(string(scheme.Status)))
+		}
+		_, err = writer.WriteString(fmt.Sprintf("%s,%s\n", strconv.Quote(defanged), annotation))
+		checkWriterErr(err, outFile)
+	}
+
+	_, err = writer.WriteString("}\n")
+	checkWriterErr(err, outFile)
+}
+
+// Name of the style's constant, less the "Style" prefix (e.g., "Brackets")
+func styleConstSuffix(style defang_schemes.Style) string {
+	name := style.String()
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func main() {
+	snapshot := flag.String("snapshot", "", "also write a dated snapshot of the dataset with the given name (e.g., "+time.Now().Format("2006_01")+")")
+	configPath := flag.String("config", "writeconsts.json", "JSON file of generation settings, relative to the module root")
+	source := flag.String("source", "", "URL of the URI Scheme table (overrides the configuration)")
+	output := flag.String("output", "", "file to write the scheme map to (overrides the configuration)")
+	statuses := flag.String("statuses", "", "comma-separated statuses of schemes to include (overrides the configuration)")
+	formats := flag.String("formats", "", "comma-separated secondary outputs to write, from "+strings.Join(FORMATS, ", ")+" (overrides the configuration)")
+	flag.Parse()
+
+	fmt.Printf("[INFO] Found base module path at %s\n", rootpath)
+
+	// Read configuration, with explicitly set flags taking precedence
+	configRequired := false
+	flag.Visit(func(f *flag.Flag) {
+		configRequired = configRequired || f.Name == "config"
+	})
+	config, err := readConfig(*configPath, configRequired)
+	if err != nil {
+		fmt.Printf("[ERROR] Cannot read configuration: %s\n", err)
+		os.Exit(1)
+	}
+	if *source != "" {
+		config.Source = *source
+	}
+	if *output != "" {
+		config.Output = *output
+	}
+	if *statuses != "" {
+		config.Statuses = nil
+		for _, status := range splitList(*statuses) {
+			config.Statuses = append(config.Statuses, defang_schemes.Status(status))
+		}
+	}
+	if *formats != "" {
+		config.Formats = splitList(*formats)
+	}
+	if err := config.validate(); err != nil {
+		fmt.Printf("[ERROR] Invalid configuration: %s\n", err)
+		os.Exit(1)
+	}
+
+	htmltable.Logger = func(_ context.Context, msg string, fields ...any) {
+		fmt.Printf("[INFO] %s %v\n", msg, fields)
+	}
+
+	// Get URI schemes from IANA
+	schemeMap, err := iana.FetchSchemesFrom(config.Source)
+	if err != nil {
+		fmt.Printf("[ERROR] %s\n", err)
+		os.Exit(1)
+	}
+	for name, scheme := range schemeMap {
+		if !config.includes(scheme.Status) {
+			delete(schemeMap, name)
+		}
 	}
 
 	// Create a sorted list of schemes
@@ -154,9 +966,12 @@ func main() {
 	// Write to Go file
 	// TODO: document this section
 	// TODO: get package meta info dynamically
-	pkgName := "defang_schemes"
+	pkgName := "schemes"
 	dataMapName := "Map"
-	outFile := filepath.Join(rootpath, "consts.go")
+	outFile := config.Output
+	if !filepath.IsAbs(outFile) {
+		outFile = filepath.Join(rootpath, outFile)
+	}
 
 	file, err := os.Create(outFile)
 	if err != nil {
@@ -167,28 +982,20 @@ func main() {
 
 	writer := bufio.NewWriter(file)
 
-	// Write consts package header
-	_, err = writer.WriteString(fmt.Sprintf("package %s\n\n", pkgName))
+	// Write consts package header, excluding the map literal from lazy builds
+	_, err = writer.WriteString(fmt.Sprintf("//go:build %s\n\npackage %s\n\n", eagerBuildConstraint, pkgName))
 	checkWriterErr(err, outFile)
 
 	// Write generated header
-	// Idea comes from Simon Sawert:
-	// https://github.com/bombsimon/tld-validator/blob/c0d0fbf9/cmd/tld-generator/main.go#L19
-	now := time.Now().Format("2006-01-02 15:04:05")
-	_, err = writer.WriteString("/*\nTHIS FILE WAS AUTOMATICALLY GENERATED AT " + now + "\n\nDo not edit this file.  Run \"go generate\" to re-generate this file with an\nupdated version of URI schemes from:\n    iana.org/assignments/uri-schemes/uri-schemes.xhtml.\n*/\n\n")
-	checkWriterErr(err, outFile)
+	writeGeneratedHeader(writer, outFile, "URI schemes", "iana.org/assignments/uri-schemes/uri-schemes.xhtml")
 
 	// Write map
-	_, err = writer.WriteString("var " + dataMapName + " = map[string]Scheme{\n")
+	_, err = writer.WriteString("func init() {\n" + dataMapName + " = map[string]Scheme{\n")
 	checkWriterErr(err, outFile)
 
-	for _, key := range schemeKeyVec {
-		scheme := schemeMap[key]
-		_, err = writer.WriteString(fmt.Sprintf("\"%s\": Scheme{\nScheme: \"%s\",\nDefangedScheme: \"%s\",\nTemplate: %s,\nDescription: %s,\nStatus: %s,\nWellKnownUriSupport: %s,\nReference: %s,\nNotes: %s,\n},\n", scheme.Scheme, scheme.Scheme, scheme.DefangedScheme, strconv.Quote(scheme.Template), strconv.Quote(scheme.Description), scheme.Status, strconv.Quote(scheme.WellKnownUriSupport), strconv.Quote(scheme.Reference), strconv.Quote(scheme.Notes)))
-		checkWriterErr(err, outFile)
-	}
+	writeSchemeMapEntries(writer, outFile, schemeMap, schemeKeyVec, "")
 
-	_, err = writer.WriteString("}\n\n")
+	_, err = writer.WriteString("}\n}\n")
 	checkWriterErr(err, outFile)
 
 	err = writer.Flush()
@@ -197,18 +1004,52 @@ func main() {
 		os.Exit(1)
 	}
 
-	fileInfo, err := os.Stat(outFile)
-	if err == nil {
-		fmt.Printf("[INFO] Wrote %d bytes to \"%s\"\n", fileInfo.Size(), outFile)
+	formatFile(outFile)
+
+	// Write alternative representation of the dataset
+	if config.writes("lazy") {
+		writeLazyConsts(pkgName, schemeMap, schemeKeyVec)
+	}
+	if config.writes("minimal") {
+		writeMinimalConsts(pkgName, schemeMap, schemeKeyVec)
 	}
 
-	// TODO: Would like to do this without calling to external command
-	// Consider using: https://github.com/mvdan/gofumpt
-	cmd := exec.Command("go", "fmt", outFile)
-	err = cmd.Run()
-	if err != nil {
-		fmt.Printf("[WARNING] Failed to run `go fmt` on output file \"%s\": %s\n", outFile, err)
-	} else {
-		fmt.Printf("[INFO] Successfully ran `go fmt` on output file \"%s\"\n", outFile)
+	// Write scheme name and defanged form constants
+	if config.writes("names") {
+		writeSchemeNameConsts(pkgName, schemeMap, schemeKeyVec)
+		writeSchemeNameReexports(pkgName, schemeKeyVec)
+	}
+
+	// Write defanged forms in other styles
+	if config.writes("styles") {
+		writeStyleConsts(pkgName, schemeKeyVec)
+	}
+
+	// Write indexes over scheme names
+	if config.writes("indexes") {
+		writeIndexConsts(pkgName, schemeKeyVec)
+	}
+
+	// Write the dataset for embedding and vendoring
+	if config.writes("data") {
+		writeDataFiles(schemeMap)
+	}
+
+	// Write the subset of Permanent schemes
+	if config.writes("permanent") {
+		writePermanentConsts(schemeMap, schemeKeyVec)
+	}
+
+	// Write snapshot, if requested
+	if *snapshot != "" {
+		writeSnapshot(*snapshot, schemeMap, schemeKeyVec)
+	}
+
+	// Write secondary datasets
+	if config.writes("well-known") {
+		writeWellKnownConsts(pkgName)
+	}
+	if config.writes("urn") {
+		writeURNConsts()
 	}
 }
@@ -0,0 +1,179 @@
+package urn
+
+/*
+THIS FILE WAS AUTOMATICALLY GENERATED AT 2026-10-16 03:55:29
+
+Do not edit this file.  Run "go generate" to re-generate this file with an
+updated version of URN namespaces from:
+    iana.org/assignments/urn-namespaces/urn-namespaces.xhtml.
+*/
+
+func init() {
+	Map = map[string]Namespace{
+		"3gpp": Namespace{
+			Namespace: "3gpp",
+			Reference: "[RFC5279]",
+			Template:  "",
+		},
+		"adid": Namespace{
+			Namespace: "adid",
+			Reference: "[RFC8091]",
+			Template:  "",
+		},
+		"cgi": Namespace{
+			Namespace: "cgi",
+			Reference: "[RFC5165]",
+			Template:  "",
+		},
+		"dvb": Namespace{
+			Namespace: "dvb",
+			Reference: "[RFC7354]",
+			Template:  "",
+		},
+		"epc": Namespace{
+			Namespace: "epc",
+			Reference: "[RFC5134]",
+			Template:  "",
+		},
+		"epcglobal": Namespace{
+			Namespace: "epcglobal",
+			Reference: "[RFC5134]",
+			Template:  "",
+		},
+		"eu": Namespace{
+			Namespace: "eu",
+			Reference: "[RFC7207]",
+			Template:  "",
+		},
+		"geant": Namespace{
+			Namespace: "geant",
+			Reference: "[RFC4926]",
+			Template:  "",
+		},
+		"ietf": Namespace{
+			Namespace: "ietf",
+			Reference: "[RFC2648]",
+			Template:  "",
+		},
+		"isbn": Namespace{
+			Namespace: "isbn",
+			Reference: "[RFC8254]",
+			Template:  "",
+		},
+		"iso": Namespace{
+			Namespace: "iso",
+			Reference: "[RFC5141]",
+			Template:  "",
+		},
+		"issn": Namespace{
+			Namespace: "issn",
+			Reference: "[RFC8254]",
+			Template:  "",
+		},
+		"lex": Namespace{
+			Namespace: "lex",
+			Reference: "[RFC9532]",
+			Template:  "",
+		},
+		"mace": Namespace{
+			Namespace: "mace",
+			Reference: "[RFC3613]",
+			Template:  "",
+		},
+		"mpeg": Namespace{
+			Namespace: "mpeg",
+			Reference: "[RFC3614]",
+			Template:  "",
+		},
+		"nbn": Namespace{
+			Namespace: "nbn",
+			Reference: "[RFC8458]",
+			Template:  "",
+		},
+		"nena": Namespace{
+			Namespace: "nena",
+			Reference: "[RFC6061]",
+			Template:  "",
+		},
+		"newsml": Namespace{
+			Namespace: "newsml",
+			Reference: "[RFC3085]",
+			Template:  "",
+		},
+		"nfc": Namespace{
+			Namespace: "nfc",
+			Reference: "[RFC4729]",
+			Template:  "",
+		},
+		"nzl": Namespace{
+			Namespace: "nzl",
+			Reference: "[RFC4350]",
+			Template:  "",
+		},
+		"oasis": Namespace{
+			Namespace: "oasis",
+			Reference: "[RFC3121]",
+			Template:  "",
+		},
+		"ogc": Namespace{
+			Namespace: "ogc",
+			Reference: "[RFC5165]",
+			Template:  "",
+		},
+		"oid": Namespace{
+			Namespace: "oid",
+			Reference: "[RFC3061]",
+			Template:  "",
+		},
+		"oipf": Namespace{
+			Namespace: "oipf",
+			Reference: "[RFC6893]",
+			Template:  "",
+		},
+		"publicid": Namespace{
+			Namespace: "publicid",
+			Reference: "[RFC3151]",
+			Template:  "",
+		},
+		"s1000d": Namespace{
+			Namespace: "s1000d",
+			Reference: "[RFC4801]",
+			Template:  "",
+		},
+		"service": Namespace{
+			Namespace: "service",
+			Reference: "[RFC5031]",
+			Template:  "",
+		},
+		"tva": Namespace{
+			Namespace: "tva",
+			Reference: "[RFC4195]",
+			Template:  "",
+		},
+		"uci": Namespace{
+			Namespace: "uci",
+			Reference: "[RFC4179]",
+			Template:  "",
+		},
+		"ucode": Namespace{
+			Namespace: "ucode",
+			Reference: "[RFC6588]",
+			Template:  "",
+		},
+		"uuid": Namespace{
+			Namespace: "uuid",
+			Reference: "[RFC9562]",
+			Template:  "",
+		},
+		"web3d": Namespace{
+			Namespace: "web3d",
+			Reference: "[RFC3541]",
+			Template:  "",
+		},
+		"xmlorg": Namespace{
+			Namespace: "xmlorg",
+			Reference: "[RFC3120]",
+			Template:  "",
+		},
+	}
+}
@@ -0,0 +1,95 @@
+// Optional dataset of URN namespaces, with defang support for URNs
+//
+// URNs (RFC 8141) share the "urn" scheme, so defanging the scheme alone says nothing
+// about the namespace.  This package vendors the IANA URN namespace registry so that
+// URN IOCs can be defanged and refanged with their namespace intact:
+//
+//	urn:uuid:6e8bc430-9c3a-11d9-9669-0800200c9a66 → urn[:]uuid[:]6e8bc430-9c3a-11d9-9669-0800200c9a66
+//
+// defang.DefangURL, and the text functions of the extract package, defang well-formed URNs
+// with Defang
+package urn
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/jakewilliami/defang-schemes/internal/ascii"
+)
+
+// A URN namespace registered with IANA
+// https://www.iana.org/assignments/urn-namespaces/urn-namespaces.xhtml
+type Namespace struct {
+	Namespace string `validate:"required"`
+	Reference string
+	Template  string
+}
+
+// Validate Namespace struct
+func (n *Namespace) Validate() error {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	return validate.Struct(n)
+}
+
+// Registered URN namespaces, keyed by namespace identifier, assigned by the generated
+// consts.go
+var Map map[string]Namespace
+
+const SCHEME = "urn"
+
+// Separator between the components of a URN, and its defanged variant
+const (
+	SEPARATOR          = ":"
+	DEFANGED_SEPARATOR = "[:]"
+)
+
+// Namespace identifiers are 2–32 characters, per RFC 8141, section 2
+// https://www.rfc-editor.org/rfc/rfc8141#section-2
+var NID_PATTERN = regexp.MustCompile(`^[[:alnum:]][[:alnum:]-]{0,30}[[:alnum:]]$`)
+
+var ErrNotURN = errors.New("not a URN")
+
+// Look up a registered URN namespace.  Namespace identifiers are case-insensitive
+func Lookup(nid string) (Namespace, bool) {
+	namespace, ok := Map[ascii.ToLower(nid)]
+	return namespace, ok
+}
+
+// Split a URN into its namespace identifier and namespace-specific string
+func split(s string, separator string) (string, string, error) {
+	parts := strings.SplitN(s, separator, 3)
+	if len(parts) != 3 || ascii.ToLower(parts[0]) != SCHEME {
+		return "", "", fmt.Errorf("%w: %q", ErrNotURN, s)
+	}
+	if !NID_PATTERN.MatchString(parts[1]) || parts[2] == "" {
+		return "", "", fmt.Errorf("%w: %q", ErrNotURN, s)
+	}
+	return parts[1], parts[2], nil
+}
+
+// Defang a URN by bracketing the separators after the scheme and namespace identifier:
+//
+//	Defang("urn:isbn:0451450523") == "urn[:]isbn[:]0451450523"
+//
+// The namespace identifier need not be registered, but the URN must be well-formed.  The
+// scheme keeps the case it is written in
+func Defang(s string) (string, error) {
+	nid, nss, err := split(s, SEPARATOR)
+	if err != nil {
+		return "", err
+	}
+	return s[:len(SCHEME)] + DEFANGED_SEPARATOR + nid + DEFANGED_SEPARATOR + nss, nil
+}
+
+// Refang a URN defanged by Defang, with a lowercase scheme
+func Refang(s string) (string, error) {
+	nid, nss, err := split(s, DEFANGED_SEPARATOR)
+	if err != nil {
+		return "", err
+	}
+	return SCHEME + SEPARATOR + nid + SEPARATOR + nss, nil
+}
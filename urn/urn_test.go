@@ -0,0 +1,55 @@
+package urn_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jakewilliami/defang-schemes/urn"
+)
+
+func TestDefangRoundTrip(t *testing.T) {
+	cases := []struct {
+		input, defanged, refanged string
+	}{
+		{"urn:isbn:0451450523", "urn[:]isbn[:]0451450523", "urn:isbn:0451450523"},
+		{"urn:uuid:6e8bc430-9c3a-11d9-9669-0800200c9a66", "urn[:]uuid[:]6e8bc430-9c3a-11d9-9669-0800200c9a66", "urn:uuid:6e8bc430-9c3a-11d9-9669-0800200c9a66"},
+		{"urn:ietf:rfc:2648", "urn[:]ietf[:]rfc:2648", "urn:ietf:rfc:2648"},
+		{"urn:example:a?+r?=q#f", "urn[:]example[:]a?+r?=q#f", "urn:example:a?+r?=q#f"},
+		// Unregistered namespaces are defanged too
+		{"urn:not-registered:x", "urn[:]not-registered[:]x", "urn:not-registered:x"},
+		// The scheme keeps its case when defanged, and is lowercase when refanged
+		{"URN:ISBN:0451450523", "URN[:]ISBN[:]0451450523", "urn:ISBN:0451450523"},
+	}
+	for _, c := range cases {
+		defanged, err := urn.Defang(c.input)
+		if err != nil || defanged != c.defanged {
+			t.Errorf("Defang(%q) = %q, %v, want %q", c.input, defanged, err, c.defanged)
+			continue
+		}
+		if refanged, err := urn.Refang(defanged); err != nil || refanged != c.refanged {
+			t.Errorf("Refang(%q) = %q, %v, want %q", defanged, refanged, err, c.refanged)
+		}
+	}
+}
+
+func TestNotURN(t *testing.T) {
+	for _, input := range []string{"", "urn:", "urn:isbn", "urn:isbn:", "urn:x:y", "urn:-isbn:x", "http://example.com", "urnx:isbn:x"} {
+		if defanged, err := urn.Defang(input); !errors.Is(err, urn.ErrNotURN) {
+			t.Errorf("Defang(%q) = %q, %v, want ErrNotURN", input, defanged, err)
+		}
+	}
+	if refanged, err := urn.Refang("urn:isbn:0451450523"); !errors.Is(err, urn.ErrNotURN) {
+		t.Errorf("Refang(%q) = %q, %v, want ErrNotURN", "urn:isbn:0451450523", refanged, err)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	for _, nid := range []string{"uuid", "UUID", "Isbn"} {
+		if _, ok := urn.Lookup(nid); !ok {
+			t.Errorf("Lookup(%q) found no namespace", nid)
+		}
+	}
+	if namespace, ok := urn.Lookup("not-registered"); ok {
+		t.Errorf("Lookup(%q) = %+v", "not-registered", namespace)
+	}
+}
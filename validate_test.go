@@ -0,0 +1,42 @@
+package defang_schemes_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jakewilliami/defang-schemes"
+	"github.com/jakewilliami/defang-schemes/check"
+)
+
+// Registry.Validate reports the same collisions as the check package, with and without an
+// allowlist
+func TestValidateAgreesWithCheck(t *testing.T) {
+	registry := defang_schemes.NewRegistry(defang_schemes.WithCollisionPolicy(defang_schemes.CollisionWarn), defang_schemes.WithWarningHandler(func(defang_schemes.Warning) {}))
+	registry.Register(defang_schemes.Scheme{Scheme: "foo", DefangedScheme: "gopher"})
+	registry.Register(defang_schemes.Scheme{Scheme: "bar", DefangedScheme: "gxpher"})
+
+	for _, allow := range [][]string{nil, {"foo", "gopher"}} {
+		var validateOpts []defang_schemes.ValidateOption
+		var checkOpts []check.Option
+		if allow != nil {
+			validateOpts = append(validateOpts, defang_schemes.WithAllowedCollisions(allow...))
+			checkOpts = append(checkOpts, check.WithAllowed(allow...))
+		}
+
+		var errs []error
+		if err := registry.Validate(validateOpts...); err != nil {
+			errs = err.(interface{ Unwrap() []error }).Unwrap()
+		}
+		failures := check.Registry(registry, checkOpts...).Failures()
+		if len(errs) != len(failures) {
+			t.Errorf("allowing %v: Validate found %d collisions, but the check package found %d", allow, len(errs), len(failures))
+			continue
+		}
+		for i, failure := range failures {
+			var collision *defang_schemes.DefangCollisionError
+			if !errors.As(errs[i], &collision) || collision.Scheme != failure.Scheme || collision.Defanged != failure.Defanged {
+				t.Errorf("allowing %v: Validate found %q, but the check package found %q", allow, errs[i], failure)
+			}
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package defang_schemes_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jakewilliami/defang-schemes"
+)
+
+func TestWindowsLocations(t *testing.T) {
+	cases := []struct {
+		input             string
+		windowsPath, hive bool
+	}{
+		{`C:\Users\Public\svc.exe`, true, false},
+		{"c:/windows/temp/svc.dll", true, false},
+		{"D:", true, false},
+		{`HKLM:\Software\Run`, false, true},
+		{`hkcu\Environment`, false, true},
+		{`HKEY_CLASSES_ROOT\htmlfile`, false, true},
+		{"https://evil.test/", false, false},
+		{"c:evil", false, false},
+		{"HKLMX\\Software", false, false},
+	}
+	for _, c := range cases {
+		if got := defang_schemes.IsWindowsPath(c.input); got != c.windowsPath {
+			t.Errorf("IsWindowsPath(%q) = %v, want %v", c.input, got, c.windowsPath)
+		}
+		if got := defang_schemes.IsRegistryKey(c.input); got != c.hive {
+			t.Errorf("IsRegistryKey(%q) = %v, want %v", c.input, got, c.hive)
+		}
+		if !c.windowsPath && !c.hive {
+			continue
+		}
+
+		// Windows locations are neither taken for schemes nor defanged as URLs
+		if scheme, ok := defang_schemes.ExtractScheme(c.input); ok {
+			t.Errorf("ExtractScheme(%q) = %q, want none", c.input, scheme)
+		}
+		if defanged, err := defang_schemes.DefangURL(c.input); !errors.Is(err, defang_schemes.ErrMissingScheme) {
+			t.Errorf("DefangURL(%q) = %q, %v, want ErrMissingScheme", c.input, defanged, err)
+		}
+	}
+}
+
+// Local device paths are not UNC paths to a host
+func TestDefangIndicatorLocalDevicePath(t *testing.T) {
+	for _, path := range []string{`\\.\pipe\msagent_12`, `\\?\D:\staging`} {
+		if defanged, kind, err := defang_schemes.DefangIndicator(path); err == nil {
+			t.Errorf("DefangIndicator(%q) = %q (%s), want an error", path, defanged, kind)
+		}
+	}
+}
+
+// Drive letters, registry hives, and local device paths in incident report text are left
+// alone, while the indicators beside them are defanged
+func TestDefangTextWindowsLocations(t *testing.T) {
+	report := `Dropper written to C:\Users\Public\svc.exe (also seen as c:/windows/temp/svc.dll) and ` +
+		`persisted via HKLM:\Software\Microsoft\Windows\CurrentVersion\Run and ` +
+		`HKEY_CURRENT_USER\Environment; it read \\.\pipe\msagent_12 and \\?\D:\staging, ` +
+		`then beaconed to https://evil.test/gate.php and \\fs01.corp.test\c$\drop.exe`
+	want := `Dropper written to C:\Users\Public\svc.exe (also seen as c:/windows/temp/svc.dll) and ` +
+		`persisted via HKLM:\Software\Microsoft\Windows\CurrentVersion\Run and ` +
+		`HKEY_CURRENT_USER\Environment; it read \\.\pipe\msagent_12 and \\?\D:\staging, ` +
+		`then beaconed to hxxps[://]evil[.]test/gate.php and \\fs01.corp.test\c$\drop.exe`
+	if defanged := defang_schemes.DefangText(report); defanged != want {
+		t.Errorf("DefangText(%q) = %q, want %q", report, defanged, want)
+	}
+}